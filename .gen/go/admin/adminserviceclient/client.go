@@ -172,6 +172,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	RefreshTaskList(
+		ctx context.Context,
+		Request *admin.RefreshTaskListRequest,
+		opts ...yarpc.CallOption,
+	) (*admin.RefreshTaskListResponse, error)
+
 	RefreshWorkflowTasks(
 		ctx context.Context,
 		Request *shared.RefreshWorkflowTasksRequest,
@@ -196,6 +202,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	ResetTaskListAckLevel(
+		ctx context.Context,
+		Request *admin.ResetTaskListAckLevelRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	RespondCrossClusterTasksCompleted(
 		ctx context.Context,
 		Request *shared.RespondCrossClusterTasksCompletedRequest,
@@ -871,6 +883,34 @@ func (c client) ReapplyEvents(
 	return
 }
 
+func (c client) RefreshTaskList(
+	ctx context.Context,
+	_Request *admin.RefreshTaskListRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.RefreshTaskListResponse, err error) {
+
+	var result admin.AdminService_RefreshTaskList_Result
+	args := admin.AdminService_RefreshTaskList_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	success, err = admin.AdminService_RefreshTaskList_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RefreshWorkflowTasks(
 	ctx context.Context,
 	_Request *shared.RefreshWorkflowTasksRequest,
@@ -983,6 +1023,34 @@ func (c client) ResetQueue(
 	return
 }
 
+func (c client) ResetTaskListAckLevel(
+	ctx context.Context,
+	_Request *admin.ResetTaskListAckLevelRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	var result admin.AdminService_ResetTaskListAckLevel_Result
+	args := admin.AdminService_ResetTaskListAckLevel_Helper.Args(_Request)
+
+	if c.nwc != nil && c.nwc.Enabled() {
+		if err = c.nwc.Call(ctx, args, &result, opts...); err != nil {
+			return
+		}
+	} else {
+		var body wire.Value
+		if body, err = c.c.Call(ctx, args, opts...); err != nil {
+			return
+		}
+
+		if err = result.FromWire(body); err != nil {
+			return
+		}
+	}
+
+	err = admin.AdminService_ResetTaskListAckLevel_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) RespondCrossClusterTasksCompleted(
 	ctx context.Context,
 	_Request *shared.RespondCrossClusterTasksCompletedRequest,
@@ -150,6 +150,11 @@ type Interface interface {
 		ReapplyEventsRequest *shared.ReapplyEventsRequest,
 	) error
 
+	RefreshTaskList(
+		ctx context.Context,
+		Request *admin.RefreshTaskListRequest,
+	) (*admin.RefreshTaskListResponse, error)
+
 	RefreshWorkflowTasks(
 		ctx context.Context,
 		Request *shared.RefreshWorkflowTasksRequest,
@@ -170,6 +175,11 @@ type Interface interface {
 		Request *shared.ResetQueueRequest,
 	) error
 
+	ResetTaskListAckLevel(
+		ctx context.Context,
+		Request *admin.ResetTaskListAckLevelRequest,
+	) error
+
 	RespondCrossClusterTasksCompleted(
 		ctx context.Context,
 		Request *shared.RespondCrossClusterTasksCompletedRequest,
@@ -471,6 +481,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "RefreshTaskList",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.RefreshTaskList),
+					NoWire: refreshtasklist_NoWireHandler{impl},
+				},
+				Signature:    "RefreshTaskList(Request *admin.RefreshTaskListRequest) (*admin.RefreshTaskListResponse)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RefreshWorkflowTasks",
 				HandlerSpec: thrift.HandlerSpec{
@@ -519,6 +541,18 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 				ThriftModule: admin.ThriftModule,
 			},
 
+			thrift.Method{
+				Name: "ResetTaskListAckLevel",
+				HandlerSpec: thrift.HandlerSpec{
+
+					Type:   transport.Unary,
+					Unary:  thrift.UnaryHandler(h.ResetTaskListAckLevel),
+					NoWire: resettasklistacklevel_NoWireHandler{impl},
+				},
+				Signature:    "ResetTaskListAckLevel(Request *admin.ResetTaskListAckLevelRequest)",
+				ThriftModule: admin.ThriftModule,
+			},
+
 			thrift.Method{
 				Name: "RespondCrossClusterTasksCompleted",
 				HandlerSpec: thrift.HandlerSpec{
@@ -581,7 +615,7 @@ func New(impl Interface, opts ...thrift.RegisterOption) []transport.Procedure {
 		},
 	}
 
-	procedures := make([]transport.Procedure, 0, 31)
+	procedures := make([]transport.Procedure, 0, 33)
 	procedures = append(procedures, thrift.BuildProcedures(service, opts...)...)
 	return procedures
 }
@@ -1252,6 +1286,36 @@ func (h handler) ReapplyEvents(ctx context.Context, body wire.Value) (thrift.Res
 	return response, err
 }
 
+func (h handler) RefreshTaskList(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_RefreshTaskList_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'RefreshTaskList': %w", err)
+	}
+
+	success, appErr := h.impl.RefreshTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RefreshTaskList_Helper.WrapResponse(success, appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) RefreshWorkflowTasks(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_RefreshWorkflowTasks_Args
 	if err := args.FromWire(body); err != nil {
@@ -1372,6 +1436,36 @@ func (h handler) ResetQueue(ctx context.Context, body wire.Value) (thrift.Respon
 	return response, err
 }
 
+func (h handler) ResetTaskListAckLevel(ctx context.Context, body wire.Value) (thrift.Response, error) {
+	var args admin.AdminService_ResetTaskListAckLevel_Args
+	if err := args.FromWire(body); err != nil {
+		return thrift.Response{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode Thrift request for service 'AdminService' procedure 'ResetTaskListAckLevel': %w", err)
+	}
+
+	appErr := h.impl.ResetTaskListAckLevel(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ResetTaskListAckLevel_Helper.WrapResponse(appErr)
+
+	var response thrift.Response
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+
+	return response, err
+}
+
 func (h handler) RespondCrossClusterTasksCompleted(ctx context.Context, body wire.Value) (thrift.Response, error) {
 	var args admin.AdminService_RespondCrossClusterTasksCompleted_Args
 	if err := args.FromWire(body); err != nil {
@@ -2336,6 +2430,43 @@ func (h reapplyevents_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thri
 
 }
 
+type refreshtasklist_NoWireHandler struct{ impl Interface }
+
+func (h refreshtasklist_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_RefreshTaskList_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'RefreshTaskList': %w", err)
+	}
+
+	success, appErr := h.impl.RefreshTaskList(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_RefreshTaskList_Helper.WrapResponse(success, appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type refreshworkflowtasks_NoWireHandler struct{ impl Interface }
 
 func (h refreshworkflowtasks_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -2484,6 +2615,43 @@ func (h resetqueue_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.
 
 }
 
+type resettasklistacklevel_NoWireHandler struct{ impl Interface }
+
+func (h resettasklistacklevel_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
+	var (
+		args admin.AdminService_ResetTaskListAckLevel_Args
+		rw   stream.ResponseWriter
+		err  error
+	)
+
+	rw, err = nwc.RequestReader.ReadRequest(ctx, nwc.EnvelopeType, nwc.Reader, &args)
+	if err != nil {
+		return thrift.NoWireResponse{}, yarpcerrors.InvalidArgumentErrorf(
+			"could not decode (via no wire) Thrift request for service 'AdminService' procedure 'ResetTaskListAckLevel': %w", err)
+	}
+
+	appErr := h.impl.ResetTaskListAckLevel(ctx, args.Request)
+
+	hadError := appErr != nil
+	result, err := admin.AdminService_ResetTaskListAckLevel_Helper.WrapResponse(appErr)
+	response := thrift.NoWireResponse{ResponseWriter: rw}
+	if err == nil {
+		response.IsApplicationError = hadError
+		response.Body = result
+		if namer, ok := appErr.(yarpcErrorNamer); ok {
+			response.ApplicationErrorName = namer.YARPCErrorName()
+		}
+		if extractor, ok := appErr.(yarpcErrorCoder); ok {
+			response.ApplicationErrorCode = extractor.YARPCErrorCode()
+		}
+		if appErr != nil {
+			response.ApplicationErrorDetails = appErr.Error()
+		}
+	}
+	return response, err
+
+}
+
 type respondcrossclustertaskscompleted_NoWireHandler struct{ impl Interface }
 
 func (h respondcrossclustertaskscompleted_NoWireHandler) HandleNoWire(ctx context.Context, nwc *thrift.NoWireCall) (thrift.NoWireResponse, error) {
@@ -784,6 +784,39 @@ func (mr *_MockClientRecorder) ReapplyEvents(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ReapplyEvents", args...)
 }
 
+// RefreshTaskList responds to a RefreshTaskList call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().RefreshTaskList(gomock.Any(), ...).Return(...)
+//	... := client.RefreshTaskList(...)
+func (m *MockClient) RefreshTaskList(
+	ctx context.Context,
+	_Request *admin.RefreshTaskListRequest,
+	opts ...yarpc.CallOption,
+) (success *admin.RefreshTaskListResponse, err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "RefreshTaskList", args...)
+	success, _ = ret[i].(*admin.RefreshTaskListResponse)
+	i++
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) RefreshTaskList(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "RefreshTaskList", args...)
+}
+
 // RefreshWorkflowTasks responds to a RefreshWorkflowTasks call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
@@ -908,6 +941,37 @@ func (mr *_MockClientRecorder) ResetQueue(
 	return mr.mock.ctrl.RecordCall(mr.mock, "ResetQueue", args...)
 }
 
+// ResetTaskListAckLevel responds to a ResetTaskListAckLevel call based on the mock expectations. This
+// call will fail if the mock does not expect this call. Use EXPECT to expect
+// a call to this function.
+//
+//	client.EXPECT().ResetTaskListAckLevel(gomock.Any(), ...).Return(...)
+//	... := client.ResetTaskListAckLevel(...)
+func (m *MockClient) ResetTaskListAckLevel(
+	ctx context.Context,
+	_Request *admin.ResetTaskListAckLevelRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := []interface{}{ctx, _Request}
+	for _, o := range opts {
+		args = append(args, o)
+	}
+	i := 0
+	ret := m.ctrl.Call(m, "ResetTaskListAckLevel", args...)
+	err, _ = ret[i].(error)
+	return
+}
+
+func (mr *_MockClientRecorder) ResetTaskListAckLevel(
+	ctx interface{},
+	_Request interface{},
+	opts ...interface{},
+) *gomock.Call {
+	args := append([]interface{}{ctx, _Request}, opts...)
+	return mr.mock.ctrl.RecordCall(mr.mock, "ResetTaskListAckLevel", args...)
+}
+
 // RespondCrossClusterTasksCompleted responds to a RespondCrossClusterTasksCompleted call based on the mock expectations. This
 // call will fail if the mock does not expect this call. Use EXPECT to expect
 // a call to this function.
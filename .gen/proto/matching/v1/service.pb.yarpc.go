@@ -51,9 +51,13 @@ type MatchingAPIYARPCClient interface {
 	QueryWorkflow(context.Context, *QueryWorkflowRequest, ...yarpc.CallOption) (*QueryWorkflowResponse, error)
 	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest, ...yarpc.CallOption) (*RespondQueryTaskCompletedResponse, error)
 	CancelOutstandingPoll(context.Context, *CancelOutstandingPollRequest, ...yarpc.CallOption) (*CancelOutstandingPollResponse, error)
+	DescribePoller(context.Context, *DescribePollerRequest, ...yarpc.CallOption) (*DescribePollerResponse, error)
 	DescribeTaskList(context.Context, *DescribeTaskListRequest, ...yarpc.CallOption) (*DescribeTaskListResponse, error)
 	ListTaskListPartitions(context.Context, *ListTaskListPartitionsRequest, ...yarpc.CallOption) (*ListTaskListPartitionsResponse, error)
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest, ...yarpc.CallOption) (*GetTaskListsByDomainResponse, error)
+	GetTaskListConfig(context.Context, *GetTaskListConfigRequest, ...yarpc.CallOption) (*GetTaskListConfigResponse, error)
+	ResetTaskListAckLevel(context.Context, *ResetTaskListAckLevelRequest, ...yarpc.CallOption) (*ResetTaskListAckLevelResponse, error)
+	RefreshTaskList(context.Context, *RefreshTaskListRequest, ...yarpc.CallOption) (*RefreshTaskListResponse, error)
 }
 
 func newMatchingAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) MatchingAPIYARPCClient {
@@ -81,9 +85,13 @@ type MatchingAPIYARPCServer interface {
 	QueryWorkflow(context.Context, *QueryWorkflowRequest) (*QueryWorkflowResponse, error)
 	RespondQueryTaskCompleted(context.Context, *RespondQueryTaskCompletedRequest) (*RespondQueryTaskCompletedResponse, error)
 	CancelOutstandingPoll(context.Context, *CancelOutstandingPollRequest) (*CancelOutstandingPollResponse, error)
+	DescribePoller(context.Context, *DescribePollerRequest) (*DescribePollerResponse, error)
 	DescribeTaskList(context.Context, *DescribeTaskListRequest) (*DescribeTaskListResponse, error)
 	ListTaskListPartitions(context.Context, *ListTaskListPartitionsRequest) (*ListTaskListPartitionsResponse, error)
 	GetTaskListsByDomain(context.Context, *GetTaskListsByDomainRequest) (*GetTaskListsByDomainResponse, error)
+	GetTaskListConfig(context.Context, *GetTaskListConfigRequest) (*GetTaskListConfigResponse, error)
+	ResetTaskListAckLevel(context.Context, *ResetTaskListAckLevelRequest) (*ResetTaskListAckLevelResponse, error)
+	RefreshTaskList(context.Context, *RefreshTaskListRequest) (*RefreshTaskListResponse, error)
 }
 
 type buildMatchingAPIYARPCProceduresParams struct {
@@ -167,6 +175,16 @@ func buildMatchingAPIYARPCProcedures(params buildMatchingAPIYARPCProceduresParam
 						},
 					),
 				},
+				{
+					MethodName: "DescribePoller",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.DescribePoller,
+							NewRequest:  newMatchingAPIServiceDescribePollerYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 				{
 					MethodName: "DescribeTaskList",
 					Handler: protobuf.NewUnaryHandler(
@@ -197,6 +215,36 @@ func buildMatchingAPIYARPCProcedures(params buildMatchingAPIYARPCProceduresParam
 						},
 					),
 				},
+				{
+					MethodName: "GetTaskListConfig",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.GetTaskListConfig,
+							NewRequest:  newMatchingAPIServiceGetTaskListConfigYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "ResetTaskListAckLevel",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.ResetTaskListAckLevel,
+							NewRequest:  newMatchingAPIServiceResetTaskListAckLevelYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+				{
+					MethodName: "RefreshTaskList",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.RefreshTaskList,
+							NewRequest:  newMatchingAPIServiceRefreshTaskListYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
 			},
 			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
 			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
@@ -399,6 +447,18 @@ func (c *_MatchingAPIYARPCCaller) CancelOutstandingPoll(ctx context.Context, req
 	return response, err
 }
 
+func (c *_MatchingAPIYARPCCaller) DescribePoller(ctx context.Context, request *DescribePollerRequest, options ...yarpc.CallOption) (*DescribePollerResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "DescribePoller", request, newMatchingAPIServiceDescribePollerYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*DescribePollerResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceDescribePollerYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 func (c *_MatchingAPIYARPCCaller) DescribeTaskList(ctx context.Context, request *DescribeTaskListRequest, options ...yarpc.CallOption) (*DescribeTaskListResponse, error) {
 	responseMessage, err := c.streamClient.Call(ctx, "DescribeTaskList", request, newMatchingAPIServiceDescribeTaskListYARPCResponse, options...)
 	if responseMessage == nil {
@@ -435,6 +495,42 @@ func (c *_MatchingAPIYARPCCaller) GetTaskListsByDomain(ctx context.Context, requ
 	return response, err
 }
 
+func (c *_MatchingAPIYARPCCaller) GetTaskListConfig(ctx context.Context, request *GetTaskListConfigRequest, options ...yarpc.CallOption) (*GetTaskListConfigResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "GetTaskListConfig", request, newMatchingAPIServiceGetTaskListConfigYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*GetTaskListConfigResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceGetTaskListConfigYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_MatchingAPIYARPCCaller) ResetTaskListAckLevel(ctx context.Context, request *ResetTaskListAckLevelRequest, options ...yarpc.CallOption) (*ResetTaskListAckLevelResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "ResetTaskListAckLevel", request, newMatchingAPIServiceResetTaskListAckLevelYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*ResetTaskListAckLevelResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceResetTaskListAckLevelYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+func (c *_MatchingAPIYARPCCaller) RefreshTaskList(ctx context.Context, request *RefreshTaskListRequest, options ...yarpc.CallOption) (*RefreshTaskListResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "RefreshTaskList", request, newMatchingAPIServiceRefreshTaskListYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*RefreshTaskListResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingAPIServiceRefreshTaskListYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
 type _MatchingAPIYARPCHandler struct {
 	server MatchingAPIYARPCServer
 }
@@ -551,6 +647,22 @@ func (h *_MatchingAPIYARPCHandler) CancelOutstandingPoll(ctx context.Context, re
 	return response, err
 }
 
+func (h *_MatchingAPIYARPCHandler) DescribePoller(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *DescribePollerRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*DescribePollerRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceDescribePollerYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.DescribePoller(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func (h *_MatchingAPIYARPCHandler) DescribeTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
 	var request *DescribeTaskListRequest
 	var ok bool
@@ -599,6 +711,54 @@ func (h *_MatchingAPIYARPCHandler) GetTaskListsByDomain(ctx context.Context, req
 	return response, err
 }
 
+func (h *_MatchingAPIYARPCHandler) GetTaskListConfig(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *GetTaskListConfigRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*GetTaskListConfigRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceGetTaskListConfigYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.GetTaskListConfig(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_MatchingAPIYARPCHandler) ResetTaskListAckLevel(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *ResetTaskListAckLevelRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*ResetTaskListAckLevelRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceResetTaskListAckLevelYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.ResetTaskListAckLevel(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func (h *_MatchingAPIYARPCHandler) RefreshTaskList(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *RefreshTaskListRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*RefreshTaskListRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingAPIServiceRefreshTaskListYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.RefreshTaskList(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
 func newMatchingAPIServicePollForDecisionTaskYARPCRequest() proto.Message {
 	return &PollForDecisionTaskRequest{}
 }
@@ -655,6 +815,14 @@ func newMatchingAPIServiceCancelOutstandingPollYARPCResponse() proto.Message {
 	return &CancelOutstandingPollResponse{}
 }
 
+func newMatchingAPIServiceDescribePollerYARPCRequest() proto.Message {
+	return &DescribePollerRequest{}
+}
+
+func newMatchingAPIServiceDescribePollerYARPCResponse() proto.Message {
+	return &DescribePollerResponse{}
+}
+
 func newMatchingAPIServiceDescribeTaskListYARPCRequest() proto.Message {
 	return &DescribeTaskListRequest{}
 }
@@ -679,6 +847,30 @@ func newMatchingAPIServiceGetTaskListsByDomainYARPCResponse() proto.Message {
 	return &GetTaskListsByDomainResponse{}
 }
 
+func newMatchingAPIServiceGetTaskListConfigYARPCRequest() proto.Message {
+	return &GetTaskListConfigRequest{}
+}
+
+func newMatchingAPIServiceGetTaskListConfigYARPCResponse() proto.Message {
+	return &GetTaskListConfigResponse{}
+}
+
+func newMatchingAPIServiceResetTaskListAckLevelYARPCRequest() proto.Message {
+	return &ResetTaskListAckLevelRequest{}
+}
+
+func newMatchingAPIServiceResetTaskListAckLevelYARPCResponse() proto.Message {
+	return &ResetTaskListAckLevelResponse{}
+}
+
+func newMatchingAPIServiceRefreshTaskListYARPCRequest() proto.Message {
+	return &RefreshTaskListRequest{}
+}
+
+func newMatchingAPIServiceRefreshTaskListYARPCResponse() proto.Message {
+	return &RefreshTaskListResponse{}
+}
+
 var (
 	emptyMatchingAPIServicePollForDecisionTaskYARPCRequest        = &PollForDecisionTaskRequest{}
 	emptyMatchingAPIServicePollForDecisionTaskYARPCResponse       = &PollForDecisionTaskResponse{}
@@ -694,148 +886,424 @@ var (
 	emptyMatchingAPIServiceRespondQueryTaskCompletedYARPCResponse = &RespondQueryTaskCompletedResponse{}
 	emptyMatchingAPIServiceCancelOutstandingPollYARPCRequest      = &CancelOutstandingPollRequest{}
 	emptyMatchingAPIServiceCancelOutstandingPollYARPCResponse     = &CancelOutstandingPollResponse{}
+	emptyMatchingAPIServiceDescribePollerYARPCRequest             = &DescribePollerRequest{}
+	emptyMatchingAPIServiceDescribePollerYARPCResponse            = &DescribePollerResponse{}
 	emptyMatchingAPIServiceDescribeTaskListYARPCRequest           = &DescribeTaskListRequest{}
 	emptyMatchingAPIServiceDescribeTaskListYARPCResponse          = &DescribeTaskListResponse{}
 	emptyMatchingAPIServiceListTaskListPartitionsYARPCRequest     = &ListTaskListPartitionsRequest{}
 	emptyMatchingAPIServiceListTaskListPartitionsYARPCResponse    = &ListTaskListPartitionsResponse{}
 	emptyMatchingAPIServiceGetTaskListsByDomainYARPCRequest       = &GetTaskListsByDomainRequest{}
 	emptyMatchingAPIServiceGetTaskListsByDomainYARPCResponse      = &GetTaskListsByDomainResponse{}
+	emptyMatchingAPIServiceGetTaskListConfigYARPCRequest          = &GetTaskListConfigRequest{}
+	emptyMatchingAPIServiceGetTaskListConfigYARPCResponse         = &GetTaskListConfigResponse{}
+	emptyMatchingAPIServiceResetTaskListAckLevelYARPCRequest      = &ResetTaskListAckLevelRequest{}
+	emptyMatchingAPIServiceResetTaskListAckLevelYARPCResponse     = &ResetTaskListAckLevelResponse{}
+	emptyMatchingAPIServiceRefreshTaskListYARPCRequest            = &RefreshTaskListRequest{}
+	emptyMatchingAPIServiceRefreshTaskListYARPCResponse           = &RefreshTaskListResponse{}
+)
+
+// MatchingHealthAPIYARPCClient is the YARPC client-side interface for the MatchingHealthAPI service.
+type MatchingHealthAPIYARPCClient interface {
+	HealthDetails(context.Context, *HealthDetailsRequest, ...yarpc.CallOption) (*HealthDetailsResponse, error)
+}
+
+func newMatchingHealthAPIYARPCClient(clientConfig transport.ClientConfig, anyResolver jsonpb.AnyResolver, options ...protobuf.ClientOption) MatchingHealthAPIYARPCClient {
+	return &_MatchingHealthAPIYARPCCaller{protobuf.NewStreamClient(
+		protobuf.ClientParams{
+			ServiceName:  "uber.cadence.matching.v1.MatchingHealthAPI",
+			ClientConfig: clientConfig,
+			AnyResolver:  anyResolver,
+			Options:      options,
+		},
+	)}
+}
+
+// NewMatchingHealthAPIYARPCClient builds a new YARPC client for the MatchingHealthAPI service.
+func NewMatchingHealthAPIYARPCClient(clientConfig transport.ClientConfig, options ...protobuf.ClientOption) MatchingHealthAPIYARPCClient {
+	return newMatchingHealthAPIYARPCClient(clientConfig, nil, options...)
+}
+
+// MatchingHealthAPIYARPCServer is the YARPC server-side interface for the MatchingHealthAPI service.
+type MatchingHealthAPIYARPCServer interface {
+	HealthDetails(context.Context, *HealthDetailsRequest) (*HealthDetailsResponse, error)
+}
+
+type buildMatchingHealthAPIYARPCProceduresParams struct {
+	Server      MatchingHealthAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver
+}
+
+func buildMatchingHealthAPIYARPCProcedures(params buildMatchingHealthAPIYARPCProceduresParams) []transport.Procedure {
+	handler := &_MatchingHealthAPIYARPCHandler{params.Server}
+	return protobuf.BuildProcedures(
+		protobuf.BuildProceduresParams{
+			ServiceName: "uber.cadence.matching.v1.MatchingHealthAPI",
+			UnaryHandlerParams: []protobuf.BuildProceduresUnaryHandlerParams{
+				{
+					MethodName: "HealthDetails",
+					Handler: protobuf.NewUnaryHandler(
+						protobuf.UnaryHandlerParams{
+							Handle:      handler.HealthDetails,
+							NewRequest:  newMatchingHealthAPIServiceHealthDetailsYARPCRequest,
+							AnyResolver: params.AnyResolver,
+						},
+					),
+				},
+			},
+			OnewayHandlerParams: []protobuf.BuildProceduresOnewayHandlerParams{},
+			StreamHandlerParams: []protobuf.BuildProceduresStreamHandlerParams{},
+		},
+	)
+}
+
+// BuildMatchingHealthAPIYARPCProcedures prepares an implementation of the MatchingHealthAPI service for YARPC registration.
+func BuildMatchingHealthAPIYARPCProcedures(server MatchingHealthAPIYARPCServer) []transport.Procedure {
+	return buildMatchingHealthAPIYARPCProcedures(buildMatchingHealthAPIYARPCProceduresParams{Server: server})
+}
+
+// FxMatchingHealthAPIYARPCClientParams defines the input
+// for NewFxMatchingHealthAPIYARPCClient. It provides the
+// paramaters to get a MatchingHealthAPIYARPCClient in an
+// Fx application.
+type FxMatchingHealthAPIYARPCClientParams struct {
+	fx.In
+
+	Provider    yarpc.ClientConfig
+	AnyResolver jsonpb.AnyResolver  `name:"yarpcfx" optional:"true"`
+	Restriction restriction.Checker `optional:"true"`
+}
+
+// FxMatchingHealthAPIYARPCClientResult defines the output
+// of NewFxMatchingHealthAPIYARPCClient. It provides a
+// MatchingHealthAPIYARPCClient to an Fx application.
+type FxMatchingHealthAPIYARPCClientResult struct {
+	fx.Out
+
+	Client MatchingHealthAPIYARPCClient
+
+	// We are using an fx.Out struct here instead of just returning a client
+	// so that we can add more values or add named versions of the client in
+	// the future without breaking any existing code.
+}
+
+// NewFxMatchingHealthAPIYARPCClient provides a MatchingHealthAPIYARPCClient
+// to an Fx application using the given name for routing.
+//
+//	fx.Provide(
+//	  matchingv1.NewFxMatchingHealthAPIYARPCClient("service-name"),
+//	  ...
+//	)
+func NewFxMatchingHealthAPIYARPCClient(name string, options ...protobuf.ClientOption) interface{} {
+	return func(params FxMatchingHealthAPIYARPCClientParams) FxMatchingHealthAPIYARPCClientResult {
+		cc := params.Provider.ClientConfig(name)
+
+		if params.Restriction != nil {
+			if namer, ok := cc.GetUnaryOutbound().(transport.Namer); ok {
+				if err := params.Restriction.Check(protobuf.Encoding, namer.TransportName()); err != nil {
+					panic(err.Error())
+				}
+			}
+		}
+
+		return FxMatchingHealthAPIYARPCClientResult{
+			Client: newMatchingHealthAPIYARPCClient(cc, params.AnyResolver, options...),
+		}
+	}
+}
+
+// FxMatchingHealthAPIYARPCProceduresParams defines the input
+// for NewFxMatchingHealthAPIYARPCProcedures. It provides the
+// paramaters to get MatchingHealthAPIYARPCServer procedures in an
+// Fx application.
+type FxMatchingHealthAPIYARPCProceduresParams struct {
+	fx.In
+
+	Server      MatchingHealthAPIYARPCServer
+	AnyResolver jsonpb.AnyResolver `name:"yarpcfx" optional:"true"`
+}
+
+// FxMatchingHealthAPIYARPCProceduresResult defines the output
+// of NewFxMatchingHealthAPIYARPCProcedures. It provides
+// MatchingHealthAPIYARPCServer procedures to an Fx application.
+//
+// The procedures are provided to the "yarpcfx" value group.
+// Dig 1.2 or newer must be used for this feature to work.
+type FxMatchingHealthAPIYARPCProceduresResult struct {
+	fx.Out
+
+	Procedures     []transport.Procedure `group:"yarpcfx"`
+	ReflectionMeta reflection.ServerMeta `group:"yarpcfx"`
+}
+
+// NewFxMatchingHealthAPIYARPCProcedures provides MatchingHealthAPIYARPCServer procedures to an Fx application.
+// It expects a MatchingHealthAPIYARPCServer to be present in the container.
+//
+//	fx.Provide(
+//	  matchingv1.NewFxMatchingHealthAPIYARPCProcedures(),
+//	  ...
+//	)
+func NewFxMatchingHealthAPIYARPCProcedures() interface{} {
+	return func(params FxMatchingHealthAPIYARPCProceduresParams) FxMatchingHealthAPIYARPCProceduresResult {
+		return FxMatchingHealthAPIYARPCProceduresResult{
+			Procedures: buildMatchingHealthAPIYARPCProcedures(buildMatchingHealthAPIYARPCProceduresParams{
+				Server:      params.Server,
+				AnyResolver: params.AnyResolver,
+			}),
+			ReflectionMeta: MatchingHealthAPIReflectionMeta,
+		}
+	}
+}
+
+// MatchingHealthAPIReflectionMeta is the reflection server metadata
+// required for using the gRPC reflection protocol with YARPC.
+//
+// See https://github.com/grpc/grpc/blob/master/doc/server-reflection.md.
+var MatchingHealthAPIReflectionMeta = reflection.ServerMeta{
+	ServiceName:     "uber.cadence.matching.v1.MatchingHealthAPI",
+	FileDescriptors: yarpcFileDescriptorClosure826e827d3aabf7fc,
+}
+
+type _MatchingHealthAPIYARPCCaller struct {
+	streamClient protobuf.StreamClient
+}
+
+func (c *_MatchingHealthAPIYARPCCaller) HealthDetails(ctx context.Context, request *HealthDetailsRequest, options ...yarpc.CallOption) (*HealthDetailsResponse, error) {
+	responseMessage, err := c.streamClient.Call(ctx, "HealthDetails", request, newMatchingHealthAPIServiceHealthDetailsYARPCResponse, options...)
+	if responseMessage == nil {
+		return nil, err
+	}
+	response, ok := responseMessage.(*HealthDetailsResponse)
+	if !ok {
+		return nil, protobuf.CastError(emptyMatchingHealthAPIServiceHealthDetailsYARPCResponse, responseMessage)
+	}
+	return response, err
+}
+
+type _MatchingHealthAPIYARPCHandler struct {
+	server MatchingHealthAPIYARPCServer
+}
+
+func (h *_MatchingHealthAPIYARPCHandler) HealthDetails(ctx context.Context, requestMessage proto.Message) (proto.Message, error) {
+	var request *HealthDetailsRequest
+	var ok bool
+	if requestMessage != nil {
+		request, ok = requestMessage.(*HealthDetailsRequest)
+		if !ok {
+			return nil, protobuf.CastError(emptyMatchingHealthAPIServiceHealthDetailsYARPCRequest, requestMessage)
+		}
+	}
+	response, err := h.server.HealthDetails(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	return response, err
+}
+
+func newMatchingHealthAPIServiceHealthDetailsYARPCRequest() proto.Message {
+	return &HealthDetailsRequest{}
+}
+
+func newMatchingHealthAPIServiceHealthDetailsYARPCResponse() proto.Message {
+	return &HealthDetailsResponse{}
+}
+
+var (
+	emptyMatchingHealthAPIServiceHealthDetailsYARPCRequest  = &HealthDetailsRequest{}
+	emptyMatchingHealthAPIServiceHealthDetailsYARPCResponse = &HealthDetailsResponse{}
 )
 
 var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 	// uber/cadence/matching/v1/service.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xdd, 0x6e, 0xdb, 0xc8,
-		0xf5, 0x07, 0xfd, 0x25, 0xeb, 0xc8, 0x96, 0x9d, 0x49, 0xd6, 0xa1, 0x95, 0x38, 0x76, 0xb4, 0xff,
-		0xdd, 0xf5, 0xbf, 0xd8, 0xd2, 0x6b, 0xef, 0x26, 0xcd, 0x26, 0x28, 0x0a, 0x7f, 0x26, 0x2a, 0x9a,
-		0x26, 0xcb, 0xb8, 0x29, 0x50, 0x14, 0x21, 0x46, 0xe4, 0xd8, 0x62, 0x4d, 0x91, 0x0c, 0x67, 0x24,
-		0xaf, 0x7a, 0xd1, 0x8b, 0xa2, 0x2d, 0x0a, 0xec, 0x6d, 0xdf, 0xa0, 0x7b, 0xd9, 0xcb, 0x3e, 0x4c,
-		0x81, 0x45, 0x2f, 0xfb, 0x00, 0xed, 0x13, 0x14, 0x33, 0x1c, 0x52, 0xa4, 0x34, 0xd4, 0x87, 0x9d,
-		0xdd, 0xed, 0x9d, 0x66, 0xe6, 0x9c, 0xdf, 0x39, 0x73, 0xbe, 0x87, 0x10, 0x7c, 0xd8, 0x69, 0x92,
-		0x68, 0xc7, 0xc6, 0x0e, 0xf1, 0x6d, 0xb2, 0xd3, 0xc6, 0xcc, 0x6e, 0xb9, 0xfe, 0xf9, 0x4e, 0x77,
-		0x77, 0x87, 0x92, 0xa8, 0xeb, 0xda, 0xc4, 0x08, 0xa3, 0x80, 0x05, 0x48, 0xe7, 0x74, 0x86, 0xa4,
-		0x33, 0x12, 0x3a, 0xa3, 0xbb, 0x5b, 0xbb, 0x77, 0x1e, 0x04, 0xe7, 0x1e, 0xd9, 0x11, 0x74, 0xcd,
-		0xce, 0xd9, 0x8e, 0xd3, 0x89, 0x30, 0x73, 0x03, 0x3f, 0xe6, 0xac, 0x6d, 0x0e, 0x9e, 0x33, 0xb7,
-		0x4d, 0x28, 0xc3, 0xed, 0x50, 0x12, 0x0c, 0x01, 0x5c, 0x46, 0x38, 0x0c, 0x49, 0x44, 0xe5, 0xf9,
-		0x56, 0x4e, 0x45, 0x1c, 0xba, 0x5c, 0x3b, 0x3b, 0x68, 0xb7, 0xfb, 0x22, 0x54, 0x14, 0x6f, 0x3b,
-		0x24, 0xea, 0x49, 0x82, 0xba, 0x8a, 0x80, 0x61, 0x7a, 0xe1, 0xb9, 0x94, 0x49, 0x9a, 0x6d, 0x15,
-		0x8d, 0x34, 0x82, 0x75, 0x19, 0x44, 0x17, 0x24, 0x92, 0x94, 0x3f, 0x18, 0x47, 0x79, 0xe6, 0x05,
-		0x97, 0x92, 0xf6, 0xbe, 0x8a, 0xb6, 0xe5, 0x52, 0x16, 0xa4, 0xca, 0xfd, 0x5f, 0x8e, 0x84, 0xb6,
-		0x70, 0x44, 0x9c, 0x61, 0xaa, 0x0f, 0x0a, 0xa8, 0xf2, 0xb7, 0xa8, 0xff, 0x5b, 0x83, 0xda, 0xcb,
-		0xc0, 0xf3, 0x4e, 0x82, 0xe8, 0x88, 0xd8, 0x2e, 0x75, 0x03, 0xff, 0x14, 0xd3, 0x0b, 0x93, 0xbc,
-		0xed, 0x10, 0xca, 0x50, 0x03, 0x4a, 0x51, 0xfc, 0x53, 0xd7, 0xb6, 0xb4, 0xed, 0xca, 0xde, 0x8e,
-		0x91, 0x73, 0x2c, 0x0e, 0x5d, 0xa3, 0xbb, 0x6b, 0x14, 0x23, 0x98, 0x09, 0x3f, 0xba, 0x03, 0x65,
-		0x27, 0x68, 0x63, 0xd7, 0xb7, 0x5c, 0x47, 0x9f, 0xd9, 0xd2, 0xb6, 0xcb, 0xe6, 0x62, 0xbc, 0xd1,
-		0x70, 0xf8, 0x61, 0x18, 0x78, 0x1e, 0x89, 0xf8, 0xe1, 0x6c, 0x7c, 0x18, 0x6f, 0x34, 0x1c, 0xf4,
-		0x01, 0x54, 0xcf, 0x82, 0xe8, 0x12, 0x47, 0x0e, 0x71, 0xac, 0xb3, 0x28, 0x68, 0xeb, 0x73, 0x82,
-		0x62, 0x39, 0xdd, 0x3d, 0x89, 0x82, 0x36, 0xfa, 0x08, 0x56, 0x5c, 0x1a, 0x78, 0x22, 0x96, 0xac,
-		0xf3, 0x28, 0xe8, 0x84, 0xfa, 0xbc, 0xa0, 0xab, 0xa6, 0xdb, 0x4f, 0xf9, 0x6e, 0xfd, 0xef, 0x65,
-		0xb8, 0xa3, 0xd4, 0x98, 0x86, 0x81, 0x4f, 0x09, 0xda, 0x00, 0xe0, 0x56, 0xb2, 0x58, 0x70, 0x41,
-		0x7c, 0x71, 0xef, 0x25, 0xb3, 0xcc, 0x77, 0x4e, 0xf9, 0x06, 0xfa, 0x05, 0xa0, 0xc4, 0x69, 0x16,
-		0xf9, 0x92, 0xd8, 0x1d, 0x8e, 0x2c, 0x6e, 0x54, 0xd9, 0xfb, 0x50, 0x69, 0x9e, 0x5f, 0x4a, 0xf2,
-		0xe3, 0x84, 0xda, 0xbc, 0x71, 0x39, 0xb8, 0x85, 0x4e, 0x60, 0x39, 0x85, 0x65, 0xbd, 0x90, 0x08,
-		0x33, 0x54, 0xf6, 0xee, 0x8f, 0x44, 0x3c, 0xed, 0x85, 0xc4, 0x5c, 0xba, 0xcc, 0xac, 0xd0, 0x6b,
-		0x58, 0x0f, 0x23, 0xd2, 0x75, 0x83, 0x0e, 0xb5, 0x28, 0xc3, 0x11, 0x23, 0x8e, 0x45, 0xba, 0xc4,
-		0x67, 0xdc, 0xb4, 0x73, 0x02, 0xf3, 0x8e, 0x11, 0xa7, 0x90, 0x91, 0xa4, 0x90, 0xd1, 0xf0, 0xd9,
-		0xc3, 0xcf, 0x5e, 0x63, 0xaf, 0x43, 0xcc, 0xb5, 0x84, 0xfb, 0x55, 0xcc, 0x7c, 0xcc, 0x79, 0x1b,
-		0x0e, 0xda, 0x86, 0xd5, 0x21, 0x38, 0x6e, 0xdf, 0x59, 0xb3, 0x4a, 0xf3, 0x94, 0x3a, 0x94, 0x30,
-		0x63, 0xa4, 0x1d, 0x32, 0x7d, 0x61, 0x4b, 0xdb, 0x9e, 0x37, 0x93, 0x25, 0xaa, 0xc3, 0xb2, 0x4f,
-		0xbe, 0x64, 0x7d, 0x80, 0x92, 0x00, 0xa8, 0xf0, 0xcd, 0x84, 0xfb, 0x63, 0x40, 0x4d, 0x6c, 0x5f,
-		0x78, 0xc1, 0xb9, 0x65, 0x07, 0x1d, 0x9f, 0x59, 0x2d, 0xd7, 0x67, 0xfa, 0xa2, 0x20, 0x5c, 0x95,
-		0x27, 0x87, 0xfc, 0xe0, 0x99, 0xeb, 0x33, 0xf4, 0x08, 0x74, 0xca, 0x5c, 0xfb, 0xa2, 0xd7, 0x77,
-		0x85, 0x45, 0x7c, 0xdc, 0xf4, 0x88, 0xa3, 0x97, 0xb7, 0xb4, 0xed, 0x45, 0x73, 0x2d, 0x3e, 0x4f,
-		0x0d, 0x7d, 0x1c, 0x9f, 0xa2, 0x47, 0x30, 0x2f, 0x52, 0x5e, 0x07, 0x61, 0x93, 0xfa, 0x48, 0x3b,
-		0x7f, 0xc1, 0x29, 0xcd, 0x98, 0x01, 0x99, 0xb0, 0xec, 0xc8, 0xb8, 0xb1, 0x5c, 0xff, 0x2c, 0xd0,
-		0x2b, 0x02, 0xe1, 0x87, 0x79, 0x84, 0x38, 0xe5, 0x38, 0xc8, 0x69, 0x84, 0x7d, 0xea, 0x12, 0x9f,
-		0x25, 0xd1, 0xd6, 0xf0, 0xcf, 0x02, 0x73, 0xc9, 0xc9, 0xac, 0xd0, 0x1b, 0xb8, 0x3b, 0x1c, 0x54,
-		0x96, 0x08, 0x43, 0x9e, 0xad, 0xfa, 0x92, 0x10, 0xb1, 0xa1, 0x54, 0x92, 0x07, 0xef, 0xcf, 0x5c,
-		0xca, 0xcc, 0xf5, 0xa1, 0xa8, 0x4a, 0x8e, 0x90, 0x01, 0x37, 0x63, 0xa3, 0xf3, 0x1a, 0x41, 0xac,
-		0x2e, 0x89, 0xb8, 0x68, 0x7d, 0x59, 0xf8, 0xe7, 0x86, 0x38, 0x7a, 0xc5, 0x4f, 0x5e, 0xc7, 0x07,
-		0xe8, 0x3e, 0x2c, 0x35, 0x23, 0xec, 0xdb, 0x2d, 0x99, 0x05, 0x55, 0x91, 0x05, 0x95, 0x78, 0x2f,
-		0xce, 0x83, 0x7d, 0xa8, 0x52, 0xbb, 0x45, 0x9c, 0x8e, 0x47, 0x1c, 0x8b, 0x17, 0x69, 0x7d, 0x45,
-		0x28, 0x59, 0x1b, 0x8a, 0xae, 0xd3, 0xa4, 0x82, 0x9b, 0xcb, 0x29, 0x07, 0xdf, 0x43, 0x3f, 0x86,
-		0xa5, 0x24, 0xa6, 0x04, 0xc0, 0xea, 0x58, 0x80, 0x8a, 0xa4, 0x17, 0xec, 0xbf, 0x86, 0x12, 0xf7,
-		0x88, 0x4b, 0xa8, 0x7e, 0x63, 0x6b, 0x76, 0xbb, 0xb2, 0x77, 0x60, 0x14, 0xb5, 0x1d, 0x63, 0x44,
-		0xc2, 0x1b, 0x5f, 0xc4, 0x20, 0xc7, 0x3e, 0x8b, 0x7a, 0x66, 0x02, 0xc9, 0x4d, 0xc6, 0x02, 0x86,
-		0x3d, 0x4b, 0x16, 0x56, 0xab, 0xd9, 0x63, 0x84, 0xea, 0x48, 0x44, 0xe2, 0x0d, 0x71, 0xf4, 0x2c,
-		0x3e, 0x39, 0xe0, 0x07, 0xb5, 0x37, 0xb0, 0x94, 0x05, 0x42, 0xab, 0x30, 0x7b, 0x41, 0x7a, 0xa2,
-		0x7e, 0x94, 0x4d, 0xfe, 0x93, 0x87, 0x5c, 0x97, 0xe7, 0x98, 0x2c, 0x16, 0x13, 0x85, 0x9c, 0x60,
-		0x78, 0x3c, 0xf3, 0x48, 0xcb, 0x96, 0xea, 0x7d, 0x9b, 0xb9, 0x5d, 0x97, 0xf5, 0xae, 0x5e, 0xaa,
-		0x15, 0x08, 0xff, 0x8b, 0xa5, 0xfa, 0xab, 0xc5, 0xb4, 0x54, 0xe7, 0x35, 0xfe, 0x5e, 0x4b, 0xf5,
-		0x26, 0x54, 0xb0, 0xd4, 0xa6, 0x6f, 0x04, 0x48, 0xb6, 0x1a, 0x0e, 0xaf, 0xe5, 0x29, 0x81, 0xa8,
-		0xe5, 0x73, 0x23, 0x6a, 0x79, 0x7a, 0x31, 0x51, 0xcb, 0x71, 0x66, 0x85, 0xf6, 0x60, 0xde, 0xf5,
-		0xc3, 0x0e, 0x13, 0xd6, 0xa9, 0xec, 0xdd, 0x55, 0x7b, 0x14, 0xf7, 0xbc, 0x00, 0x3b, 0x66, 0x4c,
-		0xaa, 0x48, 0xcb, 0x85, 0xeb, 0xa6, 0x65, 0x69, 0xba, 0xb4, 0x3c, 0x85, 0xf5, 0x04, 0xcf, 0x62,
-		0x81, 0x65, 0x7b, 0x01, 0x25, 0x02, 0x28, 0xe8, 0xc4, 0x85, 0xbc, 0xb2, 0xb7, 0x3e, 0x84, 0x75,
-		0x24, 0xa7, 0x40, 0x73, 0x2d, 0xe1, 0x3d, 0x0d, 0x0e, 0x39, 0xe7, 0x69, 0xcc, 0x88, 0x7e, 0x0e,
-		0x6b, 0x42, 0xc8, 0x30, 0x64, 0x79, 0x1c, 0xe4, 0x4d, 0xc1, 0x38, 0x80, 0x77, 0x02, 0x37, 0x5a,
-		0x04, 0x47, 0xac, 0x49, 0x30, 0x4b, 0xa1, 0x60, 0x1c, 0xd4, 0x6a, 0xca, 0x93, 0xe0, 0x64, 0xba,
-		0x5d, 0x25, 0xdf, 0xed, 0xde, 0xc0, 0xbd, 0xbc, 0x27, 0xac, 0xe0, 0xcc, 0x62, 0x2d, 0x97, 0x5a,
-		0x09, 0xc3, 0xd2, 0x58, 0xc3, 0xd6, 0x72, 0x9e, 0x79, 0x71, 0x76, 0xda, 0x72, 0xe9, 0xbe, 0xc4,
-		0x6f, 0x64, 0x6f, 0xe0, 0x10, 0x86, 0x5d, 0x8f, 0x8a, 0x8a, 0x3e, 0x2e, 0x52, 0xfa, 0x97, 0x38,
-		0x8a, 0xb9, 0x86, 0x87, 0x8f, 0xea, 0xd5, 0x86, 0x8f, 0x8f, 0x60, 0x25, 0xc5, 0x89, 0x2b, 0x86,
-		0x68, 0x0a, 0x65, 0xb3, 0x9a, 0x6c, 0x1f, 0x89, 0x5d, 0xf4, 0x29, 0x2c, 0xb4, 0x08, 0x76, 0x48,
-		0x24, 0x6b, 0xfe, 0x1d, 0xa5, 0xa4, 0x67, 0x82, 0xc4, 0x94, 0xa4, 0xf5, 0x7f, 0xcc, 0xc1, 0xda,
-		0xbe, 0xe3, 0xa8, 0x06, 0xd5, 0x5c, 0xc9, 0xd2, 0x06, 0x4a, 0xd6, 0xb7, 0x54, 0x06, 0x1e, 0x43,
-		0xb9, 0xdf, 0xa0, 0x67, 0x27, 0x69, 0xd0, 0x8b, 0x2c, 0xe9, 0xc7, 0x9b, 0x50, 0x49, 0x73, 0x44,
-		0xce, 0x65, 0xb3, 0x26, 0x24, 0x5b, 0x0d, 0x67, 0x30, 0x89, 0x64, 0xe8, 0xcb, 0x30, 0x9d, 0x9f,
-		0x22, 0x89, 0xc4, 0x18, 0x97, 0x04, 0xeb, 0x63, 0x58, 0xa0, 0x41, 0x27, 0xb2, 0xe3, 0xa2, 0x50,
-		0x1d, 0x6c, 0x41, 0x99, 0x99, 0x05, 0xd3, 0x8b, 0x57, 0x82, 0xd2, 0x94, 0x1c, 0x8a, 0xda, 0x5e,
-		0x52, 0xd5, 0xf6, 0x10, 0x56, 0x43, 0x1c, 0x31, 0x57, 0xd4, 0x76, 0x3b, 0xf0, 0xcf, 0xdc, 0x73,
-		0x7d, 0x51, 0x74, 0xe7, 0xe3, 0xe2, 0xee, 0xac, 0xf6, 0xaa, 0xf1, 0x32, 0x01, 0x3a, 0x14, 0x38,
-		0x71, 0x83, 0x5e, 0x09, 0xf3, 0xbb, 0xb5, 0x03, 0xb8, 0xa5, 0x22, 0x54, 0x34, 0xe0, 0x5b, 0xd9,
-		0x06, 0x5c, 0xce, 0x36, 0xd7, 0x75, 0xb8, 0x3d, 0xa4, 0x43, 0xdc, 0x63, 0xea, 0xff, 0x99, 0x17,
-		0x51, 0xa7, 0xea, 0xb9, 0xdf, 0x47, 0xd4, 0xf1, 0x39, 0x5c, 0x38, 0xc4, 0xea, 0x8b, 0x8e, 0x3b,
-		0x50, 0x35, 0xde, 0x3f, 0x4a, 0x14, 0xc8, 0xc5, 0xe7, 0xdc, 0xb5, 0xe2, 0x73, 0x7e, 0xba, 0xf8,
-		0x5c, 0xb8, 0x7e, 0x7c, 0x96, 0xde, 0x41, 0x7c, 0x2e, 0xaa, 0xe2, 0xd3, 0x07, 0x1d, 0x67, 0x5c,
-		0x79, 0xe4, 0xd2, 0x90, 0x07, 0x22, 0x9f, 0xc2, 0x65, 0x27, 0xd9, 0x1b, 0x11, 0xa7, 0x05, 0x9c,
-		0x66, 0x21, 0xa6, 0x32, 0x1f, 0x60, 0x82, 0x7c, 0x50, 0xc4, 0xdb, 0x77, 0x98, 0x0f, 0xdf, 0xcc,
-		0x82, 0x5e, 0x74, 0x59, 0xf4, 0x53, 0x58, 0xe9, 0x37, 0x36, 0xf1, 0x76, 0x90, 0x23, 0xa7, 0xba,
-		0x5f, 0xc8, 0x29, 0x59, 0x3c, 0xf0, 0xcc, 0xfe, 0x70, 0x22, 0xd6, 0x43, 0xb3, 0xc6, 0xcc, 0x74,
-		0xb3, 0x46, 0xa6, 0xfb, 0xce, 0x4e, 0xdb, 0x7d, 0xe7, 0xde, 0x7d, 0xf7, 0x9d, 0x7f, 0x37, 0xdd,
-		0x77, 0xe1, 0x9d, 0x75, 0xdf, 0x92, 0xaa, 0xfb, 0xca, 0x6a, 0xa7, 0x9a, 0xa8, 0xeb, 0xdf, 0x68,
-		0x70, 0x4b, 0x3c, 0x3d, 0x12, 0x39, 0x49, 0xad, 0x3b, 0x1c, 0x7c, 0x5f, 0xfc, 0xbf, 0x52, 0x3d,
-		0x15, 0xef, 0x84, 0x2f, 0x8b, 0xeb, 0xf4, 0xd3, 0xc9, 0x1e, 0x1e, 0xf5, 0xbf, 0x6a, 0xf0, 0xde,
-		0x80, 0x86, 0xf2, 0x25, 0xf1, 0x13, 0x58, 0x12, 0xaf, 0x7b, 0x2b, 0x22, 0xb4, 0xe3, 0x25, 0x77,
-		0x1c, 0xed, 0xc9, 0x8a, 0xe0, 0x30, 0x05, 0x03, 0x6a, 0x40, 0x35, 0x01, 0xf8, 0x0d, 0xb1, 0x19,
-		0x71, 0x46, 0xbe, 0xf2, 0xe2, 0xd7, 0x9d, 0xa4, 0x34, 0x97, 0xdf, 0x66, 0x97, 0xf5, 0x7f, 0x69,
-		0xb0, 0x15, 0x2b, 0xe6, 0x08, 0x3a, 0x7e, 0xdf, 0xc3, 0xa0, 0x1d, 0x7a, 0x84, 0x13, 0x4b, 0x53,
-		0xbe, 0x18, 0xf4, 0xc7, 0x03, 0xa5, 0xa0, 0x71, 0x38, 0xdf, 0x81, 0x6f, 0x6e, 0x43, 0x49, 0xf0,
-		0xca, 0x39, 0xa7, 0x6c, 0x2e, 0xf0, 0x65, 0xc3, 0xa9, 0xbf, 0x0f, 0xf7, 0x47, 0xa8, 0x27, 0x03,
-		0xf2, 0x9f, 0x1a, 0xdc, 0x3d, 0xc4, 0xbe, 0x4d, 0xbc, 0x17, 0x1d, 0x46, 0x19, 0xf6, 0x1d, 0xd7,
-		0x3f, 0xe7, 0x6f, 0xc2, 0x89, 0x9a, 0x70, 0xee, 0xb5, 0x3a, 0x33, 0xf0, 0x5a, 0x7d, 0x0a, 0xd5,
-		0xf4, 0x52, 0xfd, 0x6f, 0x6e, 0xd5, 0x82, 0xc4, 0x4b, 0x6e, 0x16, 0x27, 0x1e, 0xcb, 0xac, 0xae,
-		0xd3, 0x69, 0xeb, 0x9b, 0xb0, 0x51, 0x70, 0x3d, 0x69, 0x80, 0xdf, 0xc1, 0xed, 0x23, 0x42, 0xed,
-		0xc8, 0x6d, 0x92, 0x94, 0x5d, 0x5e, 0xfd, 0x64, 0x30, 0x06, 0x3e, 0x56, 0x4a, 0x2d, 0x60, 0x9f,
-		0xcc, 0xf5, 0xf5, 0xaf, 0x35, 0xd0, 0x87, 0x11, 0x64, 0xda, 0x7c, 0x0e, 0xa5, 0xd8, 0x9c, 0x54,
-		0xd7, 0x44, 0x53, 0xdb, 0x2c, 0xfc, 0xea, 0x40, 0x22, 0xd1, 0x29, 0x13, 0x7a, 0xf4, 0x1c, 0x56,
-		0xfb, 0xd6, 0xa7, 0x0c, 0xb3, 0x0e, 0x95, 0x29, 0xf3, 0xfe, 0x48, 0xdb, 0xbd, 0x12, 0xa4, 0x66,
-		0x95, 0xe5, 0xd6, 0x75, 0x0a, 0x1b, 0xc2, 0x1f, 0x72, 0x37, 0xed, 0x80, 0x34, 0x31, 0xd6, 0x1a,
-		0x2c, 0xc8, 0xa2, 0x18, 0x07, 0x89, 0x5c, 0xe5, 0x9d, 0x37, 0x33, 0x9d, 0xf3, 0xfe, 0x34, 0x03,
-		0xf7, 0x8a, 0xa4, 0x4a, 0x0b, 0xbd, 0x85, 0x8d, 0xfe, 0xb7, 0x80, 0xf4, 0xbe, 0x69, 0xcf, 0x4e,
-		0xec, 0x66, 0x8c, 0x14, 0x99, 0xe2, 0x3e, 0x27, 0x0c, 0x3b, 0x98, 0x61, 0xb3, 0x96, 0x1d, 0x38,
-		0xf2, 0xa2, 0xb9, 0xc8, 0xf4, 0x03, 0xa5, 0x52, 0xe4, 0xcc, 0xd5, 0x44, 0x3a, 0x99, 0xf1, 0x38,
-		0x2f, 0xb2, 0xfe, 0x00, 0xee, 0x3c, 0x25, 0xa9, 0x19, 0xe8, 0x41, 0x2f, 0xee, 0x34, 0x63, 0x6c,
-		0x5f, 0xff, 0x7a, 0x0e, 0xee, 0xaa, 0xf9, 0xa4, 0xf5, 0xfe, 0xa0, 0xc1, 0x9a, 0xe2, 0x2e, 0x6d,
-		0x1c, 0x4a, 0xbb, 0xbd, 0x28, 0x1e, 0xa2, 0x46, 0x01, 0x1b, 0x47, 0x03, 0x77, 0x79, 0x8e, 0xc3,
-		0x78, 0x9c, 0xba, 0xe9, 0x0c, 0x9f, 0x08, 0x35, 0x14, 0x5e, 0xe4, 0x6a, 0xcc, 0x5c, 0x4b, 0x8d,
-		0xfd, 0x01, 0x2f, 0xf6, 0xd5, 0xc0, 0xc3, 0x27, 0xb5, 0xdf, 0xf2, 0x4c, 0x54, 0xeb, 0xad, 0x98,
-		0xee, 0x9e, 0xe5, 0x3f, 0x37, 0x8e, 0x18, 0x6b, 0x8b, 0xd2, 0x3b, 0x33, 0x11, 0x72, 0xd9, 0x45,
-		0xca, 0x7e, 0xdb, 0xb2, 0xf7, 0xfe, 0x06, 0x50, 0x79, 0x2e, 0x79, 0xf6, 0x5f, 0x36, 0xd0, 0xef,
-		0x35, 0xb8, 0xa9, 0xf8, 0xa0, 0x8b, 0x3e, 0x9b, 0xf2, 0xfb, 0xaf, 0x08, 0xce, 0xda, 0x83, 0x2b,
-		0x7d, 0x35, 0xce, 0x2a, 0x91, 0x35, 0xcc, 0x04, 0x4a, 0x28, 0x46, 0xfb, 0x09, 0x94, 0x50, 0x7e,
-		0x00, 0xed, 0xc2, 0xca, 0xc0, 0xbb, 0x15, 0x7d, 0x32, 0xed, 0x33, 0xbb, 0xb6, 0x3b, 0x05, 0x47,
-		0x4e, 0x6e, 0xee, 0xde, 0x9f, 0x4c, 0xfb, 0x9c, 0x19, 0x23, 0x57, 0x79, 0xdf, 0x10, 0x96, 0x73,
-		0xf3, 0x1b, 0x32, 0x8a, 0x31, 0x54, 0xa3, 0x68, 0x6d, 0x67, 0x62, 0x7a, 0x29, 0xf1, 0x2f, 0x1a,
-		0xac, 0x17, 0x4e, 0x29, 0xe8, 0x71, 0x31, 0xdc, 0xb8, 0xc9, 0xab, 0xf6, 0xe4, 0x4a, 0xbc, 0x52,
-		0xad, 0x3f, 0x6b, 0xf0, 0x9e, 0x72, 0x6e, 0x40, 0x0f, 0x8b, 0x61, 0x47, 0xcd, 0x51, 0xb5, 0x1f,
-		0x4d, 0xcd, 0x27, 0x55, 0xe9, 0xc1, 0xea, 0x60, 0x12, 0xa3, 0xdd, 0x69, 0x12, 0x3e, 0x96, 0x7f,
-		0x85, 0x1a, 0x81, 0xbe, 0xd2, 0x60, 0x4d, 0xdd, 0x7f, 0xd1, 0x88, 0xeb, 0x8c, 0x9c, 0x13, 0x6a,
-		0x8f, 0xa6, 0x67, 0x94, 0xda, 0xfc, 0x51, 0x83, 0x5b, 0xaa, 0x6a, 0x8f, 0x1e, 0x4c, 0xdb, 0x1d,
-		0x62, 0x4d, 0x1e, 0x5e, 0xad, 0xa9, 0x1c, 0x3c, 0xf9, 0xd5, 0xe7, 0xe7, 0x2e, 0x6b, 0x75, 0x9a,
-		0x86, 0x1d, 0xb4, 0x77, 0x72, 0x7f, 0x04, 0x30, 0xce, 0x89, 0x1f, 0xff, 0x73, 0x22, 0xfb, 0xe7,
-		0x8d, 0x27, 0xc9, 0xef, 0xee, 0x6e, 0x73, 0x41, 0x9c, 0x7e, 0xfa, 0xdf, 0x00, 0x00, 0x00, 0xff,
-		0xff, 0x81, 0x2b, 0xa0, 0xe7, 0xea, 0x21, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0xcb, 0x73, 0xdb, 0xc6,
+		0xf9, 0x03, 0xc9, 0x92, 0xc8, 0x8f, 0x12, 0x25, 0xad, 0x64, 0x1a, 0xa2, 0x2c, 0x5b, 0x46, 0x7e,
+		0x49, 0x94, 0x4c, 0x42, 0x59, 0x4a, 0x9c, 0x38, 0xce, 0x64, 0x7e, 0xd5, 0xcb, 0x31, 0x9b, 0x38,
+		0x76, 0x20, 0x35, 0xe9, 0xb4, 0x1d, 0x63, 0x56, 0xc0, 0x4a, 0x44, 0x05, 0x02, 0x30, 0x76, 0x29,
+		0x99, 0x69, 0x4f, 0x9d, 0xa6, 0xd3, 0x99, 0xcc, 0xf4, 0xd4, 0x7b, 0x67, 0xda, 0xfe, 0x01, 0x3d,
+		0xf4, 0xd0, 0xe9, 0xf4, 0xdf, 0xe8, 0xa1, 0xcd, 0xf4, 0xd8, 0x3f, 0xa0, 0xf7, 0x1e, 0x3a, 0xfb,
+		0x00, 0x08, 0x90, 0x20, 0x45, 0x4a, 0xce, 0xeb, 0xc6, 0xdd, 0xfd, 0x5e, 0xfb, 0xed, 0xf7, 0x06,
+		0xe1, 0xa5, 0xd6, 0x21, 0x89, 0xd6, 0x6d, 0xec, 0x10, 0xdf, 0x26, 0xeb, 0x4d, 0xcc, 0xec, 0x86,
+		0xeb, 0x1f, 0xaf, 0x9f, 0x6e, 0xac, 0x53, 0x12, 0x9d, 0xba, 0x36, 0xa9, 0x85, 0x51, 0xc0, 0x02,
+		0xa4, 0x73, 0xb8, 0x9a, 0x82, 0xab, 0xc5, 0x70, 0xb5, 0xd3, 0x8d, 0xea, 0x8d, 0xe3, 0x20, 0x38,
+		0xf6, 0xc8, 0xba, 0x80, 0x3b, 0x6c, 0x1d, 0xad, 0x3b, 0xad, 0x08, 0x33, 0x37, 0xf0, 0x25, 0x66,
+		0xf5, 0x66, 0xf7, 0x39, 0x73, 0x9b, 0x84, 0x32, 0xdc, 0x0c, 0x15, 0x40, 0x0f, 0x81, 0xb3, 0x08,
+		0x87, 0x21, 0x89, 0xa8, 0x3a, 0x5f, 0xcd, 0x88, 0x88, 0x43, 0x97, 0x4b, 0x67, 0x07, 0xcd, 0x66,
+		0x87, 0x45, 0x1e, 0xc4, 0xd3, 0x16, 0x89, 0xda, 0x0a, 0xc0, 0xc8, 0x03, 0x60, 0x98, 0x9e, 0x78,
+		0x2e, 0x65, 0x0a, 0x66, 0x2d, 0x0f, 0x46, 0x29, 0xc1, 0x3a, 0x0b, 0xa2, 0x13, 0x12, 0x29, 0xc8,
+		0x57, 0xcf, 0x83, 0x3c, 0xf2, 0x82, 0x33, 0x05, 0x7b, 0x2b, 0x0f, 0xb6, 0xe1, 0x52, 0x16, 0x24,
+		0xc2, 0xfd, 0x5f, 0x06, 0x84, 0x36, 0x70, 0x44, 0x9c, 0x5e, 0xa8, 0x17, 0xfb, 0x40, 0x65, 0x6f,
+		0x61, 0xfc, 0x47, 0x83, 0xea, 0xe3, 0xc0, 0xf3, 0xee, 0x07, 0xd1, 0x2e, 0xb1, 0x5d, 0xea, 0x06,
+		0xfe, 0x01, 0xa6, 0x27, 0x26, 0x79, 0xda, 0x22, 0x94, 0xa1, 0x3a, 0x4c, 0x45, 0xf2, 0xa7, 0xae,
+		0xad, 0x6a, 0x6b, 0xa5, 0xcd, 0xf5, 0x5a, 0xe6, 0x61, 0x71, 0xe8, 0xd6, 0x4e, 0x37, 0x6a, 0xfd,
+		0x29, 0x98, 0x31, 0x3e, 0x5a, 0x86, 0xa2, 0x13, 0x34, 0xb1, 0xeb, 0x5b, 0xae, 0xa3, 0x8f, 0xad,
+		0x6a, 0x6b, 0x45, 0xb3, 0x20, 0x37, 0xea, 0x0e, 0x3f, 0x0c, 0x03, 0xcf, 0x23, 0x11, 0x3f, 0x1c,
+		0x97, 0x87, 0x72, 0xa3, 0xee, 0xa0, 0x17, 0xa1, 0x7c, 0x14, 0x44, 0x67, 0x38, 0x72, 0x88, 0x63,
+		0x1d, 0x45, 0x41, 0x53, 0xbf, 0x22, 0x20, 0x66, 0x92, 0xdd, 0xfb, 0x51, 0xd0, 0x44, 0x2f, 0xc3,
+		0xac, 0x4b, 0x03, 0x4f, 0xd8, 0x92, 0x75, 0x1c, 0x05, 0xad, 0x50, 0x9f, 0x10, 0x70, 0xe5, 0x64,
+		0xfb, 0x7d, 0xbe, 0x6b, 0xfc, 0xb9, 0x08, 0xcb, 0xb9, 0x12, 0xd3, 0x30, 0xf0, 0x29, 0x41, 0x2b,
+		0x00, 0x5c, 0x4b, 0x16, 0x0b, 0x4e, 0x88, 0x2f, 0xee, 0x3d, 0x6d, 0x16, 0xf9, 0xce, 0x01, 0xdf,
+		0x40, 0x3f, 0x00, 0x14, 0x3f, 0x9a, 0x45, 0x9e, 0x11, 0xbb, 0xc5, 0x29, 0x8b, 0x1b, 0x95, 0x36,
+		0x5f, 0xca, 0x55, 0xcf, 0xa7, 0x0a, 0x7c, 0x2f, 0x86, 0x36, 0xe7, 0xcf, 0xba, 0xb7, 0xd0, 0x7d,
+		0x98, 0x49, 0xc8, 0xb2, 0x76, 0x48, 0x84, 0x1a, 0x4a, 0x9b, 0xb7, 0x06, 0x52, 0x3c, 0x68, 0x87,
+		0xc4, 0x9c, 0x3e, 0x4b, 0xad, 0xd0, 0x27, 0xb0, 0x14, 0x46, 0xe4, 0xd4, 0x0d, 0x5a, 0xd4, 0xa2,
+		0x0c, 0x47, 0x8c, 0x38, 0x16, 0x39, 0x25, 0x3e, 0xe3, 0xaa, 0xbd, 0x22, 0x68, 0x2e, 0xd7, 0xa4,
+		0x0b, 0xd5, 0x62, 0x17, 0xaa, 0xd5, 0x7d, 0xf6, 0xd6, 0x9b, 0x9f, 0x60, 0xaf, 0x45, 0xcc, 0x4a,
+		0x8c, 0xbd, 0x2f, 0x91, 0xf7, 0x38, 0x6e, 0xdd, 0x41, 0x6b, 0x30, 0xd7, 0x43, 0x8e, 0xeb, 0x77,
+		0xdc, 0x2c, 0xd3, 0x2c, 0xa4, 0x0e, 0x53, 0x98, 0x31, 0xd2, 0x0c, 0x99, 0x3e, 0xb9, 0xaa, 0xad,
+		0x4d, 0x98, 0xf1, 0x12, 0x19, 0x30, 0xe3, 0x93, 0x67, 0xac, 0x43, 0x60, 0x4a, 0x10, 0x28, 0xf1,
+		0xcd, 0x18, 0xfb, 0x35, 0x40, 0x87, 0xd8, 0x3e, 0xf1, 0x82, 0x63, 0xcb, 0x0e, 0x5a, 0x3e, 0xb3,
+		0x1a, 0xae, 0xcf, 0xf4, 0x82, 0x00, 0x9c, 0x53, 0x27, 0x3b, 0xfc, 0xe0, 0x81, 0xeb, 0x33, 0x74,
+		0x17, 0x74, 0xca, 0x5c, 0xfb, 0xa4, 0xdd, 0x79, 0x0a, 0x8b, 0xf8, 0xf8, 0xd0, 0x23, 0x8e, 0x5e,
+		0x5c, 0xd5, 0xd6, 0x0a, 0x66, 0x45, 0x9e, 0x27, 0x8a, 0xde, 0x93, 0xa7, 0xe8, 0x2e, 0x4c, 0x08,
+		0x97, 0xd7, 0x41, 0xe8, 0xc4, 0x18, 0xa8, 0xe7, 0x8f, 0x39, 0xa4, 0x29, 0x11, 0x90, 0x09, 0x33,
+		0x8e, 0xb2, 0x1b, 0xcb, 0xf5, 0x8f, 0x02, 0xbd, 0x24, 0x28, 0xbc, 0x9e, 0xa5, 0x20, 0x5d, 0x8e,
+		0x13, 0x39, 0x88, 0xb0, 0x4f, 0x5d, 0xe2, 0xb3, 0xd8, 0xda, 0xea, 0xfe, 0x51, 0x60, 0x4e, 0x3b,
+		0xa9, 0x15, 0x7a, 0x02, 0xd7, 0x7b, 0x8d, 0xca, 0x12, 0x66, 0xc8, 0xbd, 0x55, 0x9f, 0x16, 0x2c,
+		0x56, 0x72, 0x85, 0xe4, 0xc6, 0xfb, 0xa1, 0x4b, 0x99, 0xb9, 0xd4, 0x63, 0x55, 0xf1, 0x11, 0xaa,
+		0xc1, 0x82, 0x54, 0x3a, 0x8f, 0x11, 0xc4, 0x3a, 0x25, 0x11, 0x67, 0xad, 0xcf, 0x88, 0xf7, 0x99,
+		0x17, 0x47, 0xfb, 0xfc, 0xe4, 0x13, 0x79, 0x80, 0x6e, 0xc1, 0xf4, 0x61, 0x84, 0x7d, 0xbb, 0xa1,
+		0xbc, 0xa0, 0x2c, 0xbc, 0xa0, 0x24, 0xf7, 0xa4, 0x1f, 0x6c, 0x41, 0x99, 0xda, 0x0d, 0xe2, 0xb4,
+		0x3c, 0xe2, 0x58, 0x3c, 0x48, 0xeb, 0xb3, 0x42, 0xc8, 0x6a, 0x8f, 0x75, 0x1d, 0xc4, 0x11, 0xdc,
+		0x9c, 0x49, 0x30, 0xf8, 0x1e, 0x7a, 0x0f, 0xa6, 0x63, 0x9b, 0x12, 0x04, 0xe6, 0xce, 0x25, 0x50,
+		0x52, 0xf0, 0x02, 0xfd, 0x27, 0x30, 0xc5, 0x5f, 0xc4, 0x25, 0x54, 0x9f, 0x5f, 0x1d, 0x5f, 0x2b,
+		0x6d, 0x6e, 0xd7, 0xfa, 0xa5, 0x9d, 0xda, 0x00, 0x87, 0xaf, 0x7d, 0x2c, 0x89, 0xec, 0xf9, 0x2c,
+		0x6a, 0x9b, 0x31, 0x49, 0xae, 0x32, 0x16, 0x30, 0xec, 0x59, 0x2a, 0xb0, 0x5a, 0x87, 0x6d, 0x46,
+		0xa8, 0x8e, 0x84, 0x25, 0xce, 0x8b, 0xa3, 0x07, 0xf2, 0x64, 0x9b, 0x1f, 0x54, 0x9f, 0xc0, 0x74,
+		0x9a, 0x10, 0x9a, 0x83, 0xf1, 0x13, 0xd2, 0x16, 0xf1, 0xa3, 0x68, 0xf2, 0x9f, 0xdc, 0xe4, 0x4e,
+		0xb9, 0x8f, 0xe9, 0x63, 0xc3, 0x9b, 0x9c, 0x40, 0xb8, 0x37, 0x76, 0x57, 0x4b, 0x87, 0xea, 0x2d,
+		0x9b, 0xb9, 0xa7, 0x2e, 0x6b, 0x5f, 0x3c, 0x54, 0xe7, 0x50, 0xf8, 0x36, 0x86, 0xea, 0x2f, 0x0a,
+		0xb0, 0x9c, 0x2b, 0xf1, 0x37, 0x1a, 0xaa, 0x6f, 0x42, 0x09, 0x2b, 0x69, 0x3a, 0x4a, 0x80, 0x78,
+		0xab, 0xee, 0xf0, 0x58, 0x9e, 0x00, 0x88, 0x58, 0x7e, 0x65, 0x40, 0x2c, 0x4f, 0x2e, 0x26, 0x62,
+		0x39, 0x4e, 0xad, 0xd0, 0x26, 0x4c, 0xb8, 0x7e, 0xd8, 0x62, 0x42, 0x3b, 0xa5, 0xcd, 0xeb, 0xf9,
+		0x2f, 0x8a, 0xdb, 0x5e, 0x80, 0x1d, 0x53, 0x82, 0xe6, 0xb8, 0xe5, 0xe4, 0x65, 0xdd, 0x72, 0x6a,
+		0x34, 0xb7, 0x3c, 0x80, 0xa5, 0x98, 0x9e, 0xc5, 0x02, 0xcb, 0xf6, 0x02, 0x4a, 0x04, 0xa1, 0xa0,
+		0x25, 0x03, 0x79, 0x69, 0x73, 0xa9, 0x87, 0xd6, 0xae, 0xaa, 0x02, 0xcd, 0x4a, 0x8c, 0x7b, 0x10,
+		0xec, 0x70, 0xcc, 0x03, 0x89, 0x88, 0x3e, 0x82, 0x8a, 0x60, 0xd2, 0x4b, 0xb2, 0x78, 0x1e, 0xc9,
+		0x05, 0x81, 0xd8, 0x45, 0xef, 0x3e, 0xcc, 0x37, 0x08, 0x8e, 0xd8, 0x21, 0xc1, 0x2c, 0x21, 0x05,
+		0xe7, 0x91, 0x9a, 0x4b, 0x70, 0x62, 0x3a, 0xa9, 0x6c, 0x57, 0xca, 0x66, 0xbb, 0x27, 0x70, 0x23,
+		0xfb, 0x12, 0x56, 0x70, 0x64, 0xb1, 0x86, 0x4b, 0xad, 0x18, 0x61, 0xfa, 0x5c, 0xc5, 0x56, 0x33,
+		0x2f, 0xf3, 0xe8, 0xe8, 0xa0, 0xe1, 0xd2, 0x2d, 0x45, 0xbf, 0x9e, 0xbe, 0x81, 0x43, 0x18, 0x76,
+		0x3d, 0xaa, 0xcf, 0x0c, 0x61, 0x29, 0x9d, 0x4b, 0xec, 0x4a, 0xac, 0xde, 0xe2, 0xa3, 0x7c, 0xb1,
+		0xe2, 0xe3, 0x65, 0x98, 0x4d, 0xe8, 0xc8, 0x88, 0x21, 0x92, 0x42, 0xd1, 0x2c, 0xc7, 0xdb, 0xbb,
+		0x62, 0x17, 0xbd, 0x01, 0x93, 0x0d, 0x82, 0x1d, 0x12, 0xa9, 0x98, 0xbf, 0x9c, 0xcb, 0xe9, 0x81,
+		0x00, 0x31, 0x15, 0xa8, 0xf1, 0xbb, 0x09, 0xa8, 0x6c, 0x39, 0x4e, 0x5e, 0xa1, 0x9a, 0x09, 0x59,
+		0x5a, 0x57, 0xc8, 0xfa, 0x8a, 0xc2, 0xc0, 0x3d, 0x28, 0x76, 0x12, 0xf4, 0xf8, 0x30, 0x09, 0xba,
+		0xc0, 0xd4, 0x2f, 0x1e, 0x42, 0x12, 0x1f, 0x51, 0x75, 0xd9, 0xb8, 0x09, 0xf1, 0x56, 0xdd, 0xe9,
+		0x76, 0x22, 0x65, 0xfa, 0xca, 0x4c, 0x27, 0x46, 0x70, 0x22, 0x51, 0xc6, 0xc5, 0xc6, 0x7a, 0x0f,
+		0x26, 0x69, 0xd0, 0x8a, 0x6c, 0x19, 0x14, 0xca, 0x9b, 0x46, 0xdf, 0x9a, 0x05, 0xd3, 0x93, 0x7d,
+		0x01, 0x69, 0x2a, 0x8c, 0x9c, 0xd8, 0x3e, 0x95, 0x17, 0xdb, 0x43, 0x98, 0x0b, 0x71, 0xc4, 0x5c,
+		0x11, 0xdb, 0xed, 0xc0, 0x3f, 0x72, 0x8f, 0xf5, 0x82, 0xc8, 0xce, 0x7b, 0xfd, 0xb3, 0x73, 0xfe,
+		0xab, 0xd6, 0x1e, 0xc7, 0x84, 0x76, 0x04, 0x1d, 0x99, 0xa0, 0x67, 0xc3, 0xec, 0x2e, 0xaa, 0x42,
+		0x21, 0x8c, 0xdc, 0x20, 0x72, 0x59, 0x5b, 0xc4, 0x82, 0x09, 0x33, 0x59, 0xa3, 0x57, 0x60, 0x4e,
+		0x89, 0xe7, 0xfa, 0xc7, 0x96, 0x43, 0x42, 0xd6, 0x10, 0x4e, 0x3e, 0x61, 0xce, 0x76, 0xf6, 0x77,
+		0xf9, 0x76, 0x75, 0x1b, 0x16, 0xf3, 0xf8, 0xe5, 0xe4, 0xf1, 0xc5, 0x74, 0x1e, 0x2f, 0xa6, 0x73,
+		0xf4, 0x2e, 0x5c, 0xeb, 0xb9, 0x8a, 0x4a, 0x55, 0x79, 0x92, 0x68, 0xb9, 0x92, 0x18, 0xbf, 0x99,
+		0x14, 0x76, 0x9e, 0x97, 0xe5, 0xbf, 0x09, 0x3b, 0xe7, 0x95, 0xbf, 0x30, 0x01, 0xab, 0xc3, 0x5a,
+		0xe6, 0xbc, 0xb2, 0xdc, 0xdf, 0x8d, 0x05, 0xc8, 0x78, 0xc4, 0x95, 0x4b, 0x79, 0xc4, 0xc4, 0x68,
+		0x1e, 0x31, 0x79, 0x79, 0x8f, 0x98, 0x7a, 0x0e, 0x1e, 0x51, 0xc8, 0xf3, 0x08, 0x1f, 0x74, 0x9c,
+		0x7a, 0xca, 0x5d, 0x97, 0x86, 0xdc, 0xf4, 0x79, 0xdd, 0xaf, 0x72, 0xd7, 0xe6, 0x00, 0xcf, 0xe8,
+		0x83, 0x69, 0xf6, 0xa5, 0x99, 0xeb, 0x81, 0x30, 0x84, 0x07, 0xe6, 0xd8, 0xdb, 0x05, 0x3c, 0xb0,
+		0x94, 0xf5, 0xc0, 0xe7, 0xe2, 0x56, 0x5f, 0x8e, 0x83, 0xde, 0x4f, 0x11, 0xe8, 0xfb, 0x30, 0xdb,
+		0x49, 0xb3, 0xa2, 0x93, 0xd1, 0xb5, 0x01, 0xd9, 0x4b, 0xd5, 0xec, 0xa2, 0xdd, 0x34, 0x3b, 0xa5,
+		0x92, 0x58, 0xf7, 0x54, 0x3e, 0x63, 0xa3, 0x55, 0x3e, 0xa9, 0x5a, 0x60, 0x7c, 0xd4, 0x5a, 0xe0,
+		0xca, 0xf3, 0xaf, 0x05, 0x26, 0x9e, 0x4f, 0x2d, 0x30, 0xf9, 0xdc, 0x6a, 0x81, 0xa9, 0xbc, 0x5a,
+		0xc0, 0x58, 0x12, 0x41, 0x33, 0xaf, 0xbe, 0x37, 0xbe, 0xd4, 0x60, 0x51, 0x34, 0x42, 0x31, 0x9f,
+		0x38, 0x0e, 0xee, 0x74, 0x77, 0x3b, 0xaf, 0xe4, 0x8a, 0x97, 0x87, 0x3b, 0x64, 0x9f, 0x73, 0x99,
+		0xec, 0x3e, 0x5c, 0x1b, 0x64, 0xfc, 0x41, 0x83, 0xab, 0x5d, 0x12, 0xaa, 0x64, 0xf1, 0xff, 0x30,
+		0x2d, 0x66, 0x0d, 0x56, 0x44, 0x68, 0xcb, 0x8b, 0xef, 0x38, 0xf8, 0x25, 0x4b, 0x02, 0xc3, 0x14,
+		0x08, 0xa8, 0x0e, 0xe5, 0x98, 0xc0, 0x4f, 0x89, 0xcd, 0x88, 0x33, 0xb0, 0xe7, 0x94, 0xbd, 0xa6,
+		0x82, 0x34, 0x67, 0x9e, 0xa6, 0x97, 0xc6, 0xbf, 0x35, 0x58, 0x95, 0x82, 0x39, 0x02, 0x8e, 0xdf,
+		0x77, 0x27, 0x68, 0x86, 0x1e, 0xe1, 0xc0, 0x4a, 0x95, 0x8f, 0xba, 0xdf, 0xe3, 0x4e, 0x2e, 0xa3,
+		0xf3, 0xe8, 0x7c, 0x0d, 0x6f, 0x73, 0x0d, 0xa6, 0x04, 0xae, 0xaa, 0xba, 0x8a, 0xe6, 0x24, 0x5f,
+		0xd6, 0x1d, 0xe3, 0x05, 0xb8, 0x35, 0x40, 0x3c, 0x65, 0x90, 0xff, 0xd5, 0xe0, 0xfa, 0x0e, 0xf6,
+		0x6d, 0xe2, 0x3d, 0x6a, 0x31, 0xca, 0xb0, 0xcf, 0xb3, 0x36, 0xef, 0x50, 0x87, 0x4a, 0xd0, 0x99,
+		0xde, 0x79, 0xac, 0xab, 0x77, 0x7e, 0x1f, 0xca, 0xc9, 0xa5, 0x3a, 0x13, 0xc0, 0x72, 0x1f, 0xc7,
+		0x8b, 0x6f, 0x26, 0x1d, 0x8f, 0xa5, 0x56, 0x97, 0xca, 0xc2, 0x2b, 0x00, 0xb6, 0xb8, 0x9e, 0x85,
+		0x3d, 0x4f, 0x04, 0x90, 0x82, 0x59, 0x94, 0x3b, 0x5b, 0x9e, 0x67, 0x3c, 0x82, 0x95, 0x3e, 0xb7,
+		0x57, 0x86, 0x5b, 0x83, 0x05, 0xbf, 0xd5, 0xb4, 0x24, 0x06, 0x8f, 0x75, 0xfc, 0x7a, 0x54, 0x15,
+		0x3a, 0xf3, 0x7e, 0xab, 0xb9, 0x13, 0x9f, 0x70, 0x34, 0x6a, 0xfc, 0x5d, 0x83, 0xab, 0xbb, 0x84,
+		0xda, 0x91, 0x7b, 0x48, 0x1e, 0x0b, 0x4d, 0x0c, 0xa5, 0xc8, 0xcc, 0x15, 0xc7, 0x46, 0xbb, 0xe2,
+		0x73, 0xd3, 0x73, 0x15, 0x0a, 0xae, 0x43, 0x7c, 0xc6, 0xb3, 0x9e, 0x34, 0xa5, 0x64, 0x6d, 0xfc,
+		0x49, 0x83, 0x4a, 0xf7, 0xbd, 0x94, 0x8a, 0xde, 0x86, 0x49, 0xf9, 0xe6, 0xca, 0x53, 0x6e, 0xf6,
+		0x9d, 0xd3, 0x90, 0x48, 0x64, 0x7a, 0x05, 0x9e, 0x37, 0x35, 0x19, 0xcb, 0x9b, 0x9a, 0xa0, 0x37,
+		0xa1, 0x12, 0x74, 0xde, 0xc7, 0x4a, 0x4c, 0x8e, 0xea, 0xe3, 0xab, 0xe3, 0x6b, 0x45, 0x73, 0x31,
+		0xc8, 0xbe, 0x1e, 0x37, 0x3f, 0x6a, 0xfc, 0x45, 0x83, 0x6b, 0xb1, 0xc8, 0x89, 0xda, 0xd4, 0x63,
+		0xdc, 0xef, 0x76, 0xef, 0xd7, 0x72, 0x85, 0xee, 0x83, 0x3e, 0xa4, 0x57, 0xdf, 0x05, 0xdd, 0xf5,
+		0x6d, 0xaf, 0xe5, 0x10, 0x2b, 0x9e, 0x00, 0x13, 0xca, 0xdc, 0x26, 0x66, 0xf2, 0x89, 0x0a, 0x66,
+		0x45, 0x9d, 0x6f, 0xcb, 0xe3, 0x3d, 0x75, 0x6a, 0xfc, 0x53, 0x03, 0xbd, 0x97, 0xb7, 0xd2, 0xf7,
+		0x3b, 0x30, 0x25, 0x35, 0xc0, 0xcd, 0x70, 0x7c, 0x18, 0x85, 0xc7, 0xf0, 0xe8, 0x21, 0xcc, 0x75,
+		0x4c, 0x85, 0x32, 0xcc, 0x5a, 0x54, 0x59, 0xdb, 0x0b, 0x03, 0x8d, 0x65, 0x5f, 0x80, 0x9a, 0x65,
+		0x96, 0x59, 0xf3, 0x77, 0xc9, 0x8e, 0xb6, 0x33, 0xd7, 0x1b, 0x37, 0x17, 0xd3, 0xe3, 0xed, 0xe4,
+		0x72, 0x14, 0x56, 0x84, 0xc9, 0x29, 0x5a, 0x49, 0x31, 0x45, 0xe3, 0xc7, 0xa9, 0xc0, 0xa4, 0xca,
+		0xaf, 0xd2, 0x4d, 0xd4, 0xea, 0x32, 0x4e, 0x62, 0xfc, 0x6a, 0x0c, 0x6e, 0xf4, 0xe3, 0xaa, 0xf4,
+		0xfa, 0x14, 0x56, 0x3a, 0x43, 0xae, 0x44, 0x4b, 0x49, 0x69, 0x18, 0x6b, 0xbb, 0x36, 0x90, 0x65,
+		0x42, 0xf7, 0x21, 0x61, 0xd8, 0xc1, 0x0c, 0x9b, 0xd5, 0x74, 0x5d, 0x9b, 0x65, 0xcd, 0x59, 0x26,
+		0x93, 0xf7, 0x5c, 0x96, 0x63, 0x17, 0x63, 0xe9, 0xa4, 0x1a, 0xb6, 0x2c, 0x4b, 0xe3, 0x09, 0x2c,
+		0xbf, 0x4f, 0x12, 0x35, 0xd0, 0xed, 0xb6, 0x2c, 0x5a, 0xce, 0xd3, 0xfd, 0xb0, 0xbe, 0x6a, 0xfc,
+		0xf1, 0x0a, 0x5c, 0xcf, 0x67, 0xa0, 0xd4, 0xfc, 0x4b, 0x0d, 0x2a, 0x39, 0x97, 0x6e, 0xe2, 0x50,
+		0x29, 0xf8, 0x51, 0xff, 0xa2, 0x7e, 0x10, 0xe1, 0xda, 0x6e, 0xd7, 0xa5, 0x1f, 0xe2, 0x50, 0x96,
+		0xf7, 0x0b, 0x4e, 0xef, 0x89, 0x10, 0x23, 0xe7, 0xb9, 0xb9, 0x18, 0x63, 0x97, 0x12, 0x63, 0xab,
+		0xeb, 0xb9, 0x3b, 0x62, 0xe0, 0xde, 0x93, 0xea, 0x67, 0xdc, 0xd1, 0xf3, 0xe5, 0xce, 0xe9, 0x28,
+		0x1e, 0x64, 0x07, 0xee, 0x03, 0xda, 0xac, 0x7e, 0xd1, 0x23, 0xd5, 0x85, 0x70, 0xde, 0xfd, 0x84,
+		0xfd, 0xaa, 0x79, 0x1b, 0x7f, 0xd3, 0x40, 0x4f, 0xa9, 0x51, 0x36, 0x52, 0xdf, 0x99, 0x54, 0x69,
+		0xfc, 0xa3, 0x08, 0x4b, 0x39, 0xe2, 0x67, 0x8b, 0x86, 0x88, 0x60, 0x27, 0x1b, 0x3f, 0xe2, 0xa2,
+		0xc1, 0x24, 0xd8, 0x49, 0x85, 0x81, 0xdb, 0xb0, 0xc8, 0xe1, 0xcf, 0x22, 0x97, 0x91, 0xac, 0xf7,
+		0x73, 0x04, 0xe4, 0xb7, 0x9a, 0x9f, 0xf2, 0xa3, 0x14, 0xc6, 0xab, 0x30, 0x2f, 0xbf, 0x0a, 0x5a,
+		0xb4, 0xed, 0xdb, 0x96, 0xd0, 0xbe, 0xca, 0x29, 0xb3, 0xf2, 0x60, 0xbf, 0xed, 0xdb, 0x0f, 0xf9,
+		0x36, 0xba, 0x07, 0x4b, 0x0a, 0x36, 0xfe, 0x56, 0x6e, 0x25, 0x3e, 0x2b, 0xf2, 0x7c, 0xc1, 0xbc,
+		0x26, 0x01, 0x0e, 0xd4, 0x79, 0x3d, 0x3e, 0x46, 0xeb, 0xb0, 0x78, 0x4c, 0x98, 0x40, 0xa4, 0xd6,
+		0x21, 0x27, 0x67, 0x51, 0xf7, 0x33, 0x22, 0x0a, 0xa9, 0x09, 0x73, 0xfe, 0x58, 0xaa, 0x80, 0x6e,
+		0xf3, 0x93, 0x7d, 0xf7, 0x33, 0x82, 0x5e, 0x87, 0x85, 0x26, 0x7e, 0x26, 0x1d, 0x2a, 0x05, 0x2f,
+		0xbf, 0x9b, 0xce, 0x35, 0xf1, 0x33, 0x0e, 0xdf, 0x01, 0xbf, 0x07, 0xd5, 0x04, 0xdc, 0x21, 0x1e,
+		0x61, 0x24, 0x8d, 0x35, 0x25, 0xb0, 0x2a, 0x0a, 0x6b, 0x57, 0x9c, 0x77, 0x70, 0xb7, 0xe1, 0x46,
+		0xd3, 0x55, 0x21, 0x84, 0x35, 0xa2, 0x80, 0x31, 0x8f, 0x57, 0x07, 0x87, 0xad, 0x88, 0x32, 0x89,
+		0x5f, 0x10, 0xf8, 0xd5, 0xa6, 0x2b, 0x7c, 0xeb, 0x20, 0x81, 0xd9, 0xe6, 0x20, 0x82, 0xc6, 0x07,
+		0x60, 0xa4, 0x2b, 0x0b, 0x41, 0x8b, 0xff, 0xf9, 0xc2, 0x77, 0x28, 0xa7, 0x49, 0x68, 0x23, 0xf0,
+		0x1c, 0x35, 0x84, 0xbb, 0x99, 0x82, 0xe4, 0xf4, 0xb6, 0x24, 0xdc, 0x41, 0x0c, 0x86, 0xf6, 0xe0,
+		0x66, 0xdc, 0x0f, 0x45, 0x16, 0xbf, 0x56, 0x77, 0xd1, 0x42, 0xd5, 0xa8, 0xee, 0x7a, 0x02, 0xf6,
+		0x10, 0x3f, 0xeb, 0xaa, 0x3c, 0xe9, 0x60, 0x32, 0xe2, 0x25, 0xf4, 0xd2, 0x40, 0x32, 0xe2, 0x49,
+		0xd0, 0xf7, 0x60, 0x25, 0x4b, 0x26, 0xc2, 0xdc, 0xba, 0x48, 0x64, 0x51, 0x62, 0x07, 0xbe, 0x23,
+		0x86, 0xf5, 0x13, 0xe6, 0x52, 0x9a, 0x88, 0x89, 0x19, 0x79, 0x4c, 0xa2, 0x7d, 0x01, 0x80, 0x76,
+		0xbb, 0x05, 0xb1, 0x1b, 0xae, 0xe7, 0x44, 0xc4, 0x17, 0x54, 0xfc, 0xc0, 0x21, 0xea, 0x7b, 0xeb,
+		0x72, 0x9a, 0xc6, 0x8e, 0x02, 0x7a, 0x4c, 0xa2, 0x8f, 0x02, 0x87, 0xa0, 0x3a, 0x2c, 0xb4, 0x42,
+		0x87, 0xf3, 0xc6, 0xf6, 0x89, 0xe5, 0xfa, 0x8c, 0x44, 0xa7, 0xd8, 0xd3, 0xcb, 0xe7, 0x0d, 0xb8,
+		0xe6, 0x25, 0xd6, 0x96, 0x7d, 0x52, 0x57, 0x38, 0xe8, 0xc7, 0xb0, 0xe2, 0x3a, 0xca, 0x8e, 0xa5,
+		0x0f, 0xdb, 0x0d, 0x92, 0x26, 0x3a, 0x7b, 0x1e, 0xd1, 0x25, 0x8e, 0x9f, 0x78, 0x6d, 0x83, 0xa4,
+		0x88, 0x3f, 0x82, 0x6b, 0x89, 0x29, 0x4a, 0x27, 0x11, 0xac, 0x3a, 0x9f, 0x71, 0x07, 0x7d, 0x90,
+		0x51, 0x26, 0xca, 0xa9, 0xd6, 0x39, 0x07, 0xf9, 0x35, 0x77, 0xc5, 0x0b, 0xd4, 0xcb, 0x5b, 0xe4,
+		0x59, 0xe8, 0x4a, 0xe0, 0x8e, 0xb4, 0xf3, 0xe7, 0x91, 0xad, 0x7a, 0x81, 0x34, 0x8a, 0xbd, 0x04,
+		0x3b, 0x11, 0xf7, 0x87, 0xb0, 0x8c, 0x85, 0xef, 0x4b, 0xdf, 0x51, 0x03, 0xa4, 0x64, 0x7e, 0x88,
+		0xce, 0xa3, 0xad, 0x0b, 0xec, 0xf4, 0xf0, 0x49, 0x4d, 0x10, 0x8d, 0x7f, 0x69, 0x70, 0xdd, 0x24,
+		0xb4, 0x13, 0xdd, 0xb6, 0xec, 0x93, 0x0f, 0xc9, 0x29, 0xf1, 0xbe, 0x3b, 0x9d, 0xcc, 0x32, 0x14,
+		0xb9, 0xb1, 0x79, 0x5c, 0x6a, 0xf5, 0x2d, 0xa2, 0x80, 0xd5, 0x2d, 0x8c, 0x9b, 0xb0, 0xd2, 0xe7,
+		0x7a, 0xaa, 0x27, 0xfe, 0xab, 0x06, 0x15, 0x93, 0x1c, 0x71, 0xb7, 0xee, 0xee, 0x1b, 0xbe, 0xfd,
+		0x99, 0xe9, 0x67, 0x70, 0xad, 0x47, 0xf6, 0xaf, 0x2b, 0x2d, 0x19, 0x15, 0x58, 0x7c, 0x40, 0xb0,
+		0xc7, 0x1a, 0x6a, 0xf6, 0xa6, 0xd4, 0x66, 0x1c, 0xc1, 0xd5, 0xae, 0x7d, 0x25, 0x52, 0x19, 0xc6,
+		0x82, 0x13, 0x21, 0x41, 0xc1, 0x1c, 0x0b, 0x4e, 0xd0, 0x7b, 0x30, 0x29, 0x5c, 0x3a, 0xae, 0x7c,
+		0x5f, 0xec, 0x5f, 0x65, 0x48, 0x82, 0xc2, 0x87, 0x4d, 0x85, 0x64, 0x7c, 0x00, 0xa5, 0xd4, 0x36,
+		0x42, 0x70, 0xc5, 0xc7, 0x4d, 0xa2, 0x1e, 0x4a, 0xfc, 0x56, 0x1c, 0xc7, 0x12, 0x8e, 0x3a, 0x4c,
+		0x35, 0x09, 0xa5, 0xf8, 0x98, 0xa8, 0x6f, 0x00, 0xf1, 0x72, 0xf3, 0xf7, 0x65, 0x28, 0x3d, 0x54,
+		0x0c, 0xb7, 0x1e, 0xd7, 0xd1, 0x2f, 0x34, 0x58, 0xc8, 0xf9, 0xd7, 0x05, 0x7a, 0x73, 0xc4, 0x3f,
+		0x69, 0x08, 0x95, 0x54, 0xef, 0x5c, 0xe8, 0xaf, 0x1d, 0x69, 0x21, 0xd2, 0xb5, 0xdb, 0x10, 0x42,
+		0xe4, 0x4c, 0xc3, 0xab, 0x77, 0x46, 0xc4, 0x52, 0x42, 0x9c, 0xc2, 0x6c, 0xd7, 0x57, 0x21, 0x74,
+		0x7b, 0xd4, 0x6f, 0x61, 0xd5, 0x8d, 0x11, 0x30, 0x32, 0x7c, 0x33, 0xf7, 0xbe, 0x3d, 0xea, 0x17,
+		0x80, 0xea, 0xc6, 0x08, 0x18, 0x8a, 0x6f, 0x08, 0x33, 0x99, 0xb1, 0x26, 0xaa, 0xf5, 0xa7, 0x91,
+		0x37, 0xa1, 0xad, 0xae, 0x0f, 0x0d, 0xaf, 0x38, 0xfe, 0x56, 0x83, 0xa5, 0xbe, 0xc3, 0x3b, 0x74,
+		0xaf, 0x3f, 0xb9, 0xf3, 0x06, 0x92, 0xd5, 0x77, 0x2f, 0x84, 0xab, 0xc4, 0xfa, 0xb5, 0x06, 0x57,
+		0x73, 0xe7, 0x65, 0xe8, 0xad, 0xfe, 0x64, 0x07, 0x8d, 0x17, 0xab, 0x6f, 0x8f, 0x8c, 0xa7, 0x44,
+		0xa1, 0x50, 0xce, 0xce, 0xa3, 0xd0, 0xfa, 0xf9, 0x1d, 0x49, 0x66, 0x22, 0x57, 0xbd, 0x3d, 0x3c,
+		0x82, 0x62, 0xda, 0x86, 0xb9, 0xee, 0xe6, 0x06, 0x6d, 0x8c, 0xd2, 0x08, 0x49, 0xc6, 0x17, 0xe8,
+		0x9d, 0xd0, 0x17, 0x1a, 0x54, 0xf2, 0x07, 0x18, 0x68, 0x80, 0x0e, 0x07, 0x0e, 0x5a, 0xaa, 0x77,
+		0x47, 0x47, 0x54, 0xd2, 0x7c, 0xae, 0xc1, 0x62, 0x5e, 0x17, 0x8c, 0xee, 0x8c, 0xda, 0x35, 0x4b,
+		0x49, 0xde, 0xba, 0x58, 0xb3, 0x8d, 0x7e, 0x0e, 0xf3, 0x3d, 0x6d, 0x18, 0xda, 0x1c, 0x8a, 0x58,
+		0xa6, 0xe5, 0xac, 0xbe, 0x31, 0x12, 0x4e, 0xca, 0x1d, 0x72, 0x4b, 0x89, 0x41, 0xee, 0x30, 0xa8,
+		0xb4, 0xaa, 0xbe, 0x3d, 0x32, 0x5e, 0x27, 0x34, 0x76, 0xa5, 0xfd, 0x41, 0xa1, 0x31, 0xbf, 0xba,
+		0xa9, 0x6e, 0x8c, 0x80, 0x21, 0xf9, 0x6e, 0x7e, 0xae, 0xc1, 0x7c, 0x9c, 0x24, 0x65, 0xea, 0xe5,
+		0xa9, 0x32, 0x84, 0x99, 0x4c, 0xbe, 0x1f, 0x14, 0x30, 0xf3, 0x0a, 0x86, 0xea, 0xfa, 0xd0, 0xf0,
+		0x52, 0x8e, 0xed, 0x77, 0x7f, 0xf4, 0xce, 0xb1, 0xcb, 0x1a, 0xad, 0xc3, 0x9a, 0x1d, 0x34, 0xd7,
+		0x33, 0xff, 0x15, 0xaf, 0x1d, 0x13, 0x5f, 0xfe, 0xb9, 0x3e, 0xfd, 0xff, 0xfe, 0x77, 0xe3, 0xdf,
+		0xa7, 0x1b, 0x87, 0x93, 0xe2, 0xf4, 0x8d, 0xff, 0x0d, 0x00, 0x54, 0x74, 0xd6, 0x20, 0x0d, 0x30,
+		0x00, 0x00,
 	},
 	// google/protobuf/duration.proto
 	[]byte{
@@ -850,7 +1318,7 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x95, 0x05, 0xa9, 0xc5, 0xfa, 0xd9, 0x79, 0xf9, 0xe5, 0x79, 0x70, 0xc7, 0x16, 0x24, 0xfd, 0x60,
 		0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce, 0x1d, 0xa2, 0x39, 0x00, 0xaa,
 		0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4, 0x35, 0x89, 0x0d, 0x6c, 0x94,
-		0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
+		0x31, 0x60, 0x00, 0xef, 0x8a, 0xb4, 0xc3, 0xfb, 0x00, 0x00, 0x00,
 	},
 	// google/protobuf/timestamp.proto
 	[]byte{
@@ -865,7 +1333,7 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0xac, 0x2c, 0x48, 0x2d, 0xd6, 0xcf, 0xce, 0xcb, 0x2f, 0xcf, 0x43, 0xb8, 0xb7, 0x20, 0xe9, 0x07,
 		0x23, 0xe3, 0x22, 0x26, 0x66, 0xf7, 0x00, 0xa7, 0x55, 0x4c, 0x72, 0xee, 0x10, 0xdd, 0x01, 0x50,
 		0x2d, 0x7a, 0xe1, 0xa9, 0x39, 0x39, 0xde, 0x20, 0x0d, 0x21, 0x20, 0xbd, 0x49, 0x6c, 0x60, 0xb3,
-		0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
+		0x8c, 0x01, 0x03, 0x00, 0xae, 0x65, 0xce, 0x7d, 0xff, 0x00, 0x00, 0x00,
 	},
 	// google/protobuf/wrappers.proto
 	[]byte{
@@ -884,71 +1352,69 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x94, 0x8e, 0x88, 0xab, 0x92, 0xca, 0x82, 0xd4, 0x62, 0xfd, 0xec, 0xbc, 0xfc, 0xf2, 0x3c, 0x78,
 		0xbc, 0x15, 0x24, 0xfd, 0x60, 0x64, 0x5c, 0xc4, 0xc4, 0xec, 0x1e, 0xe0, 0xb4, 0x8a, 0x49, 0xce,
 		0x1d, 0xa2, 0x39, 0x00, 0xaa, 0x43, 0x2f, 0x3c, 0x35, 0x27, 0xc7, 0x1b, 0xa4, 0x3e, 0x04, 0xa4,
-		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x92, 0x48, 0x30, 0x06,
-		0x02, 0x00, 0x00,
+		0x35, 0x89, 0x0d, 0x6c, 0x94, 0x31, 0x60, 0x00, 0x3c, 0x92, 0x48, 0x30, 0x06, 0x02, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/common.proto
 	[]byte{
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x51, 0x6f, 0xdb, 0x36,
-		0x17, 0xfd, 0x14, 0xc7, 0x4e, 0x7b, 0x9d, 0x26, 0xfa, 0x98, 0x35, 0x71, 0xd2, 0x75, 0x4b, 0x05,
-		0x0c, 0xf5, 0x8a, 0x4d, 0x46, 0xdc, 0x97, 0x62, 0x45, 0x37, 0x38, 0xb6, 0x93, 0xa8, 0xcd, 0x6c,
+		0x17, 0xfd, 0x14, 0xc7, 0x4e, 0x7b, 0x9d, 0x26, 0xfe, 0x98, 0x35, 0x71, 0xd2, 0x75, 0x4b, 0x05,
+		0x0c, 0xcd, 0x8a, 0x4d, 0x46, 0xdc, 0x97, 0x62, 0x45, 0x37, 0x38, 0xb1, 0x93, 0xa8, 0xcd, 0x6c,
 		0x43, 0xf6, 0x1a, 0x74, 0x03, 0x26, 0xd0, 0x12, 0xe5, 0x72, 0x96, 0x48, 0x81, 0xa2, 0x9c, 0xf8,
-		0x65, 0xd8, 0x2f, 0xd9, 0xc3, 0xfe, 0xd2, 0xfe, 0xd0, 0x20, 0x89, 0x8a, 0xed, 0xce, 0x41, 0xf7,
-		0x30, 0xec, 0x8d, 0xbc, 0xe7, 0xdc, 0xc3, 0x43, 0xe2, 0xde, 0x2b, 0xc1, 0x71, 0x32, 0x26, 0xa2,
-		0xe1, 0x62, 0x8f, 0x30, 0x97, 0x34, 0x70, 0x44, 0x1b, 0xb3, 0x93, 0x86, 0xcb, 0xc3, 0x90, 0x33,
-		0x33, 0x12, 0x5c, 0x72, 0xb4, 0x97, 0x32, 0x4c, 0xc5, 0x30, 0x71, 0x44, 0xcd, 0xd9, 0xc9, 0xd1,
-		0x67, 0x13, 0xce, 0x27, 0x01, 0x69, 0x64, 0x94, 0x71, 0xe2, 0x37, 0xbc, 0x44, 0x60, 0x49, 0x8b,
-		0x24, 0xe3, 0x0d, 0xfc, 0xff, 0x8a, 0x8b, 0xa9, 0x1f, 0xf0, 0xeb, 0xee, 0x0d, 0x71, 0x93, 0x14,
-		0x42, 0x9f, 0x43, 0xf5, 0x5a, 0x05, 0x1d, 0xea, 0xd5, 0xb4, 0x63, 0xad, 0x7e, 0xdf, 0x86, 0x22,
-		0x64, 0x79, 0xe8, 0x21, 0x54, 0x44, 0xc2, 0x52, 0x6c, 0x23, 0xc3, 0xca, 0x22, 0x61, 0x96, 0x67,
-		0x18, 0xb0, 0x5d, 0x88, 0x8d, 0xe6, 0x11, 0x41, 0x08, 0x36, 0x19, 0x0e, 0x89, 0x12, 0xc8, 0xd6,
-		0x29, 0xa7, 0xe5, 0x4a, 0x3a, 0xa3, 0x72, 0x7e, 0x27, 0xe7, 0x31, 0x6c, 0x0d, 0xf0, 0x3c, 0xe0,
-		0xd8, 0x4b, 0x61, 0x0f, 0x4b, 0x9c, 0xc1, 0xdb, 0x76, 0xb6, 0x36, 0x5e, 0xc2, 0xd6, 0x19, 0xa6,
-		0x41, 0x22, 0x08, 0xda, 0x87, 0x8a, 0x20, 0x38, 0xe6, 0x4c, 0xe5, 0xab, 0x1d, 0xaa, 0xc1, 0x96,
-		0x47, 0x24, 0xa6, 0x41, 0x9c, 0x39, 0xdc, 0xb6, 0x8b, 0xad, 0xf1, 0xbb, 0x06, 0x9b, 0xdf, 0x93,
-		0x90, 0xa3, 0x57, 0x50, 0xf1, 0x29, 0x09, 0xbc, 0xb8, 0xa6, 0x1d, 0x97, 0xea, 0xd5, 0xe6, 0x17,
-		0xe6, 0x9a, 0xf7, 0x33, 0x53, 0xaa, 0x79, 0x96, 0xf1, 0xba, 0x4c, 0x8a, 0xb9, 0xad, 0x92, 0x8e,
-		0xae, 0xa0, 0xba, 0x14, 0x46, 0x3a, 0x94, 0xa6, 0x64, 0xae, 0x5c, 0xa4, 0x4b, 0xd4, 0x84, 0xf2,
-		0x0c, 0x07, 0x09, 0xc9, 0x0c, 0x54, 0x9b, 0x9f, 0xae, 0x95, 0x57, 0xd7, 0xb4, 0x73, 0xea, 0x37,
-		0x1b, 0x2f, 0x34, 0xe3, 0x0f, 0x0d, 0x2a, 0x17, 0x04, 0x7b, 0x44, 0xa0, 0xef, 0x3e, 0xb0, 0xf8,
-		0x74, 0xad, 0x46, 0x4e, 0xfe, 0x6f, 0x4d, 0xfe, 0xa9, 0x81, 0x3e, 0x24, 0x58, 0xb8, 0xef, 0x5b,
-		0x52, 0x0a, 0x3a, 0x4e, 0x24, 0x89, 0x91, 0x03, 0x3b, 0x94, 0x79, 0xe4, 0x86, 0x78, 0xce, 0x8a,
-		0xed, 0x17, 0x6b, 0x55, 0x3f, 0x4c, 0x37, 0xad, 0x3c, 0x77, 0xf9, 0x1e, 0x0f, 0xe8, 0x72, 0xec,
-		0xe8, 0x67, 0x40, 0x7f, 0x27, 0xfd, 0x8b, 0xb7, 0xf2, 0xe1, 0x5e, 0x07, 0x4b, 0x7c, 0x1a, 0xf0,
-		0x31, 0x3a, 0x83, 0x07, 0x84, 0xb9, 0xdc, 0xa3, 0x6c, 0xe2, 0xc8, 0x79, 0x94, 0x17, 0xe8, 0x4e,
-		0xf3, 0xc9, 0x5a, 0xad, 0xae, 0x62, 0xa6, 0x15, 0x6d, 0x6f, 0x93, 0xa5, 0xdd, 0x6d, 0x01, 0x6f,
-		0x2c, 0x15, 0xf0, 0x20, 0x6f, 0x3a, 0x22, 0xde, 0x12, 0x11, 0x53, 0xce, 0x2c, 0xe6, 0xf3, 0x94,
-		0x48, 0xc3, 0x28, 0x28, 0x1a, 0x21, 0x5d, 0xa3, 0xa7, 0xb0, 0xeb, 0x13, 0x2c, 0x13, 0x41, 0x9c,
-		0x59, 0x4e, 0x55, 0x0d, 0xb7, 0xa3, 0xc2, 0x4a, 0xc0, 0x78, 0x03, 0x07, 0xc3, 0x24, 0x8a, 0xb8,
-		0x90, 0xc4, 0x6b, 0x07, 0x94, 0x30, 0xa9, 0x90, 0x38, 0xed, 0xd5, 0x09, 0x77, 0x62, 0x6f, 0xaa,
-		0x94, 0xcb, 0x13, 0x3e, 0xf4, 0xa6, 0xe8, 0x10, 0xee, 0xfd, 0x82, 0x67, 0x38, 0x03, 0x72, 0xcd,
-		0xad, 0x74, 0x3f, 0xf4, 0xa6, 0xc6, 0x6f, 0x25, 0xa8, 0xda, 0x44, 0x8a, 0xf9, 0x80, 0x07, 0xd4,
-		0x9d, 0xa3, 0x0e, 0xe8, 0x94, 0x51, 0x49, 0x71, 0xe0, 0x50, 0x26, 0x89, 0x98, 0xe1, 0xdc, 0x65,
-		0xb5, 0x79, 0x68, 0xe6, 0xe3, 0xc5, 0x2c, 0xc6, 0x8b, 0xd9, 0x51, 0xe3, 0xc5, 0xde, 0x55, 0x29,
-		0x96, 0xca, 0x40, 0x0d, 0xd8, 0x1b, 0x63, 0x77, 0xca, 0x7d, 0xdf, 0x71, 0x39, 0xf1, 0x7d, 0xea,
-		0xa6, 0x36, 0xb3, 0xb3, 0x35, 0x1b, 0x29, 0xa8, 0xbd, 0x40, 0xd2, 0x63, 0x43, 0x7c, 0x43, 0xc3,
-		0x24, 0x5c, 0x1c, 0x5b, 0xfa, 0xe8, 0xb1, 0x2a, 0xe5, 0xf6, 0xd8, 0x2f, 0x17, 0x2a, 0x58, 0x4a,
-		0x12, 0x46, 0x32, 0xae, 0x6d, 0x1e, 0x6b, 0xf5, 0xf2, 0x2d, 0xb5, 0xa5, 0xc2, 0xe8, 0x15, 0x3c,
-		0x62, 0x9c, 0x39, 0x22, 0xbd, 0x3a, 0x1e, 0x07, 0xc4, 0x21, 0x42, 0x70, 0xe1, 0xe4, 0x23, 0x25,
-		0xae, 0x95, 0x8f, 0x4b, 0xf5, 0xfb, 0x76, 0x8d, 0x71, 0x66, 0x17, 0x8c, 0x6e, 0x4a, 0xb0, 0x73,
-		0x1c, 0xbd, 0x86, 0x3d, 0x72, 0x13, 0xd1, 0xdc, 0xc8, 0xc2, 0x72, 0xe5, 0x63, 0x96, 0xd1, 0x22,
-		0xab, 0x70, 0x6d, 0x84, 0x70, 0x60, 0xc5, 0x3c, 0xc8, 0x82, 0xe7, 0x82, 0x27, 0xd1, 0x00, 0x0b,
-		0x49, 0xb3, 0xe1, 0xbc, 0x66, 0x60, 0xa2, 0x6f, 0xa1, 0x1c, 0x4b, 0x2c, 0xf3, 0x82, 0xdf, 0x69,
-		0xd6, 0xd7, 0x16, 0xe9, 0xaa, 0xe0, 0x30, 0xe5, 0xdb, 0x79, 0x9a, 0x31, 0x83, 0x47, 0xab, 0x68,
-		0x9b, 0x33, 0x9f, 0x4e, 0x94, 0x43, 0x74, 0x05, 0x3a, 0x2d, 0x60, 0x67, 0x92, 0xe2, 0x45, 0x6b,
-		0x7f, 0xf5, 0x0f, 0x4e, 0xba, 0xb5, 0x6e, 0xef, 0xd2, 0x15, 0x20, 0x7e, 0x76, 0x0d, 0xdb, 0xcb,
-		0xad, 0x83, 0x0e, 0xe1, 0x61, 0xb7, 0xd7, 0xee, 0x77, 0xac, 0xde, 0xb9, 0x33, 0x7a, 0x37, 0xe8,
-		0x3a, 0x56, 0xef, 0x6d, 0xeb, 0xd2, 0xea, 0xe8, 0xff, 0x43, 0x47, 0xb0, 0xbf, 0x0a, 0x8d, 0x2e,
-		0x6c, 0xeb, 0x6c, 0x64, 0x5f, 0xe9, 0x1a, 0xda, 0x07, 0xb4, 0x8a, 0xbd, 0x1e, 0xf6, 0x7b, 0xfa,
-		0x06, 0xaa, 0xc1, 0x27, 0xab, 0xf1, 0x81, 0xdd, 0x1f, 0xf5, 0x9f, 0xeb, 0xa5, 0x67, 0xbf, 0xc2,
-		0xde, 0x9a, 0xe7, 0x40, 0x4f, 0xe0, 0xb1, 0x35, 0xec, 0x5f, 0xb6, 0x46, 0x56, 0xbf, 0xe7, 0x9c,
-		0xdb, 0xfd, 0x1f, 0x06, 0xce, 0x70, 0xd4, 0x1a, 0x2d, 0xfb, 0xb8, 0x93, 0x72, 0xd1, 0x6d, 0x5d,
-		0x8e, 0x2e, 0xde, 0xe9, 0xda, 0xdd, 0x94, 0x8e, 0xdd, 0xb2, 0x7a, 0xdd, 0x8e, 0xbe, 0x71, 0xfa,
-		0x13, 0x1c, 0xb8, 0x3c, 0x5c, 0xf7, 0x78, 0xa7, 0xd5, 0x76, 0xf6, 0x51, 0x1f, 0xa4, 0x75, 0x32,
-		0xd0, 0x7e, 0x3c, 0x99, 0x50, 0xf9, 0x3e, 0x19, 0x9b, 0x2e, 0x0f, 0x1b, 0xcb, 0xbf, 0x00, 0x5f,
-		0x53, 0x2f, 0x68, 0x4c, 0x78, 0xfe, 0x61, 0x57, 0xff, 0x03, 0x2f, 0x71, 0x44, 0x67, 0x27, 0xe3,
-		0x4a, 0x16, 0x7b, 0xfe, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x31, 0x0a, 0xaa, 0xd2, 0x33, 0x08,
-		0x00, 0x00,
+		0x65, 0xd8, 0x2f, 0xd9, 0xc3, 0xfe, 0xd2, 0xfe, 0xd0, 0x40, 0x89, 0x8a, 0xed, 0xce, 0x41, 0xf7,
+		0x30, 0xec, 0x8d, 0xbc, 0xe7, 0xdc, 0x73, 0x0f, 0x89, 0x7b, 0x29, 0xc1, 0x61, 0x3a, 0x22, 0xa2,
+		0xe1, 0x61, 0x9f, 0x30, 0x8f, 0x34, 0x70, 0x4c, 0x1b, 0xd3, 0xe3, 0x86, 0xc7, 0xa3, 0x88, 0x33,
+		0x2b, 0x16, 0x5c, 0x72, 0xb4, 0xa3, 0x18, 0x96, 0x66, 0x58, 0x38, 0xa6, 0xd6, 0xf4, 0xf8, 0xe0,
+		0xb3, 0x31, 0xe7, 0xe3, 0x90, 0x34, 0x32, 0xca, 0x28, 0x0d, 0x1a, 0x7e, 0x2a, 0xb0, 0xa4, 0x45,
+		0x92, 0xf9, 0x06, 0xfe, 0x7f, 0xc5, 0xc5, 0x24, 0x08, 0xf9, 0x75, 0xe7, 0x86, 0x78, 0xa9, 0x82,
+		0xd0, 0xe7, 0x50, 0xbd, 0xd6, 0x41, 0x97, 0xfa, 0x75, 0xe3, 0xd0, 0x38, 0xba, 0xef, 0x40, 0x11,
+		0xb2, 0x7d, 0xf4, 0x10, 0x2a, 0x22, 0x65, 0x0a, 0x5b, 0xcb, 0xb0, 0xb2, 0x48, 0x99, 0xed, 0x9b,
+		0x26, 0x6c, 0x16, 0x62, 0xc3, 0x59, 0x4c, 0x10, 0x82, 0x75, 0x86, 0x23, 0xa2, 0x05, 0xb2, 0xb5,
+		0xe2, 0xb4, 0x3c, 0x49, 0xa7, 0x54, 0xce, 0xee, 0xe4, 0x3c, 0x86, 0x8d, 0x3e, 0x9e, 0x85, 0x1c,
+		0xfb, 0x0a, 0xf6, 0xb1, 0xc4, 0x19, 0xbc, 0xe9, 0x64, 0x6b, 0xf3, 0x25, 0x6c, 0x9c, 0x61, 0x1a,
+		0xa6, 0x82, 0xa0, 0x5d, 0xa8, 0x08, 0x82, 0x13, 0xce, 0x74, 0xbe, 0xde, 0xa1, 0x3a, 0x6c, 0xf8,
+		0x44, 0x62, 0x1a, 0x26, 0x99, 0xc3, 0x4d, 0xa7, 0xd8, 0x9a, 0xbf, 0x1b, 0xb0, 0xfe, 0x3d, 0x89,
+		0x38, 0x7a, 0x05, 0x95, 0x80, 0x92, 0xd0, 0x4f, 0xea, 0xc6, 0x61, 0xe9, 0xa8, 0xda, 0xfc, 0xc2,
+		0x5a, 0x71, 0x7f, 0x96, 0xa2, 0x5a, 0x67, 0x19, 0xaf, 0xc3, 0xa4, 0x98, 0x39, 0x3a, 0xe9, 0xe0,
+		0x0a, 0xaa, 0x0b, 0x61, 0x54, 0x83, 0xd2, 0x84, 0xcc, 0xb4, 0x0b, 0xb5, 0x44, 0x4d, 0x28, 0x4f,
+		0x71, 0x98, 0x92, 0xcc, 0x40, 0xb5, 0xf9, 0xe9, 0x4a, 0x79, 0x7d, 0x4c, 0x27, 0xa7, 0x7e, 0xb3,
+		0xf6, 0xc2, 0x30, 0xff, 0x30, 0xa0, 0x72, 0x41, 0xb0, 0x4f, 0x04, 0xfa, 0xee, 0x03, 0x8b, 0x4f,
+		0x57, 0x6a, 0xe4, 0xe4, 0xff, 0xd6, 0xe4, 0x9f, 0x06, 0xd4, 0x06, 0x04, 0x0b, 0xef, 0x7d, 0x4b,
+		0x4a, 0x41, 0x47, 0xa9, 0x24, 0x09, 0x72, 0x61, 0x8b, 0x32, 0x9f, 0xdc, 0x10, 0xdf, 0x5d, 0xb2,
+		0xfd, 0x62, 0xa5, 0xea, 0x87, 0xe9, 0x96, 0x9d, 0xe7, 0x2e, 0x9e, 0xe3, 0x01, 0x5d, 0x8c, 0x1d,
+		0xfc, 0x0c, 0xe8, 0xef, 0xa4, 0x7f, 0xf1, 0x54, 0x01, 0xdc, 0x6b, 0x63, 0x89, 0x4f, 0x42, 0x3e,
+		0x42, 0x67, 0xf0, 0x80, 0x30, 0x8f, 0xfb, 0x94, 0x8d, 0x5d, 0x39, 0x8b, 0xf3, 0x06, 0xdd, 0x6a,
+		0x3e, 0x59, 0xa9, 0xd5, 0xd1, 0x4c, 0xd5, 0xd1, 0xce, 0x26, 0x59, 0xd8, 0xdd, 0x36, 0xf0, 0xda,
+		0x42, 0x03, 0xf7, 0xf3, 0xa1, 0x23, 0xe2, 0x2d, 0x11, 0x09, 0xe5, 0xcc, 0x66, 0x01, 0x57, 0x44,
+		0x1a, 0xc5, 0x61, 0x31, 0x08, 0x6a, 0x8d, 0x9e, 0xc2, 0x76, 0x40, 0xb0, 0x4c, 0x05, 0x71, 0xa7,
+		0x39, 0x55, 0x0f, 0xdc, 0x96, 0x0e, 0x6b, 0x01, 0xf3, 0x0d, 0xec, 0x0d, 0xd2, 0x38, 0xe6, 0x42,
+		0x12, 0xff, 0x34, 0xa4, 0x84, 0x49, 0x8d, 0x24, 0x6a, 0x56, 0xc7, 0xdc, 0x4d, 0xfc, 0x89, 0x56,
+		0x2e, 0x8f, 0xf9, 0xc0, 0x9f, 0xa0, 0x7d, 0xb8, 0xf7, 0x0b, 0x9e, 0xe2, 0x0c, 0xc8, 0x35, 0x37,
+		0xd4, 0x7e, 0xe0, 0x4f, 0xcc, 0xdf, 0x4a, 0x50, 0x75, 0x88, 0x14, 0xb3, 0x3e, 0x0f, 0xa9, 0x37,
+		0x43, 0x6d, 0xa8, 0x51, 0x46, 0x25, 0xc5, 0xa1, 0x4b, 0x99, 0x24, 0x62, 0x8a, 0x73, 0x97, 0xd5,
+		0xe6, 0xbe, 0x95, 0x3f, 0x2f, 0x56, 0xf1, 0xbc, 0x58, 0x6d, 0xfd, 0xbc, 0x38, 0xdb, 0x3a, 0xc5,
+		0xd6, 0x19, 0xa8, 0x01, 0x3b, 0x23, 0xec, 0x4d, 0x78, 0x10, 0xb8, 0x1e, 0x27, 0x41, 0x40, 0x3d,
+		0x65, 0x33, 0xab, 0x6d, 0x38, 0x48, 0x43, 0xa7, 0x73, 0x44, 0x95, 0x8d, 0xf0, 0x0d, 0x8d, 0xd2,
+		0x68, 0x5e, 0xb6, 0xf4, 0xd1, 0xb2, 0x3a, 0xe5, 0xb6, 0xec, 0x97, 0x73, 0x15, 0x2c, 0x25, 0x89,
+		0x62, 0x99, 0xd4, 0xd7, 0x0f, 0x8d, 0xa3, 0xf2, 0x2d, 0xb5, 0xa5, 0xc3, 0xe8, 0x15, 0x3c, 0x62,
+		0x9c, 0xb9, 0x42, 0x1d, 0x1d, 0x8f, 0x42, 0xe2, 0x12, 0x21, 0xb8, 0x70, 0xf3, 0x27, 0x25, 0xa9,
+		0x97, 0x0f, 0x4b, 0x47, 0xf7, 0x9d, 0x3a, 0xe3, 0xcc, 0x29, 0x18, 0x1d, 0x45, 0x70, 0x72, 0x1c,
+		0xbd, 0x86, 0x1d, 0x72, 0x13, 0xd3, 0xdc, 0xc8, 0xdc, 0x72, 0xe5, 0x63, 0x96, 0xd1, 0x3c, 0xab,
+		0x70, 0x6d, 0x46, 0xb0, 0x67, 0x27, 0x3c, 0xcc, 0x82, 0xe7, 0x82, 0xa7, 0x71, 0x1f, 0x0b, 0x49,
+		0xd5, 0x6e, 0xd5, 0x83, 0x89, 0xbe, 0x85, 0x72, 0x22, 0xb1, 0xcc, 0x1b, 0x7e, 0xab, 0x79, 0xb4,
+		0xb2, 0x49, 0x97, 0x05, 0x07, 0x8a, 0xef, 0xe4, 0x69, 0xe6, 0x14, 0x1e, 0x2d, 0xa3, 0xa7, 0x9c,
+		0x05, 0x74, 0xac, 0x1d, 0xa2, 0x2b, 0xa8, 0xd1, 0x02, 0x76, 0xc7, 0x0a, 0x2f, 0x46, 0xfb, 0xab,
+		0x7f, 0x50, 0xe9, 0xd6, 0xba, 0xb3, 0x4d, 0x97, 0x80, 0xe4, 0xd9, 0x35, 0x6c, 0x2e, 0x8e, 0x0e,
+		0xda, 0x87, 0x87, 0x9d, 0xee, 0x69, 0xaf, 0x6d, 0x77, 0xcf, 0xdd, 0xe1, 0xbb, 0x7e, 0xc7, 0xb5,
+		0xbb, 0x6f, 0x5b, 0x97, 0x76, 0xbb, 0xf6, 0x3f, 0x74, 0x00, 0xbb, 0xcb, 0xd0, 0xf0, 0xc2, 0xb1,
+		0xcf, 0x86, 0xce, 0x55, 0xcd, 0x40, 0xbb, 0x80, 0x96, 0xb1, 0xd7, 0x83, 0x5e, 0xb7, 0xb6, 0x86,
+		0xea, 0xf0, 0xc9, 0x72, 0xbc, 0xef, 0xf4, 0x86, 0xbd, 0xe7, 0xb5, 0xd2, 0xb3, 0x5f, 0x61, 0x67,
+		0xc5, 0x75, 0xa0, 0x27, 0xf0, 0xd8, 0x1e, 0xf4, 0x2e, 0x5b, 0x43, 0xbb, 0xd7, 0x75, 0xcf, 0x9d,
+		0xde, 0x0f, 0x7d, 0x77, 0x30, 0x6c, 0x0d, 0x17, 0x7d, 0xdc, 0x49, 0xb9, 0xe8, 0xb4, 0x2e, 0x87,
+		0x17, 0xef, 0x6a, 0xc6, 0xdd, 0x94, 0xb6, 0xd3, 0xb2, 0xbb, 0x9d, 0x76, 0x6d, 0xed, 0xe4, 0x27,
+		0xd8, 0xf3, 0x78, 0xb4, 0xea, 0xf2, 0x4e, 0xaa, 0xa7, 0xd9, 0x47, 0xbd, 0xaf, 0xfa, 0xa4, 0x6f,
+		0xfc, 0x78, 0x3c, 0xa6, 0xf2, 0x7d, 0x3a, 0xb2, 0x3c, 0x1e, 0x35, 0x16, 0x7f, 0x01, 0xbe, 0xa6,
+		0x7e, 0xd8, 0x18, 0xf3, 0xfc, 0xc3, 0xae, 0xff, 0x07, 0x5e, 0xe2, 0x98, 0x4e, 0x8f, 0x47, 0x95,
+		0x2c, 0xf6, 0xfc, 0xaf, 0x01, 0x00, 0x31, 0x0a, 0xaa, 0xd2, 0x33, 0x08, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/query.proto
 	[]byte{
@@ -984,162 +1450,161 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x5c, 0xdb, 0x34, 0x3e, 0x88, 0xb5, 0xab, 0x3b, 0x78, 0x11, 0xd0, 0x69, 0xd5, 0x8b, 0x5e, 0x41,
 		0x11, 0xd0, 0xca, 0x2f, 0xc8, 0x12, 0xee, 0xba, 0x93, 0x30, 0xfd, 0x9c, 0x8d, 0xa4, 0x80, 0x4e,
 		0xe5, 0xcd, 0x93, 0x7b, 0x1d, 0x8e, 0x23, 0x79, 0x42, 0xe5, 0xe2, 0xd2, 0xca, 0xfb, 0xbb, 0xf4,
-		0x93, 0x70, 0xd6, 0x1d, 0xed, 0x14, 0xd8, 0xdb, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x69,
-		0x28, 0x5b, 0xfb, 0x03, 0x00, 0x00,
+		0x93, 0x70, 0xd6, 0x1d, 0xed, 0x14, 0xd8, 0xdb, 0x5f, 0x03, 0x00, 0xbd, 0x69, 0x28, 0x5b, 0xfb,
+		0x03, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x73, 0xdb, 0xc8,
-		0xd1, 0x7e, 0x41, 0x4a, 0xb2, 0xd4, 0xd4, 0x07, 0x34, 0x92, 0x2c, 0x5a, 0xde, 0xb5, 0x65, 0xee,
-		0xda, 0x2b, 0xf3, 0x5d, 0x49, 0x2b, 0xef, 0xda, 0x5e, 0x5b, 0x71, 0x1c, 0x08, 0x84, 0x2c, 0xd8,
-		0x14, 0xc8, 0x0c, 0x41, 0xcb, 0xda, 0x4a, 0x82, 0x82, 0xc8, 0x91, 0x84, 0x98, 0x04, 0x58, 0xc0,
-		0xd0, 0xb6, 0xee, 0xa9, 0xca, 0x39, 0x97, 0x54, 0x2a, 0xa7, 0xfc, 0x80, 0xa4, 0x52, 0xa9, 0x9c,
-		0x53, 0xa9, 0xca, 0x21, 0xb7, 0x5c, 0xf3, 0x1f, 0xf2, 0x2f, 0x52, 0x33, 0x18, 0x90, 0xe0, 0x27,
-		0xe8, 0xa4, 0x6a, 0x73, 0x13, 0x7a, 0x9e, 0xa7, 0xd1, 0xd3, 0xd3, 0xfd, 0xf4, 0x80, 0x82, 0x5c,
-		0xfb, 0x8c, 0xf8, 0xbb, 0x35, 0xbb, 0x4e, 0xdc, 0x1a, 0xd9, 0xb5, 0x5b, 0xce, 0xee, 0xbb, 0xbd,
-		0xdd, 0xf7, 0x9e, 0xff, 0xf6, 0xbc, 0xe1, 0xbd, 0xdf, 0x69, 0xf9, 0x1e, 0xf5, 0xd0, 0x0a, 0xc3,
-		0xec, 0x08, 0xcc, 0x8e, 0xdd, 0x72, 0x76, 0xde, 0xed, 0x6d, 0xdc, 0xba, 0xf0, 0xbc, 0x8b, 0x06,
-		0xd9, 0xe5, 0x90, 0xb3, 0xf6, 0xf9, 0x6e, 0xbd, 0xed, 0xdb, 0xd4, 0xf1, 0xdc, 0x90, 0xb4, 0x71,
-		0xbb, 0x7f, 0x9d, 0x3a, 0x4d, 0x12, 0x50, 0xbb, 0xd9, 0x12, 0x80, 0xcd, 0x61, 0x6f, 0xae, 0x79,
-		0xcd, 0x66, 0xc7, 0xc5, 0xd0, 0xd8, 0xa8, 0x1d, 0xbc, 0x6d, 0x38, 0x01, 0x0d, 0x31, 0xb9, 0x3f,
-		0xcc, 0xc2, 0xda, 0x89, 0x08, 0x57, 0xfb, 0x40, 0x6a, 0x6d, 0x16, 0x82, 0xee, 0x9e, 0x7b, 0xa8,
-		0x0a, 0x28, 0xda, 0x87, 0x45, 0xa2, 0x95, 0xac, 0xb4, 0x29, 0x6d, 0x65, 0x1e, 0xdc, 0xdb, 0x19,
-		0xb2, 0xa5, 0x9d, 0x01, 0x3f, 0x78, 0xf9, 0x7d, 0xbf, 0x09, 0x3d, 0x84, 0x29, 0x7a, 0xd5, 0x22,
-		0xd9, 0x14, 0x77, 0x74, 0x67, 0xac, 0x23, 0xf3, 0xaa, 0x45, 0x30, 0x87, 0xa3, 0x27, 0x00, 0x01,
-		0xb5, 0x7d, 0x6a, 0xb1, 0x34, 0x64, 0xd3, 0x9c, 0xbc, 0xb1, 0x13, 0xe6, 0x68, 0x27, 0xca, 0xd1,
-		0x8e, 0x19, 0xe5, 0x08, 0xcf, 0x71, 0x34, 0x7b, 0x66, 0xd4, 0x5a, 0xc3, 0x0b, 0x48, 0x48, 0x9d,
-		0x4a, 0xa6, 0x72, 0x34, 0xa7, 0x9a, 0x30, 0x1f, 0x52, 0x03, 0x6a, 0xd3, 0x76, 0x90, 0x9d, 0xde,
-		0x94, 0xb6, 0x16, 0x1f, 0xec, 0x4d, 0xb6, 0x7b, 0x95, 0x31, 0x2b, 0x9c, 0x88, 0x33, 0xb5, 0xee,
-		0x03, 0xba, 0x0b, 0x8b, 0x97, 0x4e, 0x40, 0x3d, 0xff, 0xca, 0x6a, 0x10, 0xf7, 0x82, 0x5e, 0x66,
-		0x67, 0x36, 0xa5, 0xad, 0x34, 0x5e, 0x10, 0xd6, 0x22, 0x37, 0xa2, 0x9f, 0xc0, 0x5a, 0xcb, 0xf6,
-		0x89, 0x4b, 0xbb, 0xe9, 0xb7, 0x1c, 0xf7, 0xdc, 0xcb, 0x5e, 0xe3, 0x5b, 0xd8, 0x1a, 0x1a, 0x45,
-		0x99, 0x33, 0x7a, 0x4e, 0x12, 0xaf, 0xb4, 0x06, 0x8d, 0x48, 0x81, 0xc5, 0xae, 0x5b, 0x9e, 0x99,
-		0xd9, 0xc4, 0xcc, 0x2c, 0x74, 0x18, 0x3c, 0x3b, 0xdb, 0x30, 0xd5, 0x24, 0x4d, 0x2f, 0x3b, 0xc7,
-		0x89, 0x37, 0x86, 0xc6, 0x73, 0x4c, 0x9a, 0x1e, 0xe6, 0x30, 0x84, 0x61, 0x39, 0x20, 0xb6, 0x5f,
-		0xbb, 0xb4, 0x6c, 0x4a, 0x7d, 0xe7, 0xac, 0x4d, 0x49, 0x90, 0x05, 0xce, 0xbd, 0x3b, 0x94, 0x5b,
-		0xe1, 0x68, 0xa5, 0x03, 0xc6, 0x72, 0xd0, 0x67, 0x41, 0x45, 0x58, 0xb6, 0xdb, 0xd4, 0xb3, 0x7c,
-		0x12, 0x10, 0x6a, 0xb5, 0x3c, 0xc7, 0xa5, 0x41, 0x36, 0xc3, 0x7d, 0x6e, 0x0e, 0xf5, 0x89, 0x19,
-		0xb0, 0xcc, 0x71, 0x78, 0x89, 0x51, 0x63, 0x06, 0x74, 0x13, 0xe6, 0x58, 0x7b, 0x58, 0xac, 0x3f,
-		0xb2, 0xf3, 0x9b, 0xd2, 0xd6, 0x1c, 0x9e, 0x65, 0x86, 0xa2, 0x13, 0x50, 0xb4, 0x0e, 0xd7, 0x9c,
-		0xc0, 0xaa, 0xf9, 0x9e, 0x9b, 0x5d, 0xd8, 0x94, 0xb6, 0x66, 0xf1, 0x8c, 0x13, 0xa8, 0xbe, 0xe7,
-		0xa2, 0x7d, 0xc8, 0xb4, 0x5b, 0x75, 0x9b, 0x8a, 0x02, 0x5b, 0x4c, 0x4c, 0x23, 0x84, 0x70, 0x9e,
-		0xc3, 0x9f, 0x83, 0xdc, 0xb2, 0x7d, 0xea, 0xf0, 0x63, 0xa8, 0x79, 0xee, 0xb9, 0x73, 0x91, 0x5d,
-		0xda, 0x4c, 0x6f, 0x65, 0x1e, 0x3c, 0x9f, 0xac, 0xca, 0xd8, 0x61, 0xb2, 0x53, 0x0f, 0x5d, 0xa8,
-		0xdc, 0x83, 0xe6, 0x52, 0xff, 0x0a, 0x2f, 0xb5, 0x7a, 0xad, 0x1b, 0x07, 0xb0, 0x3a, 0x0c, 0x88,
-		0x64, 0x48, 0xbf, 0x25, 0x57, 0xbc, 0xb5, 0xe7, 0x30, 0xfb, 0x13, 0xad, 0xc2, 0xf4, 0x3b, 0xbb,
-		0xd1, 0x0e, 0xbb, 0x74, 0x0e, 0x87, 0x0f, 0x4f, 0x53, 0xdf, 0x4a, 0xb9, 0xdf, 0xa4, 0xe0, 0xd6,
-		0x60, 0xa5, 0x73, 0x67, 0x42, 0xbf, 0xd0, 0xd3, 0x78, 0x16, 0x43, 0xbd, 0xf8, 0x74, 0xe8, 0x5e,
-		0x4c, 0x91, 0xda, 0x58, 0x92, 0x6d, 0xd8, 0xec, 0x56, 0xa5, 0x68, 0x78, 0xcf, 0xea, 0xb6, 0xaf,
-		0xd7, 0xa6, 0x42, 0x39, 0x6e, 0x0c, 0x24, 0xb8, 0x20, 0x02, 0xc0, 0x9f, 0x74, 0x5c, 0x54, 0xb8,
-		0x08, 0x78, 0x6a, 0xd4, 0xd0, 0x5e, 0x9b, 0xa2, 0x13, 0xb8, 0xc9, 0xc3, 0x1b, 0xe1, 0x3d, 0x9d,
-		0xe4, 0x7d, 0x9d, 0xb1, 0x87, 0x38, 0xce, 0xfd, 0x43, 0x82, 0x95, 0x21, 0xed, 0xc7, 0xaa, 0xaa,
-		0xee, 0x35, 0x6d, 0xc7, 0xb5, 0x9c, 0xba, 0x48, 0xf2, 0x6c, 0x68, 0xd0, 0xeb, 0xe8, 0x36, 0x64,
-		0xc4, 0xa2, 0x6b, 0x37, 0xa3, 0x7c, 0x43, 0x68, 0x32, 0xec, 0x26, 0x19, 0x21, 0xc3, 0xe9, 0xff,
-		0x56, 0x86, 0xef, 0xc0, 0xbc, 0xe3, 0x3a, 0xd4, 0xb1, 0x29, 0xa9, 0xb3, 0xb8, 0xa6, 0xb8, 0x02,
-		0x65, 0x3a, 0x36, 0xbd, 0x9e, 0xfb, 0x95, 0x04, 0x6b, 0xda, 0x07, 0x4a, 0x7c, 0xd7, 0x6e, 0x7c,
-		0x2f, 0xa3, 0xa1, 0x3f, 0xa6, 0xd4, 0x60, 0x4c, 0xbf, 0x9e, 0x81, 0x95, 0x32, 0x71, 0xeb, 0x8e,
-		0x7b, 0xa1, 0xd4, 0xa8, 0xf3, 0xce, 0xa1, 0x57, 0x3c, 0xa2, 0xdb, 0x90, 0xb1, 0xc5, 0x73, 0x37,
-		0xcb, 0x10, 0x99, 0xf4, 0x3a, 0x3a, 0x84, 0x85, 0x0e, 0x20, 0x71, 0xfe, 0x44, 0xae, 0xf9, 0xfc,
-		0x99, 0xb7, 0x63, 0x4f, 0xe8, 0x39, 0x4c, 0xb3, 0x59, 0x10, 0x8e, 0xa0, 0xc5, 0x07, 0xf7, 0x87,
-		0x8b, 0x70, 0x6f, 0x84, 0x4c, 0xf6, 0x09, 0x0e, 0x79, 0x48, 0x87, 0xe5, 0x4b, 0x62, 0xfb, 0xf4,
-		0x8c, 0xd8, 0xd4, 0xaa, 0x13, 0x6a, 0x3b, 0x8d, 0x40, 0x0c, 0xa5, 0x4f, 0x46, 0x28, 0xfa, 0x55,
-		0xc3, 0xb3, 0xeb, 0x58, 0xee, 0xd0, 0x0a, 0x21, 0x0b, 0xbd, 0x84, 0x95, 0x86, 0x1d, 0x50, 0xab,
-		0xeb, 0x8f, 0x0b, 0xd0, 0x74, 0xa2, 0x00, 0x2d, 0x33, 0xda, 0x51, 0xc4, 0xe2, 0x3a, 0x74, 0x08,
-		0xdc, 0x18, 0x76, 0x05, 0xa9, 0x87, 0x9e, 0x66, 0x12, 0x3d, 0x2d, 0x31, 0x52, 0x25, 0xe4, 0x70,
-		0x3f, 0x59, 0xb8, 0x66, 0x53, 0x4a, 0x9a, 0x2d, 0xca, 0xc7, 0xd4, 0x34, 0x8e, 0x1e, 0xd1, 0x7d,
-		0x90, 0x9b, 0xf6, 0x07, 0xa7, 0xd9, 0x6e, 0x5a, 0xc2, 0x14, 0xf0, 0x91, 0x33, 0x8d, 0x97, 0x84,
-		0x5d, 0x11, 0x66, 0x36, 0x9b, 0x82, 0xda, 0x25, 0xa9, 0xb7, 0x1b, 0x51, 0x24, 0x73, 0xc9, 0xb3,
-		0xa9, 0xc3, 0xe0, 0x71, 0xa8, 0xb0, 0x44, 0x3e, 0xb4, 0x9c, 0xb0, 0x67, 0x43, 0x1f, 0x90, 0xe8,
-		0x63, 0xb1, 0x4b, 0xe1, 0x4e, 0x9e, 0xc3, 0x3c, 0x4f, 0xca, 0xb9, 0xed, 0x34, 0xda, 0x3e, 0x11,
-		0x83, 0x65, 0xf8, 0x31, 0x1d, 0x86, 0x18, 0x9c, 0x61, 0x0c, 0xf1, 0x80, 0xbe, 0x82, 0x55, 0xee,
-		0x80, 0xd5, 0x3a, 0xf1, 0x2d, 0xa7, 0x4e, 0x5c, 0xea, 0xd0, 0x2b, 0x31, 0x5b, 0x10, 0x5b, 0x3b,
-		0xe1, 0x4b, 0xba, 0x58, 0x41, 0x8f, 0x60, 0x3d, 0x3a, 0x82, 0x7e, 0xd2, 0x02, 0x27, 0xad, 0x89,
-		0xe5, 0x5e, 0x5e, 0xee, 0xcf, 0x29, 0xb8, 0x21, 0xca, 0x4e, 0xbd, 0x74, 0x1a, 0xf5, 0xef, 0xa5,
-		0x61, 0xbf, 0x8c, 0xb9, 0x65, 0x4d, 0x15, 0xd7, 0x30, 0xf9, 0x7d, 0xec, 0x12, 0xc7, 0x95, 0xac,
-		0xbf, 0xbd, 0xd3, 0x03, 0xed, 0x8d, 0x5e, 0x83, 0xb8, 0xab, 0x08, 0x51, 0x6e, 0x79, 0x0d, 0xa7,
-		0x76, 0xc5, 0xdb, 0x63, 0x71, 0x44, 0xa0, 0xa1, 0xe2, 0x72, 0x21, 0x2e, 0x73, 0x34, 0x5e, 0x6e,
-		0xf5, 0x9b, 0xd0, 0x75, 0x98, 0x09, 0x25, 0x95, 0x37, 0xc7, 0x1c, 0x16, 0x4f, 0xb9, 0xbf, 0xa7,
-		0x3a, 0x72, 0x52, 0x20, 0x35, 0x27, 0x88, 0xf2, 0xd5, 0xe9, 0x72, 0x29, 0xb9, 0xcb, 0x23, 0x62,
-		0x4f, 0x97, 0x0f, 0x56, 0x70, 0xea, 0x63, 0x2b, 0xf8, 0x19, 0xcc, 0xf7, 0x34, 0x63, 0xf2, 0x9d,
-		0x37, 0x13, 0x0c, 0x6f, 0xc4, 0xa9, 0xde, 0x46, 0xc4, 0xb0, 0xee, 0xf9, 0xce, 0x85, 0xe3, 0xda,
-		0x0d, 0xab, 0x2f, 0xc8, 0x64, 0xe9, 0x58, 0x8b, 0xa8, 0x95, 0x78, 0xb0, 0xb9, 0xbf, 0xa4, 0xe0,
-		0x46, 0x24, 0x77, 0x45, 0xaf, 0x66, 0x37, 0x0a, 0x4e, 0xd0, 0xb2, 0x69, 0xed, 0x72, 0x32, 0x75,
-		0xfe, 0xdf, 0xa7, 0xeb, 0x67, 0x70, 0xab, 0x37, 0x02, 0xcb, 0x3b, 0xb7, 0xe8, 0xa5, 0x13, 0x58,
-		0xf1, 0x2c, 0x8e, 0x77, 0xb8, 0xd1, 0x13, 0x51, 0xe9, 0xdc, 0xbc, 0x74, 0x02, 0xa1, 0x69, 0xe8,
-		0x53, 0x00, 0x7e, 0xeb, 0xa0, 0xde, 0x5b, 0x12, 0x56, 0xe1, 0x3c, 0xe6, 0xd7, 0x24, 0x93, 0x19,
-		0x72, 0x2f, 0x21, 0x13, 0xbf, 0x88, 0xee, 0xc3, 0x8c, 0xb8, 0xcb, 0x4a, 0xfc, 0x2e, 0xf8, 0x59,
-		0xc2, 0x5d, 0x96, 0x5f, 0xf3, 0x05, 0x25, 0xf7, 0xc7, 0x14, 0x2c, 0xf6, 0x2e, 0xa1, 0x2f, 0x60,
-		0xe9, 0xcc, 0x71, 0x6d, 0xff, 0xca, 0xaa, 0x5d, 0x92, 0xda, 0xdb, 0xa0, 0xdd, 0x14, 0x87, 0xb0,
-		0x18, 0x9a, 0x55, 0x61, 0x45, 0x6b, 0x30, 0xe3, 0xb7, 0xdd, 0x68, 0xf8, 0xce, 0xe1, 0x69, 0xbf,
-		0xcd, 0x6e, 0x29, 0xcf, 0xe0, 0xe6, 0xb9, 0xe3, 0x07, 0x6c, 0x60, 0x85, 0xc5, 0x6e, 0xd5, 0xbc,
-		0x66, 0xab, 0x41, 0x7a, 0x3a, 0x39, 0xcb, 0x21, 0x51, 0x3b, 0xa8, 0x11, 0x80, 0xd3, 0xe7, 0x6b,
-		0x3e, 0xb1, 0x3b, 0x67, 0x93, 0x9c, 0xca, 0x8c, 0xc0, 0x0b, 0x19, 0x5e, 0xe0, 0xc2, 0xec, 0xb8,
-		0x17, 0x93, 0x96, 0xe9, 0x7c, 0x44, 0xe0, 0x0e, 0x6e, 0x01, 0xf0, 0x0f, 0x04, 0x6a, 0x9f, 0x35,
-		0xc2, 0xa9, 0x36, 0x8b, 0x63, 0x96, 0xfc, 0x9f, 0x24, 0x58, 0x1d, 0x36, 0xb3, 0x51, 0x0e, 0x6e,
-		0x95, 0x35, 0xa3, 0xa0, 0x1b, 0x2f, 0x2c, 0x45, 0x35, 0xf5, 0xd7, 0xba, 0x79, 0x6a, 0x55, 0x4c,
-		0xc5, 0xd4, 0x2c, 0xdd, 0x78, 0xad, 0x14, 0xf5, 0x82, 0xfc, 0x7f, 0xe8, 0x73, 0xd8, 0x1c, 0x81,
-		0xa9, 0xa8, 0x47, 0x5a, 0xa1, 0x5a, 0xd4, 0x0a, 0xb2, 0x34, 0xc6, 0x53, 0xc5, 0x54, 0xb0, 0xa9,
-		0x15, 0xe4, 0x14, 0xfa, 0x7f, 0xf8, 0x62, 0x04, 0x46, 0x55, 0x0c, 0x55, 0x2b, 0x5a, 0x58, 0xfb,
-		0x71, 0x55, 0xab, 0x30, 0x70, 0x3a, 0xff, 0x8b, 0x6e, 0xcc, 0x3d, 0x0a, 0x14, 0x7f, 0x53, 0x41,
-		0x53, 0xf5, 0x8a, 0x5e, 0x32, 0xc6, 0xc5, 0xdc, 0x87, 0x19, 0x11, 0x73, 0x3f, 0x2a, 0x8a, 0x39,
-		0xff, 0xcb, 0x54, 0xf7, 0xf7, 0x03, 0xbd, 0x8e, 0x49, 0xbb, 0xa3, 0xb9, 0x9f, 0xc3, 0xe6, 0x49,
-		0x09, 0xbf, 0x3a, 0x2c, 0x96, 0x4e, 0x2c, 0xbd, 0x60, 0x61, 0xad, 0x5a, 0xd1, 0xac, 0x72, 0xa9,
-		0xa8, 0xab, 0xa7, 0xb1, 0x48, 0xbe, 0x85, 0x6f, 0x46, 0xa2, 0x94, 0x22, 0xb3, 0x16, 0xaa, 0xe5,
-		0xa2, 0xae, 0xb2, 0xb7, 0x1e, 0x2a, 0x7a, 0x51, 0x2b, 0x58, 0x25, 0xa3, 0x78, 0x2a, 0x4b, 0xe8,
-		0x4b, 0xd8, 0x9a, 0x94, 0x29, 0xa7, 0xd0, 0x36, 0xdc, 0x1f, 0x89, 0xc6, 0xda, 0x4b, 0x4d, 0x35,
-		0x63, 0xf0, 0x34, 0xda, 0x83, 0xed, 0x91, 0x70, 0x53, 0xc3, 0xc7, 0xba, 0xc1, 0x13, 0x7a, 0x68,
-		0xe1, 0xaa, 0x61, 0xe8, 0xc6, 0x0b, 0x79, 0x2a, 0xff, 0x3b, 0x09, 0x96, 0x07, 0x86, 0x11, 0xba,
-		0x0d, 0x37, 0xcb, 0x0a, 0xd6, 0x0c, 0xd3, 0x52, 0x8b, 0xa5, 0x61, 0x09, 0x18, 0x01, 0x50, 0x0e,
-		0x14, 0xa3, 0x50, 0x32, 0x64, 0x09, 0xdd, 0x83, 0xdc, 0x30, 0x80, 0xa8, 0x05, 0x51, 0x1a, 0x72,
-		0x0a, 0xdd, 0x81, 0x4f, 0x87, 0xe1, 0x3a, 0xd1, 0xca, 0xe9, 0xfc, 0xbf, 0x52, 0xf0, 0xc9, 0xb8,
-		0x9f, 0x29, 0x58, 0x05, 0x76, 0xb6, 0xad, 0xbd, 0xd1, 0xd4, 0xaa, 0xc9, 0xce, 0x3c, 0xf4, 0xc7,
-		0x4e, 0xbe, 0x5a, 0x89, 0x45, 0x1e, 0x4f, 0xe9, 0x08, 0xb0, 0x5a, 0x3a, 0x2e, 0x17, 0x35, 0x93,
-		0x57, 0x53, 0x1e, 0xee, 0x25, 0xc1, 0xc3, 0x03, 0x96, 0x53, 0x3d, 0x67, 0x3b, 0xca, 0x35, 0xdf,
-		0x37, 0x6b, 0x05, 0xb4, 0x03, 0xf9, 0x24, 0x74, 0x27, 0x0b, 0x05, 0x79, 0x0a, 0x7d, 0x03, 0x5f,
-		0x25, 0x07, 0x6e, 0x98, 0xba, 0x51, 0xd5, 0x0a, 0x96, 0x52, 0xb1, 0x0c, 0xed, 0x44, 0x9e, 0x9e,
-		0x64, 0xbb, 0xa6, 0x7e, 0xcc, 0xea, 0xb3, 0x6a, 0xca, 0x33, 0xf9, 0xbf, 0x4a, 0x70, 0x5d, 0xf5,
-		0x5c, 0xea, 0xb8, 0x6d, 0xa2, 0x04, 0x06, 0x79, 0xaf, 0x87, 0xf7, 0x1c, 0xcf, 0x47, 0x77, 0xe1,
-		0x4e, 0xe4, 0x5f, 0xb8, 0xb7, 0x74, 0x43, 0x37, 0x75, 0xc5, 0x2c, 0xe1, 0x58, 0x7e, 0xc7, 0xc2,
-		0x58, 0x43, 0x16, 0x34, 0x1c, 0xe6, 0x75, 0x34, 0x0c, 0x6b, 0x26, 0x3e, 0x15, 0xa5, 0x10, 0x2a,
-		0xcc, 0x68, 0xac, 0x8a, 0x59, 0x7f, 0x8b, 0xfe, 0x97, 0xd3, 0xf9, 0xdf, 0x4b, 0x90, 0x11, 0xdf,
-		0xb6, 0xfc, 0xd3, 0x27, 0x0b, 0xab, 0x6c, 0x83, 0xa5, 0xaa, 0x69, 0x99, 0xa7, 0x65, 0xad, 0xb7,
-		0x86, 0x7b, 0x56, 0xb8, 0x3c, 0x58, 0x66, 0x29, 0xcc, 0x4e, 0xa8, 0x24, 0xbd, 0x00, 0xf1, 0x16,
-		0x86, 0xe1, 0x60, 0x39, 0x35, 0x16, 0x13, 0xfa, 0x49, 0xa3, 0x0d, 0xb8, 0xde, 0x83, 0x39, 0xd2,
-		0x14, 0x6c, 0x1e, 0x68, 0x8a, 0x29, 0x4f, 0xe5, 0x7f, 0x2b, 0xc1, 0x8d, 0x48, 0x09, 0x4d, 0x36,
-		0x58, 0x9d, 0x26, 0xa9, 0x97, 0xda, 0x54, 0xb5, 0xdb, 0x01, 0x41, 0xf7, 0xe1, 0x6e, 0x47, 0xc3,
-		0x4c, 0xa5, 0xf2, 0xaa, 0x7b, 0x56, 0x96, 0xaa, 0xb0, 0xe6, 0xee, 0xee, 0x26, 0x11, 0x2a, 0x42,
-		0x90, 0x25, 0xf4, 0x05, 0x7c, 0x36, 0x1e, 0x8a, 0xb5, 0x8a, 0x66, 0xca, 0xa9, 0xfc, 0x3f, 0x33,
-		0xb0, 0x1e, 0x0f, 0x8e, 0x7d, 0x20, 0x90, 0x7a, 0x18, 0xda, 0x3d, 0xc8, 0xf5, 0x3a, 0x11, 0x3a,
-		0xd7, 0x1f, 0xd7, 0x1e, 0x6c, 0x8f, 0xc1, 0x55, 0x8d, 0x23, 0xc5, 0x28, 0xb0, 0xe7, 0x08, 0x24,
-		0x4b, 0xe8, 0x39, 0xec, 0x8f, 0xa1, 0x1c, 0x28, 0x85, 0x6e, 0x96, 0x3b, 0x13, 0x47, 0x31, 0x4d,
-		0xac, 0x1f, 0x54, 0x4d, 0xad, 0x22, 0xa7, 0x90, 0x06, 0x4a, 0x82, 0x83, 0x5e, 0x1d, 0x1a, 0xea,
-		0x26, 0x8d, 0x9e, 0xc0, 0xc3, 0xa4, 0x38, 0xc2, 0x92, 0xd1, 0x8f, 0x35, 0x1c, 0xa7, 0x4e, 0xa1,
-		0xa7, 0xf0, 0x28, 0x81, 0x2a, 0xde, 0x3c, 0xc0, 0x9d, 0x46, 0xfb, 0xf0, 0x38, 0x31, 0x7a, 0xb5,
-		0x84, 0x0b, 0xd6, 0xb1, 0x82, 0x5f, 0xf5, 0x92, 0x67, 0x90, 0x0e, 0x5a, 0xd2, 0x8b, 0x85, 0xba,
-		0x59, 0x43, 0x74, 0x21, 0xe6, 0xea, 0xda, 0x04, 0x59, 0x64, 0x86, 0x04, 0x37, 0xb3, 0xe8, 0x05,
-		0xa8, 0x93, 0xa5, 0x62, 0xbc, 0xa3, 0x39, 0xf4, 0x06, 0xcc, 0x8f, 0x3b, 0x55, 0xed, 0x8d, 0xa9,
-		0x61, 0x43, 0x49, 0xf2, 0x0c, 0xe8, 0x19, 0x3c, 0x49, 0x4c, 0x5a, 0xaf, 0xfe, 0xc4, 0xe8, 0x19,
-		0xf4, 0x18, 0xbe, 0x1e, 0x43, 0x8f, 0xd7, 0x48, 0xf7, 0x56, 0xa0, 0x17, 0xe4, 0x79, 0xf4, 0x10,
-		0xf6, 0xc6, 0x10, 0x79, 0x17, 0x5a, 0x15, 0x53, 0x57, 0x5f, 0x9d, 0x86, 0xcb, 0x45, 0xbd, 0x62,
-		0xca, 0x0b, 0xe8, 0x47, 0xf0, 0x83, 0x31, 0xb4, 0xce, 0x66, 0xd9, 0x1f, 0x1a, 0x8e, 0xb5, 0x18,
-		0x83, 0x55, 0xb1, 0x26, 0x2f, 0x4e, 0x70, 0x26, 0x15, 0xfd, 0x45, 0x72, 0xe6, 0x96, 0x90, 0x0a,
-		0xcf, 0x27, 0x6a, 0x11, 0xf5, 0x48, 0x2f, 0x16, 0x86, 0x3b, 0x91, 0xd1, 0xd7, 0xb0, 0x3b, 0xc6,
-		0xc9, 0x61, 0x09, 0xab, 0x9a, 0x98, 0x58, 0x1d, 0x91, 0x58, 0x46, 0x8f, 0xe0, 0xc1, 0x38, 0x92,
-		0xa2, 0x17, 0x4b, 0xaf, 0x35, 0xdc, 0xcf, 0x43, 0x6c, 0x8c, 0x4e, 0xb6, 0x75, 0xdd, 0x28, 0x57,
-		0x4d, 0xab, 0xa2, 0x7f, 0xa7, 0xc9, 0x2b, 0x6c, 0x8c, 0x26, 0x9e, 0x54, 0x94, 0x2b, 0x79, 0x75,
-		0x50, 0x8c, 0x07, 0x5e, 0x72, 0xa0, 0x1b, 0x0a, 0x3e, 0x95, 0xd7, 0x12, 0x6a, 0x6f, 0x50, 0xe8,
-		0x7a, 0x4a, 0xe8, 0xfa, 0x24, 0xdb, 0xd1, 0x14, 0xac, 0x1e, 0xc5, 0x33, 0xbe, 0xce, 0xa6, 0xce,
-		0x1d, 0xfe, 0x83, 0xcb, 0xc0, 0xbd, 0x2a, 0x2e, 0xf1, 0x7b, 0xb0, 0x1d, 0x9e, 0xdb, 0x90, 0x2a,
-		0x18, 0xa1, 0xf6, 0x07, 0xf0, 0xc3, 0xc9, 0x28, 0x9d, 0x75, 0xa5, 0x88, 0x35, 0xa5, 0x70, 0xda,
-		0xb9, 0x92, 0x4a, 0xf9, 0xbf, 0x49, 0x90, 0x57, 0x6d, 0xb7, 0x46, 0x1a, 0xd1, 0xef, 0xb8, 0x63,
-		0xa3, 0xdc, 0x87, 0xc7, 0x13, 0xf4, 0xfb, 0x88, 0x78, 0x4f, 0xa0, 0xf2, 0xb1, 0xe4, 0xaa, 0xf1,
-		0xca, 0x28, 0x9d, 0x18, 0xe3, 0x08, 0x62, 0x13, 0x15, 0xe7, 0x82, 0xff, 0x08, 0x3d, 0xd9, 0x26,
-		0x44, 0xd9, 0xfd, 0x67, 0x9b, 0xf8, 0x58, 0xf2, 0x44, 0x9b, 0x38, 0xf8, 0x29, 0xac, 0xd7, 0xbc,
-		0xe6, 0xb0, 0xaf, 0xf8, 0x83, 0x85, 0x68, 0x3b, 0x65, 0xf6, 0x19, 0x5b, 0x96, 0xbe, 0xdb, 0xbb,
-		0x70, 0xe8, 0x65, 0xfb, 0x6c, 0xa7, 0xe6, 0x35, 0x77, 0xe3, 0xff, 0xc1, 0xdd, 0x76, 0xea, 0x8d,
-		0xdd, 0x0b, 0x2f, 0xfc, 0x8f, 0xb0, 0xf8, 0x77, 0xee, 0xbe, 0xdd, 0x72, 0xde, 0xed, 0x9d, 0xcd,
-		0x70, 0xdb, 0xd7, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x86, 0x60, 0x70, 0x2f, 0x8e, 0x1e, 0x00,
-		0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6f, 0xdb, 0xca,
+		0xd5, 0x7e, 0x29, 0xd9, 0x8e, 0x7d, 0xe4, 0x0f, 0x7a, 0x6c, 0xc7, 0x8a, 0xf3, 0xe5, 0xe8, 0xde,
+		0x24, 0x8e, 0xde, 0x6b, 0xf9, 0x3a, 0xb9, 0x49, 0x6e, 0xe2, 0xa6, 0x29, 0x4d, 0xd1, 0x31, 0x13,
+		0x99, 0x52, 0x87, 0x54, 0x1c, 0x5f, 0xb4, 0x25, 0x68, 0x89, 0xb6, 0xd9, 0x48, 0xa4, 0x40, 0x8e,
+		0x92, 0x78, 0x5f, 0xa0, 0xeb, 0x6e, 0x8a, 0xa2, 0xab, 0xfe, 0x80, 0x16, 0x45, 0xd1, 0x75, 0x51,
+		0xa0, 0x8b, 0xee, 0xba, 0xed, 0x7f, 0xe8, 0xbf, 0x28, 0x66, 0x38, 0x94, 0xa8, 0x4f, 0x2a, 0x2d,
+		0x70, 0xbb, 0x33, 0xcf, 0x3c, 0xcf, 0xe1, 0x99, 0x33, 0xe7, 0x3c, 0x67, 0x28, 0x43, 0xae, 0x7d,
+		0x6a, 0xfb, 0x3b, 0x35, 0xab, 0x6e, 0xbb, 0x35, 0x7b, 0xc7, 0x6a, 0x39, 0x3b, 0x1f, 0x76, 0x77,
+		0x3e, 0x7a, 0xfe, 0xfb, 0xb3, 0x86, 0xf7, 0xb1, 0xd0, 0xf2, 0x3d, 0xe2, 0xa1, 0x15, 0x8a, 0x29,
+		0x70, 0x4c, 0xc1, 0x6a, 0x39, 0x85, 0x0f, 0xbb, 0x1b, 0xb7, 0xce, 0x3d, 0xef, 0xbc, 0x61, 0xef,
+		0x30, 0xc8, 0x69, 0xfb, 0x6c, 0xa7, 0xde, 0xf6, 0x2d, 0xe2, 0x78, 0x6e, 0x48, 0xda, 0xb8, 0xdd,
+		0xbf, 0x4e, 0x9c, 0xa6, 0x1d, 0x10, 0xab, 0xd9, 0xe2, 0x80, 0xcd, 0x61, 0x6f, 0xae, 0x79, 0xcd,
+		0x66, 0xc7, 0xc5, 0xd0, 0xd8, 0x88, 0x15, 0xbc, 0x6f, 0x38, 0x01, 0x09, 0x31, 0xb9, 0x3f, 0xcc,
+		0xc2, 0xda, 0x31, 0x0f, 0x57, 0xf9, 0x64, 0xd7, 0xda, 0x34, 0x04, 0xd5, 0x3d, 0xf3, 0x50, 0x15,
+		0x50, 0xb4, 0x0f, 0xd3, 0x8e, 0x56, 0xb2, 0xc2, 0xa6, 0xb0, 0x95, 0x79, 0x78, 0xaf, 0x30, 0x64,
+		0x4b, 0x85, 0x01, 0x3f, 0x78, 0xf9, 0x63, 0xbf, 0x09, 0x3d, 0x86, 0x29, 0x72, 0xd9, 0xb2, 0xb3,
+		0x29, 0xe6, 0xe8, 0xce, 0x58, 0x47, 0xc6, 0x65, 0xcb, 0xc6, 0x0c, 0x8e, 0x9e, 0x01, 0x04, 0xc4,
+		0xf2, 0x89, 0x49, 0xd3, 0x90, 0x4d, 0x33, 0xf2, 0x46, 0x21, 0xcc, 0x51, 0x21, 0xca, 0x51, 0xc1,
+		0x88, 0x72, 0x84, 0xe7, 0x18, 0x9a, 0x3e, 0x53, 0x6a, 0xad, 0xe1, 0x05, 0x76, 0x48, 0x9d, 0x4a,
+		0xa6, 0x32, 0x34, 0xa3, 0x1a, 0x30, 0x1f, 0x52, 0x03, 0x62, 0x91, 0x76, 0x90, 0x9d, 0xde, 0x14,
+		0xb6, 0x16, 0x1f, 0xee, 0x4e, 0xb6, 0x7b, 0x99, 0x32, 0x75, 0x46, 0xc4, 0x99, 0x5a, 0xf7, 0x01,
+		0xdd, 0x85, 0xc5, 0x0b, 0x27, 0x20, 0x9e, 0x7f, 0x69, 0x36, 0x6c, 0xf7, 0x9c, 0x5c, 0x64, 0x67,
+		0x36, 0x85, 0xad, 0x34, 0x5e, 0xe0, 0xd6, 0x12, 0x33, 0xa2, 0x9f, 0xc0, 0x5a, 0xcb, 0xf2, 0x6d,
+		0x97, 0x74, 0xd3, 0x6f, 0x3a, 0xee, 0x99, 0x97, 0xbd, 0xc2, 0xb6, 0xb0, 0x35, 0x34, 0x8a, 0x0a,
+		0x63, 0xf4, 0x9c, 0x24, 0x5e, 0x69, 0x0d, 0x1a, 0x91, 0x04, 0x8b, 0x5d, 0xb7, 0x2c, 0x33, 0xb3,
+		0x89, 0x99, 0x59, 0xe8, 0x30, 0x58, 0x76, 0xb6, 0x61, 0xaa, 0x69, 0x37, 0xbd, 0xec, 0x1c, 0x23,
+		0x5e, 0x1b, 0x1a, 0xcf, 0x91, 0xdd, 0xf4, 0x30, 0x83, 0x21, 0x0c, 0xcb, 0x81, 0x6d, 0xf9, 0xb5,
+		0x0b, 0xd3, 0x22, 0xc4, 0x77, 0x4e, 0xdb, 0xc4, 0x0e, 0xb2, 0xc0, 0xb8, 0x77, 0x87, 0x72, 0x75,
+		0x86, 0x96, 0x3a, 0x60, 0x2c, 0x06, 0x7d, 0x16, 0x54, 0x82, 0x65, 0xab, 0x4d, 0x3c, 0xd3, 0xb7,
+		0x03, 0x9b, 0x98, 0x2d, 0xcf, 0x71, 0x49, 0x90, 0xcd, 0x30, 0x9f, 0x9b, 0x43, 0x7d, 0x62, 0x0a,
+		0xac, 0x30, 0x1c, 0x5e, 0xa2, 0xd4, 0x98, 0x01, 0x5d, 0x87, 0x39, 0xda, 0x1e, 0x26, 0xed, 0x8f,
+		0xec, 0xfc, 0xa6, 0xb0, 0x35, 0x87, 0x67, 0xa9, 0xa1, 0xe4, 0x04, 0x04, 0xad, 0xc3, 0x15, 0x27,
+		0x30, 0x6b, 0xbe, 0xe7, 0x66, 0x17, 0x36, 0x85, 0xad, 0x59, 0x3c, 0xe3, 0x04, 0xb2, 0xef, 0xb9,
+		0x68, 0x0f, 0x32, 0xed, 0x56, 0xdd, 0x22, 0xbc, 0xc0, 0x16, 0x13, 0xd3, 0x08, 0x21, 0x9c, 0xe5,
+		0xf0, 0xe7, 0x20, 0xb6, 0x2c, 0x9f, 0x38, 0xec, 0x18, 0x6a, 0x9e, 0x7b, 0xe6, 0x9c, 0x67, 0x97,
+		0x36, 0xd3, 0x5b, 0x99, 0x87, 0x2f, 0x27, 0xab, 0x32, 0x7a, 0x98, 0x85, 0x4a, 0xe4, 0x42, 0x66,
+		0x1e, 0x14, 0x97, 0xf8, 0x97, 0x78, 0xa9, 0xd5, 0x6b, 0xdd, 0xd8, 0x87, 0xd5, 0x61, 0x40, 0x24,
+		0x42, 0xfa, 0xbd, 0x7d, 0xc9, 0x5a, 0x7b, 0x0e, 0xd3, 0x3f, 0xd1, 0x2a, 0x4c, 0x7f, 0xb0, 0x1a,
+		0xed, 0xb0, 0x4b, 0xe7, 0x70, 0xf8, 0xf0, 0x3c, 0xf5, 0xad, 0x90, 0xfb, 0x4d, 0x0a, 0x6e, 0x0d,
+		0x56, 0x3a, 0x73, 0xc6, 0xf5, 0x0b, 0x3d, 0x8f, 0x67, 0x31, 0xd4, 0x8b, 0x9b, 0x43, 0xf7, 0x62,
+		0xf0, 0xd4, 0xc6, 0x92, 0x6c, 0xc1, 0x66, 0xb7, 0x2a, 0x79, 0xc3, 0x7b, 0x66, 0xb7, 0x7d, 0xbd,
+		0x36, 0xe1, 0xca, 0x71, 0x6d, 0x20, 0xc1, 0x45, 0x1e, 0x00, 0xbe, 0xd1, 0x71, 0xa1, 0x33, 0x11,
+		0xf0, 0xe4, 0xa8, 0xa1, 0xbd, 0x36, 0x41, 0xc7, 0x70, 0x9d, 0x85, 0x37, 0xc2, 0x7b, 0x3a, 0xc9,
+		0xfb, 0x3a, 0x65, 0x0f, 0x71, 0x9c, 0xfb, 0x87, 0x00, 0x2b, 0x43, 0xda, 0x8f, 0x56, 0x55, 0xdd,
+		0x6b, 0x5a, 0x8e, 0x6b, 0x3a, 0x75, 0x9e, 0xe4, 0xd9, 0xd0, 0xa0, 0xd6, 0xd1, 0x6d, 0xc8, 0xf0,
+		0x45, 0xd7, 0x6a, 0x46, 0xf9, 0x86, 0xd0, 0xa4, 0x59, 0x4d, 0x7b, 0x84, 0x0c, 0xa7, 0xff, 0x5b,
+		0x19, 0xbe, 0x03, 0xf3, 0x8e, 0xeb, 0x10, 0xc7, 0x22, 0x76, 0x9d, 0xc6, 0x35, 0xc5, 0x14, 0x28,
+		0xd3, 0xb1, 0xa9, 0xf5, 0xdc, 0xaf, 0x04, 0x58, 0x53, 0x3e, 0x11, 0xdb, 0x77, 0xad, 0xc6, 0xf7,
+		0x32, 0x1a, 0xfa, 0x63, 0x4a, 0x0d, 0xc6, 0xf4, 0xeb, 0x19, 0x58, 0xa9, 0xd8, 0x6e, 0xdd, 0x71,
+		0xcf, 0xa5, 0x1a, 0x71, 0x3e, 0x38, 0xe4, 0x92, 0x45, 0x74, 0x1b, 0x32, 0x16, 0x7f, 0xee, 0x66,
+		0x19, 0x22, 0x93, 0x5a, 0x47, 0x07, 0xb0, 0xd0, 0x01, 0x24, 0xce, 0x9f, 0xc8, 0x35, 0x9b, 0x3f,
+		0xf3, 0x56, 0xec, 0x09, 0xbd, 0x84, 0x69, 0x3a, 0x0b, 0xc2, 0x11, 0xb4, 0xf8, 0xf0, 0xc1, 0x70,
+		0x11, 0xee, 0x8d, 0x90, 0xca, 0xbe, 0x8d, 0x43, 0x1e, 0x52, 0x61, 0xf9, 0xc2, 0xb6, 0x7c, 0x72,
+		0x6a, 0x5b, 0xc4, 0xac, 0xdb, 0xc4, 0x72, 0x1a, 0x01, 0x1f, 0x4a, 0x37, 0x46, 0x28, 0xfa, 0x65,
+		0xc3, 0xb3, 0xea, 0x58, 0xec, 0xd0, 0x8a, 0x21, 0x0b, 0xbd, 0x86, 0x95, 0x86, 0x15, 0x10, 0xb3,
+		0xeb, 0x8f, 0x09, 0xd0, 0x74, 0xa2, 0x00, 0x2d, 0x53, 0xda, 0x61, 0xc4, 0xa2, 0x76, 0x74, 0x00,
+		0xcc, 0x18, 0x76, 0x85, 0x5d, 0x0f, 0x3d, 0xcd, 0x24, 0x7a, 0x5a, 0xa2, 0x24, 0x3d, 0xe4, 0x30,
+		0x3f, 0x59, 0xb8, 0x62, 0x11, 0x62, 0x37, 0x5b, 0x84, 0x8d, 0xa9, 0x69, 0x1c, 0x3d, 0xa2, 0x07,
+		0x20, 0x36, 0xad, 0x4f, 0x4e, 0xb3, 0xdd, 0x34, 0xb9, 0x29, 0x60, 0x23, 0x67, 0x1a, 0x2f, 0x71,
+		0xbb, 0xc4, 0xcd, 0x74, 0x36, 0x05, 0xb5, 0x0b, 0xbb, 0xde, 0x6e, 0x44, 0x91, 0xcc, 0x25, 0xcf,
+		0xa6, 0x0e, 0x83, 0xc5, 0x21, 0xc3, 0x92, 0xfd, 0xa9, 0xe5, 0x84, 0x3d, 0x1b, 0xfa, 0x80, 0x44,
+		0x1f, 0x8b, 0x5d, 0x0a, 0x73, 0xf2, 0x12, 0xe6, 0x59, 0x52, 0xce, 0x2c, 0xa7, 0xd1, 0xf6, 0xed,
+		0x6c, 0x66, 0xcc, 0x31, 0x1d, 0x84, 0x18, 0x9c, 0xa1, 0x0c, 0xfe, 0x80, 0xbe, 0x86, 0x55, 0xe6,
+		0x80, 0xd6, 0xba, 0xed, 0x9b, 0x4e, 0xdd, 0x76, 0x89, 0x43, 0x2e, 0xf9, 0x6c, 0x41, 0x74, 0xed,
+		0x98, 0x2d, 0xa9, 0x7c, 0x05, 0x3d, 0x81, 0xf5, 0xe8, 0x08, 0xfa, 0x49, 0x0b, 0x8c, 0xb4, 0xc6,
+		0x97, 0x7b, 0x79, 0xb9, 0x3f, 0xa7, 0xe0, 0x1a, 0x2f, 0x3b, 0xf9, 0xc2, 0x69, 0xd4, 0xbf, 0x97,
+		0x86, 0xfd, 0x2a, 0xe6, 0x96, 0x36, 0x55, 0x5c, 0xc3, 0xc4, 0x8f, 0xb1, 0x4b, 0x1c, 0x53, 0xb2,
+		0xfe, 0xf6, 0x4e, 0x0f, 0xb4, 0x37, 0x7a, 0x0b, 0xfc, 0xae, 0xc2, 0x45, 0xb9, 0xe5, 0x35, 0x9c,
+		0xda, 0x25, 0x6b, 0x8f, 0xc5, 0x11, 0x81, 0x86, 0x8a, 0xcb, 0x84, 0xb8, 0xc2, 0xd0, 0x78, 0xb9,
+		0xd5, 0x6f, 0x42, 0x57, 0x61, 0x26, 0x94, 0x54, 0xd6, 0x1c, 0x73, 0x98, 0x3f, 0xe5, 0xfe, 0x9e,
+		0xea, 0xc8, 0x49, 0xd1, 0xae, 0x39, 0x41, 0x94, 0xaf, 0x4e, 0x97, 0x0b, 0xc9, 0x5d, 0x1e, 0x11,
+		0x7b, 0xba, 0x7c, 0xb0, 0x82, 0x53, 0x9f, 0x5b, 0xc1, 0x2f, 0x60, 0xbe, 0xa7, 0x19, 0x93, 0xef,
+		0xbc, 0x99, 0x60, 0x78, 0x23, 0x4e, 0xf5, 0x36, 0x22, 0x86, 0x75, 0xcf, 0x77, 0xce, 0x1d, 0xd7,
+		0x6a, 0x98, 0x7d, 0x41, 0x26, 0x4b, 0xc7, 0x5a, 0x44, 0xd5, 0xe3, 0xc1, 0xe6, 0xfe, 0x92, 0x82,
+		0x6b, 0x91, 0xdc, 0x95, 0xbc, 0x9a, 0xd5, 0x28, 0x3a, 0x41, 0xcb, 0x22, 0xb5, 0x8b, 0xc9, 0xd4,
+		0xf9, 0x7f, 0x9f, 0xae, 0x9f, 0xc1, 0xad, 0xde, 0x08, 0x4c, 0xef, 0xcc, 0x24, 0x17, 0x4e, 0x60,
+		0xc6, 0xb3, 0x38, 0xde, 0xe1, 0x46, 0x4f, 0x44, 0xe5, 0x33, 0xe3, 0xc2, 0x09, 0xb8, 0xa6, 0xa1,
+		0x9b, 0x00, 0xec, 0xd6, 0x41, 0xbc, 0xf7, 0x76, 0x58, 0x85, 0xf3, 0x98, 0x5d, 0x93, 0x0c, 0x6a,
+		0xc8, 0xbd, 0x86, 0x4c, 0xfc, 0x22, 0xba, 0x07, 0x33, 0xfc, 0x2e, 0x2b, 0xb0, 0xbb, 0xe0, 0x17,
+		0x09, 0x77, 0x59, 0x76, 0xcd, 0xe7, 0x94, 0xdc, 0x1f, 0x53, 0xb0, 0xd8, 0xbb, 0x84, 0xee, 0xc3,
+		0xd2, 0xa9, 0xe3, 0x5a, 0xfe, 0xa5, 0x59, 0xbb, 0xb0, 0x6b, 0xef, 0x83, 0x76, 0x93, 0x1f, 0xc2,
+		0x62, 0x68, 0x96, 0xb9, 0x15, 0xad, 0xc1, 0x8c, 0xdf, 0x76, 0xa3, 0xe1, 0x3b, 0x87, 0xa7, 0xfd,
+		0x36, 0xbd, 0xa5, 0xbc, 0x80, 0xeb, 0x67, 0x8e, 0x1f, 0xd0, 0x81, 0x15, 0x16, 0xbb, 0x59, 0xf3,
+		0x9a, 0xad, 0x86, 0xdd, 0xd3, 0xc9, 0x59, 0x06, 0x89, 0xda, 0x41, 0x8e, 0x00, 0x8c, 0x3e, 0x5f,
+		0xf3, 0x6d, 0xab, 0x73, 0x36, 0xc9, 0xa9, 0xcc, 0x70, 0x3c, 0x97, 0xe1, 0x05, 0x26, 0xcc, 0x8e,
+		0x7b, 0x3e, 0x69, 0x99, 0xce, 0x47, 0x04, 0xe6, 0xe0, 0x16, 0x00, 0xfb, 0x40, 0x20, 0xd6, 0x69,
+		0x23, 0x9c, 0x6a, 0xb3, 0x38, 0x66, 0xc9, 0xff, 0x49, 0x80, 0xd5, 0x61, 0x33, 0x1b, 0xe5, 0xe0,
+		0x56, 0x45, 0xd1, 0x8a, 0xaa, 0xf6, 0xca, 0x94, 0x64, 0x43, 0x7d, 0xab, 0x1a, 0x27, 0xa6, 0x6e,
+		0x48, 0x86, 0x62, 0xaa, 0xda, 0x5b, 0xa9, 0xa4, 0x16, 0xc5, 0xff, 0x43, 0x5f, 0xc2, 0xe6, 0x08,
+		0x8c, 0x2e, 0x1f, 0x2a, 0xc5, 0x6a, 0x49, 0x29, 0x8a, 0xc2, 0x18, 0x4f, 0xba, 0x21, 0x61, 0x43,
+		0x29, 0x8a, 0x29, 0xf4, 0xff, 0x70, 0x7f, 0x04, 0x46, 0x96, 0x34, 0x59, 0x29, 0x99, 0x58, 0xf9,
+		0x71, 0x55, 0xd1, 0x29, 0x38, 0x9d, 0xff, 0x45, 0x37, 0xe6, 0x1e, 0x05, 0x8a, 0xbf, 0xa9, 0xa8,
+		0xc8, 0xaa, 0xae, 0x96, 0xb5, 0x71, 0x31, 0xf7, 0x61, 0x46, 0xc4, 0xdc, 0x8f, 0x8a, 0x62, 0xce,
+		0xff, 0x32, 0xd5, 0xfd, 0xfd, 0x40, 0xad, 0x63, 0xbb, 0xdd, 0xd1, 0xdc, 0x2f, 0x61, 0xf3, 0xb8,
+		0x8c, 0xdf, 0x1c, 0x94, 0xca, 0xc7, 0xa6, 0x5a, 0x34, 0xb1, 0x52, 0xd5, 0x15, 0xb3, 0x52, 0x2e,
+		0xa9, 0xf2, 0x49, 0x2c, 0x92, 0x6f, 0xe1, 0x9b, 0x91, 0x28, 0xa9, 0x44, 0xad, 0xc5, 0x6a, 0xa5,
+		0xa4, 0xca, 0xf4, 0xad, 0x07, 0x92, 0x5a, 0x52, 0x8a, 0x66, 0x59, 0x2b, 0x9d, 0x88, 0x02, 0xfa,
+		0x0a, 0xb6, 0x26, 0x65, 0x8a, 0x29, 0xb4, 0x0d, 0x0f, 0x46, 0xa2, 0xb1, 0xf2, 0x5a, 0x91, 0x8d,
+		0x18, 0x3c, 0x8d, 0x76, 0x61, 0x7b, 0x24, 0xdc, 0x50, 0xf0, 0x91, 0xaa, 0xb1, 0x84, 0x1e, 0x98,
+		0xb8, 0xaa, 0x69, 0xaa, 0xf6, 0x4a, 0x9c, 0xca, 0xff, 0x4e, 0x80, 0xe5, 0x81, 0x61, 0x84, 0x6e,
+		0xc3, 0xf5, 0x8a, 0x84, 0x15, 0xcd, 0x30, 0xe5, 0x52, 0x79, 0x58, 0x02, 0x46, 0x00, 0xa4, 0x7d,
+		0x49, 0x2b, 0x96, 0x35, 0x51, 0x40, 0xf7, 0x20, 0x37, 0x0c, 0xc0, 0x6b, 0x81, 0x97, 0x86, 0x98,
+		0x42, 0x77, 0xe0, 0xe6, 0x30, 0x5c, 0x27, 0x5a, 0x31, 0x9d, 0xff, 0x57, 0x0a, 0x6e, 0x8c, 0xfb,
+		0x99, 0x82, 0x56, 0x60, 0x67, 0xdb, 0xca, 0x3b, 0x45, 0xae, 0x1a, 0xf4, 0xcc, 0x43, 0x7f, 0xf4,
+		0xe4, 0xab, 0x7a, 0x2c, 0xf2, 0x78, 0x4a, 0x47, 0x80, 0xe5, 0xf2, 0x51, 0xa5, 0xa4, 0x18, 0xac,
+		0x9a, 0xf2, 0x70, 0x2f, 0x09, 0x1e, 0x1e, 0xb0, 0x98, 0xea, 0x39, 0xdb, 0x51, 0xae, 0xd9, 0xbe,
+		0x69, 0x2b, 0xa0, 0x02, 0xe4, 0x93, 0xd0, 0x9d, 0x2c, 0x14, 0xc5, 0x29, 0xf4, 0x0d, 0x7c, 0x9d,
+		0x1c, 0xb8, 0x66, 0xa8, 0x5a, 0x55, 0x29, 0x9a, 0x92, 0x6e, 0x6a, 0xca, 0xb1, 0x38, 0x3d, 0xc9,
+		0x76, 0x0d, 0xf5, 0x88, 0xd6, 0x67, 0xd5, 0x10, 0x67, 0xf2, 0x7f, 0x15, 0xe0, 0xaa, 0xec, 0xb9,
+		0xc4, 0x71, 0xdb, 0xb6, 0x14, 0x68, 0xf6, 0x47, 0x35, 0xbc, 0xe7, 0x78, 0x3e, 0xba, 0x0b, 0x77,
+		0x22, 0xff, 0xdc, 0xbd, 0xa9, 0x6a, 0xaa, 0xa1, 0x4a, 0x46, 0x19, 0xc7, 0xf2, 0x3b, 0x16, 0x46,
+		0x1b, 0xb2, 0xa8, 0xe0, 0x30, 0xaf, 0xa3, 0x61, 0x58, 0x31, 0xf0, 0x09, 0x2f, 0x85, 0x50, 0x61,
+		0x46, 0x63, 0x65, 0x5c, 0xd6, 0x3a, 0xfd, 0x2f, 0xa6, 0xf3, 0xbf, 0x17, 0x20, 0xc3, 0xbf, 0x6d,
+		0xd9, 0xa7, 0x4f, 0x16, 0x56, 0xe9, 0x06, 0xcb, 0x55, 0xc3, 0x34, 0x4e, 0x2a, 0x4a, 0x6f, 0x0d,
+		0xf7, 0xac, 0x30, 0x79, 0x30, 0x8d, 0x72, 0x98, 0x9d, 0x50, 0x49, 0x7a, 0x01, 0xfc, 0x2d, 0x14,
+		0xc3, 0xc0, 0x62, 0x6a, 0x2c, 0x26, 0xf4, 0x93, 0x46, 0x1b, 0x70, 0xb5, 0x07, 0x73, 0xa8, 0x48,
+		0xd8, 0xd8, 0x57, 0x24, 0x43, 0x9c, 0xca, 0xff, 0x56, 0x80, 0x6b, 0x91, 0x12, 0xd2, 0x5f, 0x16,
+		0x68, 0xe8, 0xf5, 0x72, 0x9b, 0xc8, 0x56, 0x3b, 0xb0, 0xd1, 0x03, 0xb8, 0xdb, 0xd1, 0x30, 0x43,
+		0xd2, 0xdf, 0x74, 0xcf, 0xca, 0x94, 0xa5, 0xaa, 0x1e, 0xdf, 0x4d, 0x22, 0x94, 0x87, 0x20, 0x0a,
+		0xe8, 0x3e, 0x7c, 0x31, 0x1e, 0x8a, 0x15, 0x5d, 0x31, 0xc4, 0x54, 0xfe, 0x9f, 0x19, 0x58, 0x8f,
+		0x07, 0x47, 0x3f, 0x10, 0xec, 0x7a, 0x18, 0xda, 0x3d, 0xc8, 0xf5, 0x3a, 0xe1, 0x3a, 0xd7, 0x1f,
+		0xd7, 0x2e, 0x6c, 0x8f, 0xc1, 0x55, 0xb5, 0x43, 0x49, 0x2b, 0xd2, 0xe7, 0x08, 0x24, 0x0a, 0xe8,
+		0x25, 0xec, 0x8d, 0xa1, 0xec, 0x4b, 0xc5, 0x6e, 0x96, 0x3b, 0x13, 0x47, 0x32, 0x0c, 0xac, 0xee,
+		0x57, 0x0d, 0x45, 0x17, 0x53, 0x48, 0x01, 0x29, 0xc1, 0x41, 0xaf, 0x0e, 0x0d, 0x75, 0x93, 0x46,
+		0xcf, 0xe0, 0x71, 0x52, 0x1c, 0x61, 0xc9, 0xa8, 0x47, 0x0a, 0x8e, 0x53, 0xa7, 0xd0, 0x73, 0x78,
+		0x92, 0x40, 0xe5, 0x6f, 0x1e, 0xe0, 0x4e, 0xa3, 0x3d, 0x78, 0x9a, 0x18, 0xbd, 0x5c, 0xc6, 0x45,
+		0xf3, 0x48, 0xc2, 0x6f, 0x7a, 0xc9, 0x33, 0x48, 0x05, 0x25, 0xe9, 0xc5, 0x5c, 0xdd, 0xcc, 0x21,
+		0xba, 0x10, 0x73, 0x75, 0x65, 0x82, 0x2c, 0x52, 0x43, 0x82, 0x9b, 0x59, 0xf4, 0x0a, 0xe4, 0xc9,
+		0x52, 0x31, 0xde, 0xd1, 0x1c, 0x7a, 0x07, 0xc6, 0xe7, 0x9d, 0xaa, 0xf2, 0xce, 0x50, 0xb0, 0x26,
+		0x25, 0x79, 0x06, 0xf4, 0x02, 0x9e, 0x25, 0x26, 0xad, 0x57, 0x7f, 0x62, 0xf4, 0x0c, 0x7a, 0x0a,
+		0x8f, 0xc6, 0xd0, 0xe3, 0x35, 0xd2, 0xbd, 0x15, 0xa8, 0x45, 0x71, 0x1e, 0x3d, 0x86, 0xdd, 0x31,
+		0x44, 0xd6, 0x85, 0xa6, 0x6e, 0xa8, 0xf2, 0x9b, 0x93, 0x70, 0xb9, 0xa4, 0xea, 0x86, 0xb8, 0x80,
+		0x7e, 0x04, 0x3f, 0x18, 0x43, 0xeb, 0x6c, 0x96, 0xfe, 0xa1, 0xe0, 0x58, 0x8b, 0x51, 0x58, 0x15,
+		0x2b, 0xe2, 0xe2, 0x04, 0x67, 0xa2, 0xab, 0xaf, 0x92, 0x33, 0xb7, 0x84, 0x64, 0x78, 0x39, 0x51,
+		0x8b, 0xc8, 0x87, 0x6a, 0xa9, 0x38, 0xdc, 0x89, 0x88, 0x1e, 0xc1, 0xce, 0x18, 0x27, 0x07, 0x65,
+		0x2c, 0x2b, 0x7c, 0x62, 0x75, 0x44, 0x62, 0x19, 0x3d, 0x81, 0x87, 0xe3, 0x48, 0x92, 0x5a, 0x2a,
+		0xbf, 0x55, 0x70, 0x3f, 0x0f, 0xd1, 0x31, 0x3a, 0xd9, 0xd6, 0x55, 0xad, 0x52, 0x35, 0x4c, 0x5d,
+		0xfd, 0x4e, 0x11, 0x57, 0xe8, 0x18, 0x4d, 0x3c, 0xa9, 0x28, 0x57, 0xe2, 0xea, 0xa0, 0x18, 0x0f,
+		0xbc, 0x64, 0x5f, 0xd5, 0x24, 0x7c, 0x22, 0xae, 0x25, 0xd4, 0xde, 0xa0, 0xd0, 0xf5, 0x94, 0xd0,
+		0xd5, 0x49, 0xb6, 0xa3, 0x48, 0x58, 0x3e, 0x8c, 0x67, 0x7c, 0x9d, 0x4e, 0x9d, 0x3b, 0xec, 0x07,
+		0x97, 0x81, 0x7b, 0x55, 0x5c, 0xe2, 0x77, 0x61, 0x3b, 0x3c, 0xb7, 0x21, 0x55, 0x30, 0x42, 0xed,
+		0xf7, 0xe1, 0x87, 0x93, 0x51, 0x3a, 0xeb, 0x52, 0x09, 0x2b, 0x52, 0xf1, 0xa4, 0x73, 0x25, 0x15,
+		0xf2, 0x7f, 0x13, 0x20, 0x2f, 0x5b, 0x6e, 0xcd, 0x6e, 0x44, 0xbf, 0xe3, 0x8e, 0x8d, 0x72, 0x0f,
+		0x9e, 0x4e, 0xd0, 0xef, 0x23, 0xe2, 0x3d, 0x06, 0xfd, 0x73, 0xc9, 0x55, 0xed, 0x8d, 0x56, 0x3e,
+		0xd6, 0xc6, 0x11, 0xf8, 0x26, 0x74, 0xe7, 0xdc, 0xb5, 0x26, 0xde, 0x04, 0x2f, 0xbb, 0xff, 0x6c,
+		0x13, 0x9f, 0x4b, 0x9e, 0x68, 0x13, 0xfb, 0x3f, 0x85, 0xf5, 0x9a, 0xd7, 0x1c, 0xf6, 0x15, 0xbf,
+		0xbf, 0x10, 0x6d, 0xa7, 0x42, 0x3f, 0x63, 0x2b, 0xc2, 0x77, 0xbb, 0xe7, 0x0e, 0xb9, 0x68, 0x9f,
+		0x16, 0x6a, 0x5e, 0x73, 0x27, 0xfe, 0x1f, 0xdc, 0x6d, 0xa7, 0xde, 0xd8, 0x39, 0xf7, 0xc2, 0xff,
+		0x08, 0xf3, 0x7f, 0xe7, 0xee, 0x59, 0x2d, 0xe7, 0xc3, 0xee, 0xe9, 0x0c, 0xb3, 0x3d, 0xfa, 0xf7,
+		0x00, 0x86, 0x60, 0x70, 0x2f, 0x8e, 0x1e, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/tasklist.proto
 	[]byte{
@@ -1189,618 +1654,616 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0xf0, 0x32, 0xe1, 0xf9, 0x21, 0x45, 0x2f, 0x3b, 0xbb, 0xcd, 0xd5, 0xaa, 0x2c, 0xac, 0x5f, 0x87,
 		0x4b, 0xa6, 0x56, 0x55, 0xec, 0x26, 0x3c, 0xf7, 0xf6, 0xff, 0xca, 0xef, 0x19, 0xc9, 0xbc, 0x25,
 		0xaf, 0xbf, 0xaf, 0xe6, 0xe3, 0xfc, 0x09, 0x97, 0x6c, 0x3d, 0x8c, 0x9f, 0x1a, 0xdb, 0x0f, 0x7f,
-		0x07, 0x00, 0x00, 0xff, 0xff, 0x41, 0xb6, 0x75, 0xa3, 0x5c, 0x05, 0x00, 0x00,
+		0x0f, 0x00, 0x41, 0xb6, 0x75, 0xa3, 0x5c, 0x05, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/service_worker.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x73, 0xdb, 0xc6,
-		0x15, 0x1f, 0x48, 0xa2, 0x3e, 0x9e, 0xbe, 0xa8, 0xd5, 0xd4, 0xa5, 0xe8, 0x2f, 0x99, 0xa9, 0x6d,
-		0xa5, 0x4d, 0xc9, 0x5a, 0x49, 0x1d, 0xc7, 0x8e, 0x3b, 0xd5, 0x87, 0x35, 0x56, 0x27, 0x4d, 0x15,
-		0x98, 0x71, 0x66, 0xd2, 0x19, 0x63, 0x96, 0xc0, 0x4a, 0xdc, 0x11, 0x88, 0xa5, 0x81, 0x05, 0x29,
-		0x5e, 0x7a, 0xe8, 0x31, 0xe9, 0xa1, 0x33, 0x9d, 0xa6, 0x97, 0xce, 0xf8, 0xdc, 0x4b, 0x6f, 0xfd,
-		0x6b, 0x7a, 0xea, 0xb9, 0xff, 0x44, 0x07, 0xbb, 0x0b, 0x10, 0x24, 0x17, 0xe0, 0x47, 0x0f, 0xca,
-		0x4c, 0x6e, 0xc2, 0xe2, 0xf7, 0x1e, 0xde, 0xbe, 0xdf, 0xdb, 0xb7, 0xbf, 0x5d, 0x11, 0xf6, 0xc2,
-		0x06, 0xf1, 0x6b, 0x36, 0x76, 0x88, 0x67, 0x93, 0x1a, 0x6e, 0xd3, 0x5a, 0xe7, 0x51, 0x2d, 0x20,
-		0x7e, 0x87, 0xda, 0xc4, 0xea, 0x32, 0xff, 0x92, 0xf8, 0xd5, 0xb6, 0xcf, 0x38, 0x43, 0xdb, 0x11,
-		0xb2, 0xaa, 0x90, 0x55, 0xdc, 0xa6, 0xd5, 0xce, 0xa3, 0xf2, 0x9d, 0x0b, 0xc6, 0x2e, 0x5c, 0x52,
-		0x13, 0x90, 0x46, 0x78, 0x5e, 0x73, 0x42, 0x1f, 0x73, 0xca, 0x3c, 0x69, 0x54, 0xbe, 0x3b, 0xfc,
-		0x9e, 0xd3, 0x16, 0x09, 0x38, 0x6e, 0xb5, 0x15, 0x60, 0xc4, 0x41, 0xd7, 0xc7, 0xed, 0x36, 0xf1,
-		0x03, 0xf5, 0x7e, 0x57, 0x17, 0x9f, 0xcd, 0x5a, 0xad, 0xe4, 0x13, 0x15, 0x1d, 0xc2, 0x21, 0x36,
-		0x0d, 0xfa, 0x61, 0xdc, 0xd3, 0x61, 0x9a, 0x34, 0xe0, 0xcc, 0xef, 0xc5, 0x91, 0xea, 0x20, 0x6f,
-		0x43, 0x92, 0x00, 0xb4, 0xdf, 0xe1, 0x38, 0xb8, 0x74, 0x69, 0xc0, 0xf3, 0x30, 0x51, 0x16, 0xcf,
-		0x5d, 0xd6, 0x95, 0x98, 0xca, 0xbf, 0x0c, 0x28, 0x9f, 0x31, 0xd7, 0x3d, 0x61, 0xfe, 0xb1, 0x8a,
-		0xb2, 0x8e, 0x83, 0x4b, 0x93, 0xbc, 0x0d, 0x49, 0xc0, 0xd1, 0x0d, 0x58, 0x74, 0x58, 0x0b, 0x53,
-		0xaf, 0x64, 0xec, 0x1a, 0x7b, 0x2b, 0xa6, 0x7a, 0x42, 0x4f, 0x61, 0x25, 0xfa, 0x98, 0x15, 0x7d,
-		0xad, 0x34, 0xb7, 0x6b, 0xec, 0xad, 0xee, 0xdf, 0xae, 0x6a, 0x28, 0xa9, 0x46, 0xce, 0x3e, 0xa3,
-		0x01, 0x37, 0x97, 0xb9, 0xfa, 0x0b, 0x95, 0x61, 0x99, 0x3a, 0xc4, 0xe3, 0x94, 0xf7, 0x4a, 0xf3,
-		0xc2, 0x6b, 0xf2, 0x8c, 0x1e, 0xc2, 0x66, 0x83, 0x7a, 0xd8, 0xef, 0x59, 0x76, 0x93, 0xd8, 0x97,
-		0x41, 0xd8, 0x2a, 0x2d, 0x08, 0xc8, 0x86, 0x1c, 0x3e, 0x52, 0xa3, 0x95, 0x7f, 0x2e, 0xc3, 0x4d,
-		0x6d, 0xdc, 0x41, 0x9b, 0x79, 0x01, 0x41, 0xb7, 0x01, 0x44, 0x80, 0x9c, 0x5d, 0x12, 0x19, 0xfc,
-		0x9a, 0x29, 0x42, 0xae, 0x47, 0x03, 0xe8, 0x4b, 0x40, 0x71, 0x22, 0x2c, 0x72, 0x45, 0xec, 0x30,
-		0xaa, 0x12, 0x35, 0x91, 0x07, 0xda, 0x89, 0x7c, 0xa5, 0xe0, 0x2f, 0x62, 0xb4, 0xb9, 0xd5, 0x1d,
-		0x1e, 0x42, 0x27, 0xb0, 0x9e, 0xb8, 0xe5, 0xbd, 0x36, 0x11, 0xf3, 0x5b, 0xdd, 0xbf, 0x97, 0xeb,
-		0xb1, 0xde, 0x6b, 0x13, 0x73, 0xad, 0x9b, 0x7a, 0x42, 0xaf, 0x61, 0xa7, 0xed, 0x93, 0x0e, 0x65,
-		0x61, 0x60, 0x05, 0x1c, 0xfb, 0x9c, 0x38, 0x16, 0xe9, 0x10, 0x8f, 0x5b, 0xd4, 0x11, 0x09, 0x59,
-		0xdd, 0xbf, 0x59, 0x95, 0xb5, 0x5a, 0x8d, 0x6b, 0xb5, 0x7a, 0xea, 0xf1, 0xc7, 0x1f, 0xbd, 0xc6,
-		0x6e, 0x48, 0xcc, 0x1b, 0xb1, 0xf5, 0x2b, 0x69, 0xfc, 0x22, 0xb2, 0x3d, 0x75, 0xd0, 0x1e, 0x14,
-		0x47, 0xdc, 0x15, 0x76, 0x8d, 0xbd, 0x79, 0x73, 0x23, 0x18, 0x44, 0x96, 0x60, 0x09, 0x73, 0x4e,
-		0x5a, 0x6d, 0x5e, 0x5a, 0x14, 0x80, 0xf8, 0x11, 0x7d, 0x00, 0xa8, 0x81, 0xed, 0x4b, 0x97, 0x5d,
-		0x58, 0x36, 0x0b, 0x3d, 0x6e, 0x35, 0xa9, 0xc7, 0x4b, 0x4b, 0x02, 0x54, 0x54, 0x6f, 0x8e, 0xa2,
-		0x17, 0x2f, 0xa9, 0xc7, 0xd1, 0x63, 0x58, 0x52, 0x95, 0x5d, 0x5a, 0x16, 0x71, 0xdf, 0xd2, 0xe6,
-		0xe2, 0xa5, 0xc4, 0x98, 0x31, 0x18, 0x3d, 0x80, 0x4d, 0x8f, 0x5c, 0x71, 0xab, 0x8d, 0x2f, 0x88,
-		0x22, 0x71, 0x45, 0x90, 0xb8, 0x1e, 0x0d, 0x9f, 0xe1, 0x0b, 0x22, 0x89, 0x7c, 0x02, 0x05, 0xb1,
-		0x2c, 0x4a, 0x20, 0xbc, 0x57, 0x72, 0x33, 0xfd, 0x45, 0x84, 0x34, 0xa5, 0x01, 0x7a, 0x03, 0xb7,
-		0x46, 0x4b, 0xc0, 0xea, 0x57, 0xf5, 0xea, 0x24, 0x55, 0xbd, 0x33, 0x52, 0x03, 0xf1, 0x2b, 0x74,
-		0x00, 0x1b, 0x81, 0xdd, 0x24, 0x4e, 0xe8, 0x12, 0xc7, 0x8a, 0x1a, 0x4d, 0x69, 0x4d, 0x78, 0x2c,
-		0x8f, 0x10, 0x57, 0x8f, 0xbb, 0x90, 0xb9, 0x9e, 0x58, 0x44, 0x63, 0xe8, 0x39, 0xac, 0xc5, 0x74,
-		0x09, 0x07, 0xeb, 0x63, 0x1d, 0xac, 0x2a, 0xbc, 0x30, 0xff, 0x0a, 0x96, 0xa2, 0xa9, 0x52, 0x12,
-		0x94, 0x36, 0x76, 0xe7, 0xf7, 0x56, 0xf7, 0x9f, 0x6b, 0x27, 0x93, 0xb3, 0x8c, 0xaa, 0x5f, 0x48,
-		0xfb, 0x17, 0x1e, 0x8f, 0xc8, 0x51, 0xde, 0x50, 0x05, 0x04, 0x0b, 0xfd, 0x1a, 0xda, 0x14, 0xec,
-		0xaf, 0x46, 0x83, 0x71, 0x01, 0x55, 0x61, 0x9b, 0x33, 0x8e, 0x5d, 0x4b, 0x31, 0x6a, 0x35, 0x7a,
-		0x9c, 0x04, 0xa5, 0xa2, 0x40, 0x6e, 0x89, 0x57, 0x8a, 0xf4, 0xc3, 0xe8, 0x45, 0xf9, 0x0d, 0xac,
-		0xa5, 0x3f, 0x86, 0x8a, 0x30, 0x7f, 0x49, 0x7a, 0xaa, 0xed, 0x44, 0x7f, 0x46, 0x54, 0x77, 0xa2,
-		0xea, 0x56, 0xcb, 0x74, 0x22, 0xaa, 0x85, 0xc1, 0xd3, 0xb9, 0x27, 0x46, 0xe5, 0x1f, 0x05, 0x78,
-		0x4f, 0x4e, 0xcb, 0x49, 0xcf, 0xf4, 0x88, 0xb5, 0xda, 0x2e, 0xe1, 0xc4, 0x89, 0x3b, 0xde, 0x98,
-		0xc6, 0xf1, 0x0c, 0x56, 0xe2, 0x6e, 0x1e, 0x94, 0xe6, 0x44, 0x56, 0xf5, 0x25, 0x12, 0x7f, 0xc4,
-		0xec, 0xe3, 0xd1, 0xcf, 0x60, 0xab, 0x5f, 0x69, 0x36, 0xf3, 0x38, 0xb9, 0xe2, 0xa2, 0x45, 0xac,
-		0x99, 0xc5, 0xe4, 0xc5, 0x91, 0x1c, 0x1f, 0x68, 0x93, 0x0b, 0x43, 0x6d, 0xf2, 0xf7, 0xb0, 0x15,
-		0x70, 0x6a, 0x5f, 0xf6, 0x2c, 0xcc, 0xb9, 0x4f, 0x1b, 0x61, 0x94, 0xda, 0x82, 0x48, 0x4b, 0x55,
-		0x1b, 0xcd, 0x2b, 0x81, 0x4e, 0x8a, 0xf4, 0x20, 0xb1, 0x32, 0x8b, 0xd2, 0x51, 0x7f, 0x04, 0x7d,
-		0x0c, 0x25, 0x9f, 0xf0, 0xd0, 0xf7, 0x2c, 0x8f, 0x74, 0xad, 0x38, 0x7a, 0xb1, 0x32, 0x44, 0x2f,
-		0x58, 0x36, 0x7f, 0x24, 0xdf, 0x7f, 0x4e, 0xba, 0xe9, 0x54, 0xa2, 0x43, 0xb8, 0x73, 0xce, 0x7c,
-		0x9b, 0x58, 0xb6, 0x4f, 0x30, 0x27, 0x1a, 0xf3, 0x25, 0x61, 0x5e, 0x16, 0xa8, 0x23, 0x01, 0x1a,
-		0xf6, 0xa1, 0xd9, 0x00, 0x96, 0x75, 0x1b, 0x00, 0x62, 0xb0, 0x2e, 0xd6, 0xb1, 0xe5, 0x93, 0x20,
-		0x74, 0x79, 0x50, 0x5a, 0x11, 0x64, 0xfc, 0x46, 0x3b, 0xfd, 0x09, 0x88, 0xaf, 0xca, 0x8a, 0x91,
-		0xce, 0x64, 0xbd, 0xaf, 0xbd, 0x4d, 0x0d, 0x95, 0x29, 0x6c, 0x8d, 0x40, 0x34, 0x55, 0xfa, 0xab,
-		0xc1, 0x2a, 0xdd, 0x9b, 0xa0, 0x4a, 0x85, 0xc3, 0x74, 0xad, 0xbe, 0x9b, 0x87, 0x9f, 0xe4, 0x87,
-		0xac, 0x76, 0xb9, 0x2f, 0x61, 0x7d, 0x30, 0xc1, 0x86, 0xf8, 0xe8, 0x2f, 0xa6, 0x5d, 0xe7, 0xe6,
-		0x9a, 0x93, 0x26, 0xe1, 0x9d, 0x01, 0x77, 0xb0, 0xcd, 0x69, 0x87, 0x72, 0x4a, 0x02, 0x8b, 0x33,
-		0xcb, 0xa1, 0x41, 0x1b, 0x73, 0xbb, 0x69, 0xb9, 0xcc, 0xc6, 0xae, 0xdb, 0x53, 0xa5, 0xff, 0xf5,
-		0x0c, 0xd9, 0x56, 0x9d, 0xe5, 0x20, 0xf1, 0x5f, 0x67, 0xc7, 0xca, 0xfb, 0x67, 0xd2, 0xb9, 0xcc,
-		0xfe, 0x4d, 0x9c, 0x8d, 0x28, 0xff, 0x01, 0x76, 0xc7, 0x39, 0xd0, 0x70, 0x73, 0x3c, 0xc8, 0x8d,
-		0x7e, 0xa9, 0x28, 0xbf, 0x3d, 0xe1, 0x2b, 0x76, 0x7c, 0xea, 0x9d, 0xb3, 0x34, 0x43, 0x7f, 0x9c,
-		0x83, 0x5d, 0xcd, 0x34, 0x4f, 0x30, 0x75, 0x27, 0x6e, 0x25, 0x87, 0x50, 0xb0, 0x71, 0x18, 0xc8,
-		0x68, 0x36, 0xf6, 0x3f, 0xc8, 0x6d, 0x23, 0x7d, 0xef, 0x47, 0x91, 0x8d, 0x29, 0x4d, 0xa3, 0xed,
-		0xd5, 0x21, 0x1c, 0x53, 0x37, 0x50, 0x52, 0x43, 0xbf, 0xbd, 0x9e, 0xe1, 0x9e, 0xcb, 0xb0, 0x63,
-		0xc6, 0xe0, 0xdc, 0xe6, 0xa2, 0x59, 0x82, 0x05, 0xad, 0x06, 0x7b, 0x0f, 0xee, 0xe5, 0xe4, 0x40,
-		0xf2, 0x5c, 0xf9, 0x4f, 0x5f, 0x60, 0xc6, 0x99, 0xbd, 0x4e, 0x81, 0xf9, 0x0a, 0x50, 0xe2, 0xd7,
-		0x6a, 0x11, 0x8e, 0x1d, 0xcc, 0xb1, 0x92, 0x54, 0xf7, 0x73, 0x3f, 0xf0, 0x5b, 0x05, 0x36, 0x8b,
-		0x7c, 0x68, 0xa4, 0xf2, 0x6d, 0x5f, 0x8c, 0x0e, 0xce, 0xf1, 0x5a, 0xc5, 0xe8, 0x5d, 0x58, 0x55,
-		0x4b, 0xa8, 0x17, 0xed, 0xd1, 0x32, 0x13, 0x10, 0x0f, 0x9d, 0x3a, 0x91, 0x5a, 0x4d, 0x00, 0x42,
-		0xad, 0x2e, 0xe4, 0xa8, 0xd5, 0x64, 0x62, 0x42, 0xad, 0xe2, 0xd4, 0x13, 0xda, 0x87, 0x02, 0xf5,
-		0xda, 0x21, 0x57, 0x3b, 0x50, 0x7e, 0x09, 0x4a, 0xa8, 0x46, 0x1d, 0x2d, 0xfe, 0xbf, 0xea, 0x68,
-		0x69, 0x3a, 0x75, 0x54, 0x87, 0x9d, 0xd8, 0x5f, 0xd4, 0xe1, 0x6c, 0x97, 0x05, 0x44, 0x38, 0x62,
-		0x21, 0x57, 0x5a, 0x75, 0x67, 0xc4, 0xd7, 0xb1, 0x3a, 0x50, 0x9a, 0x37, 0x62, 0xdb, 0x3a, 0x3b,
-		0x8a, 0x2c, 0xeb, 0xd2, 0x10, 0x7d, 0x0e, 0x37, 0xc4, 0x47, 0x46, 0x5d, 0xae, 0x8c, 0x73, 0xb9,
-		0x2d, 0x0c, 0x87, 0xfc, 0x9d, 0xc0, 0x56, 0x93, 0x60, 0x9f, 0x37, 0x08, 0xe6, 0x89, 0x2b, 0x18,
-		0xe7, 0xaa, 0x98, 0xd8, 0xc4, 0x7e, 0x52, 0x7a, 0x3e, 0x12, 0xb6, 0x85, 0xbe, 0x9e, 0x7f, 0x03,
-		0x77, 0x06, 0x99, 0xb0, 0xd8, 0xb9, 0xc5, 0x9b, 0x34, 0xb0, 0x62, 0x83, 0xf1, 0xba, 0xb5, 0x3c,
-		0xc0, 0xcc, 0xef, 0xce, 0xeb, 0x4d, 0x1a, 0x1c, 0x28, 0xff, 0xa7, 0xe9, 0x19, 0xc4, 0xcd, 0x6a,
-		0x7d, 0x82, 0x4a, 0xe9, 0x4f, 0xe2, 0x58, 0x75, 0xad, 0x91, 0xe3, 0xd5, 0xc6, 0x6c, 0xc7, 0xab,
-		0x87, 0xb0, 0x99, 0xf8, 0x51, 0xdd, 0x67, 0x53, 0x76, 0xb8, 0x78, 0xf8, 0x58, 0x76, 0xa1, 0x0f,
-		0x61, 0xb1, 0x49, 0xb0, 0x43, 0x7c, 0xa1, 0x5b, 0xa3, 0x43, 0x97, 0xf6, 0xf0, 0x22, 0x20, 0xa6,
-		0x82, 0x56, 0xbe, 0x33, 0x12, 0xa5, 0x99, 0xee, 0x06, 0xd3, 0x2a, 0xcd, 0x8f, 0x60, 0x51, 0x4a,
-		0x1b, 0xd5, 0x09, 0xf2, 0x93, 0xa5, 0xb0, 0x79, 0xbd, 0xaf, 0xf2, 0x20, 0x51, 0x15, 0x19, 0x71,
-		0xa9, 0x96, 0xfd, 0xed, 0x1c, 0x3c, 0xcc, 0x03, 0x1e, 0xf6, 0x4e, 0x8f, 0xc7, 0xf5, 0xef, 0xeb,
-		0xea, 0x69, 0xfd, 0xac, 0x2d, 0xcc, 0x98, 0xb5, 0xc2, 0x50, 0xd6, 0x7e, 0x0a, 0x7b, 0xe3, 0x93,
-		0xa1, 0x32, 0xf7, 0x57, 0x23, 0x91, 0x05, 0x69, 0xf0, 0x54, 0xb2, 0xe0, 0x31, 0x2c, 0x9d, 0x63,
-		0xea, 0x86, 0x3e, 0xc9, 0x25, 0xfe, 0x44, 0x62, 0xcc, 0x18, 0x9c, 0xcb, 0x7c, 0x7f, 0xa7, 0xd6,
-		0x85, 0xa5, 0x82, 0xff, 0x66, 0x4e, 0x5b, 0x1f, 0x12, 0xf5, 0x7d, 0xe6, 0x3c, 0x95, 0xb1, 0x85,
-		0x59, 0x33, 0x36, 0xcc, 0xfa, 0x43, 0xb8, 0x3f, 0x26, 0x17, 0x2a, 0x6b, 0x7f, 0x33, 0xa0, 0xa2,
-		0xab, 0x0f, 0xec, 0xd9, 0x64, 0x2a, 0xd2, 0xe3, 0xd6, 0x38, 0x37, 0xab, 0x8e, 0x1b, 0x26, 0xfd,
-		0xbe, 0xbe, 0x0d, 0x25, 0x81, 0xa9, 0x09, 0xfc, 0x69, 0x0e, 0x1e, 0xe4, 0xe0, 0xbe, 0xe7, 0xc4,
-		0xc7, 0x59, 0x5b, 0x98, 0x35, 0x6b, 0xc3, 0xc4, 0xbf, 0xaf, 0xef, 0x7d, 0x03, 0xd9, 0x18, 0xa0,
-		0xde, 0x66, 0xfe, 0x00, 0xf4, 0x65, 0xbc, 0x6b, 0x5d, 0x23, 0xf5, 0x67, 0x11, 0xf5, 0x39, 0x81,
-		0x29, 0x5d, 0xfa, 0x3e, 0x14, 0x6d, 0x31, 0x31, 0xcb, 0x97, 0xb1, 0x12, 0x47, 0xc4, 0xb7, 0x6c,
-		0x6e, 0xca, 0x71, 0x33, 0x1e, 0x56, 0x55, 0x92, 0xe9, 0xf2, 0x87, 0x56, 0x25, 0xf5, 0xa8, 0x4a,
-		0xc6, 0x64, 0x63, 0xfa, 0x24, 0xff, 0xbb, 0xbf, 0x7d, 0x88, 0x9b, 0x81, 0x59, 0x64, 0xc3, 0xaf,
-		0x87, 0x64, 0xc3, 0xe4, 0x17, 0x10, 0xf1, 0x66, 0xf8, 0x1a, 0xb6, 0xe5, 0xbf, 0x5a, 0xac, 0x0e,
-		0xf1, 0xc5, 0xd5, 0x02, 0xf5, 0xce, 0x99, 0x3a, 0x5f, 0x66, 0x13, 0x45, 0xfc, 0xd7, 0x12, 0x2e,
-		0xce, 0xca, 0x5b, 0xdd, 0xe1, 0xa1, 0xd4, 0x26, 0xa4, 0x9b, 0x5c, 0xac, 0x3d, 0x0c, 0x28, 0x9b,
-		0x24, 0x20, 0x5c, 0xde, 0x58, 0x25, 0xa7, 0xbb, 0x6b, 0xa9, 0xad, 0xca, 0x6d, 0xb8, 0xa9, 0x0d,
-		0x46, 0x06, 0xbb, 0xff, 0xdf, 0x4d, 0x58, 0x91, 0x53, 0x3f, 0x38, 0x3b, 0x45, 0x57, 0xb0, 0xad,
-		0xb9, 0x62, 0x41, 0xb5, 0xc9, 0x2f, 0x63, 0xc4, 0x1c, 0xcb, 0x53, 0xdf, 0xde, 0xa0, 0xbf, 0x18,
-		0x70, 0x2b, 0xef, 0xd2, 0x05, 0x3d, 0x99, 0xf5, 0x56, 0xac, 0xfc, 0xc9, 0xcc, 0x37, 0x3c, 0xe8,
-		0x1b, 0x03, 0x76, 0x32, 0xef, 0x07, 0xd0, 0x2f, 0x27, 0x75, 0x3c, 0x20, 0x9e, 0xca, 0x8f, 0xa7,
-		0x35, 0x53, 0xc1, 0xf4, 0xc9, 0x49, 0xaf, 0xd8, 0x7c, 0x72, 0x34, 0xf7, 0x15, 0xf9, 0xe4, 0x68,
-		0x0f, 0xff, 0x29, 0x72, 0xb4, 0x02, 0x32, 0x9f, 0x9c, 0xbc, 0x13, 0x44, 0x3e, 0x39, 0xb9, 0x1a,
-		0x1f, 0xbd, 0xd3, 0x2b, 0xd5, 0x01, 0x59, 0x8b, 0x3e, 0x9d, 0xda, 0x7f, 0x6a, 0x1f, 0x28, 0x3f,
-		0x9f, 0xd1, 0x7a, 0xb4, 0x7c, 0x46, 0x25, 0x58, 0x7e, 0xf9, 0x64, 0x6a, 0xef, 0xfc, 0xf2, 0xc9,
-		0xd6, 0xc6, 0xe8, 0x3b, 0x03, 0x6e, 0xe7, 0xea, 0x41, 0xf4, 0xc9, 0x74, 0x9e, 0xd3, 0x89, 0x7a,
-		0x3a, 0x8b, 0xa9, 0x0a, 0xec, 0xcf, 0x86, 0x68, 0x51, 0x59, 0x7a, 0x05, 0x7d, 0x3c, 0x31, 0x09,
-		0x83, 0x82, 0xb5, 0xfc, 0x64, 0x7a, 0x43, 0x15, 0xd2, 0xdf, 0x0d, 0xb8, 0x3b, 0x46, 0x42, 0xa1,
-		0x67, 0xd3, 0x7a, 0x4f, 0xe7, 0xeb, 0xd3, 0xd9, 0x8c, 0x07, 0x32, 0x96, 0xb9, 0x77, 0x67, 0x66,
-		0x6c, 0x9c, 0xce, 0xcb, 0xcc, 0xd8, 0x78, 0x1d, 0x26, 0x33, 0x96, 0x2b, 0x27, 0x32, 0x33, 0x36,
-		0x89, 0x24, 0xcb, 0xcc, 0xd8, 0x64, 0x0a, 0x26, 0xb5, 0x12, 0x47, 0x77, 0xee, 0xfc, 0x95, 0x98,
-		0x29, 0x63, 0xf2, 0x57, 0x62, 0xb6, 0x40, 0x88, 0x1a, 0xb9, 0x66, 0x4b, 0xce, 0x68, 0xe4, 0xd9,
-		0x4a, 0x22, 0xa3, 0x91, 0xe7, 0xec, 0xf6, 0x87, 0x0d, 0xf8, 0xb1, 0xcd, 0x5a, 0x3a, 0xb3, 0x43,
-		0x24, 0x55, 0xc0, 0x2b, 0xf9, 0x4b, 0x95, 0x33, 0x9f, 0x71, 0x76, 0x66, 0x7c, 0xfd, 0xe8, 0x82,
-		0xf2, 0x66, 0xd8, 0xa8, 0xda, 0xac, 0x55, 0x4b, 0xff, 0x14, 0xe3, 0xe7, 0xd4, 0x71, 0x6b, 0x17,
-		0x4c, 0xfe, 0xca, 0x44, 0xfd, 0x2e, 0xe3, 0x19, 0x6e, 0xd3, 0xce, 0xa3, 0xc6, 0xa2, 0x18, 0xfb,
-		0xf0, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x78, 0xdf, 0x83, 0x7f, 0x09, 0x23, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x49, 0x6f, 0xe3, 0xc8,
+		0x15, 0x06, 0x65, 0xcb, 0xcb, 0xf3, 0x26, 0x97, 0x91, 0x0e, 0x4d, 0xf7, 0xa2, 0x56, 0x2f, 0xf6,
+		0x24, 0x13, 0xa9, 0xdb, 0x33, 0xf1, 0xb8, 0xb7, 0x20, 0x5e, 0xda, 0x68, 0x07, 0x93, 0x89, 0x87,
+		0xad, 0xe9, 0x01, 0x26, 0x40, 0x13, 0x25, 0xb2, 0x6c, 0x15, 0x4c, 0xb1, 0xd4, 0x64, 0x51, 0x6e,
+		0x5d, 0x72, 0xc8, 0x71, 0x26, 0x87, 0x00, 0x41, 0x26, 0x97, 0x00, 0x7d, 0xce, 0x25, 0xb7, 0xfc,
+		0x9a, 0x9c, 0x72, 0xce, 0x9f, 0x08, 0x58, 0x55, 0x94, 0x28, 0x89, 0xa2, 0x96, 0x1c, 0x3c, 0xc0,
+		0xdc, 0xcc, 0xe2, 0xf7, 0xbe, 0x7a, 0xf5, 0xde, 0xab, 0x57, 0x1f, 0xcb, 0x82, 0x9d, 0xb0, 0x46,
+		0xfc, 0x8a, 0x8d, 0x1d, 0xe2, 0xd9, 0xa4, 0x82, 0x9b, 0xb4, 0xd2, 0x7a, 0x5c, 0x09, 0x88, 0xdf,
+		0xa2, 0x36, 0xb1, 0xae, 0x98, 0x7f, 0x49, 0xfc, 0x72, 0xd3, 0x67, 0x9c, 0xa1, 0x8d, 0x08, 0x59,
+		0x56, 0xc8, 0x32, 0x6e, 0xd2, 0x72, 0xeb, 0xb1, 0x71, 0xfb, 0x82, 0xb1, 0x0b, 0x97, 0x54, 0x04,
+		0xa4, 0x16, 0x9e, 0x57, 0x9c, 0xd0, 0xc7, 0x9c, 0x32, 0x4f, 0x1a, 0x19, 0x77, 0xfa, 0xdf, 0x73,
+		0xda, 0x20, 0x01, 0xc7, 0x8d, 0xa6, 0x02, 0x0c, 0x10, 0x5c, 0xf9, 0xb8, 0xd9, 0x24, 0x7e, 0xa0,
+		0xde, 0x17, 0xd3, 0xfc, 0xb3, 0x59, 0xa3, 0xd1, 0x99, 0xa2, 0x94, 0x86, 0x70, 0x88, 0x4d, 0x83,
+		0xae, 0x1b, 0x77, 0xd3, 0x30, 0x75, 0x1a, 0x70, 0xe6, 0xb7, 0x63, 0x4f, 0xd3, 0x20, 0xef, 0x42,
+		0xe2, 0xb7, 0xb3, 0xe6, 0xe1, 0x38, 0xb8, 0x74, 0x69, 0xc0, 0xb3, 0x30, 0x51, 0x14, 0xcf, 0x5d,
+		0x76, 0x25, 0x31, 0xa5, 0x7f, 0x69, 0x60, 0x9c, 0x31, 0xd7, 0x3d, 0x61, 0xfe, 0xb1, 0xf2, 0xb2,
+		0x8a, 0x83, 0x4b, 0x93, 0xbc, 0x0b, 0x49, 0xc0, 0xd1, 0x0d, 0x98, 0x73, 0x58, 0x03, 0x53, 0x4f,
+		0xd7, 0x8a, 0xda, 0xce, 0xa2, 0xa9, 0x9e, 0xd0, 0x53, 0x58, 0x8c, 0x26, 0xb3, 0xa2, 0xd9, 0xf4,
+		0x5c, 0x51, 0xdb, 0x59, 0xda, 0xbd, 0x55, 0x4e, 0x49, 0x49, 0x39, 0x22, 0xfb, 0x9c, 0x06, 0xdc,
+		0x5c, 0xe0, 0xea, 0x2f, 0x64, 0xc0, 0x02, 0x75, 0x88, 0xc7, 0x29, 0x6f, 0xeb, 0x33, 0x82, 0xb5,
+		0xf3, 0x8c, 0xb6, 0x61, 0xad, 0x46, 0x3d, 0xec, 0xb7, 0x2d, 0xbb, 0x4e, 0xec, 0xcb, 0x20, 0x6c,
+		0xe8, 0xb3, 0x02, 0xb2, 0x2a, 0x87, 0x8f, 0xd4, 0x68, 0xe9, 0x9f, 0x0b, 0xb0, 0x95, 0xea, 0x77,
+		0xd0, 0x64, 0x5e, 0x40, 0xd0, 0x2d, 0x00, 0xe1, 0x20, 0x67, 0x97, 0x44, 0x3a, 0xbf, 0x6c, 0x0a,
+		0x97, 0xab, 0xd1, 0x00, 0xfa, 0x0a, 0x50, 0x1c, 0x08, 0x8b, 0xbc, 0x27, 0x76, 0x18, 0x55, 0x89,
+		0x5a, 0xc8, 0xc3, 0xd4, 0x85, 0x7c, 0xad, 0xe0, 0x2f, 0x63, 0xb4, 0xb9, 0x7e, 0xd5, 0x3f, 0x84,
+		0x4e, 0x60, 0xa5, 0x43, 0xcb, 0xdb, 0x4d, 0x22, 0xd6, 0xb7, 0xb4, 0x7b, 0x37, 0x93, 0xb1, 0xda,
+		0x6e, 0x12, 0x73, 0xf9, 0x2a, 0xf1, 0x84, 0xde, 0xc0, 0x66, 0xd3, 0x27, 0x2d, 0xca, 0xc2, 0xc0,
+		0x0a, 0x38, 0xf6, 0x39, 0x71, 0x2c, 0xd2, 0x22, 0x1e, 0xb7, 0xa8, 0x23, 0x02, 0xb2, 0xb4, 0xbb,
+		0x55, 0x96, 0xb5, 0x5a, 0x8e, 0x6b, 0xb5, 0x7c, 0xea, 0xf1, 0xbd, 0x4f, 0xdf, 0x60, 0x37, 0x24,
+		0xe6, 0x8d, 0xd8, 0xfa, 0xb5, 0x34, 0x7e, 0x19, 0xd9, 0x9e, 0x3a, 0x68, 0x07, 0x0a, 0x03, 0x74,
+		0xf9, 0xa2, 0xb6, 0x33, 0x63, 0xae, 0x06, 0xbd, 0x48, 0x1d, 0xe6, 0x31, 0xe7, 0xa4, 0xd1, 0xe4,
+		0xfa, 0x9c, 0x00, 0xc4, 0x8f, 0xe8, 0x63, 0x40, 0x35, 0x6c, 0x5f, 0xba, 0xec, 0xc2, 0xb2, 0x59,
+		0xe8, 0x71, 0xab, 0x4e, 0x3d, 0xae, 0xcf, 0x0b, 0x50, 0x41, 0xbd, 0x39, 0x8a, 0x5e, 0xbc, 0xa2,
+		0x1e, 0x47, 0x7b, 0x30, 0xaf, 0x2a, 0x5b, 0x5f, 0x10, 0x7e, 0xdf, 0x4c, 0x8d, 0xc5, 0x2b, 0x89,
+		0x31, 0x63, 0x30, 0x7a, 0x08, 0x6b, 0x1e, 0x79, 0xcf, 0xad, 0x26, 0xbe, 0x20, 0x2a, 0x89, 0x8b,
+		0x22, 0x89, 0x2b, 0xd1, 0xf0, 0x19, 0xbe, 0x20, 0x32, 0x91, 0xfb, 0x90, 0x17, 0xdb, 0x42, 0x07,
+		0xc1, 0x5e, 0xca, 0x8c, 0xf4, 0x97, 0x11, 0xd2, 0x94, 0x06, 0xe8, 0x2d, 0xdc, 0x1c, 0x2c, 0x01,
+		0xab, 0x5b, 0xd5, 0x4b, 0xe3, 0x54, 0xf5, 0xe6, 0x40, 0x0d, 0xc4, 0xaf, 0xd0, 0x01, 0xac, 0x06,
+		0x76, 0x9d, 0x38, 0xa1, 0x4b, 0x1c, 0x2b, 0x6a, 0x34, 0xfa, 0xb2, 0x60, 0x34, 0x06, 0x12, 0x57,
+		0x8d, 0xbb, 0x90, 0xb9, 0xd2, 0xb1, 0x88, 0xc6, 0xd0, 0x0b, 0x58, 0x8e, 0xd3, 0x25, 0x08, 0x56,
+		0x46, 0x12, 0x2c, 0x29, 0xbc, 0x30, 0xff, 0x1a, 0xe6, 0xa3, 0xa5, 0x52, 0x12, 0xe8, 0xab, 0xc5,
+		0x99, 0x9d, 0xa5, 0xdd, 0x17, 0xa9, 0x8b, 0xc9, 0xd8, 0x46, 0xe5, 0x2f, 0xa5, 0xfd, 0x4b, 0x8f,
+		0x47, 0xc9, 0x51, 0x6c, 0xa8, 0x04, 0x22, 0x0b, 0xdd, 0x1a, 0x5a, 0x13, 0xd9, 0x5f, 0x8a, 0x06,
+		0xe3, 0x02, 0x2a, 0xc3, 0x06, 0x67, 0x1c, 0xbb, 0x96, 0xca, 0xa8, 0x55, 0x6b, 0x73, 0x12, 0xe8,
+		0x05, 0x81, 0x5c, 0x17, 0xaf, 0x54, 0xd2, 0x0f, 0xa3, 0x17, 0xc6, 0x5b, 0x58, 0x4e, 0x4e, 0x86,
+		0x0a, 0x30, 0x73, 0x49, 0xda, 0xaa, 0xed, 0x44, 0x7f, 0x46, 0xa9, 0x6e, 0x45, 0xd5, 0xad, 0xe7,
+		0xc6, 0x4f, 0xb5, 0x30, 0x78, 0x9a, 0xdb, 0xd7, 0x4a, 0xff, 0xc8, 0xc3, 0x3d, 0xb9, 0x2c, 0x27,
+		0xb9, 0xd2, 0x23, 0xd6, 0x68, 0xba, 0x84, 0x13, 0x27, 0xee, 0x78, 0x23, 0x1a, 0xc7, 0x33, 0x58,
+		0x8c, 0xbb, 0x79, 0xa0, 0xe7, 0x8a, 0x33, 0x43, 0x4b, 0x24, 0x9e, 0xc4, 0xec, 0xe2, 0xd1, 0xcf,
+		0x61, 0xbd, 0x5b, 0x69, 0x36, 0xf3, 0x38, 0x79, 0xcf, 0x45, 0x8b, 0x58, 0x36, 0x0b, 0x9d, 0x17,
+		0x47, 0x72, 0xbc, 0xa7, 0x4d, 0xce, 0xf6, 0xb5, 0xc9, 0xdf, 0xc3, 0x7a, 0xc0, 0xa9, 0x7d, 0xd9,
+		0xb6, 0x30, 0xe7, 0x3e, 0xad, 0x85, 0x51, 0x68, 0xf3, 0x22, 0x2c, 0xe5, 0x54, 0x6f, 0x5e, 0x0b,
+		0x74, 0xa7, 0x48, 0x0f, 0x3a, 0x56, 0x66, 0x41, 0x12, 0x75, 0x47, 0xd0, 0x67, 0xa0, 0xfb, 0x84,
+		0x87, 0xbe, 0x67, 0x79, 0xe4, 0xca, 0x8a, 0xbd, 0x17, 0x3b, 0x43, 0xf4, 0x82, 0x05, 0xf3, 0x27,
+		0xf2, 0xfd, 0x17, 0xe4, 0x2a, 0x19, 0x4a, 0x74, 0x08, 0xb7, 0xcf, 0x99, 0x6f, 0x13, 0xcb, 0xf6,
+		0x09, 0xe6, 0x24, 0xc5, 0x7c, 0x5e, 0x98, 0x1b, 0x02, 0x75, 0x24, 0x40, 0xfd, 0x1c, 0x29, 0x07,
+		0xc0, 0x42, 0xda, 0x01, 0x80, 0x18, 0xac, 0x88, 0x7d, 0x6c, 0xf9, 0x24, 0x08, 0x5d, 0x1e, 0xe8,
+		0x8b, 0x22, 0x19, 0xbf, 0x49, 0x5d, 0xfe, 0x18, 0x89, 0x2f, 0xcb, 0x8a, 0x91, 0x64, 0xb2, 0xde,
+		0x97, 0xdf, 0x25, 0x86, 0x0c, 0x0a, 0xeb, 0x03, 0x90, 0x94, 0x2a, 0xfd, 0x55, 0x6f, 0x95, 0xee,
+		0x8c, 0x51, 0xa5, 0x82, 0x30, 0x59, 0xab, 0x1f, 0x66, 0xe0, 0x7e, 0xb6, 0xcb, 0xea, 0x94, 0xfb,
+		0x0a, 0x56, 0x7a, 0x03, 0xac, 0x89, 0x49, 0x1f, 0x4d, 0xba, 0xcf, 0xcd, 0x65, 0x27, 0x99, 0x84,
+		0x0f, 0x1a, 0xdc, 0xc6, 0x36, 0xa7, 0x2d, 0xca, 0x29, 0x09, 0x2c, 0xce, 0x2c, 0x87, 0x06, 0x4d,
+		0xcc, 0xed, 0xba, 0xe5, 0x32, 0x1b, 0xbb, 0x6e, 0x5b, 0x95, 0xfe, 0x37, 0x53, 0x44, 0x5b, 0x75,
+		0x96, 0x83, 0x0e, 0x7f, 0x95, 0x1d, 0x2b, 0xf6, 0xcf, 0x25, 0xb9, 0x8c, 0xfe, 0x16, 0x1e, 0x8e,
+		0x30, 0xfe, 0x00, 0xc5, 0x51, 0x04, 0x29, 0xb9, 0x39, 0xee, 0xcd, 0x4d, 0xfa, 0x56, 0x51, 0xbc,
+		0x6d, 0xc1, 0x15, 0x13, 0x9f, 0x7a, 0xe7, 0x2c, 0x99, 0xa1, 0x3f, 0xe6, 0xa0, 0x98, 0xb2, 0xcc,
+		0x13, 0x4c, 0xdd, 0xb1, 0x5b, 0xc9, 0x21, 0xe4, 0x6d, 0x1c, 0x06, 0xd2, 0x9b, 0xd5, 0xdd, 0x8f,
+		0x33, 0xdb, 0x48, 0x97, 0xfd, 0x28, 0xb2, 0x31, 0xa5, 0x69, 0x74, 0xbc, 0x3a, 0x84, 0x63, 0xea,
+		0x06, 0xfa, 0x4c, 0xc6, 0xf1, 0x7a, 0x86, 0xdb, 0x2e, 0xc3, 0x8e, 0x19, 0x83, 0x33, 0x9b, 0x4b,
+		0xca, 0x16, 0xcc, 0xa7, 0x6a, 0xb0, 0x7b, 0x70, 0x37, 0x23, 0x06, 0x32, 0xcf, 0xa5, 0xff, 0x74,
+		0x05, 0x66, 0x1c, 0xd9, 0xeb, 0x14, 0x98, 0xaf, 0x01, 0x75, 0x78, 0xad, 0x06, 0xe1, 0xd8, 0xc1,
+		0x1c, 0x2b, 0x49, 0xf5, 0x20, 0x73, 0x82, 0xdf, 0x2a, 0xb0, 0x59, 0xe0, 0x7d, 0x23, 0xa5, 0xef,
+		0xba, 0x62, 0xb4, 0x77, 0x8d, 0xd7, 0x2a, 0x46, 0xef, 0xc0, 0x92, 0xda, 0x42, 0xed, 0xe8, 0x8c,
+		0x96, 0x91, 0x80, 0x78, 0xe8, 0xd4, 0x89, 0xd4, 0x6a, 0x07, 0x20, 0xd4, 0xea, 0x6c, 0x86, 0x5a,
+		0xed, 0x2c, 0x4c, 0xa8, 0x55, 0x9c, 0x78, 0x42, 0xbb, 0x90, 0xa7, 0x5e, 0x33, 0xe4, 0x7a, 0x7e,
+		0x8c, 0x12, 0x94, 0xd0, 0x14, 0x75, 0x34, 0xf7, 0xff, 0xaa, 0xa3, 0xf9, 0xc9, 0xd4, 0x51, 0x15,
+		0x36, 0x63, 0xbe, 0xa8, 0xc3, 0xd9, 0x2e, 0x0b, 0x88, 0x20, 0x62, 0x21, 0x57, 0x5a, 0x75, 0x73,
+		0x80, 0xeb, 0x58, 0x7d, 0x50, 0x9a, 0x37, 0x62, 0xdb, 0x2a, 0x3b, 0x8a, 0x2c, 0xab, 0xd2, 0x10,
+		0x7d, 0x01, 0x37, 0xc4, 0x24, 0x83, 0x94, 0x8b, 0xa3, 0x28, 0x37, 0x84, 0x61, 0x1f, 0xdf, 0x09,
+		0xac, 0xd7, 0x09, 0xf6, 0x79, 0x8d, 0x60, 0xde, 0xa1, 0x82, 0x51, 0x54, 0x85, 0x8e, 0x4d, 0xcc,
+		0x93, 0xd0, 0xf3, 0x91, 0xb0, 0xcd, 0x77, 0xf5, 0xfc, 0x5b, 0xb8, 0xdd, 0x9b, 0x09, 0x8b, 0x9d,
+		0x5b, 0xbc, 0x4e, 0x03, 0x2b, 0x36, 0x18, 0xad, 0x5b, 0x8d, 0x9e, 0xcc, 0xfc, 0xee, 0xbc, 0x5a,
+		0xa7, 0xc1, 0x81, 0xe2, 0x3f, 0x4d, 0xae, 0x20, 0x6e, 0x56, 0x2b, 0x63, 0x54, 0x4a, 0x77, 0x11,
+		0xc7, 0xaa, 0x6b, 0x0d, 0x7c, 0x5e, 0xad, 0x4e, 0xf7, 0x79, 0xb5, 0x0d, 0x6b, 0x1d, 0x1e, 0xd5,
+		0x7d, 0xd6, 0x64, 0x87, 0x8b, 0x87, 0x8f, 0xc5, 0x28, 0xfa, 0x04, 0xe6, 0xea, 0x04, 0x3b, 0xc4,
+		0xd7, 0x0b, 0xea, 0xa3, 0x2b, 0xf5, 0xe3, 0x45, 0x40, 0x4c, 0x05, 0x2d, 0x7d, 0xaf, 0x75, 0x94,
+		0x66, 0xb2, 0x1b, 0x4c, 0xaa, 0x34, 0x3f, 0x85, 0x39, 0x29, 0x6d, 0xf4, 0xdc, 0x18, 0xc1, 0x52,
+		0xd8, 0xac, 0xde, 0x57, 0x7a, 0x08, 0xf7, 0xb3, 0xfd, 0x52, 0x2d, 0xfb, 0xbb, 0x1c, 0x6c, 0x67,
+		0x01, 0x0f, 0xdb, 0xa7, 0xc7, 0xa3, 0xfa, 0xf7, 0x75, 0xf5, 0xb4, 0x6e, 0xd4, 0x66, 0xa7, 0x8c,
+		0x5a, 0xbe, 0x2f, 0x6a, 0x3f, 0x83, 0x9d, 0xd1, 0xc1, 0x50, 0x91, 0xfb, 0xab, 0x06, 0xc5, 0x14,
+		0xf0, 0x44, 0xb2, 0x60, 0x0f, 0xe6, 0xcf, 0x31, 0x75, 0x43, 0x9f, 0x64, 0x26, 0xfe, 0x44, 0x62,
+		0xcc, 0x18, 0x9c, 0x99, 0xf9, 0xee, 0x49, 0x9d, 0xe6, 0x96, 0x72, 0xfe, 0xdb, 0x1c, 0xdc, 0x1f,
+		0x8a, 0xfa, 0x21, 0xe7, 0x3c, 0x11, 0xb1, 0xd9, 0x69, 0x23, 0xd6, 0x9f, 0xf5, 0x6d, 0x78, 0x30,
+		0x22, 0x16, 0x2a, 0x6a, 0x7f, 0xd3, 0xa0, 0x94, 0x56, 0x1f, 0xd8, 0xb3, 0xc9, 0x44, 0x49, 0x8f,
+		0x5b, 0x63, 0x6e, 0x5a, 0x1d, 0xd7, 0x9f, 0xf4, 0x07, 0x70, 0x2f, 0xd3, 0x31, 0xb5, 0x80, 0x3f,
+		0xe5, 0xe0, 0x61, 0x06, 0xee, 0x07, 0x9e, 0xf8, 0x38, 0x6a, 0xb3, 0xd3, 0x46, 0xad, 0x3f, 0xf1,
+		0x1f, 0xc1, 0xf6, 0xc8, 0x68, 0xf4, 0xa4, 0xde, 0x66, 0x7e, 0x0f, 0xf4, 0x55, 0x7c, 0x6a, 0x5d,
+		0x63, 0xea, 0xcf, 0xe0, 0x5e, 0xa6, 0x63, 0x4a, 0x97, 0x7e, 0x04, 0x05, 0x5b, 0x2c, 0xcc, 0xf2,
+		0xa5, 0xaf, 0xc4, 0x11, 0xfe, 0x2d, 0x98, 0x6b, 0x72, 0xdc, 0x8c, 0x87, 0x55, 0x95, 0x0c, 0xa5,
+		0xfc, 0xb1, 0x55, 0x49, 0x15, 0xb6, 0x47, 0x46, 0x63, 0xf2, 0x20, 0xff, 0xbb, 0x7b, 0x7c, 0x88,
+		0x9b, 0x81, 0x69, 0x64, 0xc3, 0xaf, 0xfb, 0x64, 0xc3, 0xf8, 0x17, 0x10, 0xf1, 0x61, 0xf8, 0x06,
+		0x36, 0xe4, 0xbf, 0x5a, 0xac, 0x16, 0xf1, 0xc5, 0xd5, 0x02, 0xf5, 0xce, 0x99, 0x3e, 0x33, 0x22,
+		0x51, 0xc4, 0x7f, 0x23, 0xe1, 0xe2, 0x5b, 0x79, 0xfd, 0xaa, 0x7f, 0x28, 0x71, 0x08, 0xa5, 0x2d,
+		0x2e, 0xd6, 0x1e, 0x1a, 0x18, 0x26, 0x09, 0x08, 0x97, 0x37, 0x56, 0x9d, 0xaf, 0xbb, 0x6b, 0xa9,
+		0xad, 0xd2, 0x2d, 0xd8, 0x4a, 0x75, 0x46, 0x3a, 0xbb, 0xfb, 0xdf, 0x35, 0x58, 0x94, 0x4b, 0x3f,
+		0x38, 0x3b, 0x45, 0xef, 0x61, 0x23, 0xe5, 0x8a, 0x05, 0x55, 0xc6, 0xbf, 0x8c, 0x11, 0x6b, 0x34,
+		0x26, 0xbe, 0xbd, 0x41, 0x7f, 0xd1, 0xe0, 0x66, 0xd6, 0xa5, 0x0b, 0xda, 0x9f, 0xf6, 0x56, 0xcc,
+		0x78, 0x32, 0xf5, 0x0d, 0x0f, 0xfa, 0x56, 0x83, 0xcd, 0xa1, 0xf7, 0x03, 0xe8, 0x97, 0xe3, 0x12,
+		0xf7, 0x88, 0x27, 0x63, 0x6f, 0x52, 0x33, 0xe5, 0x4c, 0x37, 0x39, 0xc9, 0x1d, 0x9b, 0x9d, 0x9c,
+		0x94, 0xfb, 0x0a, 0xe3, 0xd1, 0xf8, 0x06, 0x83, 0xc9, 0x49, 0x15, 0x90, 0xd9, 0xc9, 0xc9, 0xfa,
+		0x82, 0x30, 0x9e, 0x4c, 0x61, 0xa9, 0xbc, 0xfa, 0x90, 0xae, 0x54, 0x7b, 0x64, 0x2d, 0x7a, 0x3e,
+		0x31, 0x7f, 0xe2, 0x1c, 0x30, 0x5e, 0x4c, 0x69, 0x3d, 0x58, 0x3e, 0x83, 0x12, 0x2c, 0xbb, 0x7c,
+		0x86, 0x6a, 0x6f, 0x63, 0x6f, 0x52, 0x33, 0xe5, 0xcc, 0xf7, 0x1a, 0xdc, 0xca, 0xd4, 0x83, 0xe8,
+		0xc9, 0x64, 0xcc, 0xc9, 0x40, 0x3d, 0x9d, 0xc6, 0x54, 0x39, 0xf6, 0x67, 0x0d, 0xb6, 0x52, 0x90,
+		0xb1, 0x5e, 0x41, 0x9f, 0x8d, 0x9d, 0x84, 0x5e, 0xc1, 0x6a, 0xec, 0x4f, 0x6e, 0xa8, 0x5c, 0xfa,
+		0xbb, 0x06, 0x77, 0x46, 0x48, 0x28, 0xf4, 0x6c, 0x52, 0xf6, 0x64, 0xbc, 0x9e, 0x4f, 0x67, 0xdc,
+		0x13, 0xb1, 0xa1, 0x67, 0xf7, 0xd0, 0x88, 0x8d, 0xd2, 0x79, 0xc6, 0xfe, 0xe4, 0x86, 0x3d, 0x11,
+		0xcb, 0x94, 0x13, 0x43, 0x23, 0x36, 0x8e, 0x24, 0x33, 0x9e, 0x4f, 0x67, 0x3c, 0xb8, 0x13, 0x07,
+		0x4f, 0xee, 0xec, 0x9d, 0x38, 0x54, 0xc6, 0x18, 0x7b, 0x93, 0x9a, 0x75, 0x1b, 0x79, 0xca, 0x91,
+		0x3c, 0xa4, 0x91, 0x0f, 0x57, 0x12, 0xc6, 0xa3, 0xf1, 0x0d, 0xe4, 0xcc, 0x87, 0x35, 0xf8, 0xa9,
+		0xcd, 0x1a, 0x69, 0x66, 0x87, 0x48, 0xaa, 0x80, 0xd7, 0xf2, 0x97, 0x2a, 0x67, 0x3e, 0xe3, 0xec,
+		0x4c, 0xfb, 0xe6, 0xf1, 0x05, 0xe5, 0xf5, 0xb0, 0x56, 0xb6, 0x59, 0xa3, 0x92, 0xfc, 0x29, 0xc6,
+		0x2f, 0xa8, 0xe3, 0x56, 0x2e, 0x98, 0xfc, 0x95, 0x89, 0xfa, 0x5d, 0xc6, 0x33, 0xdc, 0xa4, 0xad,
+		0xc7, 0xb5, 0x39, 0x31, 0xf6, 0xc9, 0xff, 0x06, 0x00, 0x78, 0xdf, 0x83, 0x7f, 0x09, 0x23, 0x00,
+		0x00,
 	},
 	// uber/cadence/api/v1/decision.proto
 	[]byte{
 		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6e, 0x14, 0xc7,
 		0x16, 0xbe, 0xed, 0x9f, 0xf9, 0x39, 0x1e, 0x03, 0x2e, 0x83, 0xb1, 0xc1, 0x60, 0x33, 0x57, 0xd7,
 		0x5c, 0xb0, 0x3c, 0x63, 0x1b, 0x2e, 0x42, 0x80, 0xd0, 0xc5, 0x06, 0x0b, 0x4b, 0x60, 0xac, 0xc6,
-		0x04, 0x29, 0x52, 0xd4, 0x2a, 0x57, 0x97, 0xed, 0x8a, 0x7b, 0xba, 0x27, 0xd5, 0xd5, 0x1e, 0x26,
+		0x04, 0x29, 0x52, 0xd4, 0x2a, 0x57, 0x97, 0xed, 0x8a, 0x7b, 0xba, 0x26, 0xd5, 0xd5, 0x1e, 0x26,
 		0x52, 0xa4, 0xac, 0x92, 0x6c, 0xf2, 0x00, 0x91, 0xb2, 0xca, 0x2a, 0xd9, 0x90, 0x6d, 0xa2, 0xac,
-		0xf2, 0x08, 0x59, 0xe4, 0x49, 0xf2, 0x02, 0x51, 0x55, 0x57, 0xf7, 0x8c, 0xc7, 0x3d, 0x3d, 0xdd,
-		0x86, 0xb0, 0x73, 0xd7, 0x9c, 0xf3, 0xd5, 0x57, 0x75, 0x4e, 0x9f, 0xef, 0x1b, 0x0f, 0x54, 0x83,
-		0x5d, 0xca, 0xeb, 0x04, 0xdb, 0xd4, 0x25, 0xb4, 0x8e, 0x9b, 0xac, 0x7e, 0xb4, 0x52, 0xb7, 0x29,
-		0x61, 0x3e, 0xf3, 0xdc, 0x5a, 0x93, 0x7b, 0xc2, 0x43, 0x93, 0x32, 0xa6, 0xa6, 0x63, 0x6a, 0xb8,
-		0xc9, 0x6a, 0x47, 0x2b, 0x97, 0xae, 0xee, 0x7b, 0xde, 0xbe, 0x43, 0xeb, 0x2a, 0x64, 0x37, 0xd8,
-		0xab, 0xdb, 0x01, 0xc7, 0x22, 0x4e, 0xba, 0x34, 0x9f, 0x04, 0x4c, 0xbc, 0x46, 0x23, 0x8e, 0x48,
-		0xdc, 0x5a, 0x60, 0xff, 0xd0, 0x61, 0xbe, 0x48, 0x8b, 0x69, 0x79, 0xfc, 0x70, 0xcf, 0xf1, 0x5a,
-		0x61, 0x4c, 0xf5, 0x9b, 0x09, 0x28, 0x3d, 0xd6, 0x8c, 0xd1, 0x77, 0x06, 0xdc, 0xf4, 0xc9, 0x01,
-		0xb5, 0x03, 0x87, 0x5a, 0x98, 0x08, 0x76, 0xc4, 0x44, 0xdb, 0x92, 0xa8, 0x56, 0x74, 0x2a, 0x0b,
-		0x0b, 0xc1, 0xd9, 0x6e, 0x20, 0xa8, 0x3f, 0x6d, 0xcc, 0x1b, 0xff, 0x1d, 0x5b, 0xbd, 0x5f, 0x4b,
-		0x38, 0x61, 0xed, 0xa5, 0x86, 0x79, 0xa4, 0x51, 0x76, 0xb0, 0x7f, 0x18, 0xed, 0xf3, 0x28, 0x86,
-		0x78, 0xfa, 0x2f, 0x73, 0xc1, 0xcf, 0x14, 0x89, 0x3e, 0x87, 0x39, 0x5f, 0x60, 0x2e, 0x2c, 0xc1,
-		0x1a, 0x94, 0x27, 0xf2, 0x19, 0x52, 0x7c, 0x56, 0x92, 0xf9, 0xc8, 0xdc, 0x1d, 0x99, 0x9a, 0xc8,
-		0x62, 0xd6, 0x4f, 0xf9, 0x1c, 0xfd, 0x68, 0x80, 0xbc, 0xfd, 0xa6, 0x43, 0x05, 0xb5, 0xa2, 0x0b,
-		0xb4, 0xe8, 0x1b, 0x4a, 0x02, 0x59, 0xb4, 0x44, 0x32, 0xc3, 0x8a, 0xcc, 0xff, 0x13, 0xc9, 0xac,
-		0x6b, 0xac, 0xd7, 0x1a, 0xea, 0x49, 0x84, 0x94, 0xc8, 0x6d, 0x91, 0x64, 0x0f, 0x47, 0xdf, 0x1b,
-		0xb0, 0xb8, 0x87, 0x99, 0x93, 0x95, 0xe6, 0x88, 0xa2, 0xf9, 0x20, 0x91, 0xe6, 0x06, 0x66, 0x4e,
-		0x36, 0x8a, 0xd7, 0xf7, 0xb2, 0x85, 0xa2, 0x9f, 0x0c, 0x58, 0xe6, 0xf4, 0xb3, 0x80, 0xfa, 0xc2,
-		0x22, 0xd8, 0x25, 0xd4, 0xc9, 0xd0, 0x67, 0xa3, 0x29, 0x57, 0x69, 0x86, 0x60, 0xeb, 0x0a, 0x6b,
-		0x60, 0xb3, 0x2d, 0xf2, 0xec, 0xe1, 0xe8, 0x0b, 0x98, 0xd7, 0x14, 0xfb, 0xb7, 0x5c, 0x41, 0x51,
-		0x5b, 0x4d, 0xae, 0xb2, 0x4a, 0xee, 0xdf, 0x73, 0x57, 0x48, 0x5a, 0x00, 0xfa, 0xc1, 0x80, 0x25,
-		0xbd, 0x7f, 0xc6, 0x5a, 0x16, 0x15, 0x99, 0x87, 0x29, 0x64, 0xb2, 0x55, 0xf3, 0x06, 0xc9, 0x1a,
-		0x8c, 0xfe, 0x30, 0xe0, 0x61, 0x4f, 0x3d, 0xe9, 0x1b, 0x41, 0xb9, 0x8b, 0x33, 0xb3, 0x2e, 0x29,
-		0xd6, 0xcf, 0x07, 0x57, 0xf7, 0x89, 0x06, 0xce, 0x76, 0x88, 0xbb, 0xfc, 0x94, 0xb9, 0xe8, 0x4b,
-		0x03, 0xae, 0x71, 0x4a, 0x3c, 0x6e, 0x5b, 0x0d, 0xcc, 0x0f, 0xfb, 0x54, 0xbe, 0xac, 0x68, 0xdf,
-		0xea, 0x43, 0x5b, 0x66, 0x3f, 0x57, 0xc9, 0x89, 0xe4, 0xae, 0xf2, 0xd4, 0x08, 0xf4, 0xab, 0x01,
-		0x77, 0x88, 0xe7, 0x0a, 0xe6, 0x06, 0xd4, 0xc2, 0xbe, 0xe5, 0xd2, 0x56, 0xd6, 0xeb, 0x04, 0xc5,
-		0xeb, 0x49, 0x9f, 0xb9, 0x13, 0x42, 0x3e, 0xf2, 0xb7, 0x68, 0x2b, 0xdb, 0x35, 0x2e, 0x93, 0x9c,
-		0x39, 0xe8, 0x67, 0x03, 0x56, 0xc3, 0x49, 0x4d, 0x0e, 0x98, 0x63, 0x67, 0xe5, 0x3d, 0xa6, 0x78,
-		0xaf, 0xf5, 0x1f, 0xde, 0xeb, 0x12, 0x2d, 0x1b, 0xe9, 0x25, 0x3f, 0x4f, 0x02, 0xfa, 0xcd, 0x80,
-		0x3b, 0x3e, 0xdb, 0x97, 0x3d, 0x9b, 0xb7, 0x79, 0x2b, 0x8a, 0xf5, 0x46, 0x32, 0x6b, 0x05, 0x99,
-		0xaf, 0x6b, 0x57, 0xfc, 0xbc, 0x49, 0xe8, 0x17, 0x03, 0xfe, 0x17, 0x34, 0x7d, 0xca, 0x45, 0x87,
-		0xb4, 0x4f, 0x31, 0x27, 0x07, 0x5d, 0x44, 0x13, 0xc9, 0x8f, 0xa7, 0xb4, 0xca, 0x2b, 0x85, 0x18,
-		0xed, 0xff, 0x52, 0xe1, 0x75, 0x36, 0x4d, 0x6e, 0x95, 0x20, 0x67, 0xce, 0x5a, 0x05, 0xa0, 0x43,
-		0xa7, 0xfa, 0x6d, 0x01, 0x16, 0xb2, 0xd9, 0x06, 0x34, 0x07, 0x63, 0xb1, 0x6c, 0x30, 0x5b, 0x19,
-		0x91, 0xb2, 0x09, 0xd1, 0xd2, 0xa6, 0x8d, 0x36, 0x60, 0xbc, 0xa3, 0x2b, 0xed, 0x26, 0xd5, 0xde,
-		0xe0, 0x5a, 0xe2, 0x59, 0xe3, 0xcd, 0xda, 0x4d, 0x6a, 0x56, 0x70, 0xd7, 0x13, 0x9a, 0x82, 0x82,
-		0xed, 0x35, 0x30, 0x73, 0x95, 0x9e, 0x97, 0x4d, 0xfd, 0x84, 0xee, 0x41, 0x59, 0xc9, 0x95, 0x74,
-		0x5b, 0x5a, 0x43, 0xaf, 0x24, 0x62, 0xcb, 0x03, 0x3c, 0x63, 0xbe, 0x30, 0x4b, 0x42, 0xff, 0x85,
-		0x56, 0x61, 0x94, 0xb9, 0xcd, 0x40, 0x68, 0x5d, 0x9b, 0x4d, 0xcc, 0xdb, 0xc6, 0x6d, 0xc7, 0xc3,
-		0xb6, 0x19, 0x86, 0xa2, 0x1d, 0x98, 0x89, 0x8d, 0x99, 0xf0, 0x2c, 0xe2, 0x78, 0x3e, 0x55, 0xb2,
-		0xe4, 0x05, 0x42, 0x8b, 0xd0, 0x4c, 0x2d, 0x34, 0x95, 0xb5, 0xc8, 0x54, 0xd6, 0x1e, 0x6b, 0x53,
-		0x69, 0x4e, 0x45, 0xb9, 0x3b, 0xde, 0xba, 0xcc, 0xdc, 0x09, 0x13, 0x7b, 0x51, 0x3b, 0xfe, 0x4a,
-		0xa2, 0x16, 0x73, 0xa0, 0xc6, 0xee, 0x4a, 0xa2, 0x6e, 0xc1, 0x94, 0x46, 0xea, 0x25, 0x5a, 0x1a,
-		0x04, 0x39, 0x19, 0xda, 0xb0, 0xe3, 0x2c, 0x37, 0x60, 0xe2, 0x80, 0x62, 0x2e, 0x76, 0x29, 0xee,
-		0xb0, 0x2b, 0x0f, 0x82, 0x3a, 0x17, 0xe7, 0x44, 0x38, 0xeb, 0x50, 0xe1, 0x54, 0xf0, 0xb6, 0xd5,
-		0xf4, 0x1c, 0x46, 0xda, 0x7a, 0xe2, 0xcc, 0xf7, 0x99, 0xe0, 0x82, 0xb7, 0xb7, 0x55, 0x9c, 0x39,
-		0xc6, 0x3b, 0x0f, 0xe8, 0x16, 0x14, 0x0e, 0x28, 0xb6, 0x29, 0xd7, 0xaf, 0xfe, 0xe5, 0xc4, 0xf4,
-		0xa7, 0x2a, 0xc4, 0xd4, 0xa1, 0xe8, 0x36, 0x4c, 0x45, 0x22, 0xe9, 0x78, 0x04, 0x3b, 0x96, 0xcd,
-		0xfc, 0x26, 0x16, 0xe4, 0x40, 0xbd, 0x82, 0x25, 0xf3, 0xbc, 0xfe, 0xf4, 0x99, 0xfc, 0xf0, 0xb1,
-		0xfe, 0xac, 0xfa, 0xb5, 0x01, 0xb3, 0x69, 0xb6, 0x15, 0xcd, 0x40, 0x29, 0x74, 0x26, 0xf1, 0x2b,
-		0x50, 0x54, 0xcf, 0x9b, 0x36, 0x7a, 0x06, 0x17, 0xe2, 0x1a, 0xec, 0x31, 0xde, 0x29, 0xc1, 0xd0,
-		0xa0, 0x7b, 0x43, 0xba, 0x04, 0x1b, 0x8c, 0x47, 0x15, 0xa8, 0x12, 0x58, 0xcc, 0x61, 0x59, 0xd1,
-		0x6d, 0x28, 0x70, 0xea, 0x07, 0x8e, 0xd0, 0xdf, 0x10, 0xd2, 0x3b, 0x5c, 0xc7, 0x56, 0x31, 0x5c,
-		0xcf, 0x68, 0x38, 0xd1, 0x1d, 0x28, 0x4a, 0xc3, 0x19, 0x70, 0x9a, 0xba, 0xc3, 0x46, 0x18, 0x63,
-		0x46, 0xc1, 0xd5, 0x2d, 0x58, 0xcc, 0xe1, 0x17, 0x07, 0x4e, 0x99, 0xea, 0x3d, 0xb8, 0x92, 0x6a,
-		0xf2, 0x52, 0x2a, 0x54, 0x25, 0x70, 0x23, 0xb3, 0x27, 0x93, 0x07, 0xb6, 0xa9, 0xc0, 0xcc, 0xf1,
-		0x33, 0x5d, 0x69, 0x14, 0x5c, 0xfd, 0xcb, 0x80, 0xbb, 0xa7, 0xf5, 0x50, 0x5d, 0xb3, 0xcf, 0x38,
-		0x36, 0xfb, 0x5e, 0x01, 0x3a, 0xa9, 0x8e, 0xba, 0xb1, 0x16, 0x12, 0x79, 0x9d, 0xd8, 0xcd, 0x9c,
-		0x68, 0xf5, 0x2e, 0xa1, 0x69, 0x28, 0x4a, 0xaf, 0xc1, 0x3d, 0x47, 0xcd, 0xda, 0x8a, 0x19, 0x3d,
-		0xa2, 0x1a, 0x4c, 0xf6, 0x58, 0x09, 0xcf, 0x75, 0xda, 0x6a, 0xec, 0x96, 0xcc, 0x09, 0xd2, 0x2d,
-		0xf3, 0x2f, 0x5c, 0xa7, 0x5d, 0x7d, 0x6b, 0xc0, 0xd5, 0x74, 0x0b, 0x26, 0x4b, 0xab, 0xbd, 0x9d,
-		0x8b, 0x1b, 0x34, 0x2a, 0x6d, 0xb8, 0xb4, 0x85, 0x1b, 0xb4, 0xfb, 0xc6, 0x87, 0x72, 0xdc, 0x78,
-		0xd7, 0x7c, 0x18, 0xce, 0x3c, 0x1f, 0xaa, 0x6f, 0x4b, 0xb0, 0x9c, 0xd7, 0x9b, 0x49, 0x89, 0x8b,
-		0xef, 0x43, 0x49, 0x9c, 0x91, 0x22, 0x71, 0x11, 0x60, 0x28, 0x71, 0xad, 0xae, 0xa7, 0xe3, 0x52,
-		0x36, 0x74, 0x4a, 0x29, 0x1b, 0xce, 0x2e, 0x65, 0x18, 0xe6, 0x3b, 0x9e, 0xaa, 0x8f, 0x50, 0x8c,
-		0x0c, 0x9a, 0x52, 0xb3, 0x31, 0xc4, 0xcb, 0x04, 0xc5, 0x78, 0x0d, 0x97, 0xd5, 0x91, 0xfa, 0xa0,
-		0x8f, 0x0e, 0x42, 0xbf, 0x28, 0xb3, 0x93, 0x80, 0x5f, 0xc0, 0xd4, 0x2e, 0x26, 0x87, 0xde, 0xde,
-		0x9e, 0xc6, 0x66, 0xae, 0xa0, 0xfc, 0x08, 0x3b, 0x83, 0x35, 0xf8, 0xbc, 0x4e, 0x54, 0xb0, 0x9b,
-		0x3a, 0xed, 0x84, 0x26, 0x15, 0x4f, 0xa3, 0x49, 0x9b, 0x50, 0x66, 0x2e, 0x13, 0x0c, 0x0b, 0x8f,
-		0x2b, 0x8d, 0x3d, 0xb3, 0xba, 0x38, 0xd8, 0xff, 0x6f, 0x46, 0x29, 0x66, 0x27, 0xbb, 0x7b, 0xb2,
-		0x96, 0x73, 0x4c, 0x56, 0x64, 0xc2, 0x94, 0x83, 0xe5, 0x77, 0xc0, 0x50, 0x26, 0x64, 0x69, 0xb5,
-		0x04, 0x40, 0x86, 0xce, 0x38, 0x2f, 0x73, 0xd7, 0xe3, 0x54, 0x53, 0x65, 0xa2, 0x7f, 0xc3, 0x38,
-		0xe1, 0xb2, 0x47, 0xb4, 0xcd, 0x50, 0x82, 0x5d, 0x36, 0x2b, 0x72, 0x31, 0xf2, 0x89, 0xa7, 0xd3,
-		0xe3, 0x25, 0x18, 0x69, 0xd0, 0x86, 0xa7, 0x0d, 0xf0, 0x4c, 0x62, 0xca, 0x73, 0xda, 0xf0, 0x4c,
-		0x15, 0x86, 0x4c, 0x98, 0x38, 0x61, 0xa8, 0xa7, 0xcf, 0xa8, 0xdc, 0xff, 0x24, 0x3b, 0xff, 0x1e,
-		0xeb, 0x6b, 0x9e, 0xf3, 0x7b, 0x56, 0xd0, 0x03, 0xa8, 0x7c, 0xca, 0x84, 0xa0, 0x3c, 0x6c, 0xa4,
-		0xe9, 0xb3, 0x83, 0xfa, 0x67, 0x2c, 0x0c, 0x57, 0xed, 0x53, 0xfd, 0xb3, 0x08, 0x4b, 0xb9, 0xbe,
-		0x14, 0xf5, 0x1d, 0xe6, 0x73, 0x30, 0x16, 0x4f, 0x11, 0x66, 0xab, 0xf7, 0xbf, 0x6c, 0x42, 0xb4,
-		0x14, 0x3a, 0xe9, 0xe3, 0x63, 0x66, 0xf8, 0x3d, 0x8c, 0x99, 0x0f, 0xe0, 0x98, 0xb3, 0x8c, 0x99,
-		0xc2, 0x3f, 0x3a, 0x66, 0x8a, 0xa7, 0x1e, 0x33, 0x1f, 0xc1, 0x64, 0x13, 0x73, 0xea, 0x0a, 0x8d,
-		0xa8, 0x87, 0x43, 0xf8, 0x6a, 0x2f, 0xf4, 0x39, 0xbd, 0x8c, 0x57, 0x28, 0x7a, 0x44, 0x4c, 0x34,
-		0x7b, 0x97, 0xba, 0x25, 0xb6, 0x7c, 0x5c, 0x62, 0x09, 0x4c, 0x77, 0xb5, 0x81, 0xc5, 0x69, 0xd0,
-		0xd9, 0x16, 0xd4, 0xb6, 0x37, 0x53, 0x0b, 0xbe, 0x69, 0x9b, 0x32, 0x45, 0x6f, 0x7d, 0xa1, 0x95,
-		0xb4, 0xfc, 0x7e, 0x0c, 0xf8, 0x89, 0xa9, 0x50, 0x49, 0x9d, 0x0a, 0xe3, 0xf9, 0xa7, 0xc2, 0x99,
-		0x77, 0x98, 0x0a, 0x67, 0xdf, 0x69, 0x2a, 0x54, 0x7f, 0x1f, 0x82, 0x95, 0xdc, 0xff, 0x36, 0xf8,
-		0xd0, 0x46, 0x6d, 0x0e, 0xc6, 0xf4, 0x7f, 0x4b, 0x94, 0x77, 0x0a, 0xbf, 0x18, 0x43, 0xb8, 0xa4,
-		0xbc, 0x53, 0xfc, 0xba, 0x8e, 0x64, 0x7f, 0x5d, 0xbb, 0x5a, 0x73, 0x34, 0x93, 0xfb, 0x2b, 0xf4,
-		0x73, 0x7f, 0x5f, 0x19, 0xb0, 0x9c, 0xf7, 0xbf, 0x17, 0xc9, 0xc5, 0x34, 0xde, 0xa9, 0x98, 0x6b,
-		0x9f, 0xc0, 0x45, 0xe2, 0x35, 0x92, 0xb2, 0xd7, 0xc6, 0x23, 0x0a, 0xdb, 0x72, 0x28, 0x6c, 0x1b,
-		0x1f, 0xaf, 0xec, 0x33, 0x71, 0x10, 0xec, 0xd6, 0x88, 0xd7, 0xa8, 0x77, 0xff, 0x6a, 0xb3, 0xc4,
-		0x6c, 0xa7, 0xbe, 0xef, 0x85, 0x3f, 0x14, 0xe9, 0x9f, 0x70, 0xee, 0xe3, 0x26, 0x3b, 0x5a, 0xd9,
-		0x2d, 0xa8, 0xb5, 0x5b, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xc1, 0xe0, 0x1d, 0x85, 0x1a,
-		0x00, 0x00,
+		0xf2, 0x08, 0x59, 0xe4, 0x49, 0xf2, 0x02, 0x51, 0x57, 0x57, 0xf7, 0x8c, 0xc7, 0x3d, 0x3d, 0xdd,
+		0x86, 0xb0, 0x73, 0xd7, 0x9c, 0xf3, 0xd5, 0x57, 0x75, 0x4e, 0x9f, 0xef, 0xf3, 0x0c, 0x54, 0xfd,
+		0x5d, 0x2a, 0xea, 0x04, 0xdb, 0xd4, 0x25, 0xb4, 0x8e, 0x9b, 0xac, 0x7e, 0xb4, 0x52, 0xb7, 0x29,
+		0x61, 0x1e, 0xe3, 0x6e, 0xad, 0x29, 0xb8, 0xe4, 0x68, 0x32, 0x88, 0xa9, 0xe9, 0x98, 0x1a, 0x6e,
+		0xb2, 0xda, 0xd1, 0xca, 0xa5, 0xab, 0xfb, 0x9c, 0xef, 0x3b, 0xb4, 0xae, 0x42, 0x76, 0xfd, 0xbd,
+		0xba, 0xed, 0x0b, 0x2c, 0xe3, 0xa4, 0x4b, 0xf3, 0x49, 0xc0, 0x84, 0x37, 0x1a, 0x71, 0x44, 0xe2,
+		0xd6, 0x12, 0x7b, 0x87, 0x0e, 0xf3, 0x64, 0x5a, 0x4c, 0x8b, 0x8b, 0xc3, 0x3d, 0x87, 0xb7, 0xc2,
+		0x98, 0xea, 0x37, 0x13, 0x50, 0x7a, 0xac, 0x19, 0xa3, 0xef, 0x0c, 0xb8, 0xe9, 0x91, 0x03, 0x6a,
+		0xfb, 0x0e, 0xb5, 0x30, 0x91, 0xec, 0x88, 0xc9, 0xb6, 0x15, 0xa0, 0x5a, 0xd1, 0xa9, 0x2c, 0x2c,
+		0xa5, 0x60, 0xbb, 0xbe, 0xa4, 0xde, 0xb4, 0x31, 0x6f, 0xfc, 0x77, 0x6c, 0xf5, 0x7e, 0x2d, 0xe1,
+		0x84, 0xb5, 0x97, 0x1a, 0xe6, 0x91, 0x46, 0xd9, 0xc1, 0xde, 0x61, 0xb4, 0xcf, 0xa3, 0x18, 0xe2,
+		0xe9, 0xbf, 0xcc, 0x05, 0x2f, 0x53, 0x24, 0xfa, 0x1c, 0xe6, 0x3c, 0x89, 0x85, 0xb4, 0x24, 0x6b,
+		0x50, 0x91, 0xc8, 0x67, 0x48, 0xf1, 0x59, 0x49, 0xe6, 0x13, 0xe4, 0xee, 0x04, 0xa9, 0x89, 0x2c,
+		0x66, 0xbd, 0x94, 0xcf, 0xd1, 0x8f, 0x06, 0x04, 0xb7, 0xdf, 0x74, 0xa8, 0xa4, 0x56, 0x74, 0x81,
+		0x16, 0x7d, 0x43, 0x89, 0x1f, 0x14, 0x2d, 0x91, 0xcc, 0xb0, 0x22, 0xf3, 0xff, 0x44, 0x32, 0xeb,
+		0x1a, 0xeb, 0xb5, 0x86, 0x7a, 0x12, 0x21, 0x25, 0x72, 0x5b, 0x24, 0xd9, 0xc3, 0xd1, 0xf7, 0x06,
+		0x2c, 0xee, 0x61, 0xe6, 0x64, 0xa5, 0x39, 0xa2, 0x68, 0x3e, 0x48, 0xa4, 0xb9, 0x81, 0x99, 0x93,
+		0x8d, 0xe2, 0xf5, 0xbd, 0x6c, 0xa1, 0xe8, 0x27, 0x03, 0x96, 0x05, 0xfd, 0xcc, 0xa7, 0x9e, 0xb4,
+		0x08, 0x76, 0x09, 0x75, 0x32, 0xf4, 0xd9, 0x68, 0xca, 0x55, 0x9a, 0x21, 0xd8, 0xba, 0xc2, 0x1a,
+		0xd8, 0x6c, 0x8b, 0x22, 0x7b, 0x38, 0xfa, 0x02, 0xe6, 0x35, 0xc5, 0xfe, 0x2d, 0x57, 0x50, 0xd4,
+		0x56, 0x93, 0xab, 0xac, 0x92, 0xfb, 0xf7, 0xdc, 0x15, 0x92, 0x16, 0x80, 0x7e, 0x30, 0x60, 0x49,
+		0xef, 0x9f, 0xb1, 0x96, 0x45, 0x45, 0xe6, 0x61, 0x0a, 0x99, 0x6c, 0xd5, 0xbc, 0x41, 0xb2, 0x06,
+		0xa3, 0x3f, 0x0c, 0x78, 0xd8, 0x53, 0x4f, 0xfa, 0x46, 0x52, 0xe1, 0xe2, 0xcc, 0xac, 0x4b, 0x8a,
+		0xf5, 0xf3, 0xc1, 0xd5, 0x7d, 0xa2, 0x81, 0xb3, 0x1d, 0xe2, 0xae, 0x38, 0x65, 0x2e, 0xfa, 0xd2,
+		0x80, 0x6b, 0x82, 0x12, 0x2e, 0x6c, 0xab, 0x81, 0xc5, 0x61, 0x9f, 0xca, 0x97, 0x15, 0xed, 0x5b,
+		0x7d, 0x68, 0x07, 0xd9, 0xcf, 0x55, 0x72, 0x22, 0xb9, 0xab, 0x22, 0x35, 0x02, 0xfd, 0x6a, 0xc0,
+		0x1d, 0xc2, 0x5d, 0xc9, 0x5c, 0x9f, 0x5a, 0xd8, 0xb3, 0x5c, 0xda, 0xca, 0x7a, 0x9d, 0xa0, 0x78,
+		0x3d, 0xe9, 0x33, 0x77, 0x42, 0xc8, 0x47, 0xde, 0x16, 0x6d, 0x65, 0xbb, 0xc6, 0x65, 0x92, 0x33,
+		0x07, 0xfd, 0x6c, 0xc0, 0x6a, 0x38, 0xa9, 0xc9, 0x01, 0x73, 0xec, 0xac, 0xbc, 0xc7, 0x14, 0xef,
+		0xb5, 0xfe, 0xc3, 0x7b, 0x3d, 0x40, 0xcb, 0x46, 0x7a, 0xc9, 0xcb, 0x93, 0x80, 0x7e, 0x33, 0xe0,
+		0x8e, 0xc7, 0xf6, 0x5d, 0x9c, 0xbf, 0x79, 0x2b, 0x8a, 0xf5, 0x46, 0x32, 0x6b, 0x05, 0x99, 0xaf,
+		0x6b, 0x57, 0xbc, 0xbc, 0x49, 0xe8, 0x17, 0x03, 0xfe, 0xe7, 0x37, 0x3d, 0x2a, 0x64, 0x87, 0xb4,
+		0x47, 0xb1, 0x20, 0x07, 0x5d, 0x44, 0x13, 0xc9, 0x8f, 0xa7, 0xb4, 0xca, 0x2b, 0x85, 0x18, 0xed,
+		0xff, 0x52, 0xe1, 0x75, 0x36, 0x4d, 0x6e, 0x15, 0x3f, 0x67, 0xce, 0x5a, 0x05, 0xa0, 0x43, 0xa7,
+		0xfa, 0x6d, 0x01, 0x16, 0xb2, 0xd9, 0x06, 0x34, 0x07, 0x63, 0xb1, 0x6c, 0x30, 0x5b, 0x19, 0x91,
+		0xb2, 0x09, 0xd1, 0xd2, 0xa6, 0x8d, 0x36, 0x60, 0x3c, 0x0e, 0x90, 0xed, 0x26, 0xd5, 0xde, 0xe0,
+		0x5a, 0xe2, 0x59, 0xe3, 0xcd, 0xda, 0x4d, 0x6a, 0x56, 0x70, 0xd7, 0x13, 0x9a, 0x82, 0x82, 0xcd,
+		0x1b, 0x98, 0xb9, 0x4a, 0xcf, 0xcb, 0xa6, 0x7e, 0x42, 0xf7, 0xa0, 0xac, 0xe4, 0x2a, 0x70, 0x5b,
+		0x5a, 0x43, 0xaf, 0x24, 0x62, 0x07, 0x07, 0x78, 0xc6, 0x3c, 0x69, 0x96, 0xa4, 0xfe, 0x0b, 0xad,
+		0xc2, 0x28, 0x73, 0x9b, 0xbe, 0xd4, 0xba, 0x36, 0x9b, 0x98, 0xb7, 0x8d, 0xdb, 0x0e, 0xc7, 0xb6,
+		0x19, 0x86, 0xa2, 0x1d, 0x98, 0x89, 0x8d, 0x99, 0xe4, 0x16, 0x71, 0xb8, 0x47, 0x95, 0x2c, 0x71,
+		0x5f, 0x6a, 0x11, 0x9a, 0xa9, 0x85, 0xa6, 0xb2, 0x16, 0x99, 0xca, 0xda, 0x63, 0x6d, 0x2a, 0xcd,
+		0xa9, 0x28, 0x77, 0x87, 0xaf, 0x07, 0x99, 0x3b, 0x61, 0x62, 0x2f, 0x6a, 0xc7, 0x5f, 0x05, 0xa8,
+		0xc5, 0x1c, 0xa8, 0xb1, 0xbb, 0x0a, 0x50, 0xb7, 0x60, 0x4a, 0x23, 0xf5, 0x12, 0x2d, 0x0d, 0x82,
+		0x9c, 0x0c, 0x6d, 0xd8, 0x71, 0x96, 0x1b, 0x30, 0x71, 0x40, 0xb1, 0x90, 0xbb, 0x14, 0x77, 0xd8,
+		0x95, 0x07, 0x41, 0x9d, 0x8b, 0x73, 0x22, 0x9c, 0x75, 0xa8, 0x08, 0x2a, 0x45, 0xdb, 0x6a, 0x72,
+		0x87, 0x91, 0xb6, 0x9e, 0x38, 0xf3, 0x7d, 0x26, 0xb8, 0x14, 0xed, 0x6d, 0x15, 0x67, 0x8e, 0x89,
+		0xce, 0x03, 0xba, 0x05, 0x85, 0x03, 0x8a, 0x6d, 0x2a, 0xf4, 0xab, 0x7f, 0x39, 0x31, 0xfd, 0xa9,
+		0x0a, 0x31, 0x75, 0x28, 0xba, 0x0d, 0x53, 0x91, 0x48, 0x3a, 0x9c, 0x60, 0xc7, 0xb2, 0x99, 0xd7,
+		0xc4, 0x92, 0x1c, 0xa8, 0x57, 0xb0, 0x64, 0x9e, 0xd7, 0x9f, 0x3e, 0x0b, 0x3e, 0x7c, 0xac, 0x3f,
+		0xab, 0x7e, 0x6d, 0xc0, 0x6c, 0x9a, 0x6d, 0x45, 0x33, 0x50, 0x0a, 0x9d, 0x49, 0xfc, 0x0a, 0x14,
+		0xd5, 0xf3, 0xa6, 0x8d, 0x9e, 0xc1, 0x85, 0xb8, 0x06, 0x7b, 0x4c, 0x74, 0x4a, 0x30, 0x34, 0xe8,
+		0xde, 0x90, 0x2e, 0xc1, 0x06, 0x13, 0x51, 0x05, 0xaa, 0x04, 0x16, 0x73, 0x58, 0x56, 0x74, 0x1b,
+		0x0a, 0x82, 0x7a, 0xbe, 0x23, 0xa7, 0x8d, 0x0c, 0x1d, 0xae, 0x63, 0xab, 0x18, 0xae, 0x67, 0x34,
+		0x9c, 0xe8, 0x0e, 0x14, 0x03, 0xc3, 0xe9, 0x0b, 0x9a, 0xba, 0xc3, 0x46, 0x18, 0x63, 0x46, 0xc1,
+		0xd5, 0x2d, 0x58, 0xcc, 0xe1, 0x17, 0x07, 0x4e, 0x99, 0xea, 0x3d, 0xb8, 0x92, 0x6a, 0xf2, 0x52,
+		0x2a, 0x54, 0x25, 0x70, 0x23, 0xb3, 0x27, 0x0b, 0x0e, 0x6c, 0x53, 0x89, 0x99, 0xe3, 0x65, 0xba,
+		0xd2, 0x28, 0xb8, 0xfa, 0x97, 0x01, 0x77, 0x4f, 0xeb, 0xa1, 0xba, 0x66, 0x9f, 0x71, 0x6c, 0xf6,
+		0xbd, 0x02, 0x74, 0x52, 0x1d, 0x75, 0x63, 0x2d, 0x24, 0xf2, 0x3a, 0xb1, 0x9b, 0x39, 0xd1, 0xea,
+		0x5d, 0x42, 0xd3, 0x50, 0x24, 0xdc, 0x95, 0x82, 0x3b, 0x6a, 0xd6, 0x56, 0xcc, 0xe8, 0x11, 0xd5,
+		0x60, 0xb2, 0xc7, 0x4a, 0x70, 0xd7, 0x69, 0xab, 0xb1, 0x5b, 0x32, 0x27, 0x48, 0xb7, 0xcc, 0xbf,
+		0x70, 0x9d, 0x76, 0xf5, 0xad, 0x01, 0x57, 0xd3, 0x2d, 0x58, 0x50, 0x5a, 0xed, 0xed, 0x5c, 0xdc,
+		0xa0, 0x51, 0x69, 0xc3, 0xa5, 0x2d, 0xdc, 0xa0, 0xdd, 0x37, 0x3e, 0x94, 0xe3, 0xc6, 0xbb, 0xe6,
+		0xc3, 0x70, 0xe6, 0xf9, 0x50, 0x7d, 0x5b, 0x82, 0xe5, 0xbc, 0xde, 0x2c, 0x90, 0xb8, 0xf8, 0x3e,
+		0x94, 0xc4, 0x19, 0x29, 0x12, 0x17, 0x01, 0x86, 0x12, 0xd7, 0xea, 0x7a, 0x3a, 0x2e, 0x65, 0x43,
+		0xa7, 0x94, 0xb2, 0xe1, 0xec, 0x52, 0x86, 0x61, 0xbe, 0xe3, 0xa9, 0xfa, 0x08, 0xc5, 0xc8, 0xa0,
+		0x29, 0x35, 0x1b, 0x43, 0xbc, 0x4c, 0x50, 0x8c, 0xd7, 0x70, 0x59, 0x1d, 0xa9, 0x0f, 0xfa, 0xe8,
+		0x20, 0xf4, 0x8b, 0x41, 0x76, 0x12, 0xf0, 0x0b, 0x98, 0xda, 0xc5, 0xe4, 0x90, 0xef, 0xed, 0x69,
+		0x6c, 0xe6, 0x4a, 0x2a, 0x8e, 0xb0, 0x33, 0x58, 0x83, 0xcf, 0xeb, 0x44, 0x05, 0xbb, 0xa9, 0xd3,
+		0x4e, 0x68, 0x52, 0xf1, 0x34, 0x9a, 0xb4, 0x09, 0x65, 0xe6, 0x32, 0xc9, 0xb0, 0xe4, 0x42, 0x69,
+		0xec, 0x99, 0xd5, 0xc5, 0xc1, 0xfe, 0x7f, 0x33, 0x4a, 0x31, 0x3b, 0xd9, 0xdd, 0x93, 0xb5, 0x9c,
+		0x63, 0xb2, 0x22, 0x13, 0xa6, 0x1c, 0x1c, 0xfc, 0x0f, 0x18, 0xca, 0x44, 0x50, 0x5a, 0x2d, 0x01,
+		0x90, 0xa1, 0x33, 0xce, 0x07, 0xb9, 0xeb, 0x71, 0xaa, 0xa9, 0x32, 0xd1, 0xbf, 0x61, 0x9c, 0x88,
+		0xa0, 0x47, 0xb4, 0xcd, 0x50, 0x82, 0x5d, 0x36, 0x2b, 0xc1, 0x62, 0xe4, 0x13, 0x4f, 0xa7, 0xc7,
+		0x4b, 0x30, 0xd2, 0xa0, 0x0d, 0xae, 0x0d, 0xf0, 0x4c, 0x62, 0xca, 0x73, 0xda, 0xe0, 0xa6, 0x0a,
+		0x43, 0x26, 0x4c, 0x9c, 0x30, 0xd4, 0xd3, 0x67, 0x54, 0xee, 0x7f, 0x92, 0x9d, 0x7f, 0x8f, 0xf5,
+		0x35, 0xcf, 0x79, 0x3d, 0x2b, 0xe8, 0x01, 0x54, 0x3e, 0x65, 0x52, 0x52, 0x11, 0x36, 0xd2, 0xf4,
+		0xd9, 0x41, 0xfd, 0x33, 0x16, 0x86, 0xab, 0xf6, 0xa9, 0xfe, 0x59, 0x84, 0xa5, 0x5c, 0xff, 0x14,
+		0xf5, 0x1d, 0xe6, 0x73, 0x30, 0x16, 0x4f, 0x11, 0x66, 0xab, 0xf7, 0xbf, 0x6c, 0x42, 0xb4, 0x14,
+		0x3a, 0xe9, 0xe3, 0x63, 0x66, 0xf8, 0x3d, 0x8c, 0x99, 0x0f, 0xe0, 0x98, 0xb3, 0x8c, 0x99, 0xc2,
+		0x3f, 0x3a, 0x66, 0x8a, 0xa7, 0x1e, 0x33, 0x1f, 0xc1, 0x64, 0x13, 0x0b, 0xea, 0x4a, 0x8d, 0xa8,
+		0x87, 0x43, 0xf8, 0x6a, 0x2f, 0xf4, 0x39, 0x7d, 0x10, 0xaf, 0x50, 0xf4, 0x88, 0x98, 0x68, 0xf6,
+		0x2e, 0x75, 0x4b, 0x6c, 0xf9, 0xb8, 0xc4, 0x12, 0x98, 0xee, 0x6a, 0x03, 0x4b, 0x50, 0xbf, 0xb3,
+		0x2d, 0xa8, 0x6d, 0x6f, 0xa6, 0x16, 0x7c, 0xd3, 0x36, 0xa9, 0x1f, 0xed, 0x63, 0x5e, 0x68, 0x25,
+		0x2d, 0xbf, 0x1f, 0x03, 0x7e, 0x62, 0x2a, 0x54, 0x52, 0xa7, 0xc2, 0x78, 0xfe, 0xa9, 0x70, 0xe6,
+		0x1d, 0xa6, 0xc2, 0xd9, 0x77, 0x9a, 0x0a, 0xd5, 0xdf, 0x87, 0x60, 0x25, 0xf7, 0xd7, 0x06, 0x1f,
+		0xda, 0xa8, 0xcd, 0xc1, 0x98, 0xfe, 0xb6, 0x44, 0x79, 0xa7, 0xf0, 0x1f, 0x63, 0x08, 0x97, 0x94,
+		0x77, 0x8a, 0x5f, 0xd7, 0x91, 0xec, 0xaf, 0x6b, 0x57, 0x6b, 0x8e, 0x66, 0x72, 0x7f, 0x85, 0x7e,
+		0xee, 0xef, 0x2b, 0x03, 0x96, 0xf3, 0x7e, 0x7b, 0x91, 0x5c, 0x4c, 0xe3, 0x9d, 0x8a, 0xb9, 0xf6,
+		0x09, 0x5c, 0x24, 0xbc, 0x91, 0x94, 0xbd, 0x36, 0x1e, 0x51, 0xd8, 0x16, 0x5c, 0xf2, 0x6d, 0xe3,
+		0xe3, 0x95, 0x7d, 0x26, 0x0f, 0xfc, 0xdd, 0x1a, 0xe1, 0x8d, 0x7a, 0xf7, 0xaf, 0x36, 0x4b, 0xcc,
+		0x76, 0xea, 0xfb, 0x3c, 0xfc, 0xa1, 0x48, 0xff, 0x84, 0x73, 0x1f, 0x37, 0xd9, 0xd1, 0xca, 0x6e,
+		0x41, 0xad, 0xdd, 0xfa, 0x7b, 0x00, 0x7f, 0xc1, 0xe0, 0x1d, 0x85, 0x1a, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/history.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5d, 0x6c, 0x1c, 0x57,
-		0xf5, 0xef, 0xec, 0xda, 0x6b, 0xef, 0x59, 0xdb, 0xb1, 0x6f, 0x1c, 0xc7, 0xce, 0xa7, 0x33, 0x49,
-		0x13, 0xd7, 0x71, 0xd6, 0x89, 0x93, 0x26, 0x4d, 0xd2, 0x8f, 0x7f, 0xec, 0xd8, 0xea, 0x4a, 0xfe,
-		0x27, 0x61, 0xe2, 0xa4, 0x80, 0x2a, 0x2d, 0xe3, 0x9d, 0xeb, 0x78, 0xf0, 0xee, 0xce, 0x76, 0xe6,
-		0xae, 0x37, 0x46, 0xf0, 0xc4, 0x03, 0x12, 0x6a, 0x05, 0x55, 0x85, 0x44, 0x05, 0x15, 0x08, 0x09,
-		0xd4, 0x22, 0xa4, 0x22, 0x10, 0x02, 0xc4, 0x0b, 0x20, 0x21, 0x90, 0x40, 0x85, 0x27, 0x5e, 0x78,
-		0xe5, 0x81, 0xbe, 0xf5, 0x81, 0xf2, 0x86, 0x84, 0xe6, 0xce, 0x9d, 0xdd, 0x9d, 0x99, 0x7b, 0x67,
-		0xee, 0xac, 0x9d, 0x16, 0xd4, 0xbc, 0x79, 0xee, 0x9c, 0x73, 0xe6, 0x77, 0xef, 0x3d, 0xe7, 0xdc,
-		0x73, 0xcf, 0x39, 0x6b, 0x38, 0xd1, 0x5c, 0xc7, 0xf6, 0x7c, 0x45, 0x37, 0x70, 0xbd, 0x82, 0xe7,
-		0xf5, 0x86, 0x39, 0xbf, 0x7d, 0x61, 0x7e, 0xd3, 0x74, 0x88, 0x65, 0xef, 0x14, 0x1b, 0xb6, 0x45,
-		0x2c, 0xb4, 0xdf, 0x25, 0x29, 0x32, 0x92, 0xa2, 0xde, 0x30, 0x8b, 0xdb, 0x17, 0x0e, 0x1d, 0x7b,
-		0x60, 0x59, 0x0f, 0xaa, 0x78, 0x9e, 0x92, 0xac, 0x37, 0x37, 0xe6, 0x8d, 0xa6, 0xad, 0x13, 0xd3,
-		0xaa, 0x7b, 0x4c, 0x87, 0x8e, 0x87, 0xdf, 0x13, 0xb3, 0x86, 0x1d, 0xa2, 0xd7, 0x1a, 0x8c, 0x60,
-		0x9a, 0xf7, 0xe1, 0x8a, 0x55, 0xab, 0xb5, 0x45, 0xa8, 0x3c, 0x0a, 0xa2, 0x3b, 0x5b, 0x55, 0xd3,
-		0x21, 0x71, 0x34, 0x2d, 0xcb, 0xde, 0xda, 0xa8, 0x5a, 0x2d, 0x8f, 0x46, 0xbd, 0x09, 0x03, 0x2f,
-		0x7a, 0x13, 0x42, 0x57, 0x21, 0x87, 0xb7, 0x71, 0x9d, 0x38, 0x93, 0xca, 0x74, 0x76, 0xa6, 0xb0,
-		0x70, 0xa2, 0xc8, 0x99, 0x5b, 0x91, 0x51, 0x2f, 0xbb, 0x94, 0x1a, 0x63, 0x50, 0xdf, 0xbf, 0x02,
-		0x43, 0xdd, 0x2f, 0xd0, 0x14, 0x0c, 0xd2, 0x57, 0x65, 0xd3, 0x98, 0x54, 0xa6, 0x95, 0x99, 0xac,
-		0x36, 0x40, 0x9f, 0x4b, 0x06, 0xba, 0x0a, 0xe0, 0xbd, 0x72, 0x27, 0x3d, 0x99, 0x99, 0x56, 0x66,
-		0x0a, 0x0b, 0x87, 0x8a, 0xde, 0x8a, 0x14, 0xfd, 0x15, 0x29, 0xae, 0xf9, 0x2b, 0xa2, 0xe5, 0x29,
-		0xb5, 0xfb, 0x8c, 0x26, 0x61, 0x60, 0x1b, 0xdb, 0x8e, 0x69, 0xd5, 0x27, 0xb3, 0x9e, 0x50, 0xf6,
-		0x88, 0x0e, 0xc2, 0x80, 0x3b, 0x79, 0xf7, 0x73, 0x7d, 0xf4, 0x4d, 0xce, 0x7d, 0x2c, 0x19, 0xe8,
-		0xdb, 0x0a, 0x9c, 0xf5, 0xa7, 0x5c, 0xc6, 0x0f, 0x71, 0xa5, 0xe9, 0xee, 0x43, 0xd9, 0x21, 0xba,
-		0x4d, 0xb0, 0x51, 0xf6, 0x90, 0xe8, 0x84, 0xd8, 0xe6, 0x7a, 0x93, 0x60, 0x67, 0xb2, 0x9f, 0xe2,
-		0x79, 0x96, 0x3b, 0xf5, 0x97, 0x98, 0x9c, 0x65, 0x5f, 0xcc, 0x5d, 0x4f, 0x0a, 0x9d, 0xf2, 0x8d,
-		0xb6, 0x8c, 0x17, 0x9f, 0xd0, 0xce, 0xb4, 0xe4, 0x48, 0xd1, 0xf7, 0x14, 0x38, 0xc7, 0x81, 0x57,
-		0xb1, 0x6a, 0x8d, 0x2a, 0xe6, 0x02, 0xcc, 0x51, 0x80, 0xcf, 0xcb, 0x01, 0x5c, 0xf2, 0xe5, 0x44,
-		0x21, 0x3e, 0xd5, 0x92, 0x25, 0x46, 0x6f, 0x2a, 0x30, 0xcb, 0x01, 0xb9, 0xa1, 0x9b, 0x55, 0x1e,
-		0xc2, 0x01, 0x8a, 0xf0, 0xba, 0x1c, 0xc2, 0x15, 0x2a, 0x24, 0x0a, 0xef, 0x74, 0x4b, 0x8a, 0x12,
-		0x7d, 0x97, 0xbf, 0x80, 0xae, 0x6e, 0x19, 0x65, 0xab, 0x49, 0xa2, 0xf0, 0x06, 0x29, 0xbc, 0xe7,
-		0xe4, 0xe0, 0xb9, 0x6a, 0x67, 0xdc, 0x6e, 0x92, 0x28, 0xc0, 0x99, 0x96, 0x24, 0x2d, 0x7a, 0x43,
-		0x81, 0x19, 0x03, 0x57, 0x4c, 0x87, 0x02, 0x73, 0xb5, 0xd4, 0xa9, 0x6c, 0x62, 0xa3, 0xc9, 0x5d,
-		0xbc, 0x3c, 0x45, 0x77, 0x95, 0x8b, 0xee, 0x26, 0x13, 0xb2, 0xa6, 0x3b, 0x5b, 0x77, 0x7d, 0x11,
-		0x51, 0x64, 0xa7, 0x0c, 0x09, 0x3a, 0xf4, 0x9a, 0x02, 0xa7, 0x43, 0xa8, 0x44, 0x36, 0x01, 0x14,
-		0xd3, 0x95, 0x64, 0x4c, 0x22, 0x73, 0x50, 0x8d, 0x44, 0x2a, 0xce, 0x2a, 0xc5, 0x18, 0x41, 0x41,
-		0x72, 0x95, 0x62, 0xf4, 0x3f, 0xb0, 0x4a, 0x42, 0xd5, 0x7f, 0x3d, 0x82, 0x2a, 0x46, 0xb3, 0x86,
-		0x28, 0xaa, 0x67, 0x12, 0x51, 0x89, 0x95, 0xea, 0xa4, 0x91, 0x4c, 0x86, 0xbe, 0xaa, 0xc0, 0x93,
-		0x41, 0x4c, 0x22, 0x4b, 0x1c, 0xa6, 0x80, 0x2e, 0x27, 0x02, 0x12, 0x19, 0xe1, 0x09, 0x23, 0x89,
-		0x88, 0x6e, 0x9b, 0x5e, 0x21, 0xe6, 0xb6, 0x49, 0x76, 0x12, 0x95, 0x7b, 0x24, 0x66, 0xdb, 0x6e,
-		0x30, 0x21, 0x49, 0xca, 0xad, 0x4b, 0xd0, 0x51, 0xe5, 0x0e, 0xa1, 0x12, 0x29, 0xf7, 0xbe, 0x18,
-		0xe5, 0x0e, 0x60, 0x12, 0x2a, 0xb7, 0x9e, 0x48, 0xc5, 0x59, 0xa5, 0x18, 0xe5, 0x1e, 0x95, 0x5c,
-		0xa5, 0x38, 0xe5, 0xd6, 0x25, 0xe8, 0xa8, 0x22, 0x05, 0x51, 0x89, 0x14, 0x69, 0x2c, 0x46, 0x91,
-		0xba, 0x21, 0x09, 0x15, 0x49, 0x4f, 0x22, 0xa2, 0x96, 0x16, 0x04, 0x13, 0x63, 0x69, 0x28, 0xc6,
-		0xd2, 0xba, 0xf1, 0xc4, 0x58, 0x9a, 0x9e, 0x4c, 0x86, 0x5a, 0x70, 0xcc, 0x05, 0x61, 0x8b, 0xb5,
-		0x67, 0x3f, 0x05, 0x72, 0x9e, 0x0b, 0xc4, 0x95, 0x6a, 0x0b, 0xd5, 0xe6, 0x30, 0x11, 0xbf, 0x46,
-		0xaf, 0xc0, 0x11, 0xef, 0xc3, 0x1b, 0xa6, 0xcd, 0xfb, 0xec, 0x38, 0xfd, 0x6c, 0x51, 0xfc, 0xd9,
-		0x15, 0x97, 0x2f, 0xfa, 0xd1, 0x29, 0x22, 0x7a, 0x89, 0x7e, 0xa0, 0xc0, 0x7c, 0x48, 0x45, 0xf5,
-		0x7a, 0x05, 0x57, 0xcb, 0x36, 0x7e, 0xa5, 0x89, 0x1d, 0xee, 0xec, 0x0f, 0x50, 0x18, 0x2f, 0x24,
-		0x6b, 0x2a, 0x95, 0xa4, 0xf9, 0x82, 0xa2, 0xb8, 0x66, 0x75, 0x69, 0x6a, 0xf4, 0x53, 0x05, 0x2e,
-		0x31, 0x4c, 0x3e, 0x44, 0x39, 0x25, 0x9e, 0xa0, 0x68, 0x97, 0xb8, 0x68, 0xd9, 0xd7, 0xbc, 0x4f,
-		0xcb, 0x68, 0x74, 0xd1, 0x4e, 0xc5, 0x81, 0xbe, 0xae, 0xc0, 0x19, 0xde, 0xf2, 0xf2, 0x80, 0x1e,
-		0x94, 0xd4, 0xee, 0x25, 0x26, 0x21, 0x41, 0xbb, 0x05, 0x64, 0xe8, 0x0b, 0x70, 0xdc, 0x53, 0x32,
-		0x31, 0x92, 0x49, 0x8a, 0xe4, 0x82, 0x58, 0xcf, 0xc4, 0x10, 0x3c, 0x05, 0x16, 0x7d, 0xfb, 0x2b,
-		0x0a, 0x9c, 0x62, 0x9b, 0xc7, 0x14, 0x5d, 0xb0, 0x69, 0x53, 0x14, 0xc1, 0xd3, 0x5c, 0x04, 0x9e,
-		0x70, 0x4f, 0xdf, 0x05, 0xdb, 0x34, 0x5d, 0x49, 0xa0, 0x41, 0x5f, 0x82, 0xe9, 0x9a, 0x6e, 0x6f,
-		0x61, 0xbb, 0x6c, 0xe3, 0x8a, 0x65, 0x1b, 0x3c, 0x10, 0x87, 0x28, 0x88, 0x05, 0x2e, 0x88, 0xff,
-		0xa7, 0xcc, 0x1a, 0xe3, 0x8d, 0x22, 0x38, 0x5a, 0x8b, 0x23, 0x40, 0xdf, 0x51, 0x60, 0x8e, 0x77,
-		0x3f, 0x31, 0x1f, 0xd4, 0x75, 0xee, 0x82, 0x1c, 0x4e, 0x13, 0xbe, 0xde, 0x65, 0x62, 0x64, 0xc2,
-		0x57, 0x01, 0x2d, 0xfa, 0xbe, 0x02, 0x45, 0x5e, 0x84, 0x8d, 0xed, 0x9a, 0x59, 0xd7, 0xb9, 0x7e,
-		0xe1, 0x48, 0x8c, 0x5f, 0x88, 0x86, 0xd8, 0x6d, 0x41, 0x1c, 0xbf, 0xd0, 0x92, 0xa6, 0x46, 0x3f,
-		0x53, 0xe0, 0x12, 0xef, 0x2a, 0x95, 0xe8, 0xc5, 0x8e, 0x52, 0xb4, 0x37, 0x25, 0x6f, 0x54, 0x49,
-		0xae, 0x6c, 0xbe, 0x95, 0x8e, 0x45, 0xa4, 0x01, 0x62, 0xa3, 0x3c, 0x96, 0x46, 0x03, 0xc4, 0x06,
-		0x3a, 0xd3, 0x92, 0xa4, 0x45, 0x7f, 0x57, 0x60, 0x39, 0xe4, 0x71, 0xf1, 0x43, 0x82, 0xed, 0xba,
-		0x5e, 0x2d, 0x73, 0x90, 0x9b, 0x75, 0x93, 0x98, 0x7c, 0xc5, 0x38, 0x4e, 0xa1, 0xdf, 0x4d, 0x76,
-		0xc1, 0xcb, 0x4c, 0x7e, 0x64, 0x3e, 0x25, 0x5f, 0x78, 0x74, 0x42, 0xcf, 0xdb, 0xbb, 0x92, 0x80,
-		0xfe, 0xa6, 0xc0, 0x62, 0x8a, 0x69, 0x8a, 0x3c, 0xd6, 0x34, 0x9d, 0xe3, 0x9d, 0x5d, 0xcc, 0x51,
-		0xe4, 0xcc, 0xae, 0xdb, 0xbd, 0xb3, 0xa3, 0xf7, 0x14, 0x78, 0x2e, 0x6e, 0x3a, 0xc9, 0x76, 0x72,
-		0x82, 0x4e, 0x6c, 0x95, 0x3b, 0x31, 0x21, 0x98, 0x44, 0x7b, 0xb9, 0x82, 0x7b, 0x63, 0xa5, 0x71,
-		0x00, 0x37, 0x75, 0x52, 0x27, 0x66, 0xbd, 0x89, 0x8d, 0xb2, 0xee, 0x94, 0xeb, 0xb8, 0x15, 0x9d,
-		0x87, 0x1a, 0x13, 0x07, 0x70, 0x32, 0x28, 0x4c, 0xdc, 0x0d, 0xe7, 0x16, 0x6e, 0x71, 0xe2, 0x80,
-		0x56, 0x2a, 0x0e, 0xf4, 0x5b, 0x05, 0xae, 0xd2, 0x68, 0xb2, 0x5c, 0xd9, 0x34, 0xab, 0x46, 0x4a,
-		0xfb, 0x39, 0x49, 0xa1, 0xbf, 0xc8, 0x85, 0x4e, 0x43, 0xc9, 0x25, 0x57, 0x68, 0x1a, 0xa3, 0xb9,
-		0xe8, 0xa4, 0x67, 0x43, 0xbf, 0x54, 0xe0, 0x72, 0xc2, 0x24, 0x44, 0xd6, 0x71, 0x8a, 0xce, 0x60,
-		0x39, 0xed, 0x0c, 0x44, 0x26, 0x71, 0xde, 0x49, 0xc9, 0x83, 0x7e, 0xa4, 0xc0, 0x05, 0x21, 0x6a,
-		0x61, 0x9c, 0xff, 0x24, 0x85, 0x7d, 0x83, 0x1f, 0x86, 0x70, 0xbf, 0x2e, 0x0c, 0xfc, 0xe7, 0x2a,
-		0x29, 0xe8, 0xd1, 0x4f, 0x14, 0xb8, 0x28, 0x84, 0x1b, 0x73, 0x89, 0x3c, 0x1d, 0xa3, 0xe4, 0x7c,
-		0xc0, 0x31, 0xd7, 0xc9, 0x62, 0x25, 0x15, 0x07, 0x7a, 0x47, 0x81, 0xf3, 0xa9, 0x35, 0xe3, 0x0c,
-		0x45, 0xfc, 0x7f, 0x29, 0x10, 0x8b, 0x94, 0xe2, 0x6c, 0x25, 0x85, 0x3e, 0xbc, 0xab, 0xc0, 0x82,
-		0x78, 0x81, 0x85, 0x87, 0xf0, 0x0c, 0x45, 0xbb, 0x98, 0x66, 0x7d, 0x85, 0x27, 0xf1, 0xb9, 0x4a,
-		0x1a, 0x06, 0xf4, 0xe3, 0x38, 0x95, 0x88, 0xb9, 0x34, 0x3f, 0x95, 0x1a, 0xb2, 0xf8, 0xfa, 0x2c,
-		0x80, 0x2c, 0xba, 0x48, 0xbb, 0xb1, 0x99, 0x18, 0x72, 0x4c, 0x24, 0x39, 0x1b, 0x13, 0x9b, 0x09,
-		0x30, 0xc7, 0x84, 0x93, 0xf3, 0x95, 0x74, 0x2c, 0xf4, 0xd0, 0xf4, 0x42, 0xf1, 0x5e, 0x23, 0x9e,
-		0xb3, 0x31, 0x87, 0xa6, 0x17, 0x71, 0xf7, 0x12, 0xea, 0x5c, 0x71, 0x7a, 0x63, 0x45, 0xbf, 0x53,
-		0xe0, 0x9a, 0xc4, 0x84, 0x44, 0x36, 0x3a, 0x47, 0x67, 0x53, 0xea, 0x65, 0x36, 0x22, 0x63, 0xbd,
-		0xe4, 0xf4, 0xc0, 0x87, 0x7e, 0xa1, 0xc0, 0xd3, 0x71, 0x13, 0x10, 0xdf, 0x9f, 0xce, 0xc5, 0x1c,
-		0x40, 0x42, 0x10, 0xe2, 0x7b, 0xd4, 0x79, 0x9c, 0x92, 0x87, 0x3a, 0x9c, 0x66, 0xc3, 0xc1, 0x36,
-		0xe9, 0x00, 0x77, 0xb0, 0x6e, 0x57, 0x36, 0xbb, 0x60, 0x46, 0x71, 0x17, 0x63, 0xac, 0xf7, 0x1e,
-		0x15, 0xe7, 0x23, 0xb8, 0x4b, 0x85, 0x75, 0xbe, 0xc8, 0xb1, 0xde, 0x66, 0x1a, 0x86, 0xc5, 0x21,
-		0x80, 0x0e, 0x10, 0xf5, 0x83, 0x61, 0x38, 0x23, 0x7b, 0x7a, 0xad, 0xc0, 0x70, 0x7b, 0x8e, 0x64,
-		0xa7, 0x81, 0x69, 0x2d, 0x50, 0x54, 0x59, 0xf4, 0x85, 0xae, 0xed, 0x34, 0xb0, 0x36, 0xd4, 0xea,
-		0x7a, 0x42, 0x2f, 0xc3, 0x81, 0x86, 0x6e, 0xbb, 0x2b, 0xd2, 0x6d, 0x74, 0x1b, 0x16, 0x2b, 0x1f,
-		0xce, 0x70, 0xe5, 0xdd, 0xa1, 0x1c, 0x5d, 0x36, 0xb1, 0x61, 0x69, 0xfb, 0x1b, 0xd1, 0x41, 0x74,
-		0x0d, 0xf2, 0x34, 0x23, 0x53, 0x35, 0x1d, 0x42, 0x0b, 0x8b, 0x85, 0x85, 0xa3, 0xfc, 0x94, 0x87,
-		0xee, 0x6c, 0xad, 0x9a, 0x0e, 0xd1, 0x06, 0x09, 0xfb, 0x0b, 0x2d, 0x40, 0xbf, 0x59, 0x6f, 0x34,
-		0x09, 0x2d, 0x3b, 0x16, 0x16, 0x8e, 0x08, 0x90, 0xec, 0x54, 0x2d, 0xdd, 0xd0, 0x3c, 0x52, 0xa4,
-		0xc3, 0x74, 0x28, 0xe4, 0x28, 0x13, 0xab, 0x5c, 0xa9, 0x5a, 0x0e, 0xa6, 0xfe, 0xdb, 0x6a, 0x12,
-		0x56, 0x87, 0x9c, 0x8a, 0xd4, 0x45, 0x6f, 0xb2, 0x4a, 0xb2, 0x76, 0x04, 0x07, 0xd6, 0x7e, 0xcd,
-		0x5a, 0x72, 0xf9, 0xd7, 0x3c, 0x76, 0xf4, 0x12, 0x1c, 0xee, 0xa4, 0xbd, 0xa3, 0xd2, 0x73, 0x49,
-		0xd2, 0x0f, 0x12, 0x3f, 0x99, 0x1d, 0x12, 0x7c, 0x1d, 0x0e, 0x75, 0x22, 0xec, 0xce, 0x2c, 0xec,
-		0x66, 0xbd, 0x6c, 0x1a, 0xb4, 0xf4, 0x97, 0xd7, 0x0e, 0xb6, 0x29, 0xda, 0xeb, 0xac, 0x35, 0xeb,
-		0x25, 0x03, 0x95, 0x20, 0xcf, 0x5c, 0xa5, 0x65, 0xd3, 0x3a, 0xdc, 0xc8, 0xc2, 0x59, 0xbe, 0x6b,
-		0x67, 0x02, 0x68, 0x08, 0x5d, 0xf2, 0x59, 0xb4, 0x0e, 0x37, 0x2a, 0xc1, 0x58, 0x07, 0x87, 0xeb,
-		0xae, 0x9a, 0x36, 0x66, 0xc5, 0x33, 0xfe, 0x1e, 0xac, 0x78, 0x34, 0xda, 0x68, 0x9b, 0x8d, 0x8d,
-		0x20, 0x0d, 0x26, 0xaa, 0xba, 0x7b, 0xe7, 0xf3, 0xc2, 0x19, 0x3a, 0x1d, 0xec, 0x34, 0xab, 0x84,
-		0x15, 0xbe, 0xe2, 0xf7, 0x74, 0xdc, 0xe5, 0x5d, 0x6a, 0xb3, 0x6a, 0x94, 0x13, 0x5d, 0x85, 0x29,
-		0xcb, 0x36, 0x1f, 0x98, 0x9e, 0xa3, 0x0d, 0xad, 0x52, 0x81, 0xae, 0xd2, 0x84, 0x4f, 0x10, 0x5a,
-		0xa4, 0x43, 0x30, 0x68, 0x1a, 0xb8, 0x4e, 0x4c, 0xb2, 0x43, 0x2b, 0x4a, 0x79, 0xad, 0xfd, 0x8c,
-		0x2e, 0xc2, 0xc4, 0x86, 0x69, 0x3b, 0x24, 0x2a, 0x73, 0x98, 0x52, 0xee, 0xa7, 0x6f, 0x43, 0x02,
-		0x97, 0x60, 0xc8, 0xc6, 0xc4, 0xde, 0x29, 0x37, 0xac, 0xaa, 0x59, 0xd9, 0x61, 0x55, 0x98, 0x69,
-		0xc1, 0x05, 0x95, 0xd8, 0x3b, 0x77, 0x28, 0x9d, 0x56, 0xb0, 0x3b, 0x0f, 0x68, 0x12, 0x06, 0x74,
-		0x42, 0x70, 0xad, 0x41, 0x68, 0xc5, 0xa4, 0x5f, 0xf3, 0x1f, 0xd1, 0x12, 0xec, 0xc3, 0x0f, 0x1b,
-		0xa6, 0xa7, 0x38, 0x5e, 0x51, 0x7f, 0x34, 0xb1, 0xa8, 0x3f, 0xd2, 0x61, 0xa1, 0x95, 0xfd, 0x93,
-		0x30, 0x5c, 0xb1, 0x5d, 0x6b, 0x60, 0x15, 0x1d, 0x5a, 0x71, 0xc8, 0x6b, 0x43, 0xee, 0xa0, 0x5f,
-		0xe5, 0x41, 0x9f, 0x86, 0xc3, 0xde, 0xec, 0x83, 0xd5, 0xaf, 0x75, 0xbd, 0xb2, 0x65, 0x6d, 0x6c,
-		0xb0, 0xa2, 0x40, 0x8c, 0x52, 0x4f, 0x52, 0xee, 0xee, 0xc2, 0xd7, 0xa2, 0xc7, 0x8a, 0xce, 0x41,
-		0x5f, 0x0d, 0xd7, 0x2c, 0x96, 0xce, 0x9f, 0xe2, 0x27, 0xfa, 0x70, 0xcd, 0xd2, 0x28, 0x19, 0xd2,
-		0x60, 0x2c, 0xe2, 0xb1, 0x59, 0x4e, 0xfe, 0x49, 0xfe, 0xd9, 0x18, 0xf2, 0xb0, 0xda, 0xa8, 0x13,
-		0x1a, 0x41, 0xf7, 0x60, 0xa2, 0x61, 0xe3, 0xed, 0xb2, 0xde, 0x24, 0x96, 0xab, 0x7f, 0x98, 0x94,
-		0x1b, 0x96, 0x59, 0x27, 0x7e, 0x96, 0x5d, 0xb4, 0x5f, 0x0e, 0x26, 0x77, 0x28, 0x9d, 0xb6, 0xdf,
-		0xe5, 0xbf, 0xd1, 0x24, 0x56, 0xd7, 0x20, 0xba, 0x08, 0xb9, 0x4d, 0xac, 0x1b, 0xd8, 0x66, 0xe9,
-		0xef, 0xc3, 0xfc, 0xa6, 0x0e, 0x4a, 0xa2, 0x31, 0x52, 0xb4, 0x0a, 0xe3, 0xde, 0x42, 0x77, 0x6a,
-		0x79, 0x74, 0x5f, 0x0f, 0x26, 0xee, 0x2b, 0xa2, 0x7c, 0xed, 0xba, 0x1c, 0xdd, 0xdb, 0x2f, 0xc2,
-		0x68, 0x43, 0xb7, 0x89, 0xe9, 0x5f, 0xcf, 0x37, 0xcc, 0x07, 0x93, 0x93, 0xb4, 0xc3, 0xe4, 0x53,
-		0xbb, 0x69, 0xb3, 0x70, 0xfd, 0xbb, 0x27, 0x74, 0x89, 0xca, 0x5c, 0xae, 0x13, 0x7b, 0x47, 0xdb,
-		0xd7, 0x08, 0x8e, 0x1e, 0x5a, 0x84, 0x71, 0x1e, 0x21, 0x1a, 0x85, 0xec, 0x16, 0xde, 0xa1, 0x07,
-		0x52, 0x5e, 0x73, 0xff, 0x44, 0xe3, 0xd0, 0xbf, 0xad, 0x57, 0x9b, 0x5e, 0x4f, 0x4a, 0x5e, 0xf3,
-		0x1e, 0xae, 0x65, 0x9e, 0x51, 0xd4, 0x77, 0x14, 0x78, 0x4a, 0xfe, 0xf6, 0x73, 0x09, 0x72, 0xcc,
-		0x7f, 0x28, 0x12, 0xfe, 0x83, 0xd1, 0xa2, 0x15, 0x98, 0x8e, 0x2f, 0x7f, 0x9b, 0x06, 0x05, 0x96,
-		0xd5, 0x8e, 0x88, 0x2b, 0xd7, 0x25, 0x43, 0x7d, 0x5b, 0x81, 0xd3, 0x92, 0x41, 0xd4, 0x65, 0x18,
-		0xf0, 0x3d, 0xa7, 0x22, 0xe1, 0x39, 0x7d, 0xe2, 0x3d, 0x83, 0x6a, 0xc1, 0x8c, 0xf4, 0x0d, 0x62,
-		0x09, 0x86, 0xd8, 0xe1, 0xd5, 0x09, 0x24, 0x46, 0x04, 0x46, 0xc1, 0xce, 0x2a, 0x1a, 0x47, 0x14,
-		0x48, 0xe7, 0x41, 0xfd, 0x93, 0x02, 0xa7, 0x64, 0x9a, 0x28, 0x82, 0x11, 0x81, 0x92, 0x2e, 0x22,
-		0xb8, 0x05, 0x13, 0x82, 0x53, 0x37, 0x93, 0xe4, 0xa0, 0xf6, 0x3b, 0x9c, 0x13, 0xb7, 0xcb, 0xf3,
-		0x66, 0x03, 0x9e, 0x57, 0x7d, 0x4d, 0x01, 0x35, 0xb9, 0xff, 0x02, 0xcd, 0x01, 0x0a, 0xd7, 0xe4,
-		0xdb, 0x5d, 0x59, 0xa3, 0x4e, 0x60, 0x09, 0x42, 0xc7, 0x4f, 0x26, 0x74, 0xfc, 0x1c, 0x05, 0xf0,
-		0x13, 0xa4, 0xa6, 0x41, 0xd1, 0xe4, 0xb5, 0x3c, 0x1b, 0x29, 0x19, 0xea, 0x07, 0xa1, 0xe5, 0x15,
-		0x5a, 0x48, 0x3a, 0x44, 0x33, 0x30, 0x1a, 0xcc, 0xcb, 0xb4, 0xd5, 0x6b, 0xc4, 0xe9, 0x9a, 0x71,
-		0x08, 0x7b, 0x36, 0x84, 0xfd, 0x0c, 0xec, 0x5b, 0x37, 0xeb, 0xba, 0xbd, 0x53, 0xae, 0x6c, 0xe2,
-		0xca, 0x96, 0xd3, 0xac, 0xd1, 0x90, 0x2d, 0xaf, 0x8d, 0x78, 0xc3, 0x4b, 0x6c, 0x14, 0x9d, 0x85,
-		0xb1, 0x60, 0x36, 0x11, 0x3f, 0xf4, 0xc2, 0xb1, 0x21, 0x6d, 0x14, 0x77, 0x27, 0xf9, 0xf0, 0x43,
-		0xa2, 0xbe, 0x9a, 0x85, 0x93, 0x12, 0xad, 0x1d, 0x8f, 0x6c, 0xc6, 0x61, 0xb3, 0xc8, 0xf6, 0x60,
-		0x16, 0xe8, 0x18, 0x14, 0xd6, 0x75, 0x07, 0xfb, 0xa1, 0x84, 0xb7, 0x2c, 0x79, 0x77, 0xc8, 0x0b,
-		0x20, 0x8e, 0x00, 0xd4, 0x71, 0xcb, 0x7f, 0xdd, 0xef, 0x2d, 0x6c, 0x1d, 0xb7, 0xbc, 0xb7, 0x73,
-		0x80, 0x36, 0x2c, 0x7b, 0x8b, 0x21, 0xf5, 0xfb, 0xf3, 0x72, 0xde, 0xd4, 0xdc, 0x37, 0x14, 0xeb,
-		0x7d, 0xd6, 0xa8, 0x37, 0xe1, 0x3a, 0x47, 0xdd, 0xb1, 0xea, 0x2c, 0x56, 0x64, 0x4f, 0xe8, 0x26,
-		0xf4, 0x57, 0xf4, 0xa6, 0x83, 0x59, 0x58, 0x58, 0x94, 0x6e, 0xa2, 0x59, 0x72, 0xb9, 0x34, 0x8f,
-		0x59, 0x7d, 0x3b, 0x0b, 0x27, 0x12, 0x1b, 0x5b, 0x1e, 0xd9, 0x66, 0x2c, 0xfa, 0x73, 0xf0, 0x76,
-		0x61, 0x4e, 0xb2, 0xef, 0xa6, 0x7b, 0x06, 0xdd, 0x3e, 0xb9, 0x2f, 0x8d, 0x4f, 0xee, 0x56, 0xfd,
-		0xfe, 0x90, 0xea, 0x87, 0xf6, 0x37, 0x17, 0xbf, 0xbf, 0x03, 0x52, 0xfb, 0x3b, 0x28, 0xd8, 0x5f,
-		0x8e, 0x99, 0xe5, 0x79, 0x66, 0xa6, 0xbe, 0x95, 0x83, 0x53, 0x32, 0x3d, 0x3f, 0xe8, 0x38, 0x14,
-		0xda, 0x85, 0x73, 0xb6, 0x4d, 0x79, 0x0d, 0xfc, 0xa1, 0x92, 0xe1, 0x5e, 0x32, 0x3b, 0x95, 0x75,
-		0xd7, 0x08, 0x32, 0x31, 0x97, 0xcc, 0xf6, 0x27, 0xe9, 0x25, 0x53, 0xef, 0x7a, 0x72, 0x55, 0xd3,
-		0xb0, 0x6a, 0xba, 0x59, 0x67, 0xbe, 0x83, 0x3d, 0x05, 0x0f, 0x83, 0xbe, 0x1e, 0xaf, 0x87, 0x39,
-		0xf9, 0xeb, 0xe1, 0x1a, 0x4c, 0xf9, 0x4a, 0x18, 0x3d, 0x43, 0x06, 0x92, 0xce, 0x90, 0x09, 0x9f,
-		0x37, 0x74, 0x8c, 0x84, 0xa4, 0xb2, 0x23, 0x8a, 0x49, 0x1d, 0x4c, 0x21, 0xd5, 0xbb, 0x15, 0x32,
-		0xa9, 0xe2, 0xc3, 0x2e, 0xdf, 0xd3, 0x61, 0xb7, 0x02, 0x63, 0x9b, 0x58, 0xb7, 0xc9, 0x3a, 0xd6,
-		0x3b, 0xe8, 0x20, 0x49, 0xd4, 0x68, 0x9b, 0xa7, 0x23, 0x27, 0x39, 0x44, 0x29, 0x24, 0x87, 0x28,
-		0x91, 0xbb, 0xd3, 0x50, 0x2f, 0x77, 0xa7, 0x4e, 0x0c, 0x3e, 0x2c, 0x1d, 0x83, 0xab, 0xff, 0x50,
-		0x40, 0x4d, 0xee, 0x3f, 0xfb, 0xc8, 0x0e, 0xf7, 0xee, 0x30, 0xa4, 0x2f, 0x78, 0x01, 0x7c, 0x01,
-		0x86, 0xe8, 0xfd, 0xd9, 0xf7, 0x5b, 0xfd, 0x12, 0x7e, 0xab, 0xe0, 0x72, 0xb0, 0x07, 0xf5, 0x2f,
-		0x4a, 0xd0, 0x15, 0xec, 0x71, 0x64, 0xcd, 0x5f, 0xa2, 0x4c, 0x0a, 0x77, 0x9f, 0x4d, 0x8c, 0x36,
-		0xfa, 0x82, 0x8b, 0xa9, 0xfe, 0x59, 0x81, 0x13, 0xc9, 0x4d, 0x41, 0xbd, 0x06, 0xe0, 0x1f, 0xc7,
-		0x8c, 0x7e, 0x95, 0x81, 0x93, 0x12, 0xad, 0x75, 0xee, 0x9c, 0x0c, 0x4c, 0x74, 0xb3, 0xea, 0x48,
-		0x6d, 0x92, 0x4f, 0xfc, 0xc8, 0xe6, 0x14, 0x8e, 0x90, 0xfa, 0x7a, 0x89, 0x90, 0x76, 0xad, 0xe2,
-		0xdf, 0x50, 0x60, 0x56, 0xbe, 0x23, 0x4e, 0xe6, 0xcc, 0xdb, 0x9b, 0x2b, 0xd8, 0xbb, 0x0a, 0xa4,
-		0xec, 0x7d, 0x4b, 0xc6, 0x36, 0xee, 0x87, 0x41, 0xec, 0x1e, 0xed, 0x05, 0x36, 0x32, 0x88, 0xb3,
-		0x12, 0x88, 0xdf, 0x0c, 0xe9, 0xa1, 0xa8, 0x4a, 0xd6, 0xab, 0x1e, 0xae, 0xc0, 0x74, 0x55, 0x27,
-		0x5d, 0x3d, 0x20, 0xe1, 0x8e, 0x88, 0xce, 0xca, 0x7a, 0x74, 0xbc, 0xad, 0xf4, 0xc2, 0x26, 0x8e,
-		0x3e, 0x67, 0x53, 0xe8, 0x73, 0x5f, 0xa2, 0x8d, 0x86, 0x02, 0x3d, 0xf5, 0x3d, 0x05, 0x0e, 0xc7,
-		0x74, 0x9d, 0xa2, 0x29, 0x18, 0xf4, 0xba, 0xed, 0xda, 0xfb, 0x36, 0x40, 0x9f, 0x4b, 0x06, 0x5a,
-		0x85, 0x03, 0xed, 0x83, 0x7c, 0xc3, 0xb4, 0x53, 0x5c, 0x5a, 0x11, 0x3b, 0xc7, 0x57, 0x4c, 0x1b,
-		0xa7, 0x39, 0x7e, 0x65, 0x36, 0xfb, 0x73, 0x30, 0x25, 0x6c, 0x67, 0x8d, 0x9b, 0x8d, 0x74, 0xcc,
-		0xae, 0xfe, 0x5e, 0x81, 0x23, 0x71, 0x9d, 0x8c, 0x7b, 0xf2, 0x95, 0xbd, 0x5a, 0x8f, 0x58, 0x07,
-		0xfd, 0x73, 0x05, 0xa6, 0x93, 0x3a, 0x22, 0xe3, 0x66, 0xf3, 0x48, 0xcd, 0x36, 0x16, 0xf9, 0xbf,
-		0x07, 0x20, 0x65, 0xe3, 0x0d, 0x9a, 0x87, 0x71, 0xda, 0xdb, 0x13, 0x4e, 0x83, 0x7b, 0x73, 0x1a,
-		0xab, 0xe3, 0x56, 0x28, 0x09, 0x1e, 0xa9, 0x44, 0x65, 0x7a, 0xab, 0x44, 0x3d, 0xae, 0x15, 0xc9,
-		0xd7, 0x8a, 0x64, 0x74, 0x67, 0x40, 0x42, 0x77, 0x6e, 0xc3, 0x04, 0xcb, 0xf1, 0x33, 0x8c, 0x66,
-		0x9d, 0x60, 0x7b, 0x5b, 0xaf, 0x26, 0xdf, 0x5b, 0xc6, 0x19, 0x23, 0x85, 0x57, 0x62, 0x6c, 0xc1,
-		0x3a, 0x54, 0x7e, 0x57, 0x75, 0xa8, 0xae, 0x10, 0x0e, 0xd2, 0x84, 0x70, 0xe2, 0xa2, 0x53, 0xa1,
-		0xe7, 0xa2, 0x53, 0xe7, 0x9e, 0x31, 0x24, 0x9f, 0xeb, 0xf7, 0x4b, 0x1f, 0xc3, 0xbb, 0x28, 0x7d,
-		0x8c, 0xec, 0xaa, 0xf4, 0xe1, 0xfa, 0xe0, 0xf9, 0xb4, 0xdd, 0x7f, 0x6d, 0x6f, 0xa5, 0x74, 0x7b,
-		0xab, 0xb8, 0xfb, 0xcd, 0x3a, 0x1c, 0x6c, 0x77, 0x0c, 0x84, 0xaa, 0xc8, 0x9e, 0x1d, 0xcf, 0xc6,
-		0xf6, 0x04, 0x04, 0xeb, 0xc8, 0x07, 0x30, 0x6f, 0x58, 0xfd, 0xa1, 0xc2, 0x49, 0x69, 0x8b, 0x4e,
-		0x16, 0x19, 0xf3, 0x50, 0x24, 0xcc, 0xa3, 0x2b, 0xd2, 0xc9, 0xa4, 0x88, 0x74, 0xd4, 0x0f, 0x15,
-		0x38, 0x1a, 0xdb, 0xbd, 0xee, 0x86, 0x7a, 0xac, 0x37, 0xbe, 0xae, 0xd7, 0xfc, 0xa5, 0x06, 0x6f,
-		0xe8, 0x96, 0x5e, 0xc3, 0xbd, 0x7e, 0x7a, 0xcf, 0x4e, 0x95, 0x8e, 0xc6, 0xf7, 0xc9, 0xdf, 0xac,
-		0xbf, 0xc5, 0xdb, 0x24, 0x51, 0xb7, 0xc6, 0x71, 0x28, 0xb0, 0x7e, 0x99, 0xee, 0x25, 0xf0, 0x86,
-		0xe8, 0x12, 0xb4, 0x9d, 0x7a, 0x46, 0xde, 0xa9, 0xc7, 0xe4, 0xa9, 0xd5, 0x6f, 0x2a, 0x30, 0x9b,
-		0xa2, 0x43, 0xa9, 0x93, 0x4f, 0x55, 0x02, 0xf9, 0xd4, 0x5e, 0x77, 0x26, 0x0e, 0xda, 0x6f, 0x32,
-		0xf0, 0xfc, 0xee, 0xba, 0xb4, 0xf7, 0x4c, 0xe7, 0x3b, 0xb9, 0xba, 0x4c, 0x20, 0x57, 0x77, 0x0f,
-		0x50, 0xb4, 0x1b, 0x88, 0xd9, 0xf7, 0x69, 0xb9, 0x6a, 0xa3, 0x36, 0x16, 0x69, 0xe9, 0x45, 0x93,
-		0x30, 0x50, 0xb1, 0xea, 0xc4, 0xb6, 0xaa, 0x54, 0xd1, 0x86, 0x34, 0xff, 0x11, 0x15, 0x61, 0x7f,
-		0xa8, 0xb1, 0xcd, 0xaa, 0x57, 0xbd, 0xc8, 0x7c, 0x50, 0x1b, 0x0b, 0xf4, 0x9b, 0xdd, 0xae, 0x57,
-		0x77, 0xd4, 0x37, 0xb2, 0x70, 0x7d, 0x17, 0x5d, 0xe0, 0xe8, 0x5e, 0xb7, 0xdf, 0x1b, 0x11, 0xfc,
-		0xc6, 0x42, 0x4a, 0x72, 0x20, 0xed, 0xbc, 0x47, 0xf7, 0x49, 0x61, 0x0e, 0x95, 0xbf, 0x2f, 0x7d,
-		0xbb, 0xdd, 0x97, 0x39, 0x40, 0xe1, 0xde, 0x3b, 0x56, 0xa1, 0xc8, 0x6a, 0xa3, 0x66, 0x40, 0x09,
-		0xbd, 0x14, 0x96, 0xbf, 0x8b, 0xb9, 0xc0, 0x2e, 0xaa, 0x7f, 0x55, 0xe0, 0x4a, 0x8f, 0x2d, 0xec,
-		0x02, 0x0c, 0x8a, 0x00, 0xc3, 0x47, 0xab, 0xb8, 0xea, 0xd7, 0xb2, 0x70, 0xa5, 0xc7, 0x36, 0xc3,
-		0xff, 0x55, 0x5b, 0x0d, 0x79, 0xec, 0x3e, 0xb1, 0xc7, 0xee, 0x97, 0xf7, 0xd8, 0x42, 0xd5, 0x11,
-		0x39, 0x80, 0x01, 0x91, 0x03, 0x78, 0x35, 0x0b, 0x97, 0x7a, 0x69, 0x95, 0x94, 0xb3, 0x7c, 0x29,
-		0xc9, 0x8f, 0x2d, 0xbf, 0x63, 0xf9, 0xef, 0x2b, 0x70, 0x3e, 0x6d, 0xdb, 0xe7, 0x7f, 0xb5, 0xc9,
-		0x8b, 0xcf, 0x2a, 0xf5, 0x8f, 0x0a, 0x9c, 0x4b, 0xd5, 0x2a, 0xba, 0x67, 0x2e, 0x80, 0x7b, 0x6b,
-		0xc8, 0xec, 0xee, 0xd6, 0xf0, 0x56, 0x1e, 0x2e, 0xf6, 0xf0, 0x9b, 0x97, 0xae, 0xed, 0x50, 0x02,
-		0xdb, 0x71, 0x1c, 0x0a, 0xed, 0xed, 0x60, 0x3a, 0x9f, 0xd7, 0xc0, 0x1f, 0xe2, 0xa5, 0x10, 0xb2,
-		0x7b, 0x90, 0x42, 0xe8, 0xb5, 0x9e, 0xd8, 0xbf, 0xb7, 0x29, 0x84, 0xdc, 0x23, 0x4d, 0x21, 0x0c,
-		0xf4, 0x9c, 0x42, 0xb8, 0x0f, 0xac, 0x63, 0x97, 0x49, 0x64, 0x65, 0x38, 0xaf, 0x49, 0xe0, 0x74,
-		0x4c, 0xdb, 0x2f, 0x95, 0xc2, 0x8a, 0x71, 0x63, 0x8d, 0xf0, 0x50, 0xb7, 0x91, 0xe4, 0x83, 0xfe,
-		0x5c, 0x46, 0xe5, 0x41, 0x42, 0xe5, 0x2b, 0x30, 0xd9, 0xa5, 0x4e, 0x65, 0x1b, 0x37, 0x3b, 0xf0,
-		0x0b, 0x14, 0xfe, 0x6c, 0xac, 0xe2, 0x94, 0x0c, 0xcd, 0x65, 0x61, 0x53, 0x38, 0xd0, 0xe2, 0x0d,
-		0x47, 0xca, 0x93, 0xc3, 0xbd, 0x94, 0x27, 0x23, 0xbd, 0x97, 0x23, 0x9c, 0xde, 0xcb, 0xce, 0x4d,
-		0x6b, 0x5f, 0xfa, 0xdc, 0xc2, 0xe8, 0x2e, 0x72, 0x0b, 0x63, 0xbb, 0x6b, 0xab, 0xbc, 0x06, 0x05,
-		0x03, 0x57, 0xf5, 0x1d, 0x4f, 0x35, 0x93, 0x7b, 0x44, 0x81, 0x52, 0x53, 0x55, 0x44, 0xcf, 0xc2,
-		0xd0, 0xe7, 0x4d, 0x42, 0xfc, 0xff, 0xff, 0xd0, 0xee, 0x0e, 0x15, 0x32, 0x17, 0x3c, 0x72, 0xca,
-		0xad, 0xbe, 0x9e, 0x85, 0xf3, 0x69, 0x7f, 0xd1, 0xf6, 0xf1, 0x3b, 0xa7, 0x55, 0x3f, 0xca, 0xf0,
-		0xea, 0x64, 0x97, 0x53, 0xff, 0x1c, 0x2b, 0x10, 0x5c, 0x74, 0x99, 0x59, 0x7f, 0xd0, 0xcc, 0xf8,
-		0x47, 0x68, 0x4e, 0x70, 0x84, 0xee, 0x51, 0x26, 0x51, 0xfd, 0x43, 0x06, 0xe6, 0xd2, 0xfc, 0x5c,
-		0x4f, 0xb8, 0x1f, 0xfc, 0xb3, 0x3b, 0xb3, 0xdb, 0xb3, 0x7b, 0xaf, 0x76, 0x91, 0xbf, 0xba, 0x7d,
-		0x82, 0xd5, 0xed, 0xd8, 0x76, 0xbf, 0x7c, 0x16, 0xe5, 0xc3, 0x0c, 0xa4, 0xfc, 0x21, 0xe1, 0x27,
-		0x63, 0x31, 0x79, 0x45, 0xa1, 0x7e, 0x6e, 0x51, 0xa8, 0xd3, 0xcd, 0x90, 0x93, 0xef, 0x66, 0x50,
-		0xff, 0x99, 0x81, 0xb3, 0x7b, 0xe1, 0x51, 0x3e, 0xa1, 0x8b, 0xde, 0x95, 0xaf, 0xcf, 0xa5, 0xc8,
-		0xd7, 0xab, 0xff, 0xca, 0xc0, 0xb9, 0x54, 0xbf, 0xeb, 0x7c, 0xbc, 0xf0, 0x91, 0x85, 0xf7, 0x13,
-		0x92, 0xb9, 0x34, 0x59, 0xea, 0x2f, 0x67, 0x45, 0x0b, 0x2f, 0xea, 0x40, 0x79, 0xbc, 0xf0, 0xb1,
-		0x0d, 0x30, 0xb9, 0x5e, 0x3a, 0xe7, 0x7f, 0x9d, 0x81, 0xf9, 0x94, 0xbf, 0xb7, 0x7d, 0xbc, 0x0f,
-		0x81, 0x7d, 0x98, 0x25, 0xb0, 0x8f, 0xfe, 0xb9, 0x62, 0x56, 0x09, 0xb6, 0xe9, 0xa7, 0x8e, 0xc2,
-		0xd4, 0xf2, 0xfd, 0xe5, 0x5b, 0x6b, 0xe5, 0x95, 0xd2, 0xea, 0xda, 0xb2, 0x56, 0x5e, 0xfb, 0xcc,
-		0x9d, 0xe5, 0x72, 0xe9, 0xd6, 0xfd, 0x1b, 0xab, 0xa5, 0x9b, 0xa3, 0x4f, 0xa0, 0xe3, 0x70, 0x38,
-		0xfa, 0xfa, 0xc6, 0xea, 0x6a, 0x99, 0x8e, 0x8e, 0x2a, 0xe8, 0x04, 0x1c, 0x8d, 0x12, 0x2c, 0xad,
-		0xde, 0xbe, 0xbb, 0xcc, 0x48, 0x32, 0x8b, 0x2f, 0xc3, 0xc1, 0x8a, 0x55, 0xe3, 0xad, 0xc1, 0xa2,
-		0xff, 0x1f, 0x5b, 0xef, 0xb8, 0x71, 0xec, 0x1d, 0xe5, 0xb3, 0x17, 0x1e, 0x98, 0x64, 0xb3, 0xb9,
-		0x5e, 0xac, 0x58, 0xb5, 0xf9, 0xee, 0xff, 0x1c, 0x7b, 0xce, 0x34, 0xaa, 0xf3, 0x0f, 0x2c, 0xef,
-		0xbf, 0xd5, 0xb2, 0x7f, 0x23, 0x7b, 0x5d, 0x6f, 0x98, 0xdb, 0x17, 0xd6, 0x73, 0x74, 0xec, 0xe2,
-		0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xeb, 0xec, 0x99, 0xe6, 0x29, 0x57, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4d, 0x6c, 0x1c, 0xc7,
+		0x95, 0x76, 0xcf, 0x90, 0x43, 0xcd, 0x1b, 0x92, 0x22, 0x8b, 0x14, 0x35, 0x94, 0x28, 0x89, 0x6a,
+		0xc9, 0x12, 0x4d, 0x91, 0x43, 0x89, 0x92, 0x25, 0x4b, 0xf2, 0xcf, 0x8a, 0x14, 0x09, 0x0d, 0xc0,
+		0x95, 0xb8, 0x2d, 0x4a, 0xde, 0x5d, 0x18, 0x98, 0x6d, 0x4e, 0x17, 0xc5, 0x5e, 0xce, 0x4c, 0x8f,
+		0xbb, 0x6b, 0x38, 0xe2, 0x62, 0xf7, 0xb4, 0x87, 0x05, 0x16, 0x36, 0x12, 0xc3, 0x08, 0x10, 0x23,
+		0x31, 0x12, 0x04, 0x48, 0x60, 0x07, 0x01, 0x1c, 0x24, 0x08, 0x92, 0x20, 0x97, 0x24, 0x40, 0x90,
+		0x00, 0x09, 0x9c, 0x9c, 0x72, 0xc9, 0x35, 0x87, 0xf8, 0xe6, 0x43, 0x9c, 0x5b, 0x80, 0xa0, 0xab,
+		0xab, 0xe7, 0xa7, 0xbb, 0xaa, 0xbb, 0x7a, 0x48, 0xd9, 0x09, 0xac, 0x1b, 0xbb, 0xfa, 0xbd, 0xd7,
+		0xdf, 0xab, 0x7a, 0xef, 0xd5, 0xab, 0x7a, 0x6f, 0x08, 0xa7, 0x1b, 0x9b, 0xd8, 0x5e, 0x28, 0xeb,
+		0x06, 0xae, 0x95, 0xf1, 0x82, 0x5e, 0x37, 0x17, 0x76, 0x2f, 0x2d, 0x6c, 0x9b, 0x0e, 0xb1, 0xec,
+		0xbd, 0x42, 0xdd, 0xb6, 0x88, 0x85, 0xc6, 0x5c, 0x92, 0x02, 0x23, 0x29, 0xe8, 0x75, 0xb3, 0xb0,
+		0x7b, 0xe9, 0xd8, 0xc9, 0x47, 0x96, 0xf5, 0xa8, 0x82, 0x17, 0x28, 0xc9, 0x66, 0x63, 0x6b, 0xc1,
+		0x68, 0xd8, 0x3a, 0x31, 0xad, 0x9a, 0xc7, 0x74, 0xec, 0x54, 0xf0, 0x3d, 0x31, 0xab, 0xd8, 0x21,
+		0x7a, 0xb5, 0xce, 0x08, 0xa6, 0x79, 0x1f, 0x2e, 0x5b, 0xd5, 0x6a, 0x4b, 0x84, 0xca, 0xa3, 0x20,
+		0xba, 0xb3, 0x53, 0x31, 0x1d, 0x12, 0x45, 0xd3, 0xb4, 0xec, 0x9d, 0xad, 0x8a, 0xd5, 0xf4, 0x68,
+		0xd4, 0xdb, 0x30, 0x70, 0xc7, 0x53, 0x08, 0x5d, 0x87, 0x0c, 0xde, 0xc5, 0x35, 0xe2, 0xe4, 0x95,
+		0xe9, 0xf4, 0x4c, 0x6e, 0xf1, 0x74, 0x81, 0xa3, 0x5b, 0x81, 0x51, 0xaf, 0xb8, 0x94, 0x1a, 0x63,
+		0x50, 0x3f, 0xba, 0x06, 0x83, 0x9d, 0x2f, 0xd0, 0x24, 0x1c, 0xa2, 0xaf, 0x4a, 0xa6, 0x91, 0x57,
+		0xa6, 0x95, 0x99, 0xb4, 0x36, 0x40, 0x9f, 0x8b, 0x06, 0xba, 0x0e, 0xe0, 0xbd, 0x72, 0x95, 0xce,
+		0xa7, 0xa6, 0x95, 0x99, 0xdc, 0xe2, 0xb1, 0x82, 0x37, 0x23, 0x05, 0x7f, 0x46, 0x0a, 0x1b, 0xfe,
+		0x8c, 0x68, 0x59, 0x4a, 0xed, 0x3e, 0xa3, 0x3c, 0x0c, 0xec, 0x62, 0xdb, 0x31, 0xad, 0x5a, 0x3e,
+		0xed, 0x09, 0x65, 0x8f, 0xe8, 0x28, 0x0c, 0xb8, 0xca, 0xbb, 0x9f, 0xeb, 0xa3, 0x6f, 0x32, 0xee,
+		0x63, 0xd1, 0x40, 0x5f, 0x55, 0xe0, 0x82, 0xaf, 0x72, 0x09, 0x3f, 0xc6, 0xe5, 0x86, 0xbb, 0x0e,
+		0x25, 0x87, 0xe8, 0x36, 0xc1, 0x46, 0xc9, 0x43, 0xa2, 0x13, 0x62, 0x9b, 0x9b, 0x0d, 0x82, 0x9d,
+		0x7c, 0x3f, 0xc5, 0xf3, 0x22, 0x57, 0xf5, 0x57, 0x99, 0x9c, 0x15, 0x5f, 0xcc, 0x7d, 0x4f, 0x0a,
+		0x55, 0xf9, 0x56, 0x4b, 0xc6, 0x9d, 0x67, 0xb4, 0xf3, 0x4d, 0x39, 0x52, 0xf4, 0x0d, 0x05, 0xe6,
+		0x39, 0xf0, 0xca, 0x56, 0xb5, 0x5e, 0xc1, 0x5c, 0x80, 0x19, 0x0a, 0xf0, 0x65, 0x39, 0x80, 0xcb,
+		0xbe, 0x9c, 0x30, 0xc4, 0xe7, 0x9a, 0xb2, 0xc4, 0xe8, 0x1d, 0x05, 0x66, 0x39, 0x20, 0xb7, 0x74,
+		0xb3, 0xc2, 0x43, 0x38, 0x40, 0x11, 0xde, 0x94, 0x43, 0xb8, 0x4a, 0x85, 0x84, 0xe1, 0x9d, 0x6b,
+		0x4a, 0x51, 0xa2, 0xaf, 0xf3, 0x27, 0xd0, 0xb5, 0x2d, 0xa3, 0x64, 0x35, 0x48, 0x18, 0xde, 0x21,
+		0x0a, 0xef, 0x25, 0x39, 0x78, 0xae, 0xd9, 0x19, 0xf7, 0x1a, 0x24, 0x0c, 0x70, 0xa6, 0x29, 0x49,
+		0x8b, 0xde, 0x56, 0x60, 0xc6, 0xc0, 0x65, 0xd3, 0xa1, 0xc0, 0x5c, 0x2b, 0x75, 0xca, 0xdb, 0xd8,
+		0x68, 0x70, 0x27, 0x2f, 0x4b, 0xd1, 0x5d, 0xe7, 0xa2, 0xbb, 0xcd, 0x84, 0x6c, 0xe8, 0xce, 0xce,
+		0x7d, 0x5f, 0x44, 0x18, 0xd9, 0x59, 0x43, 0x82, 0x0e, 0xbd, 0xa9, 0xc0, 0xb9, 0x00, 0x2a, 0x91,
+		0x4f, 0x00, 0xc5, 0x74, 0x2d, 0x1e, 0x93, 0xc8, 0x1d, 0x54, 0x23, 0x96, 0x8a, 0x33, 0x4b, 0x11,
+		0x4e, 0x90, 0x93, 0x9c, 0xa5, 0x08, 0xfb, 0x3f, 0x6b, 0x48, 0xd0, 0xa1, 0xb7, 0x42, 0xa8, 0x22,
+		0x2c, 0x6b, 0x90, 0xa2, 0x7a, 0x21, 0x16, 0x95, 0xd8, 0xa8, 0xce, 0x18, 0xf1, 0x64, 0xe8, 0xff,
+		0x15, 0x78, 0xb6, 0x1b, 0x93, 0xc8, 0x13, 0x87, 0x28, 0xa0, 0xab, 0xb1, 0x80, 0x44, 0x4e, 0x78,
+		0xda, 0x88, 0x23, 0xa2, 0xcb, 0xa6, 0x97, 0x89, 0xb9, 0x6b, 0x92, 0xbd, 0x58, 0xe3, 0x1e, 0x8e,
+		0x58, 0xb6, 0x5b, 0x4c, 0x48, 0x9c, 0x71, 0xeb, 0x12, 0x74, 0xd4, 0xb8, 0x03, 0xa8, 0x44, 0xc6,
+		0x7d, 0x38, 0xc2, 0xb8, 0xbb, 0x30, 0x09, 0x8d, 0x5b, 0x8f, 0xa5, 0xe2, 0xcc, 0x52, 0x84, 0x71,
+		0x8f, 0x48, 0xce, 0x52, 0x94, 0x71, 0xeb, 0x12, 0x74, 0xd4, 0x90, 0xba, 0x51, 0x89, 0x0c, 0x69,
+		0x34, 0xc2, 0x90, 0x3a, 0x21, 0x09, 0x0d, 0x49, 0x8f, 0x23, 0xa2, 0x9e, 0xd6, 0x0d, 0x26, 0xc2,
+		0xd3, 0x50, 0x84, 0xa7, 0x75, 0xe2, 0x89, 0xf0, 0x34, 0x3d, 0x9e, 0x0c, 0x35, 0xe1, 0xa4, 0x0b,
+		0xc2, 0x16, 0x5b, 0xcf, 0x18, 0x05, 0x72, 0x91, 0x0b, 0xc4, 0x95, 0x6a, 0x0b, 0xcd, 0xe6, 0x38,
+		0x11, 0xbf, 0x46, 0xaf, 0xc3, 0x94, 0xf7, 0xe1, 0x2d, 0xd3, 0xe6, 0x7d, 0x76, 0x9c, 0x7e, 0xb6,
+		0x20, 0xfe, 0xec, 0xaa, 0x69, 0x87, 0xa4, 0xde, 0x79, 0x46, 0x9b, 0x24, 0xa2, 0x97, 0xe8, 0x5b,
+		0x0a, 0x2c, 0x04, 0x4c, 0x54, 0xaf, 0x95, 0x71, 0xa5, 0x64, 0xe3, 0xd7, 0x1b, 0xd8, 0xe1, 0x6a,
+		0x7f, 0x84, 0xc2, 0x78, 0x25, 0xde, 0x52, 0xa9, 0x24, 0xcd, 0x17, 0x14, 0xc6, 0x35, 0xab, 0x4b,
+		0x53, 0xa3, 0xef, 0x2b, 0x70, 0x85, 0x61, 0xf2, 0x21, 0xca, 0x19, 0xf1, 0x04, 0x45, 0xbb, 0xcc,
+		0x45, 0xcb, 0xbe, 0xe6, 0x7d, 0x5a, 0xc6, 0xa2, 0x0b, 0x76, 0x22, 0x0e, 0xf4, 0x45, 0x05, 0xce,
+		0xf3, 0xa6, 0x97, 0x07, 0xf4, 0xa8, 0xa4, 0x75, 0x2f, 0x33, 0x09, 0x31, 0xd6, 0x2d, 0x20, 0x43,
+		0xff, 0x05, 0xa7, 0x3c, 0x23, 0x13, 0x23, 0xc9, 0x53, 0x24, 0x97, 0xc4, 0x76, 0x26, 0x86, 0x30,
+		0x45, 0x22, 0xde, 0xa3, 0xff, 0x53, 0xe0, 0x2c, 0x5b, 0x3c, 0x66, 0xe8, 0x82, 0x45, 0x9b, 0xa4,
+		0x08, 0x9e, 0xe7, 0x22, 0xf0, 0x84, 0x7b, 0xf6, 0x2e, 0x58, 0xa6, 0xe9, 0x72, 0x0c, 0x0d, 0xfa,
+		0x1f, 0x98, 0xae, 0xea, 0xf6, 0x0e, 0xb6, 0x4b, 0x36, 0x2e, 0x5b, 0xb6, 0xc1, 0x03, 0x71, 0x8c,
+		0x82, 0x58, 0xe4, 0x82, 0xf8, 0x67, 0xca, 0xac, 0x31, 0xde, 0x30, 0x82, 0x13, 0xd5, 0x28, 0x02,
+		0xf4, 0x35, 0x05, 0xe6, 0x78, 0xe7, 0x13, 0xf3, 0x51, 0x4d, 0xe7, 0x4e, 0xc8, 0xf1, 0x24, 0xe9,
+		0xeb, 0x7d, 0x26, 0x46, 0x26, 0x7d, 0x15, 0xd0, 0xa2, 0x6f, 0x2a, 0x50, 0xe0, 0x20, 0x24, 0xd8,
+		0xae, 0x9a, 0x35, 0x9d, 0x1b, 0x17, 0xa6, 0x22, 0xe2, 0x42, 0x38, 0xc5, 0x6e, 0x09, 0xe2, 0xc4,
+		0x85, 0xa6, 0x34, 0x35, 0xfa, 0x81, 0x02, 0x57, 0x78, 0x47, 0xa9, 0xd8, 0x28, 0x76, 0x82, 0xa2,
+		0xbd, 0x2d, 0x79, 0xa2, 0x8a, 0x0b, 0x65, 0x0b, 0xcd, 0x64, 0x2c, 0x22, 0x0b, 0x10, 0x3b, 0xe5,
+		0xc9, 0x24, 0x16, 0x20, 0x76, 0xd0, 0x99, 0xa6, 0x24, 0x2d, 0xfa, 0xa3, 0x02, 0x2b, 0x81, 0x88,
+		0x8b, 0x1f, 0x13, 0x6c, 0xd7, 0xf4, 0x4a, 0x89, 0x83, 0xdc, 0xac, 0x99, 0xc4, 0xe4, 0x1b, 0xc6,
+		0x29, 0x0a, 0xfd, 0x7e, 0x7c, 0x08, 0x5e, 0x61, 0xf2, 0x43, 0xfa, 0x14, 0x7d, 0xe1, 0x61, 0x85,
+		0x5e, 0xb6, 0xf7, 0x25, 0x01, 0xfd, 0x41, 0x81, 0xa5, 0x04, 0x6a, 0x8a, 0x22, 0xd6, 0x34, 0xd5,
+		0x71, 0x7d, 0x1f, 0x3a, 0x8a, 0x82, 0xd9, 0x4d, 0xbb, 0x77, 0x76, 0xf4, 0xa1, 0x02, 0x2f, 0x45,
+		0xa9, 0x13, 0xef, 0x27, 0xa7, 0xa9, 0x62, 0x6b, 0x5c, 0xc5, 0x84, 0x60, 0x62, 0xfd, 0xe5, 0x1a,
+		0xee, 0x8d, 0x95, 0xe6, 0x01, 0x3c, 0x3d, 0xac, 0x1a, 0x31, 0x6b, 0x0d, 0x6c, 0x94, 0x74, 0xa7,
+		0x54, 0xc3, 0xcd, 0xb0, 0x1e, 0x6a, 0x44, 0x1e, 0x10, 0x06, 0xe1, 0x8b, 0xbb, 0xe5, 0xdc, 0xc5,
+		0xcd, 0x30, 0xfc, 0x42, 0x33, 0x11, 0x07, 0xfa, 0xb9, 0x02, 0xd7, 0x69, 0x36, 0x59, 0x2a, 0x6f,
+		0x9b, 0x15, 0x23, 0xa1, 0xff, 0x9c, 0xa1, 0xd0, 0xef, 0x70, 0xa1, 0xd3, 0x54, 0x72, 0xd9, 0x15,
+		0x9a, 0xc4, 0x69, 0x2e, 0x3b, 0xc9, 0xd9, 0xd0, 0x8f, 0x15, 0xb8, 0x1a, 0xa3, 0x84, 0xc8, 0x3b,
+		0xce, 0x52, 0x0d, 0x56, 0x92, 0x6a, 0x20, 0x72, 0x89, 0x8b, 0x4e, 0x42, 0x1e, 0xf4, 0x1d, 0x05,
+		0x2e, 0x09, 0x51, 0x0b, 0xf3, 0xfc, 0x67, 0x29, 0xec, 0x5b, 0xfc, 0x34, 0x84, 0xfb, 0x75, 0x61,
+		0xe2, 0x3f, 0x57, 0x4e, 0x40, 0x8f, 0xbe, 0xa7, 0xc0, 0x65, 0x21, 0xdc, 0x88, 0x43, 0xe4, 0xb9,
+		0x08, 0x23, 0xe7, 0x03, 0x8e, 0x38, 0x4e, 0x16, 0xca, 0x89, 0x38, 0xd0, 0xfb, 0x0a, 0x5c, 0x4c,
+		0x6c, 0x19, 0xe7, 0x29, 0xe2, 0x7f, 0x4a, 0x80, 0x58, 0x64, 0x14, 0x17, 0xca, 0x09, 0xec, 0xe1,
+		0x03, 0x05, 0x16, 0xc5, 0x13, 0x2c, 0xdc, 0x84, 0x67, 0x28, 0xda, 0xa5, 0x24, 0xf3, 0x2b, 0xdc,
+		0x89, 0xe7, 0xcb, 0x49, 0x18, 0xd0, 0x77, 0xa3, 0x4c, 0x22, 0xe2, 0xd0, 0xfc, 0x5c, 0x62, 0xc8,
+		0xe2, 0xe3, 0xf3, 0x7c, 0x39, 0x09, 0x03, 0xcd, 0xcd, 0xc4, 0x90, 0x23, 0x32, 0xc9, 0xd9, 0x88,
+		0xdc, 0x4c, 0x80, 0x39, 0x22, 0x9d, 0x5c, 0x28, 0x27, 0x63, 0xa1, 0x9b, 0xa6, 0x97, 0x8a, 0xf7,
+		0x9a, 0xf1, 0x5c, 0x88, 0xd8, 0x34, 0xbd, 0x8c, 0xbb, 0x97, 0x54, 0xe7, 0x9a, 0xd3, 0x1b, 0x2b,
+		0xfa, 0x85, 0x02, 0x37, 0x24, 0x14, 0x12, 0xf9, 0xe8, 0x1c, 0xd5, 0xa6, 0xd8, 0x8b, 0x36, 0x22,
+		0x67, 0xbd, 0xe2, 0xf4, 0xc0, 0x87, 0x7e, 0xa4, 0xc0, 0xf3, 0x51, 0x0a, 0x88, 0xcf, 0x4f, 0xf3,
+		0x11, 0x1b, 0x90, 0x10, 0x84, 0xf8, 0x1c, 0x75, 0x11, 0x27, 0xe4, 0xa1, 0x01, 0xa7, 0x51, 0x77,
+		0xb0, 0x4d, 0xda, 0xc0, 0x1d, 0xac, 0xdb, 0xe5, 0xed, 0x0e, 0x98, 0x61, 0xdc, 0x85, 0x08, 0xef,
+		0x7d, 0x40, 0xc5, 0xf9, 0x08, 0xee, 0x53, 0x61, 0xed, 0x2f, 0x72, 0xbc, 0xb7, 0x91, 0x84, 0x61,
+		0x69, 0x10, 0xa0, 0x0d, 0x44, 0xfd, 0x78, 0x08, 0xce, 0xcb, 0xee, 0x5e, 0xab, 0x30, 0xd4, 0xd2,
+		0x91, 0xec, 0xd5, 0x31, 0xad, 0x05, 0x8a, 0x2a, 0x8b, 0xbe, 0xd0, 0x8d, 0xbd, 0x3a, 0xd6, 0x06,
+		0x9b, 0x1d, 0x4f, 0xe8, 0x35, 0x38, 0x52, 0xd7, 0x6d, 0x77, 0x46, 0x3a, 0x9d, 0x6e, 0xcb, 0x62,
+		0xe5, 0xc3, 0x19, 0xae, 0xbc, 0x75, 0xca, 0xd1, 0xe1, 0x13, 0x5b, 0x96, 0x36, 0x56, 0x0f, 0x0f,
+		0xa2, 0x1b, 0x90, 0xa5, 0x37, 0x32, 0x15, 0xd3, 0x21, 0xb4, 0xb0, 0x98, 0x5b, 0x3c, 0xc1, 0xbf,
+		0xf2, 0xd0, 0x9d, 0x9d, 0x35, 0xd3, 0x21, 0xda, 0x21, 0xc2, 0xfe, 0x42, 0x8b, 0xd0, 0x6f, 0xd6,
+		0xea, 0x0d, 0x42, 0xcb, 0x8e, 0xb9, 0xc5, 0x29, 0x01, 0x92, 0xbd, 0x8a, 0xa5, 0x1b, 0x9a, 0x47,
+		0x8a, 0x74, 0x98, 0x0e, 0xa4, 0x1c, 0x25, 0x62, 0x95, 0xca, 0x15, 0xcb, 0xc1, 0x34, 0x7e, 0x5b,
+		0x0d, 0xc2, 0xea, 0x90, 0x93, 0xa1, 0xba, 0xe8, 0x6d, 0x56, 0x49, 0xd6, 0xa6, 0x70, 0xd7, 0xdc,
+		0x6f, 0x58, 0xcb, 0x2e, 0xff, 0x86, 0xc7, 0x8e, 0x5e, 0x85, 0xe3, 0xed, 0x6b, 0xef, 0xb0, 0xf4,
+		0x4c, 0x9c, 0xf4, 0xa3, 0xc4, 0xbf, 0xcc, 0x0e, 0x08, 0xbe, 0x09, 0xc7, 0xda, 0x19, 0x76, 0x5b,
+		0x0b, 0xbb, 0x51, 0x73, 0x6b, 0xaf, 0x6e, 0xe9, 0x2f, 0xab, 0x1d, 0x6d, 0x51, 0xb4, 0xe6, 0x59,
+		0x6b, 0xd4, 0x8a, 0x06, 0x2a, 0x42, 0x96, 0x85, 0x4a, 0xcb, 0xa6, 0x75, 0xb8, 0xe1, 0xc5, 0x0b,
+		0xfc, 0xd0, 0xce, 0x04, 0xd0, 0x14, 0xba, 0xe8, 0xb3, 0x68, 0x6d, 0x6e, 0x54, 0x84, 0xd1, 0x36,
+		0x0e, 0x37, 0x5c, 0x35, 0x6c, 0x9c, 0xcf, 0x46, 0xac, 0xc1, 0xaa, 0x47, 0xa3, 0x8d, 0xb4, 0xd8,
+		0xd8, 0x08, 0xd2, 0x60, 0xa2, 0xa2, 0xbb, 0x67, 0x3e, 0x2f, 0x9d, 0xa1, 0xea, 0x60, 0xa7, 0x51,
+		0x21, 0x79, 0x88, 0x90, 0xe7, 0xaf, 0xe9, 0xb8, 0xcb, 0xbb, 0xdc, 0x62, 0xd5, 0x28, 0x27, 0xba,
+		0x0e, 0x93, 0x96, 0x6d, 0x3e, 0x32, 0xbd, 0x40, 0x1b, 0x98, 0xa5, 0x1c, 0x9d, 0xa5, 0x09, 0x9f,
+		0x20, 0x30, 0x49, 0xc7, 0xe0, 0x90, 0x69, 0xe0, 0x1a, 0x31, 0xc9, 0x1e, 0xad, 0x28, 0x65, 0xb5,
+		0xd6, 0x33, 0xba, 0x0c, 0x13, 0x5b, 0xa6, 0xed, 0x90, 0xb0, 0xcc, 0x21, 0x4a, 0x39, 0x46, 0xdf,
+		0x06, 0x04, 0x2e, 0xc3, 0xa0, 0x8d, 0x89, 0xbd, 0x57, 0xaa, 0x5b, 0x15, 0xb3, 0xbc, 0xc7, 0xaa,
+		0x30, 0xd3, 0x82, 0x03, 0x2a, 0xb1, 0xf7, 0xd6, 0x29, 0x9d, 0x96, 0xb3, 0xdb, 0x0f, 0x6e, 0xe9,
+		0x5d, 0x27, 0x04, 0x57, 0xeb, 0x84, 0x56, 0x4c, 0xfa, 0x35, 0xff, 0x11, 0x2d, 0xc3, 0x61, 0xfc,
+		0xb8, 0x6e, 0x7a, 0x86, 0xe3, 0x15, 0xf5, 0x47, 0x62, 0x8b, 0xfa, 0xc3, 0x6d, 0x16, 0x77, 0x10,
+		0x9d, 0x81, 0xa1, 0xb2, 0xed, 0x7a, 0x03, 0xab, 0xe8, 0xd0, 0x8a, 0x43, 0x56, 0x1b, 0x74, 0x07,
+		0xfd, 0x2a, 0x0f, 0xfa, 0x57, 0x38, 0xee, 0x69, 0xdf, 0x5d, 0xfd, 0xda, 0xd4, 0xcb, 0x3b, 0xd6,
+		0xd6, 0x56, 0x1e, 0xc5, 0x19, 0x75, 0x9e, 0x72, 0x77, 0x16, 0xbe, 0x96, 0x3c, 0x56, 0x34, 0x0f,
+		0x7d, 0x55, 0x5c, 0xb5, 0xd8, 0x75, 0xfe, 0x24, 0xff, 0xa2, 0x0f, 0x57, 0x2d, 0x8d, 0x92, 0x21,
+		0x0d, 0x46, 0x43, 0x11, 0x9b, 0xdd, 0xc9, 0x3f, 0xcb, 0xdf, 0x1b, 0x03, 0x11, 0x56, 0x1b, 0x71,
+		0x02, 0x23, 0xe8, 0x01, 0x4c, 0xd4, 0x6d, 0xbc, 0x5b, 0xd2, 0x1b, 0xc4, 0x72, 0xed, 0x0f, 0x93,
+		0x52, 0xdd, 0x32, 0x6b, 0xc4, 0xbf, 0x65, 0x17, 0xad, 0x97, 0x83, 0xc9, 0x3a, 0xa5, 0xd3, 0xc6,
+		0x5c, 0xfe, 0x5b, 0x0d, 0x62, 0x75, 0x0c, 0xa2, 0xcb, 0x90, 0xd9, 0xc6, 0xba, 0x81, 0x6d, 0x76,
+		0xfd, 0x7d, 0x9c, 0xdf, 0xd4, 0x41, 0x49, 0x34, 0x46, 0x8a, 0xd6, 0x60, 0xdc, 0x9b, 0xe8, 0x76,
+		0x2d, 0x8f, 0xae, 0xeb, 0xd1, 0xd8, 0x75, 0x45, 0x94, 0xaf, 0x55, 0x97, 0xa3, 0x6b, 0xfb, 0xdf,
+		0x30, 0x52, 0xd7, 0x6d, 0x62, 0xfa, 0xc7, 0xf3, 0x2d, 0xf3, 0x51, 0x3e, 0x4f, 0x3b, 0x4c, 0xfe,
+		0x65, 0x3f, 0x6d, 0x16, 0x85, 0x75, 0x5f, 0xe8, 0x32, 0x95, 0xb9, 0x52, 0x23, 0xf6, 0x9e, 0x76,
+		0xb8, 0xde, 0x3d, 0x7a, 0x6c, 0x09, 0xc6, 0x79, 0x84, 0x68, 0x04, 0xd2, 0x3b, 0x78, 0x8f, 0x6e,
+		0x48, 0x59, 0xcd, 0xfd, 0x13, 0x8d, 0x43, 0xff, 0xae, 0x5e, 0x69, 0x78, 0x3d, 0x29, 0x59, 0xcd,
+		0x7b, 0xb8, 0x91, 0x7a, 0x41, 0x51, 0xdf, 0x57, 0xe0, 0x39, 0xf9, 0xd3, 0xcf, 0x15, 0xc8, 0xb0,
+		0xf8, 0xa1, 0x48, 0xc4, 0x0f, 0x46, 0x8b, 0x56, 0x61, 0x3a, 0xba, 0xfc, 0x6d, 0x1a, 0x14, 0x58,
+		0x5a, 0x9b, 0x12, 0x57, 0xae, 0x8b, 0x86, 0xfa, 0x9e, 0x02, 0xe7, 0x24, 0x93, 0xa8, 0xab, 0x30,
+		0xe0, 0x47, 0x4e, 0x45, 0x22, 0x72, 0xfa, 0xc4, 0x07, 0x06, 0xd5, 0x82, 0x19, 0xe9, 0x13, 0xc4,
+		0x32, 0x0c, 0xb2, 0xcd, 0xab, 0x9d, 0x48, 0x0c, 0x0b, 0x9c, 0x82, 0xed, 0x55, 0x34, 0x8f, 0xc8,
+		0x91, 0xf6, 0x83, 0xfa, 0x1b, 0x05, 0xce, 0xca, 0x34, 0x51, 0x74, 0x67, 0x04, 0x4a, 0xb2, 0x8c,
+		0xe0, 0x2e, 0x4c, 0x08, 0x76, 0xdd, 0x54, 0x5c, 0x80, 0x1a, 0x73, 0x38, 0x3b, 0x6e, 0x47, 0xe4,
+		0x4d, 0x77, 0x45, 0x5e, 0xf5, 0x4d, 0x05, 0xd4, 0xf8, 0xfe, 0x0b, 0x34, 0x07, 0x28, 0x58, 0x93,
+		0x6f, 0x75, 0x65, 0x8d, 0x38, 0x5d, 0x53, 0x10, 0xd8, 0x7e, 0x52, 0x81, 0xed, 0xe7, 0x04, 0x80,
+		0x7f, 0x41, 0x6a, 0x1a, 0x14, 0x4d, 0x56, 0xcb, 0xb2, 0x91, 0xa2, 0xa1, 0x7e, 0x1c, 0x98, 0x5e,
+		0xa1, 0x87, 0x24, 0x43, 0x34, 0x03, 0x23, 0xdd, 0xf7, 0x32, 0x2d, 0xf3, 0x1a, 0x76, 0x3a, 0x34,
+		0x0e, 0x60, 0x4f, 0x07, 0xb0, 0x9f, 0x87, 0xc3, 0x9b, 0x66, 0x4d, 0xb7, 0xf7, 0x4a, 0xe5, 0x6d,
+		0x5c, 0xde, 0x71, 0x1a, 0x55, 0x9a, 0xb2, 0x65, 0xb5, 0x61, 0x6f, 0x78, 0x99, 0x8d, 0xa2, 0x0b,
+		0x30, 0xda, 0x7d, 0x9b, 0x88, 0x1f, 0x7b, 0xe9, 0xd8, 0xa0, 0x36, 0x82, 0x3b, 0x2f, 0xf9, 0xf0,
+		0x63, 0xa2, 0xbe, 0x91, 0x86, 0x33, 0x12, 0xad, 0x1d, 0x4f, 0x4c, 0xe3, 0xa0, 0x5b, 0xa4, 0x7b,
+		0x70, 0x0b, 0x74, 0x12, 0x72, 0x9b, 0xba, 0x83, 0xfd, 0x54, 0xc2, 0x9b, 0x96, 0xac, 0x3b, 0xe4,
+		0x25, 0x10, 0x53, 0x00, 0xee, 0x45, 0x2a, 0x7b, 0xdd, 0xef, 0x4d, 0x6c, 0x0d, 0x37, 0xbd, 0xb7,
+		0x73, 0x80, 0xb6, 0x2c, 0x7b, 0x87, 0x21, 0xf5, 0xfb, 0xf3, 0x32, 0x9e, 0x6a, 0xee, 0x1b, 0x8a,
+		0xf5, 0xa1, 0x37, 0x8e, 0x26, 0xdc, 0xe0, 0xa8, 0x3b, 0x56, 0x8d, 0xe5, 0x8a, 0xec, 0x09, 0xdd,
+		0x86, 0xfe, 0xb2, 0xde, 0x70, 0x30, 0x4b, 0x0b, 0x0b, 0xd2, 0x4d, 0x34, 0xcb, 0x2e, 0x97, 0xe6,
+		0x31, 0xab, 0xef, 0xa5, 0xe1, 0x74, 0x6c, 0x63, 0xcb, 0x13, 0x5b, 0x8c, 0x25, 0x5f, 0x07, 0x6f,
+		0x15, 0xe6, 0x24, 0xfb, 0x6e, 0x3a, 0x35, 0xe8, 0x8c, 0xc9, 0x7d, 0x49, 0x62, 0x72, 0xa7, 0xe9,
+		0xf7, 0x07, 0x4c, 0x3f, 0xb0, 0xbe, 0x99, 0xe8, 0xf5, 0x1d, 0x90, 0x5a, 0xdf, 0x43, 0x82, 0xf5,
+		0xe5, 0xb8, 0x59, 0x96, 0xe7, 0x66, 0xea, 0xbb, 0x19, 0x38, 0x2b, 0xd3, 0xf3, 0x83, 0x4e, 0x41,
+		0xae, 0x55, 0x38, 0x67, 0xcb, 0x94, 0xd5, 0xc0, 0x1f, 0x2a, 0x1a, 0xee, 0x21, 0xb3, 0x45, 0x40,
+		0x9d, 0x20, 0x15, 0x71, 0xc8, 0x6c, 0x7d, 0x92, 0x1e, 0x32, 0xf5, 0x8e, 0x27, 0xd7, 0x34, 0x0d,
+		0xab, 0xaa, 0x9b, 0x35, 0x16, 0x3b, 0xd8, 0x53, 0xf7, 0x66, 0xd0, 0xd7, 0xe3, 0xf1, 0x30, 0x23,
+		0x7f, 0x3c, 0xdc, 0x80, 0x49, 0xdf, 0x08, 0xc3, 0x7b, 0xc8, 0x40, 0xdc, 0x1e, 0x32, 0xe1, 0xf3,
+		0x06, 0xb6, 0x91, 0x80, 0x54, 0xb6, 0x45, 0x31, 0xa9, 0x87, 0x12, 0x48, 0xf5, 0x4e, 0x85, 0x4c,
+		0xaa, 0x78, 0xb3, 0xcb, 0xf6, 0xb4, 0xd9, 0xad, 0xc2, 0xe8, 0x36, 0xd6, 0x6d, 0xb2, 0x89, 0xf5,
+		0x36, 0x3a, 0x88, 0x13, 0x35, 0xd2, 0xe2, 0x69, 0xcb, 0x89, 0x4f, 0x51, 0x72, 0xf1, 0x29, 0x4a,
+		0xe8, 0xec, 0x34, 0xd8, 0xcb, 0xd9, 0xa9, 0x9d, 0x83, 0x0f, 0x49, 0xe7, 0xe0, 0xea, 0x9f, 0x14,
+		0x50, 0xe3, 0xfb, 0xcf, 0x3e, 0xb5, 0xcd, 0xbd, 0x33, 0x0d, 0xe9, 0xeb, 0x3e, 0x00, 0xbe, 0x02,
+		0x83, 0xf4, 0xfc, 0xec, 0xc7, 0xad, 0x7e, 0x89, 0xb8, 0x95, 0x73, 0x39, 0xd8, 0x83, 0xfa, 0x3b,
+		0xa5, 0x3b, 0x14, 0x1c, 0x70, 0x66, 0xcd, 0x9f, 0xa2, 0x54, 0x82, 0x70, 0x9f, 0x8e, 0xcd, 0x36,
+		0xfa, 0xba, 0x27, 0x53, 0xfd, 0xad, 0x02, 0xa7, 0xe3, 0x9b, 0x82, 0x7a, 0x4d, 0xc0, 0x3f, 0x0b,
+		0x8d, 0x7e, 0x92, 0x82, 0x33, 0x12, 0xad, 0x75, 0xae, 0x4e, 0x06, 0x26, 0xba, 0x59, 0x71, 0xa4,
+		0x16, 0xc9, 0x27, 0x7e, 0x62, 0x3a, 0x05, 0x33, 0xa4, 0xbe, 0x5e, 0x32, 0xa4, 0x7d, 0x9b, 0xf8,
+		0x97, 0x14, 0x98, 0x95, 0xef, 0x88, 0x93, 0xd9, 0xf3, 0x0e, 0xe6, 0x08, 0xf6, 0x81, 0x02, 0x09,
+		0x7b, 0xdf, 0xe2, 0xb1, 0x8d, 0xfb, 0x69, 0x10, 0x3b, 0x47, 0xd3, 0x07, 0x29, 0xc4, 0x69, 0x09,
+		0xc4, 0xef, 0x04, 0xec, 0x50, 0x54, 0x25, 0xeb, 0xd5, 0x0e, 0x57, 0x61, 0xba, 0xa2, 0x93, 0x8e,
+		0x1e, 0x90, 0x60, 0x47, 0x44, 0x7b, 0x66, 0x3d, 0x3a, 0xde, 0x52, 0x7a, 0x69, 0x13, 0xc7, 0x9e,
+		0xd3, 0x09, 0xec, 0xb9, 0x2f, 0xd6, 0x47, 0x03, 0x89, 0x9e, 0xfa, 0xa1, 0x02, 0xc7, 0x23, 0xba,
+		0x4e, 0xdd, 0x5f, 0xe5, 0x78, 0xdd, 0x76, 0xad, 0x75, 0x1b, 0xa0, 0xcf, 0x45, 0x03, 0xad, 0xc1,
+		0x91, 0xd6, 0x46, 0xbe, 0x65, 0xda, 0x09, 0x0e, 0xad, 0x88, 0xed, 0xe3, 0x6e, 0x57, 0x69, 0x92,
+		0xed, 0x57, 0x66, 0xb1, 0xff, 0x03, 0x26, 0x85, 0xed, 0xac, 0x51, 0xda, 0x48, 0xe7, 0xec, 0xea,
+		0x2f, 0x15, 0x98, 0x8a, 0xea, 0x64, 0x3c, 0x90, 0xaf, 0x1c, 0xd4, 0x7c, 0x44, 0x06, 0xe8, 0x1f,
+		0x2a, 0x30, 0x1d, 0xd7, 0x11, 0x19, 0xa5, 0xcd, 0x13, 0x75, 0xdb, 0x48, 0xe4, 0x7f, 0x1d, 0x80,
+		0x84, 0x8d, 0x37, 0x68, 0x01, 0xc6, 0x69, 0x6f, 0x4f, 0xf0, 0x1a, 0xdc, 0xd3, 0x69, 0xb4, 0x86,
+		0x9b, 0x81, 0x4b, 0xf0, 0x50, 0x25, 0x2a, 0xd5, 0x5b, 0x25, 0xea, 0x69, 0xad, 0x48, 0xbe, 0x56,
+		0x24, 0x63, 0x3b, 0x03, 0x12, 0xb6, 0x73, 0x0f, 0x26, 0xd8, 0x1d, 0x3f, 0xc3, 0x68, 0xd6, 0x08,
+		0xb6, 0x77, 0xf5, 0x4a, 0xfc, 0xb9, 0x65, 0x9c, 0x31, 0x52, 0x78, 0x45, 0xc6, 0xd6, 0x5d, 0x87,
+		0xca, 0xee, 0xab, 0x0e, 0xd5, 0x91, 0xc2, 0x41, 0x92, 0x14, 0x4e, 0x5c, 0x74, 0xca, 0xf5, 0x5c,
+		0x74, 0x6a, 0x9f, 0x33, 0x06, 0xe5, 0xef, 0xfa, 0xfd, 0xd2, 0xc7, 0xd0, 0x3e, 0x4a, 0x1f, 0xc3,
+		0xfb, 0x2a, 0x7d, 0xb8, 0x31, 0x78, 0x21, 0x69, 0xf7, 0x5f, 0x2b, 0x5a, 0x29, 0x9d, 0xd1, 0x2a,
+		0xea, 0x7c, 0xb3, 0x09, 0x47, 0x5b, 0x1d, 0x03, 0x81, 0x2a, 0xb2, 0xe7, 0xc7, 0xb3, 0x91, 0x3d,
+		0x01, 0xdd, 0x75, 0xe4, 0x23, 0x98, 0x37, 0xac, 0x7e, 0x5b, 0x81, 0x19, 0x81, 0x26, 0xbc, 0xe2,
+		0x78, 0xbc, 0x7b, 0x28, 0x12, 0xee, 0xd1, 0x91, 0xe9, 0xa4, 0x12, 0x64, 0x3a, 0xea, 0x27, 0x0a,
+		0x9c, 0x88, 0xec, 0x5e, 0x77, 0x53, 0x3d, 0xd6, 0x1b, 0x5f, 0xd3, 0xab, 0xfe, 0x54, 0x83, 0x37,
+		0x74, 0x57, 0xaf, 0xe2, 0x5e, 0x3f, 0x7d, 0x60, 0xbb, 0x4a, 0xdb, 0xe2, 0xfb, 0xe4, 0x4f, 0xd6,
+		0x5f, 0xe1, 0x2d, 0x92, 0xa8, 0x5b, 0xe3, 0x14, 0xe4, 0x58, 0xbf, 0x4c, 0xe7, 0x14, 0x78, 0x43,
+		0x74, 0x0a, 0x5a, 0x41, 0x3d, 0x25, 0x1f, 0xd4, 0x23, 0xee, 0xa9, 0xd5, 0x2f, 0x2b, 0x30, 0x9b,
+		0xa0, 0x43, 0xa9, 0x7d, 0x9f, 0xaa, 0x74, 0xdd, 0xa7, 0xf6, 0xba, 0x32, 0x51, 0xd0, 0x7e, 0x96,
+		0x82, 0x97, 0xf7, 0xd7, 0xa5, 0x7d, 0x60, 0x36, 0xdf, 0xbe, 0xab, 0x4b, 0x75, 0xdd, 0xd5, 0x3d,
+		0x00, 0x14, 0xee, 0x06, 0x62, 0xfe, 0x7d, 0x4e, 0xae, 0xda, 0xa8, 0x8d, 0x86, 0x5a, 0x7a, 0xdd,
+		0xcb, 0x8f, 0xb2, 0x55, 0x23, 0xb6, 0x55, 0xa1, 0x86, 0x36, 0xa8, 0xf9, 0x8f, 0xa8, 0x00, 0x63,
+		0x81, 0xc6, 0x36, 0xab, 0x56, 0xf1, 0x32, 0xf3, 0x43, 0xda, 0x68, 0x57, 0xbf, 0xd9, 0xbd, 0x5a,
+		0x65, 0x4f, 0x7d, 0x3b, 0x0d, 0x37, 0xf7, 0xd1, 0x05, 0x8e, 0x1e, 0x74, 0xc6, 0xbd, 0x61, 0xc1,
+		0x6f, 0x2c, 0xa4, 0x24, 0x77, 0x5d, 0x3b, 0x1f, 0xd0, 0x79, 0x52, 0x78, 0x87, 0xca, 0x5f, 0x97,
+		0xbe, 0xfd, 0xae, 0xcb, 0x1c, 0xa0, 0x60, 0xef, 0x1d, 0xab, 0x50, 0xa4, 0xb5, 0x11, 0xb3, 0xcb,
+		0x08, 0xbd, 0x2b, 0x2c, 0x7f, 0x15, 0x33, 0x5d, 0xab, 0xa8, 0xfe, 0x5e, 0x81, 0x6b, 0x3d, 0xb6,
+		0xb0, 0x0b, 0x30, 0x28, 0x02, 0x0c, 0x9f, 0xae, 0xe1, 0xaa, 0x5f, 0x48, 0xc3, 0xb5, 0x1e, 0xdb,
+		0x0c, 0xff, 0x51, 0x7d, 0x35, 0x10, 0xb1, 0xfb, 0xc4, 0x11, 0xbb, 0x5f, 0x3e, 0x62, 0x0b, 0x4d,
+		0x47, 0x14, 0x00, 0x06, 0x44, 0x01, 0xe0, 0x8d, 0x34, 0x5c, 0xe9, 0xa5, 0x55, 0x52, 0xce, 0xf3,
+		0xa5, 0x24, 0x3f, 0xf5, 0xfc, 0xb6, 0xe7, 0x7f, 0xa4, 0xc0, 0xc5, 0xa4, 0x6d, 0x9f, 0x7f, 0xd7,
+		0x2e, 0x2f, 0xde, 0xab, 0xd4, 0x5f, 0x2b, 0x30, 0x9f, 0xa8, 0x55, 0xf4, 0xc0, 0x42, 0x00, 0xf7,
+		0xd4, 0x90, 0xda, 0xdf, 0xa9, 0xe1, 0xdd, 0x2c, 0x5c, 0xee, 0xe1, 0x37, 0x2f, 0x1d, 0xcb, 0xa1,
+		0x74, 0x2d, 0xc7, 0x29, 0xc8, 0xb5, 0x96, 0x83, 0xd9, 0x7c, 0x56, 0x03, 0x7f, 0x88, 0x77, 0x85,
+		0x90, 0x3e, 0x80, 0x2b, 0x84, 0x5e, 0xeb, 0x89, 0xfd, 0x07, 0x7b, 0x85, 0x90, 0x79, 0xa2, 0x57,
+		0x08, 0x03, 0x3d, 0x5f, 0x21, 0x3c, 0x04, 0xd6, 0xb1, 0xcb, 0x24, 0xb2, 0x32, 0x9c, 0xd7, 0x24,
+		0x70, 0x2e, 0xa2, 0xed, 0x97, 0x4a, 0x61, 0xc5, 0xb8, 0xd1, 0x7a, 0x70, 0xa8, 0xd3, 0x49, 0xb2,
+		0xdd, 0xf1, 0x5c, 0xc6, 0xe4, 0x41, 0xc2, 0xe4, 0xcb, 0x90, 0xef, 0x30, 0xa7, 0x92, 0x8d, 0x1b,
+		0x6d, 0xf8, 0x39, 0x0a, 0x7f, 0x36, 0xd2, 0x70, 0x8a, 0x86, 0x86, 0x1b, 0x3e, 0x5e, 0xed, 0x48,
+		0x93, 0x37, 0x1c, 0x2a, 0x4f, 0x0e, 0xf5, 0x52, 0x9e, 0x0c, 0xf5, 0x5e, 0x0e, 0x73, 0x7a, 0x2f,
+		0xdb, 0x27, 0xad, 0xc3, 0xc9, 0xef, 0x16, 0x46, 0xf6, 0x71, 0xb7, 0x30, 0xba, 0xbf, 0xb6, 0xca,
+		0x1b, 0x90, 0x33, 0x70, 0x45, 0xdf, 0xf3, 0x4c, 0x33, 0xbe, 0x47, 0x14, 0x28, 0x35, 0x35, 0x45,
+		0xf4, 0x22, 0x0c, 0xfe, 0xa7, 0x49, 0x88, 0xff, 0xff, 0x1f, 0xf2, 0x63, 0x71, 0xcc, 0x39, 0x8f,
+		0x9c, 0x72, 0xab, 0x6f, 0xa5, 0xe1, 0x62, 0xd2, 0x5f, 0xb4, 0x7d, 0xf6, 0xc1, 0x69, 0xcd, 0xcf,
+		0x32, 0xbc, 0x3a, 0xd9, 0xd5, 0xc4, 0x3f, 0xc7, 0xea, 0x4a, 0x2e, 0x3a, 0xdc, 0xac, 0xbf, 0xdb,
+		0xcd, 0xf8, 0x5b, 0x68, 0x46, 0xb0, 0x85, 0x1e, 0xd0, 0x4d, 0xa2, 0xfa, 0xab, 0x14, 0xcc, 0x25,
+		0xf9, 0xb9, 0x9e, 0x70, 0x3d, 0xf8, 0x7b, 0x77, 0x6a, 0xbf, 0x7b, 0xf7, 0x41, 0xad, 0x22, 0x7f,
+		0x76, 0xfb, 0x04, 0xb3, 0xdb, 0xf6, 0xed, 0x7e, 0xf9, 0x5b, 0x94, 0x4f, 0x52, 0x90, 0xf0, 0x87,
+		0x84, 0x9f, 0x8f, 0xc9, 0xe4, 0x15, 0x85, 0xfa, 0xb9, 0x45, 0xa1, 0x76, 0x37, 0x43, 0x46, 0xbe,
+		0x9b, 0x41, 0xfd, 0x73, 0x0a, 0x2e, 0x1c, 0x44, 0x44, 0xf9, 0x9c, 0x4e, 0x7a, 0xc7, 0x7d, 0x7d,
+		0x26, 0xc1, 0x7d, 0xbd, 0xfa, 0x97, 0x14, 0xcc, 0x27, 0xfa, 0x5d, 0xe7, 0xd3, 0x89, 0x0f, 0x4d,
+		0xbc, 0x7f, 0x21, 0x99, 0x49, 0x72, 0x4b, 0xfd, 0xbf, 0x69, 0xd1, 0xc4, 0x8b, 0x3a, 0x50, 0x9e,
+		0x4e, 0x7c, 0x64, 0x03, 0x4c, 0xa6, 0x97, 0xce, 0xf9, 0x9f, 0xa6, 0x60, 0x21, 0xe1, 0xef, 0x6d,
+		0x9f, 0xae, 0x43, 0xd7, 0x3a, 0xcc, 0x12, 0x38, 0x4c, 0xff, 0x5c, 0x35, 0x2b, 0x04, 0xdb, 0xf4,
+		0x53, 0x27, 0x60, 0x72, 0xe5, 0xe1, 0xca, 0xdd, 0x8d, 0xd2, 0x6a, 0x71, 0x6d, 0x63, 0x45, 0x2b,
+		0x6d, 0xfc, 0xdb, 0xfa, 0x4a, 0xa9, 0x78, 0xf7, 0xe1, 0xad, 0xb5, 0xe2, 0xed, 0x91, 0x67, 0xd0,
+		0x29, 0x38, 0x1e, 0x7e, 0x7d, 0x6b, 0x6d, 0xad, 0x44, 0x47, 0x47, 0x14, 0x74, 0x1a, 0x4e, 0x84,
+		0x09, 0x96, 0xd7, 0xee, 0xdd, 0x5f, 0x61, 0x24, 0xa9, 0xa5, 0xd7, 0xe0, 0x68, 0xd9, 0xaa, 0xf2,
+		0xe6, 0x60, 0xc9, 0xff, 0x8f, 0xad, 0xeb, 0xb6, 0x45, 0xac, 0x75, 0xe5, 0xdf, 0x2f, 0x3d, 0x32,
+		0xc9, 0x76, 0x63, 0xb3, 0x50, 0xb6, 0xaa, 0x0b, 0x9d, 0xff, 0x39, 0x76, 0xde, 0x34, 0x2a, 0x0b,
+		0x8f, 0x2c, 0xef, 0xbf, 0xd5, 0xb2, 0x7f, 0x23, 0x7b, 0x53, 0xaf, 0x9b, 0xbb, 0x97, 0x36, 0x33,
+		0x74, 0xec, 0xf2, 0xdf, 0x06, 0x00, 0xeb, 0xec, 0x99, 0xe6, 0x29, 0x57, 0x00, 0x00,
 	},
 	// uber/cadence/api/v1/service_workflow.proto
 	[]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x5b, 0x6f, 0x1c, 0x49,
-		0xf5, 0x57, 0xcf, 0xd8, 0xce, 0xf8, 0xcc, 0xd8, 0x71, 0x2a, 0xb1, 0x3d, 0x99, 0xc4, 0x97, 0x74,
-		0x76, 0xf3, 0xf7, 0x3f, 0xbb, 0x19, 0x13, 0x67, 0x73, 0xd9, 0xec, 0xc2, 0xca, 0x99, 0xd8, 0x59,
-		0xa3, 0xcd, 0xca, 0xb4, 0x0d, 0x11, 0xbc, 0xb4, 0xca, 0xdd, 0x35, 0x33, 0xb5, 0xee, 0xe9, 0x6e,
-		0x57, 0x55, 0xdb, 0x3b, 0xcb, 0x03, 0x02, 0xad, 0x00, 0x71, 0x13, 0x3c, 0x22, 0x21, 0xf1, 0x00,
-		0xcf, 0xbc, 0xf0, 0x15, 0x10, 0x1f, 0x81, 0x77, 0x3e, 0x01, 0xdf, 0x60, 0x85, 0xba, 0xaa, 0x7a,
-		0x6e, 0xee, 0xee, 0xb1, 0x8d, 0x56, 0x09, 0xbc, 0xb9, 0xab, 0xce, 0xef, 0xd4, 0xb9, 0xd5, 0xa9,
-		0x73, 0x8e, 0x07, 0xee, 0x46, 0x07, 0x84, 0xad, 0x3b, 0xd8, 0x25, 0xbe, 0x43, 0xd6, 0x71, 0x48,
-		0xd7, 0x8f, 0xef, 0xaf, 0x73, 0xc2, 0x8e, 0xa9, 0x43, 0xec, 0x93, 0x80, 0x1d, 0x36, 0xbd, 0xe0,
-		0xa4, 0x1e, 0xb2, 0x40, 0x04, 0xe8, 0x6a, 0x4c, 0x5b, 0xd7, 0xb4, 0x75, 0x1c, 0xd2, 0xfa, 0xf1,
-		0xfd, 0xda, 0x72, 0x2b, 0x08, 0x5a, 0x1e, 0x59, 0x97, 0x24, 0x07, 0x51, 0x73, 0xdd, 0x8d, 0x18,
-		0x16, 0x34, 0xf0, 0x15, 0xa8, 0xb6, 0x9a, 0x76, 0x80, 0x13, 0x74, 0x3a, 0x3d, 0x8a, 0x5b, 0x69,
-		0x14, 0x6d, 0xca, 0x45, 0xc0, 0xba, 0x9a, 0x64, 0x25, 0x8d, 0xe4, 0x28, 0x22, 0x3d, 0x02, 0x33,
-		0x8d, 0x40, 0x60, 0x7e, 0xe8, 0x51, 0x2e, 0xf2, 0x68, 0x86, 0x55, 0x34, 0xff, 0x66, 0xc0, 0x8a,
-		0x45, 0xb8, 0xc0, 0x4c, 0xbc, 0xd2, 0x3b, 0x5b, 0x9f, 0x13, 0x27, 0x8a, 0x15, 0xb2, 0xc8, 0x51,
-		0x44, 0xb8, 0x40, 0x0b, 0x30, 0xe5, 0x06, 0x1d, 0x4c, 0xfd, 0xaa, 0xb1, 0x6a, 0xac, 0x4d, 0x5b,
-		0xfa, 0x0b, 0x7d, 0x17, 0x50, 0xc2, 0xcd, 0x26, 0x09, 0xa8, 0x5a, 0x58, 0x35, 0xd6, 0xca, 0x1b,
-		0x77, 0xea, 0x29, 0xb6, 0xab, 0x9f, 0x3e, 0xe2, 0xca, 0xc9, 0xe8, 0x12, 0xaa, 0x41, 0x89, 0xba,
-		0xc4, 0x17, 0x54, 0x74, 0xab, 0x45, 0x79, 0x60, 0xef, 0x3b, 0x16, 0x85, 0x11, 0xcc, 0x03, 0xbf,
-		0x3a, 0xa1, 0x44, 0x51, 0x5f, 0xe6, 0xcf, 0x4b, 0xb0, 0xb4, 0x77, 0x21, 0x25, 0x56, 0xa0, 0xdc,
-		0x53, 0x82, 0xba, 0x52, 0xfa, 0x69, 0x0b, 0x92, 0xa5, 0x1d, 0x17, 0x6d, 0xc3, 0x4c, 0x8f, 0x40,
-		0x74, 0x43, 0x22, 0x65, 0x2a, 0x6f, 0xdc, 0xca, 0x55, 0x70, 0xbf, 0x1b, 0x12, 0xab, 0x72, 0x32,
-		0xf0, 0x85, 0x9e, 0xc2, 0x74, 0xec, 0x1f, 0x3b, 0x76, 0x90, 0x94, 0xbe, 0xbc, 0xb1, 0x94, 0xca,
-		0x63, 0x1f, 0xf3, 0xc3, 0x4f, 0x28, 0x17, 0x56, 0x49, 0xe8, 0xbf, 0xd0, 0x06, 0x4c, 0x52, 0x3f,
-		0x8c, 0x44, 0x75, 0x52, 0xe2, 0x6e, 0xa6, 0xe2, 0x76, 0x71, 0xd7, 0x0b, 0xb0, 0x6b, 0x29, 0x52,
-		0x84, 0x61, 0xb5, 0xe7, 0x14, 0x5b, 0x3a, 0xd8, 0x16, 0x81, 0xed, 0x78, 0x01, 0x27, 0xb6, 0xa0,
-		0x1d, 0x12, 0x44, 0xa2, 0x3a, 0x25, 0xd9, 0x5d, 0xaf, 0xab, 0x90, 0xae, 0x27, 0x21, 0x5d, 0x7f,
-		0xae, 0x43, 0xda, 0xba, 0xd9, 0x63, 0x21, 0xad, 0xbb, 0x1f, 0x34, 0x62, 0xfc, 0xbe, 0x82, 0xa3,
-		0x57, 0x70, 0x43, 0xaa, 0x94, 0xc1, 0xfd, 0xd2, 0x38, 0xee, 0x8b, 0x31, 0x3a, 0x8d, 0xf1, 0x60,
-		0x08, 0x94, 0x46, 0x42, 0x60, 0x09, 0x80, 0x29, 0x9f, 0xc6, 0xfe, 0x9a, 0x96, 0xbb, 0xd3, 0x7a,
-		0x65, 0xc7, 0x45, 0x0e, 0x54, 0x07, 0xfc, 0x69, 0x33, 0x12, 0x71, 0x62, 0x87, 0x81, 0x47, 0x9d,
-		0x6e, 0x15, 0x56, 0x8d, 0xb5, 0xd9, 0x8d, 0xbb, 0xb9, 0x9e, 0xdb, 0x71, 0xad, 0x18, 0xb2, 0x2b,
-		0x11, 0xd6, 0xfc, 0x49, 0xda, 0x32, 0x6a, 0x40, 0x85, 0x11, 0xc1, 0xba, 0x09, 0xe3, 0xb2, 0xd4,
-		0x74, 0x35, 0x95, 0xb1, 0x15, 0x13, 0x6a, 0x76, 0x65, 0xd6, 0xff, 0x40, 0xb7, 0x61, 0xc6, 0x61,
-		0xb1, 0x6f, 0x9c, 0x36, 0x71, 0x23, 0x8f, 0x54, 0x2b, 0x52, 0x97, 0x4a, 0xbc, 0xb8, 0xa7, 0xd7,
-		0xd0, 0x3d, 0x98, 0xe8, 0x90, 0x4e, 0x50, 0x9d, 0xd1, 0xb6, 0x4c, 0x3b, 0xe1, 0x25, 0xe9, 0x04,
-		0x96, 0x24, 0x43, 0x16, 0x5c, 0xe1, 0x04, 0x33, 0xa7, 0x6d, 0x63, 0x21, 0x18, 0x3d, 0x88, 0x04,
-		0xe1, 0xd5, 0x59, 0x89, 0x7d, 0x3b, 0x15, 0xbb, 0x27, 0xa9, 0x37, 0x7b, 0xc4, 0xd6, 0x1c, 0x1f,
-		0x59, 0x41, 0x0f, 0x60, 0xaa, 0x4d, 0xb0, 0x4b, 0x58, 0xf5, 0xb2, 0x64, 0x74, 0x23, 0x95, 0xd1,
-		0xc7, 0x92, 0xc4, 0xd2, 0xa4, 0xe8, 0x29, 0x94, 0x5d, 0xe2, 0xe1, 0xae, 0x8a, 0x8d, 0xea, 0xdc,
-		0xb8, 0x50, 0x00, 0x49, 0x2d, 0x63, 0x01, 0x7d, 0x08, 0x95, 0xcf, 0xa8, 0x10, 0x84, 0x69, 0xf0,
-		0x95, 0x71, 0xe0, 0xb2, 0x22, 0x97, 0x68, 0xf3, 0x31, 0x2c, 0x67, 0x65, 0x02, 0x1e, 0x06, 0x3e,
-		0x27, 0x68, 0x1e, 0xa6, 0x58, 0xe4, 0xc7, 0xd1, 0xa3, 0x52, 0xc1, 0x24, 0x8b, 0xfc, 0x1d, 0xd7,
-		0x7c, 0x1f, 0x56, 0xb3, 0x33, 0x61, 0x3e, 0xf4, 0xef, 0x05, 0x58, 0xde, 0xa3, 0x2d, 0x1f, 0x7b,
-		0xff, 0x0d, 0x49, 0x74, 0xf8, 0x06, 0x4d, 0x8c, 0xde, 0xa0, 0x15, 0x28, 0x73, 0xa9, 0x8b, 0xed,
-		0xe3, 0x0e, 0x91, 0x29, 0x67, 0xda, 0x02, 0xb5, 0xf4, 0x29, 0xee, 0x10, 0xf4, 0x11, 0x54, 0x34,
-		0x81, 0x4a, 0x4a, 0x53, 0x67, 0x48, 0x4a, 0x9a, 0xe5, 0x8e, 0x4c, 0x4d, 0x55, 0xb8, 0xe4, 0x04,
-		0xbe, 0x60, 0x81, 0x27, 0x73, 0x44, 0xc5, 0x4a, 0x3e, 0xcd, 0x5b, 0xb0, 0x92, 0x69, 0x47, 0xe5,
-		0x02, 0xf3, 0x2b, 0x03, 0xfe, 0x4f, 0xd3, 0x50, 0xd1, 0xce, 0x4f, 0xfa, 0xaf, 0x60, 0x46, 0xe5,
-		0x26, 0xad, 0x9d, 0xb4, 0x7d, 0x79, 0x63, 0x23, 0xfd, 0x2a, 0xe4, 0xb1, 0xb2, 0x2a, 0x92, 0x51,
-		0xc2, 0x78, 0xc4, 0x46, 0x85, 0xb1, 0x36, 0x2a, 0xfe, 0x07, 0x36, 0x9a, 0x18, 0xb6, 0xd1, 0x26,
-		0xac, 0x8d, 0xd7, 0x3f, 0x3f, 0x5e, 0xff, 0x52, 0x80, 0x25, 0x8b, 0x70, 0xf2, 0xc6, 0xbc, 0xf9,
-		0xfd, 0x77, 0xbd, 0x38, 0xf8, 0xae, 0xa3, 0xc7, 0x50, 0x75, 0x89, 0x43, 0x79, 0xfc, 0x86, 0x35,
-		0xa9, 0x4f, 0x79, 0xdb, 0x26, 0xc7, 0xc4, 0xef, 0x05, 0x6e, 0xd1, 0x9a, 0x4f, 0xf6, 0xb7, 0xe5,
-		0xf6, 0x56, 0xbc, 0xbb, 0xe3, 0x8e, 0xc4, 0xf8, 0xe4, 0x68, 0x8c, 0xd7, 0xe1, 0x2a, 0x3f, 0xa4,
-		0xa1, 0xad, 0x7d, 0xc4, 0x08, 0x0e, 0x43, 0xaf, 0x2b, 0x23, 0xb9, 0x64, 0x5d, 0x89, 0xb7, 0x94,
-		0x89, 0x2d, 0xb5, 0x11, 0x27, 0x95, 0x2c, 0x7b, 0xe5, 0x5b, 0xfa, 0x0f, 0x05, 0x78, 0x5b, 0xdb,
-		0xb4, 0x81, 0x7d, 0x87, 0xfc, 0x2f, 0x24, 0x88, 0x6b, 0x30, 0xe9, 0xe0, 0x88, 0x27, 0xa9, 0x41,
-		0x7d, 0xa0, 0x07, 0xb0, 0xd0, 0xa4, 0x8c, 0x8b, 0xbe, 0x90, 0xb6, 0x36, 0xc8, 0x94, 0x24, 0xbb,
-		0x2a, 0x77, 0xfb, 0x32, 0x49, 0xf3, 0xac, 0xc1, 0x9d, 0x71, 0xd6, 0xd1, 0xd7, 0xfe, 0xaf, 0x05,
-		0xb8, 0xb5, 0x4f, 0x58, 0x87, 0xfa, 0x58, 0x90, 0x37, 0x3d, 0x6c, 0x1f, 0xc1, 0x25, 0x97, 0x08,
-		0x4c, 0x3d, 0xae, 0x2b, 0xbd, 0xfc, 0x8b, 0x9f, 0x10, 0x0f, 0x39, 0x65, 0x72, 0xc4, 0x29, 0x17,
-		0xb2, 0xef, 0x5b, 0x60, 0xe6, 0x19, 0x4d, 0xdb, 0xf6, 0x77, 0x06, 0xac, 0x3e, 0x27, 0xdc, 0x61,
-		0xf4, 0xe0, 0x4d, 0x31, 0xad, 0xf9, 0x55, 0x11, 0x6e, 0xe5, 0xc8, 0xa4, 0x6f, 0x9d, 0x07, 0x8b,
-		0x7d, 0x73, 0x38, 0x81, 0xdf, 0xa4, 0x2d, 0x5d, 0x14, 0xe8, 0x54, 0xff, 0xe0, 0x6c, 0x12, 0x34,
-		0x06, 0xa1, 0xd6, 0x02, 0x49, 0x5d, 0x47, 0x07, 0xb0, 0x78, 0x5a, 0x55, 0x9b, 0xfa, 0xcd, 0x40,
-		0xeb, 0x7b, 0xf7, 0x6c, 0xa7, 0xed, 0xf8, 0xcd, 0xa0, 0x5f, 0x5a, 0x0e, 0x2d, 0xa3, 0x57, 0x80,
-		0x42, 0xe2, 0xbb, 0xd4, 0x6f, 0xd9, 0xd8, 0x11, 0xf4, 0x98, 0x0a, 0x4a, 0x78, 0xb5, 0xb8, 0x5a,
-		0x5c, 0x2b, 0x6f, 0xac, 0xa5, 0x47, 0x91, 0x22, 0xdf, 0x54, 0xd4, 0x5d, 0xc9, 0xfc, 0x4a, 0x38,
-		0xb4, 0x48, 0x09, 0x47, 0xdf, 0x87, 0xb9, 0x84, 0xb1, 0xd3, 0xa6, 0x9e, 0xcb, 0x48, 0xdc, 0x44,
-		0xc5, 0x6c, 0xeb, 0x79, 0x6c, 0x1b, 0x31, 0xed, 0xb0, 0xe4, 0x97, 0xc3, 0x81, 0x2d, 0x46, 0x7c,
-		0xb4, 0xd7, 0x67, 0x9d, 0x64, 0x63, 0xdd, 0xa9, 0xe4, 0x4a, 0xfc, 0x5c, 0xd3, 0x0e, 0x31, 0x4d,
-		0x16, 0xcd, 0x2f, 0x8b, 0x70, 0xed, 0x3b, 0x71, 0xc7, 0x9b, 0x98, 0xef, 0x35, 0xdd, 0xf1, 0x27,
-		0x30, 0x29, 0x1b, 0x6f, 0xfd, 0x84, 0x9b, 0xb9, 0x9c, 0xa4, 0xc0, 0x96, 0x02, 0x20, 0x1b, 0x16,
-		0xe4, 0x1f, 0x36, 0x23, 0x9f, 0x11, 0x47, 0xc4, 0xf1, 0xe9, 0x52, 0x29, 0xd4, 0x84, 0x6c, 0x44,
-		0xfe, 0x3f, 0x95, 0x95, 0x62, 0x21, 0x11, 0x8d, 0x04, 0x60, 0x5d, 0x3b, 0x4a, 0x59, 0x8d, 0xe3,
-		0x51, 0x1d, 0xe0, 0x04, 0x3e, 0xa7, 0x5c, 0x10, 0xdf, 0xe9, 0xda, 0x1e, 0x39, 0x26, 0x9e, 0x34,
-		0x7f, 0x56, 0xab, 0x23, 0x4f, 0x68, 0xf4, 0x21, 0x9f, 0xc4, 0x08, 0x6b, 0xfe, 0x28, 0x6d, 0xd9,
-		0xfc, 0x93, 0x01, 0xf3, 0x23, 0x6e, 0xd0, 0x77, 0xef, 0x23, 0xa8, 0x24, 0xea, 0xf1, 0xc8, 0x4b,
-		0x6a, 0xab, 0x31, 0x25, 0x8e, 0xd6, 0x23, 0x06, 0xa0, 0x1d, 0x98, 0x1d, 0xb4, 0x0f, 0x71, 0xb5,
-		0xb3, 0xcc, 0x71, 0x76, 0x21, 0xae, 0x35, 0x73, 0x34, 0xf8, 0x69, 0xfe, 0xcb, 0x80, 0xc5, 0x24,
-		0x5b, 0xf4, 0xfa, 0xe7, 0x31, 0xf1, 0x32, 0xd4, 0x90, 0x17, 0xce, 0xd7, 0x90, 0xbf, 0x80, 0xd9,
-		0x1e, 0xb6, 0x3f, 0x15, 0x98, 0xcd, 0x98, 0x0a, 0x24, 0x0c, 0xd4, 0x54, 0x40, 0x0c, 0x7c, 0xc5,
-		0x05, 0x0e, 0xf5, 0x1d, 0x2f, 0x72, 0x89, 0xdd, 0x67, 0xc8, 0x05, 0x16, 0x91, 0x7a, 0x3a, 0x4a,
-		0xd6, 0xbc, 0xde, 0x4f, 0x98, 0xec, 0xc9, 0x4d, 0xf3, 0xcf, 0x06, 0x54, 0x4f, 0x6b, 0xac, 0x5d,
-		0xf3, 0x3e, 0x5c, 0x0a, 0x03, 0xcf, 0x23, 0x8c, 0x57, 0x0d, 0x79, 0xc5, 0x57, 0xd2, 0xbd, 0x22,
-		0x69, 0xe4, 0xf5, 0x4b, 0xe8, 0xd1, 0x4b, 0x98, 0x3b, 0x25, 0x88, 0x32, 0xce, 0xed, 0x5c, 0xdd,
-		0x94, 0x58, 0xd6, 0xac, 0x18, 0x16, 0xf3, 0x21, 0xdc, 0x78, 0x41, 0x44, 0x42, 0xc4, 0x9f, 0x75,
-		0x9f, 0x4b, 0xe3, 0x8f, 0xf1, 0x8d, 0xf9, 0x9b, 0x09, 0xb8, 0x99, 0x8e, 0xd3, 0x1a, 0xfe, 0x08,
-		0x16, 0x7a, 0x85, 0x61, 0x5f, 0xde, 0x0e, 0x0e, 0xb5, 0xc2, 0xdf, 0x4e, 0x15, 0x36, 0x8f, 0x65,
-		0x3d, 0xc9, 0x3c, 0x09, 0xc5, 0x4b, 0x1c, 0x6e, 0xf9, 0x82, 0x75, 0xad, 0xab, 0xee, 0xe9, 0x9d,
-		0x58, 0x00, 0x9d, 0x9f, 0xbb, 0x23, 0x02, 0x14, 0x2e, 0x2a, 0x40, 0x92, 0xc1, 0x4f, 0x0b, 0x80,
-		0x4f, 0xef, 0xd4, 0xa2, 0xd8, 0xff, 0xe9, 0x12, 0xa3, 0x39, 0x28, 0x1e, 0x92, 0xae, 0xb6, 0x69,
-		0xfc, 0x27, 0x6a, 0xc0, 0xe4, 0x31, 0xf6, 0x22, 0xa2, 0x7d, 0x79, 0x2f, 0x55, 0xba, 0xac, 0x78,
-		0xb2, 0x14, 0xf6, 0x69, 0xe1, 0x89, 0x11, 0x1f, 0x9b, 0x25, 0xe7, 0xd7, 0x78, 0xac, 0xc9, 0x61,
-		0x49, 0xde, 0x19, 0x4d, 0xb2, 0x8b, 0x99, 0x90, 0x39, 0x90, 0x7f, 0x8d, 0xb7, 0xdc, 0xfc, 0x69,
-		0x01, 0x96, 0xb3, 0x4e, 0xd5, 0x71, 0x78, 0x04, 0x4b, 0x29, 0x61, 0x10, 0xf6, 0x08, 0x75, 0x38,
-		0xd6, 0x73, 0x8f, 0xec, 0xf1, 0x7d, 0x49, 0x04, 0x76, 0xb1, 0xc0, 0x56, 0x6d, 0xd4, 0xe3, 0xfd,
-		0xa3, 0xe3, 0x23, 0x53, 0x42, 0x7f, 0xe0, 0xc8, 0xc2, 0xc5, 0x8e, 0x1c, 0x8d, 0xf2, 0xfe, 0x91,
-		0xe6, 0x22, 0xcc, 0xbf, 0x20, 0xa2, 0xe1, 0x45, 0x5c, 0xe8, 0x7c, 0xa1, 0xac, 0x6e, 0xfe, 0xc4,
-		0x80, 0x85, 0xd1, 0x1d, 0x6d, 0x99, 0x36, 0x5c, 0xe7, 0x51, 0x18, 0x06, 0x4c, 0x10, 0xd7, 0x76,
-		0x3c, 0x1a, 0x77, 0x6d, 0xc7, 0x84, 0x71, 0x6d, 0x95, 0xd8, 0x11, 0xef, 0xa6, 0xf7, 0xe1, 0x09,
-		0xaa, 0x21, 0x41, 0xdf, 0xd3, 0x18, 0x6b, 0x91, 0xa7, 0x6f, 0x98, 0xbf, 0x2c, 0x82, 0xf9, 0x22,
-		0xa5, 0x37, 0xfb, 0x58, 0x8d, 0xd4, 0x5f, 0x53, 0xdd, 0x70, 0x03, 0xa6, 0x43, 0xdc, 0x22, 0x36,
-		0xa7, 0x5f, 0xa8, 0xd7, 0x61, 0xd2, 0x2a, 0xc5, 0x0b, 0x7b, 0xf4, 0x0b, 0x82, 0xee, 0xc0, 0x65,
-		0x9f, 0x7c, 0x1e, 0x7b, 0xad, 0x45, 0x6c, 0x11, 0x1c, 0x12, 0x5f, 0x77, 0xf9, 0x33, 0xf1, 0xf2,
-		0x2e, 0x6e, 0x91, 0xfd, 0x78, 0x11, 0xbd, 0x03, 0xe8, 0x04, 0x53, 0x61, 0x37, 0x03, 0x66, 0xfb,
-		0xe4, 0x44, 0x35, 0xbf, 0xf2, 0x71, 0x2f, 0x59, 0x97, 0xe3, 0x9d, 0xed, 0x80, 0x7d, 0x4a, 0x4e,
-		0x64, 0xd7, 0x8b, 0x6c, 0xb8, 0xae, 0xff, 0x8b, 0xa0, 0x9b, 0xe4, 0x26, 0xf5, 0x04, 0x61, 0xea,
-		0x7d, 0x9a, 0x92, 0xef, 0xd3, 0x5b, 0xa9, 0xfa, 0x48, 0xf8, 0xb6, 0x24, 0x96, 0x4f, 0xd4, 0x82,
-		0x66, 0x33, 0xb2, 0x8e, 0x6e, 0xc3, 0x8c, 0xec, 0x9a, 0x31, 0x73, 0xda, 0xf4, 0x18, 0xab, 0xe9,
-		0x4d, 0xc9, 0xaa, 0xc4, 0x8b, 0x9b, 0x7a, 0xcd, 0xfc, 0xa7, 0x01, 0xb7, 0x73, 0xbd, 0xa1, 0xe3,
-		0xe3, 0x11, 0x5c, 0xd2, 0xc7, 0xe4, 0x56, 0x0e, 0x09, 0x2c, 0x21, 0x46, 0xdf, 0x82, 0x32, 0xc3,
-		0x27, 0x76, 0x82, 0x55, 0xc1, 0x9e, 0x7e, 0xa5, 0x9f, 0x63, 0x81, 0x9f, 0x79, 0xc1, 0x81, 0x05,
-		0x0c, 0x9f, 0x68, 0x46, 0x69, 0xa6, 0x2f, 0xa6, 0x99, 0xbe, 0x06, 0x25, 0xa5, 0x27, 0x71, 0xf5,
-		0x4b, 0xdc, 0xfb, 0x36, 0xbb, 0x50, 0xd9, 0x26, 0x58, 0x44, 0x8c, 0x6c, 0x7b, 0xb8, 0xc5, 0x11,
-		0x85, 0x8d, 0x94, 0xc6, 0x00, 0x7b, 0x8c, 0x60, 0x37, 0xae, 0xce, 0x3a, 0xa1, 0x47, 0xe2, 0x6b,
-		0x40, 0x18, 0x0b, 0x98, 0x4d, 0x7c, 0x7c, 0xe0, 0x11, 0x35, 0x28, 0x28, 0x59, 0xf7, 0x4e, 0x85,
-		0xce, 0xa6, 0xc2, 0x35, 0x12, 0xd8, 0x56, 0x8c, 0xda, 0x52, 0x20, 0xf3, 0x57, 0x06, 0xdc, 0xb0,
-		0x48, 0x93, 0x11, 0xde, 0xee, 0xfd, 0xb3, 0x01, 0xf3, 0x43, 0xfe, 0x9a, 0xda, 0xb4, 0x65, 0xb8,
-		0x99, 0x2e, 0x8d, 0xf2, 0xf2, 0xc6, 0x3f, 0x2e, 0x43, 0x39, 0xd9, 0xd9, 0xdc, 0xdd, 0x41, 0x3f,
-		0x33, 0xa0, 0x9a, 0x35, 0x65, 0x45, 0xef, 0x65, 0x0c, 0xd0, 0x73, 0xff, 0x3d, 0x55, 0x7b, 0x78,
-		0x4e, 0x94, 0x8e, 0xbf, 0x1f, 0x1b, 0xb0, 0x90, 0x3e, 0x3d, 0x43, 0x17, 0x98, 0x0f, 0xd6, 0x1e,
-		0x9c, 0x0b, 0xa3, 0x65, 0xf8, 0xd2, 0x80, 0xc5, 0x8c, 0x79, 0x27, 0xca, 0x60, 0x98, 0x3b, 0x65,
-		0xae, 0xbd, 0x77, 0x3e, 0x90, 0x16, 0xe3, 0x8f, 0x06, 0xac, 0x8e, 0x1b, 0x29, 0xa2, 0x0f, 0xf3,
-		0x58, 0x8f, 0x9b, 0xc4, 0xd6, 0xbe, 0x79, 0x41, 0xf4, 0x80, 0xb3, 0xd2, 0x07, 0x70, 0x19, 0xce,
-		0xca, 0x9d, 0x6e, 0x66, 0x38, 0x6b, 0xcc, 0x84, 0xef, 0xf7, 0x06, 0x2c, 0xe7, 0x0f, 0xab, 0xd0,
-		0xd3, 0x0c, 0xbe, 0x67, 0x98, 0xff, 0xd5, 0x3e, 0xb8, 0x10, 0x56, 0xcb, 0xf6, 0x6b, 0x03, 0x6a,
-		0xd9, 0x83, 0x1e, 0xf4, 0x28, 0xbd, 0x16, 0x18, 0x37, 0x4e, 0xab, 0x3d, 0x3e, 0x37, 0x4e, 0xcb,
-		0xf3, 0x0b, 0x03, 0xae, 0x67, 0x4e, 0x6f, 0xd0, 0xc3, 0xdc, 0x32, 0x30, 0x53, 0x9a, 0x47, 0xe7,
-		0x85, 0x69, 0x61, 0x9a, 0x30, 0x33, 0xd4, 0xc1, 0xa2, 0x9c, 0xc6, 0x7b, 0x64, 0xd8, 0x50, 0xbb,
-		0x7b, 0x16, 0x52, 0x7d, 0x4e, 0x00, 0x73, 0xa3, 0xa5, 0x2c, 0x7a, 0xf7, 0x8c, 0x15, 0xaf, 0x3a,
-		0xed, 0x7c, 0xf5, 0x31, 0xfa, 0x21, 0x5c, 0x4b, 0x6b, 0x28, 0xd0, 0x37, 0xce, 0xd1, 0x7b, 0xa8,
-		0x83, 0xef, 0x9f, 0xbb, 0x5b, 0x91, 0x57, 0x32, 0xbd, 0x38, 0xce, 0xb8, 0x92, 0xb9, 0xf5, 0x7b,
-		0xc6, 0x95, 0x1c, 0x53, 0x7d, 0x53, 0x98, 0x1d, 0xae, 0x3e, 0xd1, 0xdd, 0x2c, 0x45, 0x4e, 0x17,
-		0xaf, 0xb5, 0x77, 0xce, 0x44, 0xab, 0x8f, 0xfa, 0xad, 0x21, 0x3b, 0xd9, 0xac, 0xb2, 0x06, 0x3d,
-		0xce, 0x62, 0x36, 0xa6, 0x2c, 0xad, 0x3d, 0x39, 0x3f, 0xb0, 0xef, 0xfe, 0xb4, 0xb7, 0x37, 0xc3,
-		0xfd, 0x39, 0x45, 0x43, 0x86, 0xfb, 0xf3, 0x1e, 0xf6, 0x67, 0x2e, 0x2c, 0x3a, 0x41, 0x27, 0x0d,
-		0xf7, 0xec, 0x5a, 0x82, 0xd8, 0x53, 0x3f, 0xab, 0xd9, 0x65, 0x81, 0x08, 0x76, 0x8d, 0x1f, 0xdc,
-		0x6f, 0x51, 0xd1, 0x8e, 0x0e, 0xea, 0x4e, 0xd0, 0x59, 0x1f, 0xfc, 0x69, 0xca, 0x3d, 0xea, 0x7a,
-		0xeb, 0xad, 0x40, 0xfd, 0xa2, 0x46, 0xff, 0x4e, 0xe5, 0x03, 0x1c, 0xd2, 0xe3, 0xfb, 0x07, 0x53,
-		0x72, 0xed, 0xc1, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xdb, 0xd5, 0x62, 0xb6, 0x23, 0x00,
-		0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x5b, 0x6f, 0xdc, 0xc6,
+		0xf5, 0x07, 0x57, 0x17, 0x4b, 0x67, 0x25, 0x59, 0x1e, 0x5b, 0x12, 0xbd, 0xb6, 0x6e, 0x74, 0xe2,
+		0xbf, 0xfe, 0x4e, 0xbc, 0xaa, 0xa4, 0xf8, 0x12, 0x27, 0x6d, 0x20, 0xaf, 0x2d, 0x47, 0x45, 0x1c,
+		0xa8, 0x94, 0x5a, 0xa3, 0x7d, 0x21, 0x46, 0xe4, 0xec, 0x6a, 0x22, 0x2e, 0x49, 0x71, 0x86, 0x52,
+		0x36, 0x7d, 0x28, 0x5a, 0x04, 0x6d, 0xd1, 0x1b, 0xda, 0xc7, 0x02, 0x05, 0xfa, 0xd0, 0x3e, 0xf7,
+		0xa5, 0x5f, 0xa1, 0xe8, 0x47, 0xe8, 0x7b, 0x3f, 0x41, 0xbf, 0x41, 0x50, 0xcc, 0x85, 0x7b, 0x13,
+		0xc9, 0xd5, 0xaa, 0x08, 0xec, 0xf6, 0x4d, 0x9c, 0x39, 0xbf, 0x33, 0xe7, 0x36, 0x67, 0xce, 0x39,
+		0x5a, 0xb8, 0x97, 0x1c, 0x92, 0x78, 0xdd, 0xc5, 0x1e, 0x09, 0x5c, 0xb2, 0x8e, 0x23, 0xba, 0x7e,
+		0xba, 0xb1, 0xce, 0x48, 0x7c, 0x4a, 0x5d, 0xe2, 0x9c, 0x85, 0xf1, 0x71, 0xdd, 0x0f, 0xcf, 0xaa,
+		0x51, 0x1c, 0xf2, 0x10, 0x5d, 0x17, 0xb4, 0x55, 0x4d, 0x5b, 0xc5, 0x11, 0xad, 0x9e, 0x6e, 0x54,
+		0x96, 0x1a, 0x61, 0xd8, 0xf0, 0xc9, 0xba, 0x24, 0x39, 0x4c, 0xea, 0xeb, 0x5e, 0x12, 0x63, 0x4e,
+		0xc3, 0x40, 0x81, 0x2a, 0x2b, 0x59, 0x07, 0xb8, 0x61, 0xb3, 0xd9, 0xa6, 0x58, 0xcd, 0xa2, 0x38,
+		0xa2, 0x8c, 0x87, 0x71, 0x4b, 0x93, 0x2c, 0x67, 0x91, 0x9c, 0x24, 0xa4, 0x4d, 0x60, 0x65, 0x11,
+		0x70, 0xcc, 0x8e, 0x7d, 0xca, 0x78, 0x11, 0x4d, 0xaf, 0x8a, 0xd6, 0xdf, 0x0c, 0x58, 0xb6, 0x09,
+		0xe3, 0x38, 0xe6, 0xaf, 0xf4, 0xce, 0xf3, 0xcf, 0x89, 0x9b, 0x08, 0x85, 0x6c, 0x72, 0x92, 0x10,
+		0xc6, 0xd1, 0x3c, 0x8c, 0x7b, 0x61, 0x13, 0xd3, 0xc0, 0x34, 0x56, 0x8c, 0xb5, 0x49, 0x5b, 0x7f,
+		0xa1, 0xef, 0x02, 0x4a, 0xb9, 0x39, 0x24, 0x05, 0x99, 0xa5, 0x15, 0x63, 0xad, 0xbc, 0x79, 0xb7,
+		0x9a, 0x61, 0xbb, 0xea, 0xf9, 0x23, 0xae, 0x9d, 0xf5, 0x2f, 0xa1, 0x0a, 0x4c, 0x50, 0x8f, 0x04,
+		0x9c, 0xf2, 0x96, 0x39, 0x22, 0x0f, 0x6c, 0x7f, 0x0b, 0x51, 0x62, 0x82, 0x59, 0x18, 0x98, 0xa3,
+		0x4a, 0x14, 0xf5, 0x65, 0xfd, 0x7c, 0x02, 0x16, 0xf7, 0x2f, 0xa5, 0xc4, 0x32, 0x94, 0xdb, 0x4a,
+		0x50, 0x4f, 0x4a, 0x3f, 0x69, 0x43, 0xba, 0xb4, 0xeb, 0xa1, 0x1d, 0x98, 0x6e, 0x13, 0xf0, 0x56,
+		0x44, 0xa4, 0x4c, 0xe5, 0xcd, 0xd5, 0x42, 0x05, 0x0f, 0x5a, 0x11, 0xb1, 0xa7, 0xce, 0xba, 0xbe,
+		0xd0, 0x13, 0x98, 0x14, 0xfe, 0x71, 0x84, 0x83, 0xa4, 0xf4, 0xe5, 0xcd, 0xc5, 0x4c, 0x1e, 0x07,
+		0x98, 0x1d, 0x7f, 0x42, 0x19, 0xb7, 0x27, 0xb8, 0xfe, 0x0b, 0x6d, 0xc2, 0x18, 0x0d, 0xa2, 0x84,
+		0x9b, 0x63, 0x12, 0x77, 0x3b, 0x13, 0xb7, 0x87, 0x5b, 0x7e, 0x88, 0x3d, 0x5b, 0x91, 0x22, 0x0c,
+		0x2b, 0x6d, 0xa7, 0x38, 0xd2, 0xc1, 0x0e, 0x0f, 0x1d, 0xd7, 0x0f, 0x19, 0x71, 0x38, 0x6d, 0x92,
+		0x30, 0xe1, 0xe6, 0xb8, 0x64, 0x77, 0xb3, 0xaa, 0x42, 0xba, 0x9a, 0x86, 0x74, 0xf5, 0x99, 0x0e,
+		0x69, 0xfb, 0x76, 0x9b, 0x85, 0xb4, 0xee, 0x41, 0x58, 0x13, 0xf8, 0x03, 0x05, 0x47, 0xaf, 0xe0,
+		0x96, 0x54, 0x29, 0x87, 0xfb, 0x95, 0x41, 0xdc, 0x17, 0x04, 0x3a, 0x8b, 0x71, 0x77, 0x08, 0x4c,
+		0xf4, 0x85, 0xc0, 0x22, 0x40, 0xac, 0x7c, 0x2a, 0xfc, 0x35, 0x29, 0x77, 0x27, 0xf5, 0xca, 0xae,
+		0x87, 0x5c, 0x30, 0xbb, 0xfc, 0xe9, 0xc4, 0x24, 0x61, 0xc4, 0x89, 0x42, 0x9f, 0xba, 0x2d, 0x13,
+		0x56, 0x8c, 0xb5, 0x99, 0xcd, 0x7b, 0x85, 0x9e, 0xdb, 0xf5, 0x6c, 0x01, 0xd9, 0x93, 0x08, 0x7b,
+		0xee, 0x2c, 0x6b, 0x19, 0xd5, 0x60, 0x2a, 0x26, 0x3c, 0x6e, 0xa5, 0x8c, 0xcb, 0x52, 0xd3, 0x95,
+		0x4c, 0xc6, 0xb6, 0x20, 0xd4, 0xec, 0xca, 0x71, 0xe7, 0x03, 0xdd, 0x81, 0x69, 0x37, 0x16, 0xbe,
+		0x71, 0x8f, 0x88, 0x97, 0xf8, 0xc4, 0x9c, 0x92, 0xba, 0x4c, 0x89, 0xc5, 0x7d, 0xbd, 0x86, 0xee,
+		0xc3, 0x68, 0x93, 0x34, 0x43, 0x73, 0x5a, 0xdb, 0x32, 0xeb, 0x84, 0x97, 0xa4, 0x19, 0xda, 0x92,
+		0x0c, 0xd9, 0x70, 0x8d, 0x11, 0x1c, 0xbb, 0x47, 0x0e, 0xe6, 0x3c, 0xa6, 0x87, 0x09, 0x27, 0xcc,
+		0x9c, 0x91, 0xd8, 0xb7, 0x33, 0xb1, 0xfb, 0x92, 0x7a, 0xbb, 0x4d, 0x6c, 0xcf, 0xb2, 0xbe, 0x15,
+		0xb4, 0x05, 0xe3, 0x47, 0x04, 0x7b, 0x24, 0x36, 0xaf, 0x4a, 0x46, 0xb7, 0x32, 0x19, 0x7d, 0x2c,
+		0x49, 0x6c, 0x4d, 0x8a, 0x9e, 0x40, 0xd9, 0x23, 0x3e, 0x6e, 0xa9, 0xd8, 0x30, 0x67, 0x07, 0x85,
+		0x02, 0x48, 0x6a, 0x19, 0x0b, 0xe8, 0x43, 0x98, 0xfa, 0x8c, 0x72, 0x4e, 0x62, 0x0d, 0xbe, 0x36,
+		0x08, 0x5c, 0x56, 0xe4, 0x12, 0x6d, 0x3d, 0x82, 0xa5, 0xbc, 0x4c, 0xc0, 0xa2, 0x30, 0x60, 0x04,
+		0xcd, 0xc1, 0x78, 0x9c, 0x04, 0x22, 0x7a, 0x54, 0x2a, 0x18, 0x8b, 0x93, 0x60, 0xd7, 0xb3, 0xde,
+		0x87, 0x95, 0xfc, 0x4c, 0x58, 0x0c, 0xfd, 0x7b, 0x09, 0x96, 0xf6, 0x69, 0x23, 0xc0, 0xfe, 0x7f,
+		0x43, 0x12, 0xed, 0xbd, 0x41, 0xa3, 0xfd, 0x37, 0x68, 0x19, 0xca, 0x4c, 0xea, 0xe2, 0x04, 0xb8,
+		0x49, 0x64, 0xca, 0x99, 0xb4, 0x41, 0x2d, 0x7d, 0x8a, 0x9b, 0x04, 0x7d, 0x04, 0x53, 0x9a, 0x40,
+		0x25, 0xa5, 0xf1, 0x0b, 0x24, 0x25, 0xcd, 0x72, 0x57, 0xa6, 0x26, 0x13, 0xae, 0xb8, 0x61, 0xc0,
+		0xe3, 0xd0, 0x97, 0x39, 0x62, 0xca, 0x4e, 0x3f, 0xad, 0x55, 0x58, 0xce, 0xb5, 0xa3, 0x72, 0x81,
+		0xf5, 0x95, 0x01, 0xff, 0xa7, 0x69, 0x28, 0x3f, 0x2a, 0x4e, 0xfa, 0xaf, 0x60, 0x5a, 0xe5, 0x26,
+		0xad, 0x9d, 0xb4, 0x7d, 0x79, 0x73, 0x33, 0xfb, 0x2a, 0x14, 0xb1, 0xb2, 0xa7, 0x24, 0xa3, 0x94,
+		0x71, 0x9f, 0x8d, 0x4a, 0x03, 0x6d, 0x34, 0xf2, 0x1f, 0xd8, 0x68, 0xb4, 0xd7, 0x46, 0xdb, 0xb0,
+		0x36, 0x58, 0xff, 0xe2, 0x78, 0xfd, 0x4b, 0x09, 0x16, 0x6d, 0xc2, 0xc8, 0x1b, 0xf3, 0xe6, 0x77,
+		0xde, 0xf5, 0x91, 0xee, 0x77, 0x1d, 0x3d, 0x02, 0xd3, 0x23, 0x2e, 0x65, 0xe2, 0x0d, 0xab, 0xd3,
+		0x80, 0xb2, 0x23, 0x87, 0x9c, 0x92, 0xa0, 0x1d, 0xb8, 0x23, 0xf6, 0x5c, 0xba, 0xbf, 0x23, 0xb7,
+		0x9f, 0x8b, 0xdd, 0x5d, 0xaf, 0x2f, 0xc6, 0xc7, 0xfa, 0x63, 0xbc, 0x0a, 0xd7, 0xd9, 0x31, 0x8d,
+		0x1c, 0xed, 0xa3, 0x98, 0xe0, 0x28, 0xf2, 0x5b, 0x32, 0x92, 0x27, 0xec, 0x6b, 0x62, 0x4b, 0x99,
+		0xd8, 0x56, 0x1b, 0x22, 0xa9, 0xe4, 0xd9, 0xab, 0xd8, 0xd2, 0x7f, 0x28, 0xc1, 0xdb, 0xda, 0xa6,
+		0x35, 0x1c, 0xb8, 0xe4, 0x7f, 0x21, 0x41, 0xdc, 0x80, 0x31, 0x17, 0x27, 0x2c, 0x4d, 0x0d, 0xea,
+		0x03, 0x6d, 0xc1, 0x7c, 0x9d, 0xc6, 0x8c, 0x77, 0x84, 0x74, 0xb4, 0x41, 0xc6, 0x25, 0xd9, 0x75,
+		0xb9, 0xdb, 0x91, 0x49, 0x9a, 0x67, 0x0d, 0xee, 0x0e, 0xb2, 0x8e, 0xbe, 0xf6, 0x7f, 0x2d, 0xc1,
+		0xea, 0x01, 0x89, 0x9b, 0x34, 0xc0, 0x9c, 0xbc, 0xe9, 0x61, 0xfb, 0x10, 0xae, 0x78, 0x84, 0x63,
+		0xea, 0x33, 0x73, 0xf4, 0x02, 0x17, 0x3f, 0x25, 0xee, 0x71, 0xca, 0x58, 0x9f, 0x53, 0x2e, 0x65,
+		0xdf, 0xb7, 0xc0, 0x2a, 0x32, 0x9a, 0xb6, 0xed, 0xef, 0x0c, 0x58, 0x79, 0x46, 0x98, 0x1b, 0xd3,
+		0xc3, 0x37, 0xc5, 0xb4, 0xd6, 0x57, 0x23, 0xb0, 0x5a, 0x20, 0x93, 0xbe, 0x75, 0x3e, 0x2c, 0x74,
+		0xcc, 0xe1, 0x86, 0x41, 0x9d, 0x36, 0x74, 0x51, 0xa0, 0x53, 0xfd, 0xd6, 0xc5, 0x24, 0xa8, 0x75,
+		0x43, 0xed, 0x79, 0x92, 0xb9, 0x8e, 0x0e, 0x61, 0xe1, 0xbc, 0xaa, 0x0e, 0x0d, 0xea, 0xa1, 0xd6,
+		0xf7, 0xde, 0xc5, 0x4e, 0xdb, 0x0d, 0xea, 0x61, 0xa7, 0xb4, 0xec, 0x59, 0x46, 0xaf, 0x00, 0x45,
+		0x24, 0xf0, 0x68, 0xd0, 0x70, 0xb0, 0xcb, 0xe9, 0x29, 0xe5, 0x94, 0x30, 0x73, 0x64, 0x65, 0x64,
+		0xad, 0xbc, 0xb9, 0x96, 0x1d, 0x45, 0x8a, 0x7c, 0x5b, 0x51, 0xb7, 0x24, 0xf3, 0x6b, 0x51, 0xcf,
+		0x22, 0x25, 0x0c, 0x7d, 0x1f, 0x66, 0x53, 0xc6, 0xee, 0x11, 0xf5, 0xbd, 0x98, 0x88, 0x26, 0x4a,
+		0xb0, 0xad, 0x16, 0xb1, 0xad, 0x09, 0xda, 0x5e, 0xc9, 0xaf, 0x46, 0x5d, 0x5b, 0x31, 0x09, 0xd0,
+		0x7e, 0x87, 0x75, 0x9a, 0x8d, 0x75, 0xa7, 0x52, 0x28, 0xf1, 0x33, 0x4d, 0xdb, 0xc3, 0x34, 0x5d,
+		0xb4, 0xbe, 0x1c, 0x81, 0x1b, 0xdf, 0x11, 0x1d, 0x6f, 0x6a, 0xbe, 0xd7, 0x74, 0xc7, 0x1f, 0xc3,
+		0x98, 0x6c, 0xbc, 0xf5, 0x13, 0x6e, 0x15, 0x72, 0x92, 0x02, 0xdb, 0x0a, 0x80, 0x1c, 0x98, 0x97,
+		0x7f, 0x38, 0x31, 0xf9, 0x8c, 0xb8, 0x5c, 0xc4, 0xa7, 0x47, 0xa5, 0x50, 0xa3, 0xb2, 0x11, 0xf9,
+		0xff, 0x4c, 0x56, 0x8a, 0x85, 0x44, 0xd4, 0x52, 0x80, 0x7d, 0xe3, 0x24, 0x63, 0x55, 0xc4, 0xa3,
+		0x3a, 0xc0, 0x0d, 0x03, 0x46, 0x19, 0x27, 0x81, 0xdb, 0x72, 0x7c, 0x72, 0x4a, 0x7c, 0x73, 0xac,
+		0xa0, 0xd5, 0x91, 0x27, 0xd4, 0x3a, 0x90, 0x4f, 0x04, 0xc2, 0x9e, 0x3b, 0xc9, 0x5a, 0xb6, 0xfe,
+		0x64, 0xc0, 0x5c, 0x9f, 0x1b, 0xf4, 0xdd, 0xfb, 0x08, 0xa6, 0x52, 0xf5, 0x58, 0xe2, 0xa7, 0xb5,
+		0xd5, 0x80, 0x12, 0x47, 0xeb, 0x21, 0x00, 0x68, 0x17, 0x66, 0xba, 0xed, 0x43, 0x3c, 0xb3, 0x54,
+		0x60, 0xe2, 0x2e, 0xbb, 0x10, 0xcf, 0x9e, 0x3e, 0xe9, 0xfe, 0xb4, 0xfe, 0x65, 0xc0, 0x42, 0x9a,
+		0x2d, 0xda, 0xfd, 0xf3, 0x80, 0x78, 0xe9, 0x69, 0xc8, 0x4b, 0xc3, 0x35, 0xe4, 0x2f, 0x60, 0xa6,
+		0x8d, 0xed, 0x4c, 0x05, 0x66, 0x36, 0x57, 0x0b, 0x19, 0xa8, 0xa9, 0x00, 0xef, 0xfa, 0x12, 0x05,
+		0x0e, 0x0d, 0x5c, 0x3f, 0xf1, 0x88, 0xd3, 0x61, 0xc8, 0x38, 0xe6, 0x89, 0x7a, 0x3a, 0x26, 0xec,
+		0x39, 0xbd, 0x9f, 0x32, 0xd9, 0x97, 0x9b, 0xd6, 0x9f, 0x0d, 0x30, 0xcf, 0x6b, 0xac, 0x5d, 0xf3,
+		0x3e, 0x5c, 0x89, 0x42, 0xdf, 0x27, 0x31, 0x33, 0x0d, 0x79, 0xc5, 0x97, 0xb3, 0xbd, 0x22, 0x69,
+		0xe4, 0xf5, 0x4b, 0xe9, 0xd1, 0x4b, 0x98, 0x3d, 0x27, 0x88, 0x32, 0xce, 0x9d, 0x42, 0xdd, 0x94,
+		0x58, 0xf6, 0x0c, 0xef, 0x15, 0xf3, 0x01, 0xdc, 0x7a, 0x41, 0x78, 0x4a, 0xc4, 0x9e, 0xb6, 0x9e,
+		0x49, 0xe3, 0x0f, 0xf0, 0x8d, 0xf5, 0x9b, 0x51, 0xb8, 0x9d, 0x8d, 0xd3, 0x1a, 0xfe, 0x08, 0xe6,
+		0xdb, 0x85, 0x61, 0x47, 0xde, 0x26, 0x8e, 0xb4, 0xc2, 0xdf, 0xce, 0x14, 0xb6, 0x88, 0x65, 0x35,
+		0xcd, 0x3c, 0x29, 0xc5, 0x4b, 0x1c, 0x3d, 0x0f, 0x78, 0xdc, 0xb2, 0xaf, 0x7b, 0xe7, 0x77, 0x84,
+		0x00, 0x3a, 0x3f, 0xb7, 0xfa, 0x04, 0x28, 0x5d, 0x56, 0x80, 0x34, 0x83, 0x9f, 0x17, 0x00, 0x9f,
+		0xdf, 0xa9, 0x24, 0xc2, 0xff, 0xd9, 0x12, 0xa3, 0x59, 0x18, 0x39, 0x26, 0x2d, 0x6d, 0x53, 0xf1,
+		0x27, 0xaa, 0xc1, 0xd8, 0x29, 0xf6, 0x13, 0xa2, 0x7d, 0x79, 0x3f, 0x53, 0xba, 0xbc, 0x78, 0xb2,
+		0x15, 0xf6, 0x49, 0xe9, 0xb1, 0x21, 0x8e, 0xcd, 0x93, 0xf3, 0x6b, 0x3c, 0xd6, 0x62, 0xb0, 0x28,
+		0xef, 0x8c, 0x26, 0xd9, 0xc3, 0x31, 0x97, 0x39, 0x90, 0x7d, 0x8d, 0xb7, 0xdc, 0xfa, 0x69, 0x09,
+		0x96, 0xf2, 0x4e, 0xd5, 0x71, 0x78, 0x02, 0x8b, 0x19, 0x61, 0x10, 0xb5, 0x09, 0x4d, 0xa3, 0xe0,
+		0x89, 0x3d, 0xc7, 0xf7, 0x25, 0xe1, 0xd8, 0xc3, 0x1c, 0xdb, 0x95, 0x7e, 0x8f, 0x77, 0x8e, 0x16,
+		0x47, 0x66, 0x84, 0x7e, 0xd7, 0x91, 0xa5, 0xcb, 0x1d, 0xd9, 0x1f, 0xe5, 0x9d, 0x23, 0xad, 0x05,
+		0x98, 0x7b, 0x41, 0x78, 0xcd, 0x4f, 0x18, 0xd7, 0xf9, 0x42, 0x59, 0xdd, 0xfa, 0x89, 0x01, 0xf3,
+		0xfd, 0x3b, 0xda, 0x32, 0x47, 0x70, 0x93, 0x25, 0x51, 0x14, 0xc6, 0x9c, 0x78, 0x8e, 0xeb, 0x53,
+		0xd1, 0xb5, 0x9d, 0x92, 0x98, 0x69, 0xab, 0x08, 0x47, 0xbc, 0x9b, 0xdd, 0x87, 0xa7, 0xa8, 0x9a,
+		0x04, 0x7d, 0x4f, 0x63, 0xec, 0x05, 0x96, 0xbd, 0x61, 0xfd, 0x72, 0x04, 0xac, 0x17, 0x19, 0xbd,
+		0xd9, 0xc7, 0x6a, 0xa4, 0xfe, 0x9a, 0xea, 0x86, 0x5b, 0x30, 0x19, 0xe1, 0x06, 0x71, 0x18, 0xfd,
+		0x42, 0xbd, 0x0e, 0x63, 0xf6, 0x84, 0x58, 0xd8, 0xa7, 0x5f, 0x10, 0x74, 0x17, 0xae, 0x06, 0xe4,
+		0x73, 0xe1, 0xb5, 0x06, 0x71, 0x78, 0x78, 0x4c, 0x02, 0xdd, 0xe5, 0x4f, 0x8b, 0xe5, 0x3d, 0xdc,
+		0x20, 0x07, 0x62, 0x11, 0xbd, 0x03, 0xe8, 0x0c, 0x53, 0xee, 0xd4, 0xc3, 0xd8, 0x09, 0xc8, 0x99,
+		0x6a, 0x7e, 0xe5, 0xe3, 0x3e, 0x61, 0x5f, 0x15, 0x3b, 0x3b, 0x61, 0xfc, 0x29, 0x39, 0x93, 0x5d,
+		0x2f, 0x72, 0xe0, 0xa6, 0xfe, 0x2f, 0x82, 0xa2, 0x73, 0xea, 0xd4, 0x17, 0x53, 0x34, 0xf9, 0x3e,
+		0x8d, 0xcb, 0xf7, 0xe9, 0xad, 0x4c, 0x7d, 0x24, 0x7c, 0x47, 0x12, 0xcb, 0x27, 0x6a, 0x5e, 0xb3,
+		0xe9, 0x5b, 0x17, 0x13, 0x4b, 0xd9, 0x35, 0x8b, 0x01, 0x21, 0x3d, 0xc5, 0x6a, 0x7a, 0x33, 0x61,
+		0x4f, 0x89, 0xc5, 0x6d, 0xbd, 0x66, 0xfd, 0xd3, 0x80, 0x3b, 0x85, 0xde, 0xd0, 0xf1, 0xf1, 0x10,
+		0xae, 0xe8, 0x63, 0x0a, 0x2b, 0x87, 0x14, 0x96, 0x12, 0xa3, 0x6f, 0x41, 0x39, 0xc6, 0x67, 0x4e,
+		0x8a, 0x55, 0xc1, 0x9e, 0x7d, 0xa5, 0x9f, 0x61, 0x8e, 0x9f, 0xfa, 0xe1, 0xa1, 0x0d, 0x31, 0x3e,
+		0xd3, 0x8c, 0xb2, 0x4c, 0x3f, 0x92, 0x65, 0xfa, 0x0a, 0x4c, 0x28, 0x3d, 0x89, 0xa7, 0x5f, 0xe2,
+		0xf6, 0xb7, 0xd5, 0x82, 0xa9, 0x1d, 0x82, 0x79, 0x12, 0x93, 0x1d, 0x1f, 0x37, 0x18, 0xa2, 0xb0,
+		0x99, 0xd1, 0x18, 0x60, 0x3f, 0x26, 0xd8, 0x13, 0xd5, 0x59, 0x33, 0xf2, 0x89, 0xb8, 0x06, 0x24,
+		0x8e, 0xc3, 0xd8, 0x21, 0x01, 0x3e, 0xf4, 0x89, 0x1a, 0x14, 0x4c, 0xd8, 0xf7, 0xcf, 0x85, 0xce,
+		0xb6, 0xc2, 0xd5, 0x52, 0xd8, 0x73, 0x81, 0x7a, 0xae, 0x40, 0xd6, 0xaf, 0x0c, 0xb8, 0x65, 0x93,
+		0x7a, 0x4c, 0xd8, 0x51, 0xfb, 0x9f, 0x0d, 0x98, 0x1d, 0xb3, 0xd7, 0xd4, 0xa6, 0x2d, 0xc1, 0xed,
+		0x6c, 0x69, 0x94, 0x97, 0x37, 0xff, 0x71, 0x15, 0xca, 0xe9, 0xce, 0xf6, 0xde, 0x2e, 0xfa, 0x99,
+		0x01, 0x66, 0xde, 0x94, 0x15, 0xbd, 0x97, 0x33, 0x40, 0x2f, 0xfc, 0xf7, 0x54, 0xe5, 0xc1, 0x90,
+		0x28, 0x1d, 0x7f, 0x3f, 0x36, 0x60, 0x3e, 0x7b, 0x7a, 0x86, 0x2e, 0x31, 0x1f, 0xac, 0x6c, 0x0d,
+		0x85, 0xd1, 0x32, 0x7c, 0x69, 0xc0, 0x42, 0xce, 0xbc, 0x13, 0xe5, 0x30, 0x2c, 0x9c, 0x32, 0x57,
+		0xde, 0x1b, 0x0e, 0xa4, 0xc5, 0xf8, 0xa3, 0x01, 0x2b, 0x83, 0x46, 0x8a, 0xe8, 0xc3, 0x22, 0xd6,
+		0x83, 0x26, 0xb1, 0x95, 0x6f, 0x5e, 0x12, 0xdd, 0xe5, 0xac, 0xec, 0x01, 0x5c, 0x8e, 0xb3, 0x0a,
+		0xa7, 0x9b, 0x95, 0xad, 0xa1, 0x30, 0x5a, 0x86, 0xdf, 0x1b, 0xb0, 0xa4, 0x19, 0xe4, 0x0c, 0xab,
+		0xd0, 0x93, 0x1c, 0xbe, 0x17, 0x98, 0xff, 0x55, 0x3e, 0xb8, 0x14, 0x56, 0xcb, 0xf6, 0x6b, 0x03,
+		0x2a, 0xf9, 0x83, 0x1e, 0xf4, 0x30, 0xbb, 0x16, 0x18, 0x34, 0x4e, 0xab, 0x3c, 0x1a, 0x1a, 0xa7,
+		0xe5, 0xf9, 0x85, 0x01, 0x37, 0x73, 0xa7, 0x37, 0xe8, 0x41, 0x61, 0x19, 0x98, 0x2b, 0xcd, 0xc3,
+		0x61, 0x61, 0x5a, 0x98, 0x3a, 0x4c, 0xf7, 0x74, 0xb0, 0xa8, 0xa0, 0xf1, 0xee, 0x1b, 0x36, 0x54,
+		0xee, 0x5d, 0x84, 0x54, 0x9f, 0x13, 0xc2, 0x6c, 0x7f, 0x29, 0x8b, 0xde, 0xbd, 0x60, 0xc5, 0xab,
+		0x4e, 0x1b, 0xae, 0x3e, 0x46, 0x3f, 0x84, 0x1b, 0x59, 0x0d, 0x05, 0xfa, 0xc6, 0x10, 0xbd, 0x87,
+		0x3a, 0x78, 0x63, 0xe8, 0x6e, 0x45, 0x5e, 0xc9, 0xec, 0xe2, 0x38, 0xe7, 0x4a, 0x16, 0xd6, 0xef,
+		0x39, 0x57, 0x72, 0x40, 0xf5, 0x4d, 0x61, 0xa6, 0xb7, 0xfa, 0x44, 0xf7, 0xf2, 0x14, 0x39, 0x5f,
+		0xbc, 0x56, 0xde, 0xb9, 0x10, 0xad, 0x3e, 0xea, 0xb7, 0x86, 0xec, 0x64, 0xf3, 0xca, 0x1a, 0xf4,
+		0x28, 0x8f, 0xd9, 0x80, 0xb2, 0xb4, 0xf2, 0x78, 0x78, 0x60, 0xc7, 0xfd, 0x59, 0x6f, 0x6f, 0x8e,
+		0xfb, 0x0b, 0x8a, 0x86, 0xca, 0xc6, 0x10, 0x08, 0x75, 0xf8, 0x53, 0x0f, 0x16, 0xdc, 0xb0, 0x99,
+		0x85, 0x7b, 0x7a, 0x23, 0x45, 0xec, 0xab, 0x9f, 0xd5, 0xec, 0xc5, 0x21, 0x0f, 0xf7, 0x8c, 0x1f,
+		0x6c, 0x34, 0x28, 0x3f, 0x4a, 0x0e, 0xab, 0x6e, 0xd8, 0x5c, 0xef, 0xfe, 0x69, 0xca, 0x7d, 0xea,
+		0xf9, 0xeb, 0x8d, 0x50, 0xfd, 0xa2, 0x46, 0xff, 0x4e, 0xe5, 0x03, 0x1c, 0xd1, 0xd3, 0x8d, 0xc3,
+		0x71, 0xb9, 0xb6, 0xf5, 0xef, 0x01, 0x00, 0x4b, 0xdb, 0xd5, 0x62, 0xb6, 0x23, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/history.proto
 	[]byte{
@@ -1821,8 +2284,8 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0xb3, 0xa4, 0x83, 0x02, 0x7f, 0x39, 0xf3, 0x44, 0x7c, 0x8b, 0xb6, 0xcb, 0x9f, 0xbc, 0x41, 0x6f,
 		0xf8, 0x6f, 0xd2, 0x9f, 0x9e, 0xfd, 0xea, 0xe6, 0xcf, 0xe7, 0xdb, 0x85, 0x7a, 0xb6, 0xf6, 0x6e,
 		0x2e, 0x5f, 0x2f, 0xe6, 0xc2, 0x96, 0x75, 0x81, 0x99, 0x5a, 0x90, 0xe0, 0xf8, 0x78, 0x0e, 0x92,
-		0x34, 0x07, 0x5f, 0x3f, 0xf4, 0x55, 0xfb, 0xe5, 0xd2, 0x62, 0xab, 0xd9, 0x9c, 0x7f, 0x07, 0x00,
-		0x00, 0xff, 0xff, 0xc6, 0xf4, 0xa6, 0x9c, 0x12, 0x02, 0x00, 0x00,
+		0x34, 0x07, 0x5f, 0x3f, 0xf4, 0x55, 0xfb, 0xe5, 0xd2, 0x62, 0xab, 0xd9, 0x9c, 0x7f, 0x0f, 0x00,
+		0xc6, 0xf4, 0xa6, 0x9c, 0x12, 0x02, 0x00, 0x00,
 	},
 	// uber/cadence/admin/v1/history.proto
 	[]byte{
@@ -1839,8 +2302,7 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x0d, 0x6e, 0x23, 0x4d, 0x3d, 0xac, 0xce, 0xd4, 0xc3, 0x74, 0x63, 0x10, 0x44, 0x9f, 0x93, 0x79,
 		0x94, 0x69, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x72, 0x48, 0xe8,
 		0x66, 0xa6, 0xe4, 0xe8, 0xa7, 0xe7, 0xeb, 0x83, 0xfd, 0x0f, 0x0f, 0x16, 0x6b, 0x30, 0xa3, 0xcc,
-		0x30, 0x89, 0x0d, 0x2c, 0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x44, 0x14, 0xd7, 0xd4, 0x3e,
-		0x01, 0x00, 0x00,
+		0x30, 0x89, 0x0d, 0x2c, 0x6e, 0x0c, 0x18, 0x00, 0x44, 0x14, 0xd7, 0xd4, 0x3e, 0x01, 0x00, 0x00,
 	},
 	// uber/cadence/shared/v1/tasklist.proto
 	[]byte{
@@ -1854,8 +2316,8 @@ var yarpcFileDescriptorClosure826e827d3aabf7fc = [][]byte{
 		0x14, 0x92, 0xe2, 0x12, 0x43, 0x96, 0x70, 0x71, 0x8a, 0x77, 0x72, 0x74, 0xf6, 0xf6, 0xf1, 0x77,
 		0x17, 0x60, 0x72, 0x32, 0x8f, 0x32, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
 		0xd5, 0x47, 0x71, 0xa7, 0x5e, 0x7a, 0x6a, 0x9e, 0x3e, 0xd8, 0x65, 0x08, 0x27, 0x5b, 0x43, 0x58,
-		0x65, 0x86, 0x49, 0x6c, 0x60, 0x19, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x73, 0x06,
-		0x1c, 0xdc, 0x00, 0x00, 0x00,
+		0x65, 0x86, 0x49, 0x6c, 0x60, 0x19, 0x63, 0xc0, 0x00, 0x1f, 0x73, 0x06, 0x1c, 0xdc, 0x00, 0x00,
+		0x00,
 	},
 }
 
@@ -1865,4 +2327,9 @@ func init() {
 			return NewMatchingAPIYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
 		},
 	)
+	yarpc.RegisterClientBuilder(
+		func(clientConfig transport.ClientConfig, structField reflect.StructField) MatchingHealthAPIYARPCClient {
+			return NewMatchingHealthAPIYARPCClient(clientConfig, protobuf.ClientBuilderOptions(clientConfig, structField)...)
+		},
+	)
 }
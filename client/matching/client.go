@@ -188,10 +188,10 @@ func (c *clientImpl) CancelOutstandingPoll(
 	ctx context.Context,
 	request *types.CancelOutstandingPollRequest,
 	opts ...yarpc.CallOption,
-) error {
+) (*types.CancelOutstandingPollResponse, error) {
 	peer, err := c.peerResolver.FromTaskList(request.TaskList.GetName())
 	if err != nil {
-		return err
+		return nil, err
 	}
 	ctx, cancel := c.createContext(ctx)
 	defer cancel()
@@ -212,6 +212,34 @@ func (c *clientImpl) DescribeTaskList(
 	return c.client.DescribeTaskList(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
 }
 
+func (c *clientImpl) RefreshTaskList(
+	ctx context.Context,
+	request *types.MatchingRefreshTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingRefreshTaskListResponse, error) {
+	peer, err := c.peerResolver.FromTaskList(request.GetTaskList().GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.RefreshTaskList(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+}
+
+func (c *clientImpl) ResetTaskListAckLevel(
+	ctx context.Context,
+	request *types.MatchingResetTaskListAckLevelRequest,
+	opts ...yarpc.CallOption,
+) error {
+	peer, err := c.peerResolver.FromTaskList(request.GetTaskList().GetName())
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ResetTaskListAckLevel(ctx, request, append(opts, yarpc.WithShardKey(peer))...)
+}
+
 func (c *clientImpl) ListTaskListPartitions(
 	ctx context.Context,
 	request *types.MatchingListTaskListPartitionsRequest,
@@ -213,13 +213,14 @@ func (c *errorInjectionClient) CancelOutstandingPoll(
 	ctx context.Context,
 	request *types.CancelOutstandingPollRequest,
 	opts ...yarpc.CallOption,
-) error {
+) (*types.CancelOutstandingPollResponse, error) {
 	fakeErr := errors.GenerateFakeError(c.errorRate)
 
+	var resp *types.CancelOutstandingPollResponse
 	var clientErr error
 	var forwardCall bool
 	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
-		clientErr = c.client.CancelOutstandingPoll(ctx, request, opts...)
+		resp, clientErr = c.client.CancelOutstandingPoll(ctx, request, opts...)
 	}
 
 	if fakeErr != nil {
@@ -229,9 +230,9 @@ func (c *errorInjectionClient) CancelOutstandingPoll(
 			tag.Bool(forwardCall),
 			tag.ClientError(clientErr),
 		)
-		return fakeErr
+		return nil, fakeErr
 	}
-	return clientErr
+	return resp, clientErr
 }
 
 func (c *errorInjectionClient) DescribeTaskList(
@@ -260,6 +261,57 @@ func (c *errorInjectionClient) DescribeTaskList(
 	return resp, clientErr
 }
 
+func (c *errorInjectionClient) RefreshTaskList(
+	ctx context.Context,
+	request *types.MatchingRefreshTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingRefreshTaskListResponse, error) {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var resp *types.MatchingRefreshTaskListResponse
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		resp, clientErr = c.client.RefreshTaskList(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.MatchingClientOperationRefreshTaskList,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return nil, fakeErr
+	}
+	return resp, clientErr
+}
+
+func (c *errorInjectionClient) ResetTaskListAckLevel(
+	ctx context.Context,
+	request *types.MatchingResetTaskListAckLevelRequest,
+	opts ...yarpc.CallOption,
+) error {
+	fakeErr := errors.GenerateFakeError(c.errorRate)
+
+	var clientErr error
+	var forwardCall bool
+	if forwardCall = errors.ShouldForwardCall(fakeErr); forwardCall {
+		clientErr = c.client.ResetTaskListAckLevel(ctx, request, opts...)
+	}
+
+	if fakeErr != nil {
+		c.logger.Error(msgInjectedFakeErr,
+			tag.MatchingClientOperationResetTaskListAckLevel,
+			tag.Error(fakeErr),
+			tag.Bool(forwardCall),
+			tag.ClientError(clientErr),
+		)
+		return fakeErr
+	}
+	return clientErr
+}
+
 func (c *errorInjectionClient) ListTaskListPartitions(
 	ctx context.Context,
 	request *types.MatchingListTaskListPartitionsRequest,
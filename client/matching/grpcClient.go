@@ -48,9 +48,9 @@ func (g grpcClient) AddDecisionTask(ctx context.Context, request *types.AddDecis
 	return proto.ToError(err)
 }
 
-func (g grpcClient) CancelOutstandingPoll(ctx context.Context, request *types.CancelOutstandingPollRequest, opts ...yarpc.CallOption) error {
-	_, err := g.c.CancelOutstandingPoll(ctx, proto.FromMatchingCancelOutstandingPollRequest(request), opts...)
-	return proto.ToError(err)
+func (g grpcClient) CancelOutstandingPoll(ctx context.Context, request *types.CancelOutstandingPollRequest, opts ...yarpc.CallOption) (*types.CancelOutstandingPollResponse, error) {
+	response, err := g.c.CancelOutstandingPoll(ctx, proto.FromMatchingCancelOutstandingPollRequest(request), opts...)
+	return proto.ToMatchingCancelOutstandingPollResponse(response), proto.ToError(err)
 }
 
 func (g grpcClient) DescribeTaskList(ctx context.Context, request *types.MatchingDescribeTaskListRequest, opts ...yarpc.CallOption) (*types.DescribeTaskListResponse, error) {
@@ -58,6 +58,16 @@ func (g grpcClient) DescribeTaskList(ctx context.Context, request *types.Matchin
 	return proto.ToMatchingDescribeTaskListResponse(response), proto.ToError(err)
 }
 
+func (g grpcClient) RefreshTaskList(ctx context.Context, request *types.MatchingRefreshTaskListRequest, opts ...yarpc.CallOption) (*types.MatchingRefreshTaskListResponse, error) {
+	response, err := g.c.RefreshTaskList(ctx, proto.FromMatchingRefreshTaskListRequest(request), opts...)
+	return proto.ToMatchingRefreshTaskListResponse(response), proto.ToError(err)
+}
+
+func (g grpcClient) ResetTaskListAckLevel(ctx context.Context, request *types.MatchingResetTaskListAckLevelRequest, opts ...yarpc.CallOption) error {
+	_, err := g.c.ResetTaskListAckLevel(ctx, proto.FromMatchingResetTaskListAckLevelRequest(request), opts...)
+	return proto.ToError(err)
+}
+
 func (g grpcClient) ListTaskListPartitions(ctx context.Context, request *types.MatchingListTaskListPartitionsRequest, opts ...yarpc.CallOption) (*types.ListTaskListPartitionsResponse, error) {
 	response, err := g.c.ListTaskListPartitions(ctx, proto.FromMatchingListTaskListPartitionsRequest(request), opts...)
 	return proto.ToMatchingListTaskListPartitionsResponse(response), proto.ToError(err)
@@ -98,15 +98,16 @@ func (mr *MockClientMockRecorder) AddDecisionTask(arg0, arg1 interface{}, arg2 .
 }
 
 // CancelOutstandingPoll mocks base method.
-func (m *MockClient) CancelOutstandingPoll(arg0 context.Context, arg1 *types.CancelOutstandingPollRequest, arg2 ...yarpc.CallOption) error {
+func (m *MockClient) CancelOutstandingPoll(arg0 context.Context, arg1 *types.CancelOutstandingPollRequest, arg2 ...yarpc.CallOption) (*types.CancelOutstandingPollResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "CancelOutstandingPoll", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*types.CancelOutstandingPollResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CancelOutstandingPoll indicates an expected call of CancelOutstandingPoll.
@@ -236,6 +237,45 @@ func (mr *MockClientMockRecorder) QueryWorkflow(arg0, arg1 interface{}, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockClient)(nil).QueryWorkflow), varargs...)
 }
 
+// RefreshTaskList mocks base method.
+func (m *MockClient) RefreshTaskList(arg0 context.Context, arg1 *types.MatchingRefreshTaskListRequest, arg2 ...yarpc.CallOption) (*types.MatchingRefreshTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RefreshTaskList", varargs...)
+	ret0, _ := ret[0].(*types.MatchingRefreshTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshTaskList indicates an expected call of RefreshTaskList.
+func (mr *MockClientMockRecorder) RefreshTaskList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTaskList", reflect.TypeOf((*MockClient)(nil).RefreshTaskList), varargs...)
+}
+
+// ResetTaskListAckLevel mocks base method.
+func (m *MockClient) ResetTaskListAckLevel(arg0 context.Context, arg1 *types.MatchingResetTaskListAckLevelRequest, arg2 ...yarpc.CallOption) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResetTaskListAckLevel", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetTaskListAckLevel indicates an expected call of ResetTaskListAckLevel.
+func (mr *MockClientMockRecorder) ResetTaskListAckLevel(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetTaskListAckLevel", reflect.TypeOf((*MockClient)(nil).ResetTaskListAckLevel), varargs...)
+}
+
 // RespondQueryTaskCompleted mocks base method.
 func (m *MockClient) RespondQueryTaskCompleted(arg0 context.Context, arg1 *types.MatchingRespondQueryTaskCompletedRequest, arg2 ...yarpc.CallOption) error {
 	m.ctrl.T.Helper()
@@ -192,18 +192,18 @@ func (c *metricClient) CancelOutstandingPoll(
 	ctx context.Context,
 	request *types.CancelOutstandingPollRequest,
 	opts ...yarpc.CallOption,
-) error {
+) (*types.CancelOutstandingPollResponse, error) {
 	c.metricsClient.IncCounter(metrics.MatchingClientCancelOutstandingPollScope, metrics.CadenceClientRequests)
 
 	sw := c.metricsClient.StartTimer(metrics.MatchingClientCancelOutstandingPollScope, metrics.CadenceClientLatency)
-	err := c.client.CancelOutstandingPoll(ctx, request, opts...)
+	resp, err := c.client.CancelOutstandingPoll(ctx, request, opts...)
 	sw.Stop()
 
 	if err != nil {
 		c.metricsClient.IncCounter(metrics.MatchingClientCancelOutstandingPollScope, metrics.CadenceClientFailures)
 	}
 
-	return err
+	return resp, err
 }
 
 func (c *metricClient) DescribeTaskList(
@@ -224,6 +224,42 @@ func (c *metricClient) DescribeTaskList(
 	return resp, err
 }
 
+func (c *metricClient) RefreshTaskList(
+	ctx context.Context,
+	request *types.MatchingRefreshTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingRefreshTaskListResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientRefreshTaskListScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientRefreshTaskListScope, metrics.CadenceClientLatency)
+	resp, err := c.client.RefreshTaskList(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientRefreshTaskListScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) ResetTaskListAckLevel(
+	ctx context.Context,
+	request *types.MatchingResetTaskListAckLevelRequest,
+	opts ...yarpc.CallOption,
+) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientResetTaskListAckLevelScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientResetTaskListAckLevelScope, metrics.CadenceClientLatency)
+	err := c.client.ResetTaskListAckLevel(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientResetTaskListAckLevelScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) ListTaskListPartitions(
 	ctx context.Context,
 	request *types.MatchingListTaskListPartitionsRequest,
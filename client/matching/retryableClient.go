@@ -144,13 +144,17 @@ func (c *retryableClient) CancelOutstandingPoll(
 	ctx context.Context,
 	request *types.CancelOutstandingPollRequest,
 	opts ...yarpc.CallOption,
-) error {
+) (*types.CancelOutstandingPollResponse, error) {
 
+	var resp *types.CancelOutstandingPollResponse
 	op := func() error {
-		return c.client.CancelOutstandingPoll(ctx, request, opts...)
+		var err error
+		resp, err = c.client.CancelOutstandingPoll(ctx, request, opts...)
+		return err
 	}
 
-	return c.throttleRetry.Do(ctx, op)
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
 }
 
 func (c *retryableClient) DescribeTaskList(
@@ -170,6 +174,36 @@ func (c *retryableClient) DescribeTaskList(
 	return resp, err
 }
 
+func (c *retryableClient) RefreshTaskList(
+	ctx context.Context,
+	request *types.MatchingRefreshTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingRefreshTaskListResponse, error) {
+
+	var resp *types.MatchingRefreshTaskListResponse
+	op := func() error {
+		var err error
+		resp, err = c.client.RefreshTaskList(ctx, request, opts...)
+		return err
+	}
+
+	err := c.throttleRetry.Do(ctx, op)
+	return resp, err
+}
+
+func (c *retryableClient) ResetTaskListAckLevel(
+	ctx context.Context,
+	request *types.MatchingResetTaskListAckLevelRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	op := func() error {
+		return c.client.ResetTaskListAckLevel(ctx, request, opts...)
+	}
+
+	return c.throttleRetry.Do(ctx, op)
+}
+
 func (c *retryableClient) ListTaskListPartitions(
 	ctx context.Context,
 	request *types.MatchingListTaskListPartitionsRequest,
@@ -61,9 +61,13 @@ func (t thriftClient) CancelOutstandingPoll(
 	ctx context.Context,
 	request *types.CancelOutstandingPollRequest,
 	opts ...yarpc.CallOption,
-) error {
+) (*types.CancelOutstandingPollResponse, error) {
+	// thrift API does not report the number of cancelled polls
 	err := t.c.CancelOutstandingPoll(ctx, thrift.FromCancelOutstandingPollRequest(request), opts...)
-	return thrift.ToError(err)
+	if err != nil {
+		return nil, thrift.ToError(err)
+	}
+	return &types.CancelOutstandingPollResponse{}, nil
 }
 
 func (t thriftClient) DescribeTaskList(
@@ -75,6 +79,22 @@ func (t thriftClient) DescribeTaskList(
 	return thrift.ToDescribeTaskListResponse(response), thrift.ToError(err)
 }
 
+func (t thriftClient) RefreshTaskList(
+	ctx context.Context,
+	request *types.MatchingRefreshTaskListRequest,
+	opts ...yarpc.CallOption,
+) (*types.MatchingRefreshTaskListResponse, error) {
+	return nil, thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
+func (t thriftClient) ResetTaskListAckLevel(
+	ctx context.Context,
+	request *types.MatchingResetTaskListAckLevelRequest,
+	opts ...yarpc.CallOption,
+) error {
+	return thrift.ToError(&types.BadRequestError{Message: "Feature not supported on TChannel"})
+}
+
 func (t thriftClient) ListTaskListPartitions(
 	ctx context.Context,
 	request *types.MatchingListTaskListPartitionsRequest,
@@ -27,7 +27,9 @@ import (
 	"os/signal"
 	"path/filepath"
 	"strings"
+	"sync"
 	"syscall"
+	"time"
 
 	"github.com/urfave/cli"
 
@@ -45,30 +47,7 @@ var validServices = service.ShortNames(service.List)
 
 // startHandler is the handler for the cli start command
 func startHandler(c *cli.Context) {
-	env := getEnvironment(c)
-	zone := getZone(c)
-	configDir := getConfigDir(c)
-	rootDir := getRootDir(c)
-
-	log.Printf("Loading config; env=%v,zone=%v,configDir=%v\n", env, zone, configDir)
-
-	var cfg config.Config
-	err := config.Load(env, configDir, zone, &cfg)
-	if err != nil {
-		log.Fatal(fmt.Sprintf("Config file corrupted: %v", err))
-	}
-	if cfg.Log.Level == "debug" {
-		log.Printf("config=\n%v\n", cfg.String())
-	}
-	if cfg.DynamicConfig.Client == "" {
-		cfg.DynamicConfigClient.Filepath = constructPathIfNeed(rootDir, cfg.DynamicConfigClient.Filepath)
-	} else {
-		cfg.DynamicConfig.FileBased.Filepath = constructPathIfNeed(rootDir, cfg.DynamicConfig.FileBased.Filepath)
-	}
-
-	if err := cfg.ValidateAndFillDefaults(); err != nil {
-		log.Fatalf("config validation failed: %v", err)
-	}
+	cfg := loadConfig(c)
 	// cassandra schema version validation
 	if err := cassandra.VerifyCompatibleVersion(cfg.Persistence, gocql.Quorum); err != nil {
 		log.Fatal("cassandra schema version compatibility check failed: ", err)
@@ -78,22 +57,77 @@ func startHandler(c *cli.Context) {
 		log.Fatal("sql schema version compatibility check failed: ", err)
 	}
 
-	var daemons []common.Daemon
+	daemons := make(map[string]common.Daemon)
 	services := getServices(c)
 	sigc := make(chan os.Signal, 1)
 	signal.Notify(sigc, syscall.SIGTERM, syscall.SIGINT)
 	for _, svc := range services {
-		server := newServer(svc, &cfg)
-		daemons = append(daemons, server)
+		server := newServer(svc, cfg, c.Duration("shutdown-timeout"))
+		daemons[svc] = server
 		server.Start()
 	}
 
-	<-sigc
-	log.Println("Received SIGTERM signal, initiating shutdown.")
-	for _, daemon := range daemons {
+	sig := <-sigc
+	log.Printf("Received %v signal, draining services %v before shutdown.\n", sig, services)
+	stopDaemons(daemons)
+	log.Println("All services stopped, exiting.")
+	os.Exit(0)
+}
+
+// stopDaemons stops the daemons by service name. The frontend is stopped first so that it stops accepting
+// requests and drains those in flight while the other services still serve them. The other services are then
+// stopped concurrently, so the shutdown takes as long as their slowest drain rather than the sum of them.
+func stopDaemons(daemons map[string]common.Daemon) {
+	frontend := service.ShortName(service.Frontend)
+	if daemon, ok := daemons[frontend]; ok {
 		daemon.Stop()
 	}
-	os.Exit(0)
+
+	var wg sync.WaitGroup
+	for name, daemon := range daemons {
+		if name == frontend {
+			continue
+		}
+		wg.Add(1)
+		go func(daemon common.Daemon) {
+			defer wg.Done()
+			daemon.Stop()
+		}(daemon)
+	}
+	wg.Wait()
+}
+
+// loadConfig loads and validates the config selected by the global flags
+func loadConfig(c *cli.Context) *config.Config {
+	cfg, err := readConfig(getEnvironment(c), getConfigDir(c), getZone(c), getRootDir(c))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := cfg.ValidateAndFillDefaults(); err != nil {
+		log.Fatalf("config validation failed: %v", err)
+	}
+	return cfg
+}
+
+// readConfig loads the config files and resolves the dynamic config file path against rootDir,
+// the returned config is not validated yet
+func readConfig(env string, configDir string, zone string, rootDir string) (*config.Config, error) {
+	log.Printf("Loading config; env=%v,zone=%v,configDir=%v\n", env, zone, configDir)
+
+	var cfg config.Config
+	err := config.Load(env, configDir, zone, &cfg)
+	if err != nil {
+		return nil, fmt.Errorf("Config file corrupted: %v", err)
+	}
+	if cfg.Log.Level == "debug" {
+		log.Printf("config=\n%v\n", cfg.String())
+	}
+	if cfg.DynamicConfig.Client == "" {
+		cfg.DynamicConfigClient.Filepath = constructPathIfNeed(rootDir, cfg.DynamicConfigClient.Filepath)
+	} else {
+		cfg.DynamicConfig.FileBased.Filepath = constructPathIfNeed(rootDir, cfg.DynamicConfig.FileBased.Filepath)
+	}
+	return &cfg, nil
 }
 
 func getEnvironment(c *cli.Context) string {
@@ -211,11 +245,35 @@ func BuildCLI(releaseVersion string, gitRevision string) *cli.App {
 					Value: strings.Join(validServices, ","),
 					Usage: "list of services to start",
 				},
+				cli.DurationFlag{
+					Name:  "shutdown-timeout",
+					Value: time.Minute,
+					Usage: "max time to wait for each service to drain its traffic and stop after SIGTERM",
+				},
 			},
 			Action: func(c *cli.Context) {
 				startHandler(c)
 			},
 		},
+		{
+			Name:  "validate",
+			Usage: "validate the config without starting the server, exits non-zero if any problem is found",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "services, s",
+					Value: strings.Join(validServices, ","),
+					Usage: "list of services to validate the config for",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return validateHandler(c)
+			},
+		},
+		{
+			Name:        "admin",
+			Usage:       "offline admin tools",
+			Subcommands: newAdminCommands(),
+		},
 	}
 
 	return app
@@ -22,9 +22,12 @@ package cadence
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/uber/cadence/common"
 )
 
 type CadenceSuite struct {
@@ -54,3 +57,47 @@ func (s *CadenceSuite) TestPath() {
 	s.Equal("foo/bar", constructPathIfNeed("foo", "bar"))
 	s.Equal("/bar", constructPathIfNeed("foo", "/bar"))
 }
+
+type slowDaemon struct {
+	stopDelay time.Duration
+	stopped   chan struct{}
+	// mustStopAfter, if set, must be stopped before the daemon is
+	mustStopAfter *slowDaemon
+	stoppedEarly  bool
+}
+
+func (d *slowDaemon) Start() {}
+
+func (d *slowDaemon) Stop() {
+	if d.mustStopAfter != nil {
+		select {
+		case <-d.mustStopAfter.stopped:
+		default:
+			d.stoppedEarly = true
+		}
+	}
+	time.Sleep(d.stopDelay)
+	close(d.stopped)
+}
+
+func (s *CadenceSuite) TestStopDaemons() {
+	frontend := &slowDaemon{stopDelay: 100 * time.Millisecond, stopped: make(chan struct{})}
+	daemons := map[string]common.Daemon{"frontend": frontend}
+	for _, name := range []string{"history", "matching", "worker"} {
+		daemons[name] = &slowDaemon{stopDelay: 100 * time.Millisecond, stopped: make(chan struct{}), mustStopAfter: frontend}
+	}
+
+	start := time.Now()
+	stopDaemons(daemons)
+	// the frontend is stopped first, then the other daemons are stopped concurrently
+	s.True(time.Since(start) >= 200*time.Millisecond)
+	s.True(time.Since(start) < 350*time.Millisecond)
+	for _, daemon := range daemons {
+		s.False(daemon.(*slowDaemon).stoppedEarly)
+		select {
+		case <-daemon.(*slowDaemon).stopped:
+		default:
+			s.Fail("daemon is not stopped")
+		}
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	persistenceClient "github.com/uber/cadence/common/persistence/client"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra/gocql"
+	mysql_db "github.com/uber/cadence/common/persistence/sql/sqlplugin/mysql"
+	postgres_db "github.com/uber/cadence/common/persistence/sql/sqlplugin/postgres"
+	cassandra_schema "github.com/uber/cadence/schema/cassandra"
+	mysql_schema "github.com/uber/cadence/schema/mysql"
+	postgres_schema "github.com/uber/cadence/schema/postgres"
+	"github.com/uber/cadence/tools/cassandra"
+	"github.com/uber/cadence/tools/common/schema"
+	"github.com/uber/cadence/tools/sql"
+)
+
+const (
+	dbRoleDefault    = "default"
+	dbRoleVisibility = "visibility"
+)
+
+type dbPingResult struct {
+	Store                 string `json:"store"`
+	Role                  string `json:"role"`
+	Plugin                string `json:"plugin"`
+	Latency               string `json:"latency,omitempty"`
+	SchemaVersion         string `json:"schemaVersion,omitempty"`
+	ExpectedSchemaVersion string `json:"expectedSchemaVersion,omitempty"`
+	Error                 string `json:"error,omitempty"`
+}
+
+func newDBCommand() cli.Command {
+	return cli.Command{
+		Name:  "db",
+		Usage: "check the persistence stores of the server",
+		Subcommands: []cli.Command{
+			{
+				Name:  "ping",
+				Usage: "connect to the default and visibility stores, run a trivial read and check their schema versions",
+				Flags: []cli.Flag{
+					cli.DurationFlag{
+						Name:  "timeout",
+						Value: 10 * time.Second,
+						Usage: "timeout of the read done on each store",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return pingDBHandler(c)
+				},
+			},
+		},
+	}
+}
+
+func pingDBHandler(c *cli.Context) error {
+	cfg := loadConfig(c)
+
+	results := pingDBs(cfg, c.Duration("timeout"))
+	output, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return cli.NewExitError(fmt.Sprintf("Failed to marshal results: %v", err), 1)
+	}
+	fmt.Fprintln(c.App.Writer, string(output))
+
+	for _, result := range results {
+		if result.Error != "" {
+			return cli.NewExitError("some stores failed the check", 1)
+		}
+	}
+	return nil
+}
+
+// pingDBs checks the default store by reading the domain replication queue ack levels, and the
+// visibility store, if it is not an advanced visibility store, by reading its schema version
+func pingDBs(cfg *config.Config, timeout time.Duration) []*dbPingResult {
+	results := []*dbPingResult{pingDefaultStore(cfg, timeout)}
+	if ds, ok := cfg.Persistence.DataStores[cfg.Persistence.VisibilityStore]; ok {
+		result := newDBPingResult(cfg.Persistence.VisibilityStore, dbRoleVisibility, ds)
+		start := time.Now()
+		if err := checkSchemaVersion(ds, result); err != nil {
+			result.Error = err.Error()
+		} else {
+			result.Latency = time.Since(start).String()
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+func pingDefaultStore(cfg *config.Config, timeout time.Duration) *dbPingResult {
+	ds := cfg.Persistence.DataStores[cfg.Persistence.DefaultStore]
+	result := newDBPingResult(cfg.Persistence.DefaultStore, dbRoleDefault, ds)
+	if err := checkSchemaVersion(ds, result); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	persistenceCfg := cfg.Persistence
+	persistenceCfg.TransactionSizeLimit = dynamicconfig.GetIntPropertyFn(0)
+	persistenceCfg.ErrorInjectionRate = dynamicconfig.GetFloatPropertyFn(0)
+	factory := persistenceClient.NewFactory(
+		&persistenceCfg,
+		func() float64 { return 0 },
+		cfg.ClusterGroupMetadata.CurrentClusterName,
+		metrics.NewNoopMetricsClient(),
+		loggerimpl.NewNopLogger(),
+		&persistence.DynamicConfiguration{
+			EnableSQLAsyncTransaction: dynamicconfig.GetBoolPropertyFn(false),
+		},
+	)
+	defer factory.Close()
+
+	queueManager, err := factory.NewDomainReplicationQueueManager()
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	defer queueManager.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	start := time.Now()
+	if _, err := queueManager.GetAckLevels(ctx); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Latency = time.Since(start).String()
+	return result
+}
+
+func newDBPingResult(store string, role string, ds config.DataStore) *dbPingResult {
+	result := &dbPingResult{Store: store, Role: role}
+	switch {
+	case ds.NoSQL != nil:
+		result.Plugin = ds.NoSQL.PluginName
+	case ds.ShardedNoSQL != nil:
+		if connection, ok := ds.ShardedNoSQL.Connections[ds.ShardedNoSQL.DefaultShard]; ok && connection.NoSQLPlugin != nil {
+			result.Plugin = connection.NoSQLPlugin.PluginName
+		}
+	case ds.SQL != nil:
+		result.Plugin = ds.SQL.PluginName
+	}
+	return result
+}
+
+// checkSchemaVersion reads the schema version of the store, for sharded stores the default shard
+// or the first database is checked, and fails if it is older than the version this binary expects
+func checkSchemaVersion(ds config.DataStore, result *dbPingResult) error {
+	var (
+		client schema.SchemaClient
+		dbName string
+	)
+	switch {
+	case ds.NoSQL != nil || ds.ShardedNoSQL != nil:
+		nosqlCfg := ds.NoSQL
+		if nosqlCfg == nil {
+			nosqlCfg = ds.ShardedNoSQL.Connections[ds.ShardedNoSQL.DefaultShard].NoSQLPlugin
+		}
+		if nosqlCfg == nil || nosqlCfg.PluginName != "cassandra" {
+			return fmt.Errorf("schema version check is not supported for nosql plugin %q", result.Plugin)
+		}
+		result.ExpectedSchemaVersion = cassandra_schema.Version
+		if result.Role == dbRoleVisibility {
+			result.ExpectedSchemaVersion = cassandra_schema.VisibilityVersion
+		}
+		cqlClient, err := cassandra.NewCQLClientFromConfig(*nosqlCfg, gocql.Quorum)
+		if err != nil {
+			return fmt.Errorf("creating CQL client: %w", err)
+		}
+		defer cqlClient.Close()
+		client, dbName = cqlClient, nosqlCfg.Keyspace
+	case ds.SQL != nil:
+		sqlCfg := *ds.SQL
+		if sqlCfg.UseMultipleDatabases && len(sqlCfg.MultipleDatabasesConfig) > 0 {
+			entry := sqlCfg.MultipleDatabasesConfig[0]
+			sqlCfg.UseMultipleDatabases = false
+			sqlCfg.User, sqlCfg.Password = entry.User, entry.Password
+			sqlCfg.DatabaseName, sqlCfg.ConnectAddr = entry.DatabaseName, entry.ConnectAddr
+		}
+		result.ExpectedSchemaVersion = expectedSQLSchemaVersion(sqlCfg.PluginName, result.Role)
+		connection, err := sql.NewConnection(&sqlCfg)
+		if err != nil {
+			return fmt.Errorf("creating SQL connection: %w", err)
+		}
+		defer connection.Close()
+		client, dbName = connection, sqlCfg.DatabaseName
+	default:
+		return errors.New("store has no nosql or sql config")
+	}
+
+	version, err := client.ReadSchemaVersion()
+	if err != nil {
+		return fmt.Errorf("reading schema version: %w", err)
+	}
+	result.SchemaVersion = version
+	if result.ExpectedSchemaVersion == "" {
+		return nil
+	}
+	return schema.VerifyCompatibleVersion(client, dbName, result.ExpectedSchemaVersion)
+}
+
+func expectedSQLSchemaVersion(pluginName string, role string) string {
+	switch pluginName {
+	case mysql_db.PluginName:
+		if role == dbRoleVisibility {
+			return mysql_schema.VisibilityVersion
+		}
+		return mysql_schema.Version
+	case postgres_db.PluginName:
+		if role == dbRoleVisibility {
+			return postgres_schema.VisibilityVersion
+		}
+		return postgres_schema.Version
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/config"
+	mysql_schema "github.com/uber/cadence/schema/mysql"
+	postgres_schema "github.com/uber/cadence/schema/postgres"
+)
+
+func TestNewDBPingResult(t *testing.T) {
+	tests := map[string]struct {
+		ds             config.DataStore
+		expectedPlugin string
+	}{
+		"nosql": {
+			ds:             config.DataStore{NoSQL: &config.NoSQL{PluginName: "cassandra"}},
+			expectedPlugin: "cassandra",
+		},
+		"sharded nosql": {
+			ds: config.DataStore{ShardedNoSQL: &config.ShardedNoSQL{
+				DefaultShard: "shard-1",
+				Connections: map[string]config.DBShardConnection{
+					"shard-1": {NoSQLPlugin: &config.NoSQL{PluginName: "cassandra"}},
+				},
+			}},
+			expectedPlugin: "cassandra",
+		},
+		"sql": {
+			ds:             config.DataStore{SQL: &config.SQL{PluginName: "mysql"}},
+			expectedPlugin: "mysql",
+		},
+		"advanced visibility": {
+			ds: config.DataStore{ElasticSearch: &config.ElasticSearchConfig{}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			result := newDBPingResult("store", dbRoleDefault, tc.ds)
+			assert.Equal(t, "store", result.Store)
+			assert.Equal(t, dbRoleDefault, result.Role)
+			assert.Equal(t, tc.expectedPlugin, result.Plugin)
+		})
+	}
+}
+
+func TestCheckSchemaVersion_Unsupported(t *testing.T) {
+	result := newDBPingResult("store", dbRoleDefault, config.DataStore{NoSQL: &config.NoSQL{PluginName: "dynamodb"}})
+	err := checkSchemaVersion(config.DataStore{NoSQL: &config.NoSQL{PluginName: "dynamodb"}}, result)
+	assert.EqualError(t, err, `schema version check is not supported for nosql plugin "dynamodb"`)
+
+	err = checkSchemaVersion(config.DataStore{}, &dbPingResult{})
+	assert.Error(t, err)
+}
+
+func TestExpectedSQLSchemaVersion(t *testing.T) {
+	assert.Equal(t, mysql_schema.Version, expectedSQLSchemaVersion("mysql", dbRoleDefault))
+	assert.Equal(t, mysql_schema.VisibilityVersion, expectedSQLSchemaVersion("mysql", dbRoleVisibility))
+	assert.Equal(t, postgres_schema.Version, expectedSQLSchemaVersion("postgres", dbRoleDefault))
+	assert.Equal(t, postgres_schema.VisibilityVersion, expectedSQLSchemaVersion("postgres", dbRoleVisibility))
+	assert.Empty(t, expectedSQLSchemaVersion("sqlite", dbRoleDefault))
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/urfave/cli"
+	"gopkg.in/yaml.v2"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/dynamicconfig/configstore"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	// dynamicConfigSourceFilters means the value comes from a value whose filters matched
+	dynamicConfigSourceFilters = "filters"
+	// dynamicConfigSourceDefault means the value comes from the unfiltered value configured for the key
+	dynamicConfigSourceDefault = "default"
+	// dynamicConfigSourceKeyDefault means nothing is configured and the value is the key's default
+	dynamicConfigSourceKeyDefault = "key-default"
+)
+
+type resolvedDynamicConfig struct {
+	Name           string                 `json:"name"`
+	Filters        map[string]interface{} `json:"filters,omitempty"`
+	Value          interface{}            `json:"value"`
+	Source         string                 `json:"source"`
+	MatchedFilters map[string]interface{} `json:"matchedFilters,omitempty"`
+}
+
+func newDynamicConfigCommand() cli.Command {
+	return cli.Command{
+		Name:  "dynamic-config",
+		Usage: "inspect the dynamic config of the server",
+		Subcommands: []cli.Command{
+			{
+				Name:  "resolve",
+				Usage: "print the value a dynamic config key resolves to and the filters that selected it",
+				Flags: []cli.Flag{
+					cli.StringFlag{
+						Name:  "name, n",
+						Usage: "name of the dynamic config key, e.g. history.persistenceMaxQPS",
+					},
+					cli.StringSliceFlag{
+						Name:  "filter",
+						Usage: "filter to resolve the key with, in the form of name=value, e.g. domainName=samples-domain",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					return resolveDynamicConfigHandler(c)
+				},
+			},
+		},
+	}
+}
+
+func resolveDynamicConfigHandler(c *cli.Context) error {
+	key, err := dynamicconfig.GetKeyFromKeyName(c.String("name"))
+	if err != nil {
+		return cli.NewExitError(err.Error(), 1)
+	}
+	filters, err := parseDynamicConfigFilters(c.StringSlice("filter"))
+	if err != nil {
+		return cli.NewExitError(err.Error(), 1)
+	}
+
+	doneC := make(chan struct{})
+	defer close(doneC)
+	client, err := newDynamicConfigClient(loadConfig(c), loggerimpl.NewNopLogger(), doneC)
+	if err != nil {
+		return cli.NewExitError(fmt.Sprintf("Failed to create dynamic config client: %v", err), 1)
+	}
+
+	resolved, err := resolveDynamicConfig(client, key, filters)
+	if err != nil {
+		return cli.NewExitError(fmt.Sprintf("Failed to resolve %v: %v", key, err), 1)
+	}
+	output, err := json.MarshalIndent(resolved, "", "  ")
+	if err != nil {
+		return cli.NewExitError(fmt.Sprintf("Failed to marshal value: %v", err), 1)
+	}
+	fmt.Fprintln(c.App.Writer, string(output))
+	return nil
+}
+
+// newDynamicConfigClient creates the dynamic config client selected by the config,
+// falling back to the legacy file based client config if no client is specified
+func newDynamicConfigClient(cfg *config.Config, logger log.Logger, doneC chan struct{}) (dynamicconfig.Client, error) {
+	if cfg.DynamicConfig.Client == "" {
+		logger.Warn("falling back to legacy file based dynamicClientConfig")
+		return dynamicconfig.NewFileBasedClient(&cfg.DynamicConfigClient, logger, doneC)
+	}
+
+	switch cfg.DynamicConfig.Client {
+	case dynamicconfig.ConfigStoreClient:
+		logger.Info("initialising ConfigStore dynamic config client")
+		return configstore.NewConfigStoreClient(
+			&cfg.DynamicConfig.ConfigStore,
+			&cfg.Persistence,
+			logger,
+			persistence.DynamicConfig,
+		)
+	case dynamicconfig.FileBasedClient:
+		logger.Info("initialising File Based dynamic config client")
+		return dynamicconfig.NewFileBasedClient(&cfg.DynamicConfig.FileBased, logger, doneC)
+	default:
+		logger.Info("initialising NOP dynamic config client")
+		return dynamicconfig.NewNopClient(), nil
+	}
+}
+
+// parseDynamicConfigFilters parses name=value filters, values are parsed as yaml
+// so they get the same types as the constraints in the file based config, e.g. taskType=0 is an int
+func parseDynamicConfigFilters(rawFilters []string) (map[dynamicconfig.Filter]interface{}, error) {
+	filters := make(map[dynamicconfig.Filter]interface{}, len(rawFilters))
+	for _, rawFilter := range rawFilters {
+		parts := strings.SplitN(rawFilter, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid filter %q, expected name=value", rawFilter)
+		}
+		filter := dynamicconfig.ParseFilter(parts[0])
+		if filter == dynamicconfig.UnknownFilter {
+			return nil, fmt.Errorf("unknown filter %q", parts[0])
+		}
+		var value interface{}
+		if err := yaml.Unmarshal([]byte(parts[1]), &value); err != nil || value == nil {
+			value = parts[1]
+		}
+		filters[filter] = value
+	}
+	return filters, nil
+}
+
+// resolveDynamicConfig resolves the value of the key with the given filters, and finds the configured
+// value that was picked using the same rules as the clients: the first value whose filters all match wins,
+// otherwise the value without filters is used
+func resolveDynamicConfig(
+	client dynamicconfig.Client,
+	key dynamicconfig.Key,
+	filters map[dynamicconfig.Filter]interface{},
+) (*resolvedDynamicConfig, error) {
+	resolved := &resolvedDynamicConfig{
+		Name:    key.String(),
+		Filters: make(map[string]interface{}, len(filters)),
+	}
+	for filter, value := range filters {
+		resolved.Filters[filter.String()] = value
+	}
+
+	value, err := client.GetValueWithFilters(key, filters)
+	if err == dynamicconfig.NotFoundError {
+		resolved.Value = key.DefaultValue()
+		resolved.Source = dynamicConfigSourceKeyDefault
+		return resolved, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	resolved.Value = value
+	resolved.Source = dynamicConfigSourceDefault
+
+	entries, err := client.ListValue(key)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.Name != key.String() {
+			continue
+		}
+		for _, dcValue := range entry.Values {
+			if len(dcValue.Filters) == 0 {
+				continue
+			}
+			matchedFilters, ok, err := matchDynamicConfigFilters(dcValue.Filters, filters)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				resolved.Source = dynamicConfigSourceFilters
+				resolved.MatchedFilters = matchedFilters
+				return resolved, nil
+			}
+		}
+	}
+	return resolved, nil
+}
+
+// matchDynamicConfigFilters returns the decoded value filters if all of them are present in the given filters,
+// values are compared by their JSON representation as the clients may decode numbers into different types
+func matchDynamicConfigFilters(
+	valueFilters []*types.DynamicConfigFilter,
+	filters map[dynamicconfig.Filter]interface{},
+) (map[string]interface{}, bool, error) {
+	matchedFilters := make(map[string]interface{}, len(valueFilters))
+	for _, valueFilter := range valueFilters {
+		requestValue, ok := filters[dynamicconfig.ParseFilter(valueFilter.Name)]
+		if !ok {
+			return nil, false, nil
+		}
+		var filterValue interface{}
+		if err := json.Unmarshal(valueFilter.Value.GetData(), &filterValue); err != nil {
+			return nil, false, fmt.Errorf("failed to decode filter %v: %v", valueFilter.Name, err)
+		}
+		expected, err := json.Marshal(filterValue)
+		if err != nil {
+			return nil, false, err
+		}
+		actual, err := json.Marshal(requestValue)
+		if err != nil || string(expected) != string(actual) {
+			return nil, false, nil
+		}
+		matchedFilters[valueFilter.Name] = filterValue
+	}
+	return matchedFilters, true, nil
+}
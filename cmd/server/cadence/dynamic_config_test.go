@@ -0,0 +1,120 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log"
+)
+
+const testDynamicConfig = `
+matching.numTasklistReadPartitions:
+- value: 1
+  constraints: {}
+- value: 4
+  constraints:
+    domainName: samples-domain
+    taskType: 0
+- value: 2
+  constraints:
+    domainName: samples-domain
+`
+
+func TestResolveDynamicConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dynamicconfig.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(testDynamicConfig), 0644))
+	doneC := make(chan struct{})
+	defer close(doneC)
+	client, err := dynamicconfig.NewFileBasedClient(&dynamicconfig.FileBasedClientConfig{
+		Filepath:     path,
+		PollInterval: time.Minute,
+	}, log.NewNoop(), doneC)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		key                    dynamicconfig.Key
+		filters                []string
+		expectedValue          interface{}
+		expectedSource         string
+		expectedMatchedFilters map[string]interface{}
+	}{
+		"all filters match": {
+			key:                    dynamicconfig.MatchingNumTasklistReadPartitions,
+			filters:                []string{"domainName=samples-domain", "taskType=0"},
+			expectedValue:          4,
+			expectedSource:         dynamicConfigSourceFilters,
+			expectedMatchedFilters: map[string]interface{}{"domainName": "samples-domain", "taskType": float64(0)},
+		},
+		"subset of filters match": {
+			key:                    dynamicconfig.MatchingNumTasklistReadPartitions,
+			filters:                []string{"domainName=samples-domain", "taskType=1"},
+			expectedValue:          2,
+			expectedSource:         dynamicConfigSourceFilters,
+			expectedMatchedFilters: map[string]interface{}{"domainName": "samples-domain"},
+		},
+		"no filter match": {
+			key:            dynamicconfig.MatchingNumTasklistReadPartitions,
+			filters:        []string{"domainName=other-domain"},
+			expectedValue:  1,
+			expectedSource: dynamicConfigSourceDefault,
+		},
+		"key not configured": {
+			key:            dynamicconfig.MatchingNumTasklistWritePartitions,
+			expectedValue:  dynamicconfig.MatchingNumTasklistWritePartitions.DefaultValue(),
+			expectedSource: dynamicConfigSourceKeyDefault,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			filters, err := parseDynamicConfigFilters(tc.filters)
+			require.NoError(t, err)
+			resolved, err := resolveDynamicConfig(client, tc.key, filters)
+			require.NoError(t, err)
+			assert.Equal(t, tc.key.String(), resolved.Name)
+			assert.Equal(t, tc.expectedValue, resolved.Value)
+			assert.Equal(t, tc.expectedSource, resolved.Source)
+			assert.Equal(t, tc.expectedMatchedFilters, resolved.MatchedFilters)
+		})
+	}
+}
+
+func TestParseDynamicConfigFilters(t *testing.T) {
+	filters, err := parseDynamicConfigFilters([]string{"domainName=samples-domain", "shardID=10", "taskListName=a=b"})
+	require.NoError(t, err)
+	assert.Equal(t, map[dynamicconfig.Filter]interface{}{
+		dynamicconfig.DomainName:   "samples-domain",
+		dynamicconfig.ShardID:      10,
+		dynamicconfig.TaskListName: "a=b",
+	}, filters)
+
+	_, err = parseDynamicConfigFilters([]string{"domainName"})
+	assert.Error(t, err)
+	_, err = parseDynamicConfigFilters([]string{"unknown=value"})
+	assert.Error(t, err)
+}
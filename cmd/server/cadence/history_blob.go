@@ -0,0 +1,199 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const (
+	blobFormatHex    = "hex"
+	blobFormatBase64 = "base64"
+)
+
+type encodedHistoryBlob struct {
+	Encoding string `json:"encoding"`
+	Data     string `json:"data"`
+}
+
+func newAdminCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "history",
+			Usage: "inspect and craft history blobs",
+			Subcommands: []cli.Command{
+				{
+					Name:  "decode",
+					Usage: "decode a history events blob and print the events as JSON",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "data, d",
+							Usage: "the blob to decode, read from stdin if not set",
+						},
+						cli.StringFlag{
+							Name:  "format, f",
+							Value: blobFormatHex,
+							Usage: "format of the blob: hex or base64",
+						},
+						cli.StringFlag{
+							Name:  "encoding, enc",
+							Usage: "encoding type of the blob, e.g. thriftrw or json, empty defaults to json",
+						},
+						cli.BoolFlag{
+							Name:  "strict",
+							Usage: "fail if a json blob has fields unknown to this binary, e.g. written by a newer version",
+						},
+					},
+					Action: func(c *cli.Context) {
+						decodeHistoryBlobHandler(c)
+					},
+				},
+				{
+					Name:  "encode",
+					Usage: "encode history events given as a JSON array into a blob",
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "data, d",
+							Usage: "the JSON events to encode, read from stdin if not set",
+						},
+						cli.StringFlag{
+							Name:  "format, f",
+							Value: blobFormatHex,
+							Usage: "format of the printed blob: hex or base64",
+						},
+						cli.StringFlag{
+							Name:  "encoding, enc",
+							Value: string(common.EncodingTypeThriftRW),
+							Usage: "encoding type of the blob, e.g. thriftrw or json, empty defaults to json",
+						},
+					},
+					Action: func(c *cli.Context) {
+						encodeHistoryBlobHandler(c)
+					},
+				},
+			},
+		},
+		newDynamicConfigCommand(),
+		newDBCommand(),
+	}
+}
+
+func decodeHistoryBlobHandler(c *cli.Context) {
+	data, err := readDataFlag(c)
+	if err != nil {
+		log.Fatalf("Failed to read blob: %v", err)
+	}
+	events, err := decodeHistoryBlob(data, c.String("format"), c.String("encoding"), c.Bool("strict"))
+	if err != nil {
+		log.Fatalf("Failed to decode blob: %v", err)
+	}
+	output, err := json.MarshalIndent(events, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal events: %v", err)
+	}
+	fmt.Fprintln(c.App.Writer, string(output))
+}
+
+func encodeHistoryBlobHandler(c *cli.Context) {
+	data, err := readDataFlag(c)
+	if err != nil {
+		log.Fatalf("Failed to read events: %v", err)
+	}
+	blob, err := encodeHistoryBlob([]byte(data), c.String("encoding"), c.String("format"))
+	if err != nil {
+		log.Fatalf("Failed to encode events: %v", err)
+	}
+	output, err := json.MarshalIndent(blob, "", "  ")
+	if err != nil {
+		log.Fatalf("Failed to marshal blob: %v", err)
+	}
+	fmt.Fprintln(c.App.Writer, string(output))
+}
+
+func readDataFlag(c *cli.Context) (string, error) {
+	if c.IsSet("data") {
+		return c.String("data"), nil
+	}
+	data, err := io.ReadAll(os.Stdin)
+	return string(data), err
+}
+
+// decodeHistoryBlob deserializes a hex or base64 encoded history events blob
+func decodeHistoryBlob(data string, format string, encoding string, strict bool) ([]*types.HistoryEvent, error) {
+	payload, err := parseBlob(strings.TrimSpace(data), format)
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewPayloadSerializerWithOptions(strict).DeserializeBatchEvents(persistence.NewDataBlob(payload, common.EncodingType(encoding)))
+}
+
+// encodeHistoryBlob serializes a JSON array of history events into a hex or base64 encoded blob,
+// the returned encoding is the one the serializer applied, which is json if encoding is empty
+func encodeHistoryBlob(eventsJSON []byte, encoding string, format string) (*encodedHistoryBlob, error) {
+	var events []*types.HistoryEvent
+	if err := json.Unmarshal(eventsJSON, &events); err != nil {
+		return nil, err
+	}
+	blob, err := persistence.NewPayloadSerializer().SerializeBatchEvents(events, common.EncodingType(encoding))
+	if err != nil {
+		return nil, err
+	}
+	data, err := formatBlob(blob.Data, format)
+	if err != nil {
+		return nil, err
+	}
+	return &encodedHistoryBlob{Encoding: string(blob.Encoding), Data: data}, nil
+}
+
+func parseBlob(data string, format string) ([]byte, error) {
+	switch format {
+	case blobFormatHex:
+		return hex.DecodeString(strings.TrimPrefix(data, "0x"))
+	case blobFormatBase64:
+		return base64.StdEncoding.DecodeString(data)
+	default:
+		return nil, fmt.Errorf("unknown blob format %q, expected %v or %v", format, blobFormatHex, blobFormatBase64)
+	}
+}
+
+func formatBlob(data []byte, format string) (string, error) {
+	switch format {
+	case blobFormatHex:
+		return hex.EncodeToString(data), nil
+	case blobFormatBase64:
+		return base64.StdEncoding.EncodeToString(data), nil
+	default:
+		return "", fmt.Errorf("unknown blob format %q, expected %v or %v", format, blobFormatHex, blobFormatBase64)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestHistoryBlobRoundTrip(t *testing.T) {
+	events := []*types.HistoryEvent{
+		{
+			ID:        1,
+			Version:   2,
+			EventType: types.EventTypeWorkflowExecutionStarted.Ptr(),
+			WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+				WorkflowType: &types.WorkflowType{Name: "workflow-type"},
+				TaskList:     &types.TaskList{Name: "task-list"},
+			},
+		},
+		{
+			ID:        2,
+			Version:   2,
+			EventType: types.EventTypeDecisionTaskScheduled.Ptr(),
+			DecisionTaskScheduledEventAttributes: &types.DecisionTaskScheduledEventAttributes{
+				TaskList: &types.TaskList{Name: "task-list"},
+			},
+		},
+	}
+	eventsJSON, err := json.Marshal(events)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		encoding         string
+		format           string
+		expectedEncoding string
+	}{
+		"thriftrw hex": {
+			encoding:         string(common.EncodingTypeThriftRW),
+			format:           blobFormatHex,
+			expectedEncoding: string(common.EncodingTypeThriftRW),
+		},
+		"json base64": {
+			encoding:         string(common.EncodingTypeJSON),
+			format:           blobFormatBase64,
+			expectedEncoding: string(common.EncodingTypeJSON),
+		},
+		"empty encoding defaults to json": {
+			encoding:         "",
+			format:           blobFormatHex,
+			expectedEncoding: string(common.EncodingTypeJSON),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			blob, err := encodeHistoryBlob(eventsJSON, test.encoding, test.format)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedEncoding, blob.Encoding)
+
+			decoded, err := decodeHistoryBlob(blob.Data, test.format, test.encoding, true)
+			require.NoError(t, err)
+			assert.Equal(t, events, decoded)
+		})
+	}
+}
+
+func TestHistoryBlobInvalidFormat(t *testing.T) {
+	_, err := encodeHistoryBlob([]byte("[]"), string(common.EncodingTypeJSON), "binary")
+	assert.Error(t, err)
+
+	_, err = decodeHistoryBlob("00", "binary", string(common.EncodingTypeJSON), false)
+	assert.Error(t, err)
+
+	_, err = decodeHistoryBlob("not hex", blobFormatHex, string(common.EncodingTypeJSON), false)
+	assert.Error(t, err)
+}
@@ -36,7 +36,6 @@ import (
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/dynamicconfig"
-	"github.com/uber/cadence/common/dynamicconfig/configstore"
 	"github.com/uber/cadence/common/elasticsearch"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
@@ -44,7 +43,6 @@ import (
 	"github.com/uber/cadence/common/messaging/kafka"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/peerprovider/ringpopprovider"
-	"github.com/uber/cadence/common/persistence"
 	pnt "github.com/uber/cadence/common/pinot"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/rpc"
@@ -57,20 +55,22 @@ import (
 
 type (
 	server struct {
-		name   string
-		cfg    *config.Config
-		doneC  chan struct{}
-		daemon common.Daemon
+		name            string
+		cfg             *config.Config
+		shutdownTimeout time.Duration
+		doneC           chan struct{}
+		daemon          common.Daemon
 	}
 )
 
 // newServer returns a new instance of a daemon
-// that represents a cadence service
-func newServer(service string, cfg *config.Config) common.Daemon {
+// that represents a cadence service, Stop waits up to shutdownTimeout for the service to drain
+func newServer(service string, cfg *config.Config, shutdownTimeout time.Duration) common.Daemon {
 	return &server{
-		cfg:   cfg,
-		name:  service,
-		doneC: make(chan struct{}),
+		cfg:             cfg,
+		name:            service,
+		shutdownTimeout: shutdownTimeout,
+		doneC:           make(chan struct{}),
 	}
 }
 
@@ -92,7 +92,7 @@ func (s *server) Stop() {
 		s.daemon.Stop()
 		select {
 		case <-s.doneC:
-		case <-time.After(time.Minute):
+		case <-time.After(s.shutdownTimeout):
 			log.Printf("timed out waiting for server %v to exit\n", s.name)
 		}
 	}
@@ -116,29 +116,7 @@ func (s *server) startService() common.Daemon {
 
 	params.PersistenceConfig = s.cfg.Persistence
 
-	err = nil
-	if s.cfg.DynamicConfig.Client == "" {
-		params.Logger.Warn("falling back to legacy file based dynamicClientConfig")
-		params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfigClient, params.Logger, s.doneC)
-	} else {
-		switch s.cfg.DynamicConfig.Client {
-		case dynamicconfig.ConfigStoreClient:
-			params.Logger.Info("initialising ConfigStore dynamic config client")
-			params.DynamicConfig, err = configstore.NewConfigStoreClient(
-				&s.cfg.DynamicConfig.ConfigStore,
-				&s.cfg.Persistence,
-				params.Logger,
-				persistence.DynamicConfig,
-			)
-		case dynamicconfig.FileBasedClient:
-			params.Logger.Info("initialising File Based dynamic config client")
-			params.DynamicConfig, err = dynamicconfig.NewFileBasedClient(&s.cfg.DynamicConfig.FileBased, params.Logger, s.doneC)
-		default:
-			params.Logger.Info("initialising NOP dynamic config client")
-			params.DynamicConfig = dynamicconfig.NewNopClient()
-		}
-	}
-
+	params.DynamicConfig, err = newDynamicConfigClient(s.cfg, params.Logger, s.doneC)
 	if err != nil {
 		params.Logger.Error("creating dynamic config client failed, using no-op config client instead", tag.Error(err))
 		params.DynamicConfig = dynamicconfig.NewNopClient()
@@ -275,6 +253,7 @@ func (s *server) startService() common.Daemon {
 
 	params.ArchiverProvider = provider.NewArchiverProvider(s.cfg.Archival.History.Provider, s.cfg.Archival.Visibility.Provider)
 	params.PersistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicconfig.TransactionSizeLimit)
+	params.PersistenceConfig.HistoryBlobCompressionThreshold = dc.GetIntProperty(dynamicconfig.HistoryBlobCompressionThreshold)
 	params.PersistenceConfig.ErrorInjectionRate = dc.GetFloat64Property(dynamicconfig.PersistenceErrorInjectionRate)
 	params.AuthorizationConfig = s.cfg.Authorization
 	params.BlobstoreClient, err = filestore.NewFilestoreClient(s.cfg.Blobstore.Filestore)
@@ -102,7 +102,7 @@ func (s *ServerSuite) TestServerStartup() {
 	var daemons []common.Daemon
 	services := service.ShortNames(service.List)
 	for _, svc := range services {
-		server := newServer(svc, &cfg)
+		server := newServer(svc, &cfg, time.Minute)
 		daemons = append(daemons, server)
 		server.Start()
 	}
@@ -0,0 +1,137 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/persistence/nosql"
+	"github.com/uber/cadence/common/persistence/sql"
+)
+
+// validateHandler is the handler for the cli validate command
+func validateHandler(c *cli.Context) error {
+	cfg, err := readConfig(getEnvironment(c), getConfigDir(c), getZone(c), getRootDir(c))
+	if err != nil {
+		return cli.NewExitError(err.Error(), 1)
+	}
+	services := strings.Split(strings.TrimSpace(c.String("services")), ",")
+
+	problems := validateConfig(cfg, services)
+	if len(problems) == 0 {
+		fmt.Fprintln(c.App.Writer, "Config is valid")
+		return nil
+	}
+	fmt.Fprintf(c.App.Writer, "Found %d problem(s) in config:\n", len(problems))
+	for _, problem := range problems {
+		fmt.Fprintf(c.App.Writer, "  - %v\n", problem)
+	}
+	return cli.NewExitError("config validation failed", 1)
+}
+
+// validateConfig checks everything the server needs from the config up to the point of starting
+// the services, without binding ports or connecting to any database, and returns all problems found
+func validateConfig(cfg *config.Config, services []string) []error {
+	var problems []error
+	if err := cfg.ValidateAndFillDefaults(); err != nil {
+		problems = append(problems, err)
+	}
+	problems = append(problems, validatePersistencePlugins(&cfg.Persistence)...)
+	for _, svc := range services {
+		if !isValidService(svc) {
+			problems = append(problems, fmt.Errorf("invalid service %q, valid services are %v", svc, validServices))
+			continue
+		}
+		if _, err := cfg.GetServiceConfig(svc); err != nil {
+			problems = append(problems, err)
+		}
+	}
+	if err := validateDynamicConfig(cfg); err != nil {
+		problems = append(problems, err)
+	}
+	return problems
+}
+
+// validatePersistencePlugins checks that the plugin of every datastore is registered
+func validatePersistencePlugins(persistenceCfg *config.Persistence) []error {
+	storeNames := make([]string, 0, len(persistenceCfg.DataStores))
+	for storeName := range persistenceCfg.DataStores {
+		storeNames = append(storeNames, storeName)
+	}
+	sort.Strings(storeNames)
+
+	var problems []error
+	for _, storeName := range storeNames {
+		ds := persistenceCfg.DataStores[storeName]
+		if ds.SQL != nil && !sql.PluginRegistered(ds.SQL.PluginName) {
+			problems = append(problems, fmt.Errorf("datastore %v: unknown sql plugin %q, registered plugins: %v",
+				storeName, ds.SQL.PluginName, sql.GetRegisteredPluginNames()))
+		}
+		if ds.NoSQL != nil && !nosql.PluginRegistered(ds.NoSQL.PluginName) {
+			problems = append(problems, fmt.Errorf("datastore %v: unknown nosql plugin %q, registered plugins: %v",
+				storeName, ds.NoSQL.PluginName, nosql.GetRegisteredPluginNames()))
+		}
+		if ds.ShardedNoSQL != nil {
+			shardNames := make([]string, 0, len(ds.ShardedNoSQL.Connections))
+			for shardName := range ds.ShardedNoSQL.Connections {
+				shardNames = append(shardNames, shardName)
+			}
+			sort.Strings(shardNames)
+			for _, shardName := range shardNames {
+				plugin := ds.ShardedNoSQL.Connections[shardName].NoSQLPlugin
+				if plugin == nil {
+					problems = append(problems, fmt.Errorf("datastore %v: shard %v: missing nosqlPlugin config", storeName, shardName))
+					continue
+				}
+				if !nosql.PluginRegistered(plugin.PluginName) {
+					problems = append(problems, fmt.Errorf("datastore %v: shard %v: unknown nosql plugin %q, registered plugins: %v",
+						storeName, shardName, plugin.PluginName, nosql.GetRegisteredPluginNames()))
+				}
+			}
+		}
+	}
+	return problems
+}
+
+// validateDynamicConfig checks the dynamic config client, file based configs are loaded to make sure
+// they can be parsed while the config store is left alone as it would need a database connection
+func validateDynamicConfig(cfg *config.Config) error {
+	switch cfg.DynamicConfig.Client {
+	case "", dynamicconfig.FileBasedClient:
+		doneC := make(chan struct{})
+		defer close(doneC)
+		if _, err := newDynamicConfigClient(cfg, loggerimpl.NewNopLogger(), doneC); err != nil {
+			return fmt.Errorf("dynamic config: %v", err)
+		}
+		return nil
+	case dynamicconfig.ConfigStoreClient, dynamicconfig.NopClient:
+		return nil
+	default:
+		return fmt.Errorf("dynamic config: unknown client %q, the no-op client would be used", cfg.DynamicConfig.Client)
+	}
+}
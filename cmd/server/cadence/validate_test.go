@@ -0,0 +1,94 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cadence
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/config"
+
+	_ "github.com/uber/cadence/common/persistence/nosql/nosqlplugin/cassandra" // needed to load cassandra plugin
+)
+
+func TestValidateConfig(t *testing.T) {
+	tests := map[string]struct {
+		services         []string
+		modify           func(cfg *config.Config)
+		expectedProblems []string
+	}{
+		"valid": {
+			services: validServices,
+		},
+		"unknown persistence plugin": {
+			services: validServices,
+			modify: func(cfg *config.Config) {
+				ds := cfg.Persistence.DataStores["cass-default"]
+				ds.NoSQL.PluginName = "unknown"
+			},
+			expectedProblems: []string{`datastore cass-default: unknown nosql plugin "unknown"`},
+		},
+		"invalid authorization": {
+			services: validServices,
+			modify: func(cfg *config.Config) {
+				cfg.Authorization.OAuthAuthorizer.Enable = true
+				cfg.Authorization.NoopAuthorizer.Enable = true
+			},
+			expectedProblems: []string{"More than one authorizer is enabled"},
+		},
+		"invalid service": {
+			services:         []string{"frontend", "unknown"},
+			expectedProblems: []string{`invalid service "unknown"`},
+		},
+		"unknown dynamic config client": {
+			services: validServices,
+			modify: func(cfg *config.Config) {
+				cfg.DynamicConfig.Client = "unknown"
+			},
+			expectedProblems: []string{`dynamic config: unknown client "unknown"`},
+		},
+		"missing dynamic config file": {
+			services: validServices,
+			modify: func(cfg *config.Config) {
+				cfg.DynamicConfig.FileBased.Filepath = "not-exist.yaml"
+			},
+			expectedProblems: []string{"dynamic config:"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			rootDir := "../../.."
+			cfg, err := readConfig("development", constructPathIfNeed(rootDir, "config"), "", rootDir)
+			require.NoError(t, err)
+			if tc.modify != nil {
+				tc.modify(cfg)
+			}
+
+			problems := validateConfig(cfg, tc.services)
+			require.Len(t, problems, len(tc.expectedProblems), "%v", problems)
+			for i, expected := range tc.expectedProblems {
+				assert.Contains(t, problems[i].Error(), expected)
+			}
+		})
+	}
+}
@@ -71,6 +71,44 @@ func (mr *MockAuthorizerMockRecorder) Authorize(ctx, attributes interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAuthorizer)(nil).Authorize), ctx, attributes)
 }
 
+// MockBatchAuthorizer is a mock of BatchAuthorizer interface.
+type MockBatchAuthorizer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchAuthorizerMockRecorder
+}
+
+// MockBatchAuthorizerMockRecorder is the mock recorder for MockBatchAuthorizer.
+type MockBatchAuthorizerMockRecorder struct {
+	mock *MockBatchAuthorizer
+}
+
+// NewMockBatchAuthorizer creates a new mock instance.
+func NewMockBatchAuthorizer(ctrl *gomock.Controller) *MockBatchAuthorizer {
+	mock := &MockBatchAuthorizer{ctrl: ctrl}
+	mock.recorder = &MockBatchAuthorizerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchAuthorizer) EXPECT() *MockBatchAuthorizerMockRecorder {
+	return m.recorder
+}
+
+// AuthorizeBatch mocks base method.
+func (m *MockBatchAuthorizer) AuthorizeBatch(ctx context.Context, attributes []*Attributes) ([]Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeBatch", ctx, attributes)
+	ret0, _ := ret[0].([]Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeBatch indicates an expected call of AuthorizeBatch.
+func (mr *MockBatchAuthorizerMockRecorder) AuthorizeBatch(ctx, attributes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeBatch", reflect.TypeOf((*MockBatchAuthorizer)(nil).AuthorizeBatch), ctx, attributes)
+}
+
 // MockFilteredRequestBody is a mock of FilteredRequestBody interface.
 type MockFilteredRequestBody struct {
 	ctrl     *gomock.Controller
@@ -52,7 +52,12 @@ type (
 	// Attributes is input for authority to make decision.
 	// It can be extended in future if required auth on resources like WorkflowType and TaskList
 	Attributes struct {
-		Actor        string
+		// Actor is the authenticated principal of the request, if known
+		Actor string
+		// CallerService is the service name the caller declared in its YARPC call metadata, it's not authenticated
+		CallerService string
+		// TLSSubject is the subject of the client certificate presented over mutual TLS
+		TLSSubject   string
 		APIName      string
 		DomainName   string
 		WorkflowType *types.WorkflowType
@@ -64,6 +69,9 @@ type (
 	// Result is result from authority.
 	Result struct {
 		Decision Decision
+		// Reason optionally explains a DecisionDeny in a human-readable way, e.g. the policy that denied the request.
+		// It's returned to the client unless the frontend is configured to suppress it.
+		Reason string
 	}
 
 	// Decision is enum type for auth decision
@@ -91,6 +99,12 @@ type Authorizer interface {
 	Authorize(ctx context.Context, attributes *Attributes) (Result, error)
 }
 
+// BatchAuthorizer is an optional interface an Authorizer can implement to authorize
+// multiple attributes in a single call, results are returned in the order of the attributes
+type BatchAuthorizer interface {
+	AuthorizeBatch(ctx context.Context, attributes []*Attributes) ([]Result, error)
+}
+
 func GetAuthProviderClient(privateKey string) (clientworker.AuthorizationProvider, error) {
 	pk, err := ioutil.ReadFile(privateKey)
 	if err != nil {
@@ -0,0 +1,80 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/yarpc"
+
+	"github.com/uber/cadence/common/config"
+)
+
+type (
+	// PrincipalExtractor extracts the authenticated principal of a request, it allows the frontend to be
+	// deployed behind different authentication mechanisms (mTLS, JWT header, OAuth introspection, etc.).
+	// An empty principal means the extractor does not know the principal of the request.
+	PrincipalExtractor interface {
+		ExtractPrincipal(ctx context.Context) (string, error)
+	}
+
+	nopPrincipalExtractor struct{}
+
+	headerPrincipalExtractor struct {
+		header string
+	}
+)
+
+// NewPrincipalExtractor creates a principal extractor according to the authorization config
+func NewPrincipalExtractor(authorization config.Authorization) (PrincipalExtractor, error) {
+	cfg := authorization.PrincipalExtractor
+	switch cfg.Type {
+	case "", config.PrincipalExtractorTypeNoop:
+		return NewNopPrincipalExtractor(), nil
+	case config.PrincipalExtractorTypeHeader:
+		if cfg.Header == "" {
+			return nil, fmt.Errorf("header of the principal extractor can't be empty")
+		}
+		return NewHeaderPrincipalExtractor(cfg.Header), nil
+	default:
+		return nil, fmt.Errorf("unknown principal extractor type %q", cfg.Type)
+	}
+}
+
+// NewNopPrincipalExtractor creates a principal extractor which never extracts a principal
+func NewNopPrincipalExtractor() PrincipalExtractor {
+	return &nopPrincipalExtractor{}
+}
+
+func (e *nopPrincipalExtractor) ExtractPrincipal(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+// NewHeaderPrincipalExtractor creates a principal extractor which reads the principal from the given
+// YARPC request header, the header must be set by a trusted proxy which authenticated the request
+func NewHeaderPrincipalExtractor(header string) PrincipalExtractor {
+	return &headerPrincipalExtractor{header: header}
+}
+
+func (e *headerPrincipalExtractor) ExtractPrincipal(ctx context.Context) (string, error) {
+	return yarpc.CallFromContext(ctx).Header(e.header), nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/yarpc/yarpctest"
+
+	"github.com/uber/cadence/common/config"
+)
+
+func TestNewPrincipalExtractor(t *testing.T) {
+	extractor, err := NewPrincipalExtractor(config.Authorization{})
+	require.NoError(t, err)
+	assert.Equal(t, NewNopPrincipalExtractor(), extractor)
+
+	extractor, err = NewPrincipalExtractor(config.Authorization{
+		PrincipalExtractor: config.PrincipalExtractor{Type: config.PrincipalExtractorTypeHeader, Header: "x-principal"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, NewHeaderPrincipalExtractor("x-principal"), extractor)
+
+	_, err = NewPrincipalExtractor(config.Authorization{
+		PrincipalExtractor: config.PrincipalExtractor{Type: config.PrincipalExtractorTypeHeader},
+	})
+	assert.Error(t, err)
+
+	_, err = NewPrincipalExtractor(config.Authorization{
+		PrincipalExtractor: config.PrincipalExtractor{Type: "jwt"},
+	})
+	assert.Error(t, err)
+}
+
+func TestPrincipalExtractors(t *testing.T) {
+	ctx := yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{
+		Headers: map[string]string{"x-principal": "user"},
+	})
+
+	principal, err := NewNopPrincipalExtractor().ExtractPrincipal(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, principal)
+
+	principal, err = NewHeaderPrincipalExtractor("x-principal").ExtractPrincipal(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "user", principal)
+
+	principal, err = NewHeaderPrincipalExtractor("x-other").ExtractPrincipal(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, principal)
+
+	principal, err = NewHeaderPrincipalExtractor("x-principal").ExtractPrincipal(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, principal)
+}
@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/robfig/cron"
@@ -34,12 +35,19 @@ import (
 // NoBackoff is used to represent backoff when no cron backoff is needed
 const NoBackoff = time.Duration(-1)
 
+// ValidateCronSchedule returns a *types.BadRequestError explaining why the cron schedule is invalid, or nil if
+// it is a valid standard cron spec with 5 fields or a descriptor, including "@every <duration>"
+func ValidateCronSchedule(cronSchedule string) error {
+	_, err := ValidateSchedule(cronSchedule)
+	return err
+}
+
 // ValidateSchedule validates a cron schedule spec
 func ValidateSchedule(cronSchedule string) (cron.Schedule, error) {
 	sched, err := cron.ParseStandard(cronSchedule)
 	if err != nil {
 		return nil, &types.BadRequestError{
-			Message: fmt.Sprintf("Invalid CronSchedule, failed to parse: %q, err: %v", cronSchedule, err),
+			Message: fmt.Sprintf("Invalid CronSchedule, failed to parse: %q, err: %v, %v", cronSchedule, err, cronScheduleHint(cronSchedule)),
 		}
 	}
 	// schedule must parse and there must be a next-firing date (catches impossible dates like Feb 30)
@@ -52,6 +60,19 @@ func ValidateSchedule(cronSchedule string) (cron.Schedule, error) {
 	return sched, nil
 }
 
+// cronScheduleHint describes the expected format of the kind of spec the cron schedule looks like
+func cronScheduleHint(cronSchedule string) string {
+	spec := strings.TrimSpace(cronSchedule)
+	switch {
+	case strings.HasPrefix(spec, "@every"):
+		return "@every must be followed by a duration, e.g. \"@every 1h30m\""
+	case strings.HasPrefix(spec, "@"):
+		return "supported descriptors are @yearly, @annually, @monthly, @weekly, @daily, @midnight, @hourly and @every <duration>"
+	default:
+		return "expected 5 fields: minute hour day-of-month month day-of-week, e.g. \"0 10 * * *\""
+	}
+}
+
 // GetBackoffForNextSchedule calculates the backoff time for the next run given
 // a cronSchedule, workflow start time and workflow close time
 func GetBackoffForNextSchedule(
@@ -107,3 +128,25 @@ func GetBackoffForNextScheduleInSeconds(
 	}
 	return int32(math.Ceil(backoffDuration.Seconds())), nil
 }
+
+// NextCronTimes returns the next n fire times in UTC of a cronSchedule after from
+func NextCronTimes(cronSchedule string, from time.Time, n int) ([]time.Time, error) {
+	if n <= 0 {
+		return nil, &types.BadRequestError{Message: fmt.Sprintf("Invalid number of cron fire times: %v, it must be greater than 0", n)}
+	}
+	sched, err := ValidateSchedule(cronSchedule)
+	if err != nil {
+		return nil, err
+	}
+	times := make([]time.Time, 0, n)
+	next := from.In(time.UTC)
+	for i := 0; i < n; i++ {
+		next = sched.Next(next)
+		if next.IsZero() {
+			// it's possible for specs only firing in a limited range, e.g. a specific day of a year
+			break
+		}
+		times = append(times, next)
+	}
+	return times, nil
+}
@@ -29,6 +29,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
 )
 
 func TestCron(t *testing.T) {
@@ -73,6 +75,31 @@ func TestCron(t *testing.T) {
 	}
 }
 
+func TestValidateCronSchedule(t *testing.T) {
+	for _, cronSchedule := range []string{"0 10 * * *", "*/10 * * * *", "0 3 * * 0-6", "@every 5h", "@every 1h30m", "@daily", "@hourly"} {
+		assert.NoError(t, ValidateCronSchedule(cronSchedule), cronSchedule)
+	}
+
+	for cronSchedule, expectedHint := range map[string]string{
+		"":                  "expected 5 fields",
+		"invalid-cron-spec": "expected 5 fields",
+		"0 10 * *":          "expected 5 fields",
+		"61 * * * *":        "expected 5 fields",
+		"@every":            "@every must be followed by a duration",
+		"@every 5x":         "@every must be followed by a duration",
+		"@fortnightly":      "supported descriptors are",
+	} {
+		err := ValidateCronSchedule(cronSchedule)
+		var badRequestErr *types.BadRequestError
+		require.ErrorAs(t, err, &badRequestErr, cronSchedule)
+		assert.Contains(t, badRequestErr.Message, fmt.Sprintf("Invalid CronSchedule, failed to parse: %q", cronSchedule))
+		assert.Contains(t, badRequestErr.Message, expectedHint, cronSchedule)
+	}
+
+	err := ValidateCronSchedule("0 0 30 2 *")
+	assert.ErrorContains(t, err, "no next firing time found")
+}
+
 func TestCronWithJitterStart(t *testing.T) {
 	var cronWithJitterStartTests = []struct {
 		cron                   string
@@ -156,3 +183,32 @@ func TestCronWithJitterStart(t *testing.T) {
 		})
 	}
 }
+
+func TestNextCronTimes(t *testing.T) {
+	from, _ := time.Parse(time.RFC3339, "2018-12-17T08:08:18+00:00")
+	var tests = []struct {
+		cron   string
+		n      int
+		result []string
+	}{
+		{"0 10 * * *", 2, []string{"2018-12-17T10:00:00Z", "2018-12-18T10:00:00Z"}},
+		{"*/10 * * * *", 3, []string{"2018-12-17T08:10:00Z", "2018-12-17T08:20:00Z", "2018-12-17T08:30:00Z"}},
+		{"@every 5h", 2, []string{"2018-12-17T13:08:18Z", "2018-12-17T18:08:18Z"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cron, func(t *testing.T) {
+			times, err := NextCronTimes(tt.cron, from, tt.n)
+			require.NoError(t, err)
+			result := make([]string, 0, len(times))
+			for _, fireTime := range times {
+				result = append(result, fireTime.Format(time.RFC3339))
+			}
+			assert.Equal(t, tt.result, result)
+		})
+	}
+
+	_, err := NextCronTimes("invalid-cron-spec", from, 1)
+	assert.ErrorContains(t, err, "Invalid CronSchedule, failed to parse")
+	_, err = NextCronTimes("* * * * *", from, 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backoff
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// ErrRetryExhausted is returned by RetryIterator once the retry policy doesn't allow any more attempts
+var ErrRetryExhausted = errors.New("retry policy is exhausted")
+
+// RetryIterator yields the successive delays of a RetryPolicy, for callers running their own retry loop
+type RetryIterator struct {
+	retrier    Retrier
+	fullJitter bool
+}
+
+// NewRetryIterator creates a RetryIterator, with fullJitter each delay is picked uniformly from [0, delay]
+func NewRetryIterator(policy RetryPolicy, clock Clock, fullJitter bool) *RetryIterator {
+	return &RetryIterator{
+		retrier:    NewRetrier(policy, clock),
+		fullJitter: fullJitter,
+	}
+}
+
+// Next returns the delay before the next attempt, or ErrRetryExhausted if there are no more attempts
+func (i *RetryIterator) Next() (time.Duration, error) {
+	delay := i.retrier.NextBackOff()
+	if delay == done {
+		return 0, ErrRetryExhausted
+	}
+	if i.fullJitter && delay > 0 {
+		delay = time.Duration(rand.Int63n(int64(delay) + 1))
+	}
+	return delay, nil
+}
+
+// Reset restarts the schedule from the first attempt
+func (i *RetryIterator) Reset() {
+	i.retrier.Reset()
+}
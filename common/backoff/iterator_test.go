@@ -0,0 +1,72 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package backoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetryIterator(t *testing.T) {
+	policy := NewExponentialRetryPolicy(time.Second)
+	policy.SetMaximumInterval(4 * time.Second)
+	policy.SetMaximumAttempts(4)
+	policy.SetExpirationInterval(NoInterval)
+
+	iterator := NewRetryIterator(policy, SystemClock, false)
+	for _, expected := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second} {
+		delay, err := iterator.Next()
+		require.NoError(t, err)
+		// the exponential policy already adds up to 20% jitter below the delay
+		assert.True(t, delay >= expected*8/10 && delay <= expected, "delay %v, expected %v", delay, expected)
+	}
+	_, err := iterator.Next()
+	assert.Equal(t, ErrRetryExhausted, err)
+
+	iterator.Reset()
+	delay, err := iterator.Next()
+	require.NoError(t, err)
+	assert.True(t, delay <= time.Second)
+}
+
+func TestRetryIteratorFullJitter(t *testing.T) {
+	policy := NewExponentialRetryPolicy(time.Second)
+	policy.SetMaximumInterval(time.Second)
+	policy.SetMaximumAttempts(100)
+	policy.SetExpirationInterval(NoInterval)
+
+	iterator := NewRetryIterator(policy, SystemClock, true)
+	var belowHalf int
+	for i := 0; i < 100; i++ {
+		delay, err := iterator.Next()
+		require.NoError(t, err)
+		assert.True(t, delay >= 0 && delay <= time.Second)
+		if delay < 500*time.Millisecond {
+			belowHalf++
+		}
+	}
+	assert.True(t, belowHalf > 0, "full jitter is expected to pick delays from the whole range")
+	_, err := iterator.Next()
+	assert.Equal(t, ErrRetryExhausted, err)
+}
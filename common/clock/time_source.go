@@ -21,6 +21,7 @@
 package clock
 
 import (
+	"sync"
 	"sync/atomic"
 	"time"
 )
@@ -33,15 +34,35 @@ type (
 	TimeSource interface {
 		Now() time.Time
 	}
+
+	// Clock is a TimeSource which can also
+	// be used to wait for a duration, so
+	// that waits can be mocked out in unit test
+	Clock interface {
+		TimeSource
+		After(d time.Duration) <-chan time.Time
+	}
+
 	// RealTimeSource serves real wall-clock time
 	RealTimeSource struct{}
 
 	// EventTimeSource serves fake controlled time
 	EventTimeSource struct {
 		now int64
+
+		sync.Mutex
+		waiters []eventTimeWaiter
+	}
+
+	eventTimeWaiter struct {
+		deadline time.Time
+		ch       chan time.Time
 	}
 )
 
+var _ Clock = (*RealTimeSource)(nil)
+var _ Clock = (*EventTimeSource)(nil)
+
 // NewRealTimeSource returns a time source that servers
 // real wall clock time
 func NewRealTimeSource() *RealTimeSource {
@@ -53,6 +74,11 @@ func (ts *RealTimeSource) Now() time.Time {
 	return time.Now()
 }
 
+// After waits for the duration to elapse on the real wall clock
+func (ts *RealTimeSource) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
 // NewEventTimeSource returns a time source that servers
 // fake controlled time
 func NewEventTimeSource() *EventTimeSource {
@@ -64,8 +90,38 @@ func (ts *EventTimeSource) Now() time.Time {
 	return time.Unix(0, atomic.LoadInt64(&ts.now)).UTC()
 }
 
+// After returns a channel which receives the fake current time
+// once Update moves it to or past the deadline
+func (ts *EventTimeSource) After(d time.Duration) <-chan time.Time {
+	ts.Lock()
+	defer ts.Unlock()
+
+	ch := make(chan time.Time, 1)
+	now := ts.Now()
+	deadline := now.Add(d)
+	if !deadline.After(now) {
+		ch <- now
+		return ch
+	}
+	ts.waiters = append(ts.waiters, eventTimeWaiter{deadline: deadline, ch: ch})
+	return ch
+}
+
 // Update update the fake current time
 func (ts *EventTimeSource) Update(now time.Time) *EventTimeSource {
+	ts.Lock()
+	defer ts.Unlock()
+
 	atomic.StoreInt64(&ts.now, now.UnixNano())
+	now = ts.Now()
+	pending := ts.waiters[:0]
+	for _, waiter := range ts.waiters {
+		if waiter.deadline.After(now) {
+			pending = append(pending, waiter)
+			continue
+		}
+		waiter.ch <- now
+	}
+	ts.waiters = pending
 	return ts
 }
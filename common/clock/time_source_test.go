@@ -0,0 +1,56 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventTimeSource_After(t *testing.T) {
+	start := time.Unix(0, 100).UTC()
+	ts := NewEventTimeSource().Update(start)
+
+	select {
+	case now := <-ts.After(0):
+		assert.Equal(t, start, now)
+	default:
+		t.Fatal("expected After with a non-positive duration to fire immediately")
+	}
+
+	ch := ts.After(time.Second)
+	ts.Update(start.Add(time.Second - 1))
+	select {
+	case <-ch:
+		t.Fatal("expected After not to fire before the deadline")
+	default:
+	}
+
+	ts.Update(start.Add(time.Second))
+	select {
+	case now := <-ch:
+		assert.Equal(t, start.Add(time.Second), now)
+	default:
+		t.Fatal("expected After to fire once the deadline is reached")
+	}
+}
@@ -75,3 +75,8 @@ func (t *ThriftRWEncoder) Decode(b []byte, val ThriftObject) error {
 	sr := binary.Default.Reader(reader)
 	return val.Decode(sr)
 }
+
+// HasThriftRWPreamble returns true if the payload starts with the version preamble written by ThriftRWEncoder
+func HasThriftRWPreamble(b []byte) bool {
+	return len(b) > 0 && b[0] == preambleVersion0
+}
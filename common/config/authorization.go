@@ -26,6 +26,13 @@ import (
 	"github.com/cristalhq/jwt/v3"
 )
 
+const (
+	// PrincipalExtractorTypeNoop is the principal extractor which does not extract any principal
+	PrincipalExtractorTypeNoop = "noop"
+	// PrincipalExtractorTypeHeader is the principal extractor which reads the principal from a request header
+	PrincipalExtractorTypeHeader = "header"
+)
+
 // Validate validates the persistence config
 func (a *Authorization) Validate() error {
 	if a.OAuthAuthorizer.Enable && a.NoopAuthorizer.Enable {
@@ -38,9 +45,32 @@ func (a *Authorization) Validate() error {
 		}
 	}
 
+	if err := a.PrincipalExtractor.Validate(); err != nil {
+		return err
+	}
+
+	if a.PrincipalRateLimit.Enable && a.PrincipalRateLimit.RPS <= 0 {
+		return fmt.Errorf("[PrincipalRateLimitConfig] RPS must be greater than 0")
+	}
+
 	return nil
 }
 
+// Validate validates the principal extractor config
+func (p *PrincipalExtractor) Validate() error {
+	switch p.Type {
+	case "", PrincipalExtractorTypeNoop:
+		return nil
+	case PrincipalExtractorTypeHeader:
+		if p.Header == "" {
+			return fmt.Errorf("[PrincipalExtractorConfig] Header can't be empty")
+		}
+		return nil
+	default:
+		return fmt.Errorf("[PrincipalExtractorConfig] Unknown type %q", p.Type)
+	}
+}
+
 func (a *Authorization) validateOAuth() error {
 	oauthConfig := a.OAuthAuthorizer
 
@@ -112,3 +112,49 @@ func TestCorrectValidation(t *testing.T) {
 	err := cfg.Validate()
 	assert.NoError(t, err)
 }
+
+func TestPrincipalExtractorValidation(t *testing.T) {
+	tests := map[string]struct {
+		cfg         PrincipalExtractor
+		expectedErr string
+	}{
+		"default": {
+			cfg: PrincipalExtractor{},
+		},
+		"noop": {
+			cfg: PrincipalExtractor{Type: PrincipalExtractorTypeNoop},
+		},
+		"header": {
+			cfg: PrincipalExtractor{Type: PrincipalExtractorTypeHeader, Header: "x-principal"},
+		},
+		"header without header name": {
+			cfg:         PrincipalExtractor{Type: PrincipalExtractorTypeHeader},
+			expectedErr: "[PrincipalExtractorConfig] Header can't be empty",
+		},
+		"unknown type": {
+			cfg:         PrincipalExtractor{Type: "jwt"},
+			expectedErr: `[PrincipalExtractorConfig] Unknown type "jwt"`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := (&Authorization{PrincipalExtractor: tc.cfg}).Validate()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestPrincipalRateLimitValidation(t *testing.T) {
+	cfg := Authorization{PrincipalRateLimit: PrincipalRateLimit{Enable: true}}
+	assert.EqualError(t, cfg.Validate(), "[PrincipalRateLimitConfig] RPS must be greater than 0")
+
+	cfg.PrincipalRateLimit.RPS = 10
+	assert.NoError(t, cfg.Validate())
+
+	cfg = Authorization{PrincipalRateLimit: PrincipalRateLimit{Enable: false}}
+	assert.NoError(t, cfg.Validate())
+}
@@ -86,6 +86,54 @@ type (
 	Authorization struct {
 		OAuthAuthorizer OAuthAuthorizer `yaml:"oauthAuthorizer"`
 		NoopAuthorizer  NoopAuthorizer  `yaml:"noopAuthorizer"`
+		// DecisionCache configures the in-memory cache of authorization decisions made by the frontend
+		DecisionCache AuthorizationDecisionCache `yaml:"decisionCache"`
+		// FailOpen allows the frontend workflow APIs when the authorizer returns an error,
+		// by default (fail-closed) such requests are rejected. Admin APIs always fail closed.
+		FailOpen bool `yaml:"failOpen"`
+		// SuppressDenyReason hides the reason of denied requests given by the authorizer from the clients,
+		// by default the reason is included in the access denied error
+		SuppressDenyReason bool `yaml:"suppressDenyReason"`
+		// PrincipalExtractor configures how the frontend extracts the authenticated principal of a request,
+		// by default the principal is the subject of the client's TLS certificate, if any
+		PrincipalExtractor PrincipalExtractor `yaml:"principalExtractor"`
+		// PrincipalRateLimit configures the frontend to throttle the authorized requests of each principal
+		PrincipalRateLimit PrincipalRateLimit `yaml:"principalRateLimit"`
+	}
+
+	// PrincipalRateLimit contains the config for the token bucket rate limiter of each principal
+	PrincipalRateLimit struct {
+		// Enable turns on the per principal rate limit, disabled by default
+		Enable bool `yaml:"enable"`
+		// RPS is the refill rate of each principal's bucket, in requests per second
+		RPS float64 `yaml:"rps"`
+		// Burst is the size of each principal's bucket, in requests, i.e. the max number of requests
+		// a principal can make at once. Defaults to RPS rounded up
+		Burst int `yaml:"burst"`
+		// MaxCount is the max number of principals whose rate limiters are kept, defaults to 10000.
+		// The least recently seen principals are evicted first.
+		MaxCount int `yaml:"maxCount"`
+	}
+
+	// PrincipalExtractor contains the config for extracting the principal of a request
+	PrincipalExtractor struct {
+		// Type of the principal extractor, supported values are "noop" (default) and "header"
+		Type string `yaml:"type"`
+		// Header is the name of the request header carrying the principal, required by the header extractor.
+		// The header must be set by a trusted proxy which authenticated the request, e.g. by verifying its JWT.
+		Header string `yaml:"header"`
+	}
+
+	AuthorizationDecisionCache struct {
+		// Enable caches decisions per principal, API and request body. Only the serialization of the request
+		// used for logging is keyed, so decisions must not depend on the fields it filters out.
+		Enable bool `yaml:"enable"`
+		// TTL of a cached decision, defaults to 10 seconds
+		TTL time.Duration `yaml:"ttl"`
+		// MaxCount is the max number of cached decisions, defaults to 10000
+		MaxCount int `yaml:"maxCount"`
+		// CacheDeny caches DecisionDeny results as well, by default only DecisionAllow results are cached
+		CacheDeny bool `yaml:"cacheDeny"`
 	}
 
 	DynamicConfig struct {
@@ -201,8 +249,33 @@ type (
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
 		// TODO: move dynamic config out of static config
+		// HistoryBlobCompressionThreshold is the minimum size of a history event batch to be compressed
+		HistoryBlobCompressionThreshold dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// TODO: move dynamic config out of static config
 		// ErrorInjectionRate is the the rate for injecting random error
 		ErrorInjectionRate dynamicconfig.FloatPropertyFn `yaml:"-" json:"-"`
+		// ErrorInjectionSeed is the seed of the random errors injected when ErrorInjectionRate is not zero,
+		// a random seed is used if not set
+		ErrorInjectionSeed int64 `yaml:"errorInjectionSeed"`
+		// ErrorInjectionErrors are the weights of the errors injected when ErrorInjectionRate is not zero, keyed by
+		// error name: ServiceBusy, InternalService, Timeout, Unhandled, ShardOwnershipLost or ConditionFailed.
+		// The default fake errors are injected if not set
+		ErrorInjectionErrors map[string]int `yaml:"errorInjectionErrors"`
+		// ErrorInjectionLatency is the latency injected into every persistence call when ErrorInjectionRate
+		// is not zero, no latency is injected if not set
+		ErrorInjectionLatency *ErrorInjectionLatency `yaml:"errorInjectionLatency"`
+	}
+
+	// ErrorInjectionLatency is the distribution of the latency injected into persistence calls
+	ErrorInjectionLatency struct {
+		// Distribution is one of fixed, uniform or exponential
+		Distribution string `yaml:"distribution"`
+		// Latency is the latency of the fixed distribution, or the mean of the exponential distribution
+		Latency time.Duration `yaml:"latency"`
+		// Min is the lower bound of the uniform distribution
+		Min time.Duration `yaml:"min"`
+		// Max is the upper bound of the uniform distribution
+		Max time.Duration `yaml:"max"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -32,6 +32,18 @@ type (
 		// Applications describes the applications that will use the Kafka topics
 		Applications map[string]TopicList `yaml:"applications"`
 		Version      string               `yaml:"version"`
+		// Producer describes the settings shared by all producers
+		Producer ProducerConfig `yaml:"producer"`
+	}
+
+	// ProducerConfig describes the settings of Kafka producers
+	ProducerConfig struct {
+		// RequiredAcks is the acknowledgement the brokers must send for a message to be published,
+		// either "none", "local" or "all". It defaults to "local", or "all" if Idempotent is enabled.
+		RequiredAcks string `yaml:"required-acks,omitempty"`
+		// Idempotent makes the brokers write a message exactly once when the producer retries sending it.
+		// It requires RequiredAcks to be "all" and Kafka version 0.11 or newer.
+		Idempotent bool `yaml:"idempotent,omitempty"`
 	}
 
 	// ClusterConfig describes the configuration for a single Kafka cluster
@@ -44,6 +56,15 @@ type (
 		Cluster string `yaml:"cluster"`
 		// Properties map describes whether the topic properties, such as whether it is secure
 		Properties map[string]any `yaml:"properties,omitempty"`
+		// DomainPartitions is the number of partitions indexer messages of a single domain are spread over.
+		// It is disabled by default, in which case messages are partitioned by workflowID.
+		DomainPartitions int `yaml:"domain-partitions,omitempty"`
+		// PartitionKey is the field indexer messages are keyed by, either "workflowID" or "domainID".
+		// It defaults to workflowID, and keying by domainID makes all messages of a domain land on one partition.
+		PartitionKey string `yaml:"partition-key,omitempty"`
+		// MaxMessageSize is the maximum size in bytes of the key and value of messages published to the topic,
+		// larger messages are rejected without being sent. It is disabled by default.
+		MaxMessageSize int `yaml:"max-message-size,omitempty"`
 	}
 
 	// TopicList describes the topic names for each cluster
@@ -53,6 +74,20 @@ type (
 	}
 )
 
+const (
+	// KafkaPartitionKeyWorkflowID keys indexer messages by workflowID
+	KafkaPartitionKeyWorkflowID = "workflowID"
+	// KafkaPartitionKeyDomainID keys indexer messages by domainID
+	KafkaPartitionKeyDomainID = "domainID"
+
+	// KafkaRequiredAcksNone doesn't wait for the brokers to acknowledge messages
+	KafkaRequiredAcksNone = "none"
+	// KafkaRequiredAcksLocal waits for the partition leader to acknowledge messages
+	KafkaRequiredAcksLocal = "local"
+	// KafkaRequiredAcksAll waits for all in-sync replicas to acknowledge messages
+	KafkaRequiredAcksAll = "all"
+)
+
 // Validate will validate config for kafka
 func (k *KafkaConfig) Validate(checkApp bool) {
 	if len(k.Clusters) == 0 {
@@ -71,9 +106,21 @@ func (k *KafkaConfig) Validate(checkApp bool) {
 			panic(fmt.Sprintf("Missing Kafka Cluster Config for Cluster %v", topicConfig.Cluster))
 		} else if len(clusterConfig.Brokers) == 0 {
 			panic(fmt.Sprintf("Missing Kafka Brokers Config for Cluster %v", topicConfig.Cluster))
+		} else if !isValidPartitionKey(topicConfig.PartitionKey) {
+			panic(fmt.Sprintf("Invalid Partition Key %v for Topic %v", topicConfig.PartitionKey, topic))
 		}
 	}
 
+	switch k.Producer.RequiredAcks {
+	case "", KafkaRequiredAcksAll:
+	case KafkaRequiredAcksNone, KafkaRequiredAcksLocal:
+		if k.Producer.Idempotent {
+			panic(fmt.Sprintf("Idempotent Producer requires Required Acks %v", KafkaRequiredAcksAll))
+		}
+	default:
+		panic(fmt.Sprintf("Invalid Producer Required Acks %v", k.Producer.RequiredAcks))
+	}
+
 	if checkApp {
 		if len(k.Applications) == 0 {
 			panic("Empty Applications Config")
@@ -95,6 +142,21 @@ func (k *KafkaConfig) GetBrokersForKafkaCluster(kafkaCluster string) []string {
 	return k.Clusters[kafkaCluster].Brokers
 }
 
+// GetDomainPartitionsForTopic gets the number of partitions per domain for a topic, 0 if domain affinity is disabled
+func (k *KafkaConfig) GetDomainPartitionsForTopic(topic string) int {
+	return k.Topics[topic].DomainPartitions
+}
+
+// GetPartitionKeyForTopic gets the field indexer messages of a topic are keyed by, empty for the default
+func (k *KafkaConfig) GetPartitionKeyForTopic(topic string) string {
+	return k.Topics[topic].PartitionKey
+}
+
+// GetMaxMessageSizeForTopic gets the maximum size of messages published to a topic, 0 if there is no limit
+func (k *KafkaConfig) GetMaxMessageSizeForTopic(topic string) int {
+	return k.Topics[topic].MaxMessageSize
+}
+
 // GetTopicsForApplication gets topic from application
 func (k *KafkaConfig) GetTopicsForApplication(app string) TopicList {
 	return k.Applications[app]
@@ -117,3 +179,12 @@ func (k *KafkaConfig) GetKafkaPropertyForTopic(topic string, property string) an
 
 	return propertyValue
 }
+
+func isValidPartitionKey(partitionKey string) bool {
+	switch partitionKey {
+	case "", KafkaPartitionKeyWorkflowID, KafkaPartitionKeyDomainID:
+		return true
+	default:
+		return false
+	}
+}
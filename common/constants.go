@@ -60,12 +60,13 @@ const (
 
 // Data encoding types
 const (
-	EncodingTypeJSON     EncodingType = "json"
-	EncodingTypeThriftRW EncodingType = "thriftrw"
-	EncodingTypeGob      EncodingType = "gob"
-	EncodingTypeUnknown  EncodingType = "unknow"
-	EncodingTypeEmpty    EncodingType = ""
-	EncodingTypeProto    EncodingType = "proto3"
+	EncodingTypeJSON           EncodingType = "json"
+	EncodingTypeThriftRW       EncodingType = "thriftrw"
+	EncodingTypeThriftRWSnappy EncodingType = "thriftrw-snappy" // thriftrw compressed with snappy, only used for persisted history events
+	EncodingTypeGob            EncodingType = "gob"
+	EncodingTypeUnknown        EncodingType = "unknow"
+	EncodingTypeEmpty          EncodingType = ""
+	EncodingTypeProto          EncodingType = "proto3"
 )
 
 type (
@@ -226,6 +227,9 @@ const MemoKeyForOperator = "operator"
 // ReservedTaskListPrefix is the required naming prefix for any task list partition other than partition 0
 const ReservedTaskListPrefix = "/__cadence_sys/"
 
+// MaxTaskPriority is the highest priority a task can be added to matching with, 0 is the default priority
+const MaxTaskPriority = 10
+
 type (
 	// VisibilityOperation is an enum that represents visibility message types
 	VisibilityOperation string
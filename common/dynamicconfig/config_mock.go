@@ -103,3 +103,8 @@ func GetStringPropertyFn(value string) func(opts ...FilterOption) string {
 func GetMapPropertyFn(value map[string]interface{}) func(opts ...FilterOption) map[string]interface{} {
 	return func(...FilterOption) map[string]interface{} { return value }
 }
+
+// GetListPropertyFn returns value as ListPropertyFn
+func GetListPropertyFn(value []interface{}) func(opts ...FilterOption) []interface{} {
+	return func(...FilterOption) []interface{} { return value }
+}
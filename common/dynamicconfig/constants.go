@@ -345,6 +345,7 @@ const (
 	// key for common & admin
 
 	TransactionSizeLimit
+	HistoryBlobCompressionThreshold
 	MaxRetentionDays
 	MinRetentionDays
 	MaxDecisionStartToCloseSeconds
@@ -604,6 +605,18 @@ const (
 	// Default value: 40960 (40*1024)
 	// Allowed filters: DomainName
 	SearchAttributesTotalSizeLimit
+	// RetryPolicyMaxExpirationIntervalInSeconds is the max ExpirationIntervalInSeconds of the retry policy of a started workflow
+	// KeyName: frontend.retryPolicyMaxExpirationIntervalInSeconds
+	// Value type: Int
+	// Default value: 0 (no limit)
+	// Allowed filters: DomainName
+	RetryPolicyMaxExpirationIntervalInSeconds
+	// RetryPolicyMaxAttempts is the max MaximumAttempts of the retry policy of a started workflow
+	// KeyName: frontend.retryPolicyMaxAttempts
+	// Value type: Int
+	// Default value: 0 (no limit)
+	// Allowed filters: DomainName
+	RetryPolicyMaxAttempts
 	// VisibilityArchivalQueryMaxPageSize is the maximum page size for a visibility archival query
 	// KeyName: frontend.visibilityArchivalQueryMaxPageSize
 	// Value type: Int
@@ -721,6 +734,13 @@ const (
 	// Default value: 20
 	// Allowed filters: DomainName,TasklistName,TasklistType
 	MatchingForwarderMaxChildrenPerNode
+	// MatchingMaxForwardingDepth is the max number of partition hops a forwarded task can take, tasks forwarded
+	// deeper than it are rejected. A non-positive value disables the check
+	// KeyName: matching.maxForwardingDepth
+	// Value type: Int
+	// Default value: 10
+	// Allowed filters: DomainName,TasklistName,TasklistType
+	MatchingMaxForwardingDepth
 
 	// key for history
 
@@ -1246,6 +1266,12 @@ const (
 	// Default value: 1000
 	// Allowed filters: N/A
 	ScannerGetOrphanTasksPageSize
+	// ScannerOrphanTasksDeleteConcurrency is the number of orphan tasks the tasklist scavenger deletes concurrently
+	// KeyName: worker.scannerOrphanTasksDeleteConcurrency
+	// Value type: Int
+	// Default value: 1
+	// Allowed filters: N/A
+	ScannerOrphanTasksDeleteConcurrency
 	// ScannerBatchSizeForTasklistHandler is for: 1. max number of tasks to query per call(get tasks for tasklist) in the scavenger handler. 2. The scavenger then uses the return to decide if a tasklist can be deleted. It's better to keep it a relatively high number to let it be more efficient.
 	// KeyName: worker.scannerBatchSizeForTasklistHandler
 	// Value type: Int
@@ -1258,6 +1284,12 @@ const (
 	// Default value: 256
 	// Allowed filters: N/A
 	ScannerMaxTasksProcessedPerTasklistJob
+	// ScannerMaxTaskDeleteBatchSize is the maximum number of tasks the tasklist scavenger deletes in one persistence call
+	// KeyName: worker.scannerMaxTaskDeleteBatchSize
+	// Value type: Int
+	// Default value: 1000
+	// Allowed filters: N/A
+	ScannerMaxTaskDeleteBatchSize
 	// ConcreteExecutionsScannerConcurrency is indicates the concurrency of concrete execution scanner
 	// KeyName: worker.executionsScannerConcurrency
 	// Value type: Int
@@ -1690,6 +1722,12 @@ const (
 	// Default value: false
 	// Allowed filters: N/A
 	EnableCleaningOrphanTaskInTasklistScavenger
+	// TaskListScavengerDryRun indicates if the task list scavenger only logs and counts the tasks and task lists it would delete
+	// KeyName: worker.taskListScavengerDryRun
+	// Value type: Bool
+	// Default value: false
+	// Allowed filters: N/A
+	TaskListScavengerDryRun
 	// TaskListScannerEnabled is indicates if task list scanner should be started as part of worker.Scanner
 	// KeyName: worker.taskListScannerEnabled
 	// Value type: Bool
@@ -2576,6 +2614,13 @@ const (
 	// Default value: 10m (time.Minute*10)
 	// Allowed filters: N/A
 	WorkerReplicationTaskMaxRetryDuration
+	// ScannerTaskListGracePeriod is the amount of time a task list has to be idle before the tasklist scavenger deletes it,
+	// it's never lower than the MatchingIdleTasklistCheckInterval of the task list
+	// KeyName: worker.scannerTaskListGracePeriod
+	// Value type: Duration
+	// Default value: 48h
+	// Allowed filters: DomainName
+	ScannerTaskListGracePeriod
 	// ESAnalyzerTimeWindow defines the time window ElasticSearch Analyzer will consider while taking workflow averages
 	// KeyName: worker.ESAnalyzerTimeWindow
 	// Value type: Duration
@@ -2681,6 +2726,19 @@ const (
 	// Default value: N/A
 	// Allowed filters: N/A
 	AllIsolationGroups
+	// ScannerTaskListAllowlist is the list of regexes of the task list names the tasklist scavenger processes,
+	// all task lists are processed if it is empty
+	// KeyName: worker.scannerTaskListAllowlist
+	// Value type: []string
+	// Default value: N/A
+	// Allowed filters: N/A
+	ScannerTaskListAllowlist
+	// ScannerTaskListDenylist is the list of regexes of the task list names the tasklist scavenger never processes
+	// KeyName: worker.scannerTaskListDenylist
+	// Value type: []string
+	// Default value: N/A
+	// Allowed filters: N/A
+	ScannerTaskListDenylist
 
 	LastListKey
 )
@@ -2712,6 +2770,11 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "TransactionSizeLimit is the largest allowed transaction size to persistence",
 		DefaultValue: 14680064,
 	},
+	HistoryBlobCompressionThreshold: DynamicInt{
+		KeyName:      "system.historyBlobCompressionThreshold",
+		Description:  "HistoryBlobCompressionThreshold is the minimum size in bytes of a history event batch to be compressed with snappy before it is persisted, 0 disables compression",
+		DefaultValue: 0,
+	},
 	MaxRetentionDays: DynamicInt{
 		KeyName:      "system.maxRetentionDays",
 		Description:  "MaxRetentionDays is the maximum allowed retention days for domain",
@@ -2973,6 +3036,18 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "SearchAttributesTotalSizeLimit is the size limit of the whole map",
 		DefaultValue: 40 * 1024,
 	},
+	RetryPolicyMaxExpirationIntervalInSeconds: DynamicInt{
+		KeyName:      "frontend.retryPolicyMaxExpirationIntervalInSeconds",
+		Filters:      []Filter{DomainName},
+		Description:  "RetryPolicyMaxExpirationIntervalInSeconds is the max ExpirationIntervalInSeconds of the retry policy of a started workflow, 0 means no limit",
+		DefaultValue: 0,
+	},
+	RetryPolicyMaxAttempts: DynamicInt{
+		KeyName:      "frontend.retryPolicyMaxAttempts",
+		Filters:      []Filter{DomainName},
+		Description:  "RetryPolicyMaxAttempts is the max MaximumAttempts of the retry policy of a started workflow, 0 means no limit",
+		DefaultValue: 0,
+	},
 	VisibilityArchivalQueryMaxPageSize: DynamicInt{
 		KeyName:      "frontend.visibilityArchivalQueryMaxPageSize",
 		Description:  "VisibilityArchivalQueryMaxPageSize is the maximum page size for a visibility archival query",
@@ -3079,6 +3154,12 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "MatchingForwarderMaxChildrenPerNode is the max number of children per node in the task list partition tree",
 		DefaultValue: 20,
 	},
+	MatchingMaxForwardingDepth: DynamicInt{
+		KeyName:      "matching.maxForwardingDepth",
+		Filters:      []Filter{DomainName, TaskListName, TaskType},
+		Description:  "MatchingMaxForwardingDepth is the max number of partition hops a forwarded task can take, tasks forwarded deeper than it are rejected. A non-positive value disables the check",
+		DefaultValue: 10,
+	},
 	HistoryRPS: DynamicInt{
 		KeyName:      "history.rps",
 		Description:  "HistoryRPS is request rate per second for each history host",
@@ -3511,6 +3592,11 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "ScannerGetOrphanTasksPageSize is the maximum number of orphans to delete in one batch",
 		DefaultValue: 1000,
 	},
+	ScannerOrphanTasksDeleteConcurrency: DynamicInt{
+		KeyName:      "worker.scannerOrphanTasksDeleteConcurrency",
+		Description:  "ScannerOrphanTasksDeleteConcurrency is the number of orphan tasks the tasklist scavenger deletes concurrently",
+		DefaultValue: 1,
+	},
 	ScannerBatchSizeForTasklistHandler: DynamicInt{
 		KeyName:      "worker.scannerBatchSizeForTasklistHandler",
 		Description:  "ScannerBatchSizeForTasklistHandler is for: 1. max number of tasks to query per call(get tasks for tasklist) in the scavenger handler. 2. The scavenger then uses the return to decide if a tasklist can be deleted. It's better to keep it a relatively high number to let it be more efficient.",
@@ -3521,6 +3607,11 @@ var IntKeys = map[IntKey]DynamicInt{
 		Description:  "ScannerMaxTasksProcessedPerTasklistJob is the number of tasks to process for a tasklist in each workflow run",
 		DefaultValue: 256,
 	},
+	ScannerMaxTaskDeleteBatchSize: DynamicInt{
+		KeyName:      "worker.scannerMaxTaskDeleteBatchSize",
+		Description:  "ScannerMaxTaskDeleteBatchSize is the maximum number of tasks the tasklist scavenger deletes in one persistence call",
+		DefaultValue: 1000,
+	},
 	ConcreteExecutionsScannerConcurrency: DynamicInt{
 		KeyName:      "worker.executionsScannerConcurrency",
 		Description:  "ConcreteExecutionsScannerConcurrency is indicates the concurrency of concrete execution scanner",
@@ -3906,6 +3997,11 @@ var BoolKeys = map[BoolKey]DynamicBool{
 		Description:  "EnableCleaningOrphanTaskInTasklistScavenger indicates if enabling the scanner to clean up orphan tasks",
 		DefaultValue: false,
 	},
+	TaskListScavengerDryRun: DynamicBool{
+		KeyName:      "worker.taskListScavengerDryRun",
+		Description:  "TaskListScavengerDryRun indicates if the task list scavenger only logs and counts the tasks and task lists it would delete",
+		DefaultValue: false,
+	},
 	TaskListScannerEnabled: DynamicBool{
 		KeyName:      "worker.taskListScannerEnabled",
 		Description:  "TaskListScannerEnabled is indicates if task list scanner should be started as part of worker.Scanner",
@@ -4668,6 +4764,12 @@ var DurationKeys = map[DurationKey]DynamicDuration{
 		Description:  "WorkerReplicationTaskMaxRetryDuration is the max retry duration for any task",
 		DefaultValue: time.Minute * 10,
 	},
+	ScannerTaskListGracePeriod: DynamicDuration{
+		KeyName:      "worker.scannerTaskListGracePeriod",
+		Filters:      []Filter{DomainName},
+		Description:  "ScannerTaskListGracePeriod is the amount of time a task list has to be idle before the tasklist scavenger deletes it",
+		DefaultValue: time.Hour * 48,
+	},
 	ESAnalyzerTimeWindow: DynamicDuration{
 		KeyName:      "worker.ESAnalyzerTimeWindow",
 		Description:  "ESAnalyzerTimeWindow defines the time window ElasticSearch Analyzer will consider while taking workflow averages",
@@ -4743,6 +4845,14 @@ var ListKeys = map[ListKey]DynamicList{
 		KeyName:     "system.allIsolationGroups",
 		Description: "A list of all the isolation groups in a system",
 	},
+	ScannerTaskListAllowlist: {
+		KeyName:     "worker.scannerTaskListAllowlist",
+		Description: "ScannerTaskListAllowlist is the list of regexes of the task list names the tasklist scavenger processes, all task lists are processed if it is empty",
+	},
+	ScannerTaskListDenylist: {
+		KeyName:     "worker.scannerTaskListDenylist",
+		Description: "ScannerTaskListDenylist is the list of regexes of the task list names the tasklist scavenger never processes",
+	},
 	DefaultIsolationGroupConfigStoreManagerGlobalMapping: {
 		KeyName: "system.defaultIsolationGroupConfigStoreManagerGlobalMapping",
 		Description: "A configuration store for global isolation groups - used in isolation-group config only, not normal dynamic config." +
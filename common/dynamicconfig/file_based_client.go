@@ -21,10 +21,12 @@
 package dynamicconfig
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"sort"
 	"sync/atomic"
 	"time"
 
@@ -234,8 +236,33 @@ func (fc *fileBasedClient) RestoreValue(name Key, filters map[Filter]interface{}
 	return errors.New("not supported for file based client")
 }
 
+// ListValue returns the values of the given key, or all keys if the key is nil or not present in the file,
+// with values and constraints converted to JSON blobs the same way the config store client keeps them
 func (fc *fileBasedClient) ListValue(name Key) ([]*types.DynamicConfigEntry, error) {
-	return nil, errors.New("not supported for file based client")
+	values := fc.values.Load().(map[string][]*constrainedValue)
+
+	var keyNames []string
+	if name != nil {
+		if _, ok := values[name.String()]; ok {
+			keyNames = []string{name.String()}
+		}
+	}
+	if keyNames == nil {
+		for keyName := range values {
+			keyNames = append(keyNames, keyName)
+		}
+		sort.Strings(keyNames)
+	}
+
+	entries := make([]*types.DynamicConfigEntry, 0, len(keyNames))
+	for _, keyName := range keyNames {
+		entry, err := convertToDynamicConfigEntry(keyName, values[keyName])
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
 }
 
 func (fc *fileBasedClient) update() error {
@@ -321,6 +348,53 @@ func match(v *constrainedValue, filters map[Filter]interface{}) bool {
 	return true
 }
 
+func convertToDynamicConfigEntry(keyName string, constrainedValues []*constrainedValue) (*types.DynamicConfigEntry, error) {
+	entry := &types.DynamicConfigEntry{
+		Name:   keyName,
+		Values: make([]*types.DynamicConfigValue, 0, len(constrainedValues)),
+	}
+	for _, cv := range constrainedValues {
+		value, err := convertToDataBlob(cv.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert value of %v: %v", keyName, err)
+		}
+		// sort the constraints so the listed filters are stable across calls
+		constraintNames := make([]string, 0, len(cv.Constraints))
+		for constraintName := range cv.Constraints {
+			constraintNames = append(constraintNames, constraintName)
+		}
+		sort.Strings(constraintNames)
+
+		filters := make([]*types.DynamicConfigFilter, 0, len(constraintNames))
+		for _, constraintName := range constraintNames {
+			filterValue, err := convertToDataBlob(cv.Constraints[constraintName])
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert constraint %v of %v: %v", constraintName, keyName, err)
+			}
+			filters = append(filters, &types.DynamicConfigFilter{
+				Name:  constraintName,
+				Value: filterValue,
+			})
+		}
+		entry.Values = append(entry.Values, &types.DynamicConfigValue{
+			Value:   value,
+			Filters: filters,
+		})
+	}
+	return entry, nil
+}
+
+func convertToDataBlob(v interface{}) (*types.DataBlob, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return &types.DataBlob{
+		EncodingType: types.EncodingTypeJSON.Ptr(),
+		Data:         data,
+	}, nil
+}
+
 func convertKeyTypeToString(v interface{}) (interface{}, error) {
 	switch v := v.(type) {
 	case map[interface{}]interface{}:
@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/types"
 )
 
 type fileBasedClientSuite struct {
@@ -212,6 +213,34 @@ func (s *fileBasedClientSuite) TestGetDurationValue_ParseFailed() {
 	s.Equal(TestGetDurationPropertyKey.DefaultDuration(), v)
 }
 
+func (s *fileBasedClientSuite) TestListValue() {
+	entries, err := s.client.ListValue(TestGetBoolPropertyKey)
+	s.NoError(err)
+	s.Len(entries, 1)
+	s.Equal(TestGetBoolPropertyKey.String(), entries[0].Name)
+	s.Len(entries[0].Values, 3)
+
+	defaultValue := entries[0].Values[0]
+	s.Equal(types.EncodingTypeJSON, defaultValue.Value.GetEncodingType())
+	s.Equal("false", string(defaultValue.Value.Data))
+	s.Empty(defaultValue.Filters)
+
+	constrainedValue := entries[0].Values[1]
+	s.Equal("true", string(constrainedValue.Value.Data))
+	s.Len(constrainedValue.Filters, 1)
+	s.Equal(DomainName.String(), constrainedValue.Filters[0].Name)
+	s.Equal(`"global-samples-domain"`, string(constrainedValue.Filters[0].Value.Data))
+}
+
+func (s *fileBasedClientSuite) TestListValue_AllKeys() {
+	entries, err := s.client.ListValue(nil)
+	s.NoError(err)
+	s.True(len(entries) > 1)
+	for i := 1; i < len(entries); i++ {
+		s.True(entries[i-1].Name < entries[i].Name)
+	}
+}
+
 func (s *fileBasedClientSuite) TestValidateConfig_ConfigNotExist() {
 	_, err := NewFileBasedClient(nil, nil, nil)
 	s.Error(err)
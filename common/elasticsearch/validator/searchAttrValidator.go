@@ -139,7 +139,11 @@ func (sv *SearchAttributesValidator) isValidSearchAttributesValue(
 	key string,
 	value []byte,
 ) bool {
-	valueType := common.ConvertIndexedValueTypeToInternalType(validAttr[key], sv.logger)
-	_, err := common.DeserializeSearchAttributeValue(value, valueType)
+	valueType, err := common.ConvertIndexedValueTypeToInternalTypeWithError(validAttr[key])
+	if err != nil {
+		sv.logger.Error("invalid search attribute type in dynamic config", tag.ESKey(key), tag.Error(err))
+		return false
+	}
+	_, err = common.DeserializeSearchAttributeValue(value, valueType)
 	return err == nil
 }
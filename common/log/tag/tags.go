@@ -217,6 +217,11 @@ func OperationName(operationName string) Tag {
 	return newStringTag("operation-name", operationName)
 }
 
+// ContextDeadlineBudget returns tag for the remaining deadline budget of a request context
+func ContextDeadlineBudget(budget time.Duration) Tag {
+	return newDurationTag("context-deadline-budget", budget)
+}
+
 // history event ID related
 
 // WorkflowEventID returns tag for WorkflowEventID
@@ -454,6 +459,11 @@ func StoreShard(storeShard string) Tag {
 	return newPredefinedStringTag("store-shard", storeShard)
 }
 
+// StoreLatency returns tag for StoreLatency
+func StoreLatency(latency time.Duration) Tag {
+	return newDurationTag("store-latency", latency)
+}
+
 // ClientError returns tag for ClientError
 func ClientError(clientErr error) Tag {
 	return newErrorTag("client-error", clientErr)
@@ -548,6 +558,11 @@ func ReadLevel(lv int64) Tag {
 	return newInt64("read-level", lv)
 }
 
+// AckLevel returns tag for AckLevel
+func AckLevel(lv int64) Tag {
+	return newInt64("ack-level", lv)
+}
+
 // MinLevel returns tag for MinLevel
 func MinLevel(lv int64) Tag {
 	return newInt64("min-level", lv)
@@ -730,6 +745,11 @@ func KafkaOffset(offset int64) Tag {
 	return newInt64("kafka-offset", offset)
 }
 
+// KafkaMessageSize returns tag for the serialized size of a Kafka message
+func KafkaMessageSize(size int) Tag {
+	return newInt("kafka-message-size", size)
+}
+
 // TokenLastEventID returns tag for TokenLastEventID
 func TokenLastEventID(id int64) Tag {
 	return newInt64("token-last-event-id", id)
@@ -224,6 +224,7 @@ var (
 	StoreOperationListTaskList          = storeOperation("list-task-list")
 	StoreOperationDeleteTaskList        = storeOperation("delete-task-list")
 	StoreOperationGetTaskListSize       = storeOperation("get-task-list-size")
+	StoreOperationGetTaskList           = storeOperation("get-task-list")
 	StoreOperationStopTaskList          = storeOperation("stop-task-list")
 
 	StoreOperationCreateDomain       = storeOperation("create-domain")
@@ -262,17 +263,24 @@ var (
 	StoreOperationGetAllHistoryTreeBranches = storeOperation("get-all-history-tree-branches")
 
 	StoreOperationEnqueueMessage             = storeOperation("enqueue-message")
+	StoreOperationEnqueueMessageWithDedup    = storeOperation("enqueue-message-with-dedup")
 	StoreOperationReadMessages               = storeOperation("read-messages")
+	StoreOperationReadMessagesReverse        = storeOperation("read-messages-reverse")
+	StoreOperationGetMessage                 = storeOperation("get-message")
 	StoreOperationUpdateAckLevel             = storeOperation("update-ack-level")
 	StoreOperationGetAckLevels               = storeOperation("get-ack-levels")
 	StoreOperationDeleteMessagesBefore       = storeOperation("delete-messages-before")
+	StoreOperationPurgeQueue                 = storeOperation("purge-queue")
 	StoreOperationEnqueueMessageToDLQ        = storeOperation("enqueue-message-to-dlq")
 	StoreOperationReadMessagesFromDLQ        = storeOperation("read-messages-from-dlq")
+	StoreOperationPeekDLQMessages            = storeOperation("peek-dlq-messages")
 	StoreOperationRangeDeleteMessagesFromDLQ = storeOperation("range-delete-messages-from-dlq")
 	StoreOperationUpdateDLQAckLevel          = storeOperation("update-dlq-ack-level")
 	StoreOperationGetDLQAckLevels            = storeOperation("get-dlq-ack-levels")
 	StoreOperationGetDLQSize                 = storeOperation("get-dlq-size")
+	StoreOperationGetDLQOldestMessageTime    = storeOperation("get-dlq-oldest-message-timestamp")
 	StoreOperationDeleteMessageFromDLQ       = storeOperation("delete-message-from-dlq")
+	StoreOperationDeleteDLQMessagesWhere     = storeOperation("delete-dlq-messages-where")
 
 	StoreOperationFetchDynamicConfig  = storeOperation("fetch-dynamic-config")
 	StoreOperationUpdateDynamicConfig = storeOperation("update-dynamic-config")
@@ -408,6 +416,8 @@ var (
 	MatchingClientOperationCancelOutstandingPoll  = clientOperation("matching-cancel-outstanding-poll")
 	MatchingClientOperationDescribeTaskList       = clientOperation("matching-describe-task-list")
 	MatchingClientOperationListTaskListPartitions = clientOperation("matching-list-task-list-partitions")
+	MatchingClientOperationRefreshTaskList        = clientOperation("matching-refresh-task-list")
+	MatchingClientOperationResetTaskListAckLevel  = clientOperation("matching-reset-task-list-ack-level")
 	MatchingClientOperationGetTaskListsByDomain   = clientOperation("get-task-list-for-domain")
 )
 
@@ -20,9 +20,57 @@
 
 package messaging
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
 
 var (
 	// ErrMessageSizeLimit indicate that message is rejected by server due to size limitation
 	ErrMessageSizeLimit = errors.New("message was too large, server rejected it to avoid allocation error")
+	// ErrPublishMetadataNotSupported indicate that the wrapped producer does not report publish metadata
+	ErrPublishMetadataNotSupported = errors.New("producer does not support publishing with metadata")
+	// ErrHealthCheckNotSupported indicate that the producer can't check the health of its brokers
+	ErrHealthCheckNotSupported = errors.New("producer does not support health check")
 )
+
+// PublishBatchError is returned by BatchProducer.PublishBatch when some of the messages failed to be published
+type PublishBatchError struct {
+	// Errors maps the index of every message which failed to be published to its error
+	Errors map[int]error
+}
+
+func (e *PublishBatchError) Error() string {
+	indexes := e.FailedIndexes()
+	if len(indexes) == 0 {
+		return "failed to publish messages"
+	}
+	return fmt.Sprintf("failed to publish %v messages, first failed message at index %v: %v",
+		len(indexes), indexes[0], e.Errors[indexes[0]])
+}
+
+// FailedIndexes returns the sorted indexes of the messages which failed to be published
+func (e *PublishBatchError) FailedIndexes() []int {
+	indexes := make([]int, 0, len(e.Errors))
+	for index := range e.Errors {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+// DeadLetteredError is returned by the DLQ fallback producer when a message failed to be published
+// and was published to the DLQ instead
+type DeadLetteredError struct {
+	// Err is the error the message failed to be published with
+	Err error
+}
+
+func (e *DeadLetteredError) Error() string {
+	return fmt.Sprintf("message was published to DLQ: %v", e.Err)
+}
+
+func (e *DeadLetteredError) Unwrap() error {
+	return e.Err
+}
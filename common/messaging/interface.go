@@ -22,6 +22,18 @@ package messaging
 
 import "context"
 
+const (
+	// HeaderEncodingType is the header of published messages recording the common.EncodingType of their value
+	HeaderEncodingType = "cadence-encoding-type"
+	// HeaderSchemaVersion is the header of published messages recording the schema version of their value
+	HeaderSchemaVersion = "cadence-schema-version"
+
+	// IndexerSchemaVersion is the current schema version of the thrift, proto and Pinot indexer messages
+	IndexerSchemaVersion = "1"
+	// HistoryEventsSchemaVersion is the current schema version of the history events messages
+	HistoryEventsSchemaVersion = "1"
+)
+
 type (
 	// Client is the interface used to abstract out interaction with messaging system for replication
 	Client interface {
@@ -64,6 +76,41 @@ type (
 		Close() error
 	}
 
+	// BatchProducer is a Producer which can publish multiple messages at once
+	BatchProducer interface {
+		Producer
+		// PublishBatch publishes the messages in a single request, if some of them failed to be published
+		// the returned error is a *PublishBatchError
+		PublishBatch(ctx context.Context, messages []interface{}) error
+	}
+
+	// MetadataProducer is a Producer which can report where a published message landed
+	MetadataProducer interface {
+		Producer
+		// PublishWithMetadata publishes the message and returns the partition and offset it was written to
+		PublishWithMetadata(ctx context.Context, message interface{}) (PublishMetadata, error)
+	}
+
+	// HealthCheckProducer is a Producer which can check whether its brokers are reachable without publishing
+	HealthCheckProducer interface {
+		Producer
+		// Healthy returns an error if the brokers of the producer can't be reached
+		Healthy(ctx context.Context) error
+	}
+
+	// HealthCheckClient is a Client which can check the health of the producers it created
+	HealthCheckClient interface {
+		Client
+		// Healthy returns an error if any of the producers created by the client can't reach its brokers
+		Healthy(ctx context.Context) error
+	}
+
+	// PublishMetadata is the location of a published message
+	PublishMetadata struct {
+		Partition int32
+		Offset    int64
+	}
+
 	// AckManager convert out of order acks into ackLevel movement.
 	AckManager interface {
 		// Read an item into backlog for processing for ack
@@ -82,3 +129,12 @@ type (
 		GetBacklogCount() int64
 	}
 )
+
+// CheckClientHealth returns the error of the health check of the client,
+// clients which are not a HealthCheckClient, including nil, are considered healthy
+func CheckClientHealth(ctx context.Context, client Client) error {
+	if healthCheckClient, ok := client.(HealthCheckClient); ok {
+		return healthCheckClient.Healthy(ctx)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/multierr"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+)
+
+// maxAsyncPublishErrors is the maximum number of publish errors accumulated to be returned by Close
+const maxAsyncPublishErrors = 100
+
+type (
+	asyncProducerImpl struct {
+		// builder builds the messages, its sync producer is not used. It is not embedded so that
+		// the sync only methods of producerImpl are not promoted
+		builder  *producerImpl
+		producer sarama.AsyncProducer
+		callback AsyncPublishCallback
+
+		// closeLock prevents Close from closing the input of the producer while messages are sent to it
+		closeLock sync.RWMutex
+		closed    bool
+
+		// errLock is separate from closeLock so that errors are drained while Publish is blocked on a full input,
+		// sarama stops consuming its input until its errors are drained
+		errLock sync.Mutex
+		// publishErrs are the first maxAsyncPublishErrors publish errors, numErrs counts all of them
+		publishErrs error
+		numErrs     int
+
+		closeOnce sync.Once
+		shutdownW sync.WaitGroup
+	}
+
+	// AsyncPublishCallback is invoked with the published message and the result once it was acknowledged
+	// or failed to be published, it is invoked from a background goroutine and must not block
+	AsyncPublishCallback func(msg interface{}, err error)
+)
+
+var _ messaging.CloseableProducer = (*asyncProducerImpl)(nil)
+
+var errAsyncProducerClosed = errors.New("kafka async producer is closed")
+
+// NewKafkaAsyncProducer is used to create the Kafka based producer implementation which publishes messages
+// asynchronously. Publish returns as soon as the message is queued, and the callback, if not nil, is invoked
+// with the result of every message. Publish failures are also accumulated and returned by Close.
+// The Return.Successes setting of the sarama config determines whether the callback is invoked for successes.
+func NewKafkaAsyncProducer(
+	topic string,
+	producer sarama.AsyncProducer,
+	callback AsyncPublishCallback,
+	logger log.Logger,
+	opts ...ProducerOption,
+) messaging.CloseableProducer {
+	p := &asyncProducerImpl{
+		builder:  NewKafkaProducer(topic, nil, logger, opts...).(*producerImpl),
+		producer: producer,
+		callback: callback,
+	}
+	p.shutdownW.Add(2)
+	go p.drainSuccesses()
+	go p.drainErrors()
+	return p
+}
+
+// Publish queues the message to be sent to the Kafka topic, it only blocks if the queue of the producer is full
+func (p *asyncProducerImpl) Publish(ctx context.Context, msg interface{}) error {
+	message, err := p.builder.getProducerMessage(msg)
+	if err != nil {
+		return err
+	}
+	message.Metadata = msg
+
+	if p.builder.payloadTap != nil {
+		p.builder.tapMessage(message)
+	}
+
+	p.closeLock.RLock()
+	defer p.closeLock.RUnlock()
+	if p.closed {
+		return errAsyncProducerClosed
+	}
+	select {
+	case p.producer.Input() <- message:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close flushes the queued messages, closes the producer and returns the publish errors
+// which occurred since the producer was created
+func (p *asyncProducerImpl) Close() error {
+	p.closeOnce.Do(func() {
+		p.closeLock.Lock()
+		p.closed = true
+		p.closeLock.Unlock()
+
+		p.producer.AsyncClose()
+		p.shutdownW.Wait()
+	})
+
+	p.errLock.Lock()
+	defer p.errLock.Unlock()
+	if p.numErrs > maxAsyncPublishErrors {
+		return multierr.Append(p.publishErrs, fmt.Errorf("%v more publish errors", p.numErrs-maxAsyncPublishErrors))
+	}
+	return p.publishErrs
+}
+
+func (p *asyncProducerImpl) drainSuccesses() {
+	defer p.shutdownW.Done()
+
+	for message := range p.producer.Successes() {
+		if p.callback != nil {
+			p.callback(message.Metadata, nil)
+		}
+	}
+}
+
+func (p *asyncProducerImpl) drainErrors() {
+	defer p.shutdownW.Done()
+
+	for producerErr := range p.producer.Errors() {
+		err := p.builder.convertErr(producerErr.Err)
+		p.builder.logger.Warn("Failed to publish message to kafka",
+			tag.KafkaPartition(producerErr.Msg.Partition),
+			tag.KafkaPartitionKey(producerErr.Msg.Key),
+			tag.KafkaOffset(producerErr.Msg.Offset),
+			tag.Error(err))
+
+		p.errLock.Lock()
+		p.numErrs++
+		if p.numErrs <= maxAsyncPublishErrors {
+			p.publishErrs = multierr.Append(p.publishErrs, err)
+		}
+		p.errLock.Unlock()
+
+		if p.callback != nil {
+			p.callback(producerErr.Msg.Metadata, err)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/messaging"
+)
+
+func TestAsyncProducer(t *testing.T) {
+	config := mocks.NewTestConfig()
+	config.Producer.Return.Successes = true
+	asyncProducer := mocks.NewAsyncProducer(t, config)
+
+	var lock sync.Mutex
+	results := make(map[string]error)
+	callback := func(msg interface{}, err error) {
+		lock.Lock()
+		defer lock.Unlock()
+		results[string(msg.(*sarama.ConsumerMessage).Key)] = err
+	}
+	producer := NewKafkaAsyncProducer("test-topic", asyncProducer, callback, loggerimpl.NewNopLogger())
+
+	asyncProducer.ExpectInputAndSucceed()
+	asyncProducer.ExpectInputAndFail(sarama.ErrMessageSizeTooLarge)
+	asyncProducer.ExpectInputAndSucceed()
+	for _, key := range []string{"key-1", "key-2", "key-3"} {
+		require.NoError(t, producer.Publish(context.Background(), &sarama.ConsumerMessage{Key: []byte(key), Value: []byte("value")}))
+	}
+
+	err := producer.Close()
+	assert.Equal(t, messaging.ErrMessageSizeLimit, err)
+	assert.Equal(t, map[string]error{
+		"key-1": nil,
+		"key-2": messaging.ErrMessageSizeLimit,
+		"key-3": nil,
+	}, results)
+
+	// the producer can't be used once closed
+	assert.Equal(t, errAsyncProducerClosed, producer.Publish(context.Background(), &sarama.ConsumerMessage{}))
+	assert.Equal(t, messaging.ErrMessageSizeLimit, producer.Close())
+}
+
+// blockingAsyncProducer is an unbuffered sarama.AsyncProducer which stops consuming its input until the error
+// of the previous message is drained, like sarama does once its buffers are full
+type blockingAsyncProducer struct {
+	input     chan *sarama.ProducerMessage
+	successes chan *sarama.ProducerMessage
+	errors    chan *sarama.ProducerError
+}
+
+func newBlockingAsyncProducer() *blockingAsyncProducer {
+	p := &blockingAsyncProducer{
+		input:     make(chan *sarama.ProducerMessage),
+		successes: make(chan *sarama.ProducerMessage),
+		errors:    make(chan *sarama.ProducerError),
+	}
+	go func() {
+		defer close(p.successes)
+		defer close(p.errors)
+		for msg := range p.input {
+			p.errors <- &sarama.ProducerError{Msg: msg, Err: sarama.ErrOutOfBrokers}
+		}
+	}()
+	return p
+}
+
+func (p *blockingAsyncProducer) AsyncClose()                               { close(p.input) }
+func (p *blockingAsyncProducer) Close() error                              { p.AsyncClose(); return nil }
+func (p *blockingAsyncProducer) Input() chan<- *sarama.ProducerMessage     { return p.input }
+func (p *blockingAsyncProducer) Successes() <-chan *sarama.ProducerMessage { return p.successes }
+func (p *blockingAsyncProducer) Errors() <-chan *sarama.ProducerError      { return p.errors }
+
+func TestAsyncProducerDrainsErrorsWhilePublishBlocks(t *testing.T) {
+	producer := NewKafkaAsyncProducer("test-topic", newBlockingAsyncProducer(), nil, loggerimpl.NewNopLogger())
+
+	done := make(chan error, 1)
+	go func() {
+		for i := 0; i < 10; i++ {
+			if err := producer.Publish(context.Background(), &sarama.ConsumerMessage{Key: []byte("key"), Value: []byte("value")}); err != nil {
+				done <- err
+				return
+			}
+		}
+		done <- producer.Close()
+	}()
+
+	select {
+	case err := <-done:
+		assert.Error(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("publish deadlocked with the draining of the publish errors")
+	}
+}
+
+func TestAsyncProducerWithoutCallback(t *testing.T) {
+	asyncProducer := mocks.NewAsyncProducer(t, mocks.NewTestConfig())
+	producer := NewKafkaAsyncProducer("test-topic", asyncProducer, nil, loggerimpl.NewNopLogger())
+
+	asyncProducer.ExpectInputAndSucceed()
+	require.NoError(t, producer.Publish(context.Background(), &sarama.ConsumerMessage{Key: []byte("key"), Value: []byte("value")}))
+	assert.NoError(t, producer.Close())
+}
@@ -21,14 +21,18 @@
 package kafka
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/uber-go/tally"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/messaging"
@@ -41,10 +45,24 @@ type (
 		config        *config.KafkaConfig
 		metricsClient metrics.Client
 		logger        log.Logger
+
+		timeSource     clock.TimeSource
+		healthCheckTTL time.Duration
+
+		sync.Mutex
+		// producers are the open producers created by the client, checked by Healthy
+		producers map[messaging.HealthCheckProducer]struct{}
+		// lastHealthCheck and lastHealthErr cache the result of Healthy for healthCheckTTL
+		lastHealthCheck time.Time
+		lastHealthErr   error
 	}
 )
 
-var _ messaging.Client = (*clientImpl)(nil)
+// defaultHealthCheckTTL is how long the result of Healthy is reused, so that frequent probes don't refresh
+// the metadata of every topic on each call
+const defaultHealthCheckTTL = 10 * time.Second
+
+var _ messaging.HealthCheckClient = (*clientImpl)(nil)
 
 // NewKafkaClient is used to create an instance of KafkaClient
 func NewKafkaClient(
@@ -74,9 +92,12 @@ func NewKafkaClient(
 	}
 
 	return &clientImpl{
-		config:        kc,
-		metricsClient: metricsClient,
-		logger:        logger,
+		config:         kc,
+		metricsClient:  metricsClient,
+		logger:         logger,
+		timeSource:     clock.NewRealTimeSource(),
+		healthCheckTTL: defaultHealthCheckTTL,
+		producers:      map[messaging.HealthCheckProducer]struct{}{},
 	}
 }
 
@@ -102,7 +123,7 @@ func (c *clientImpl) NewConsumer(app, consumerName string) (messaging.Consumer,
 	saramaConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
 	saramaConfig.Consumer.MaxProcessingTime = 250 * time.Millisecond
 
-	err = c.initAuth(saramaConfig)
+	err = initAuth(saramaConfig, c.config)
 	if err != nil {
 		return nil, err
 	}
@@ -118,36 +139,78 @@ func (c *clientImpl) NewConsumer(app, consumerName string) (messaging.Consumer,
 // NewProducer is used to create a Kafka producer
 func (c *clientImpl) NewProducer(app string) (messaging.Producer, error) {
 	topics := c.config.GetTopicsForApplication(app)
-	return c.newProducerByTopic(topics.Topic)
+	// the DLQ producer is not retried here since the consumer already retries publishing to the DLQ
+	return c.newProducerByTopic(topics.Topic, WithPublishRetryPolicy(common.CreateKafkaPublishRetryPolicy()))
 }
 
-func (c *clientImpl) newProducerByTopic(topic string) (messaging.Producer, error) {
-	kafkaClusterName := c.config.GetKafkaClusterForTopic(topic)
-	brokers := c.config.GetBrokersForKafkaCluster(kafkaClusterName)
-
-	config := sarama.NewConfig()
-	config.Producer.Return.Successes = true
-	err := c.initAuth(config)
+func (c *clientImpl) newProducerByTopic(topic string, opts ...ProducerOption) (messaging.Producer, error) {
+	var healthCheckProducer messaging.HealthCheckProducer
+	// closed producers are no longer checked by Healthy
+	unregister := withOnClose(func() {
+		c.Lock()
+		defer c.Unlock()
+		delete(c.producers, healthCheckProducer)
+	})
+	kafkaProducer, err := NewKafkaProducerFromConfig(topic, c.config, c.logger, append(opts, unregister)...)
 	if err != nil {
 		return nil, err
 	}
-
-	producer, err := sarama.NewSyncProducer(brokers, config)
-	if err != nil {
-		return nil, err
+	if p, ok := kafkaProducer.(messaging.HealthCheckProducer); ok {
+		healthCheckProducer = p
+		c.Lock()
+		c.producers[healthCheckProducer] = struct{}{}
+		c.Unlock()
 	}
 
 	if c.metricsClient != nil {
 		c.logger.Info("Create producer with metricsClient")
-		return messaging.NewMetricProducer(NewKafkaProducer(topic, producer, c.logger), c.metricsClient), nil
+		return messaging.NewMetricProducer(kafkaProducer, c.metricsClient), nil
+	}
+	return kafkaProducer, nil
+}
+
+// Healthy checks the brokers of every open producer created by the client are reachable. The result is
+// reused for healthCheckTTL, except for errors of ctx which are not cached.
+func (c *clientImpl) Healthy(ctx context.Context) error {
+	c.Lock()
+	now := c.timeSource.Now()
+	if !c.lastHealthCheck.IsZero() && now.Sub(c.lastHealthCheck) < c.healthCheckTTL {
+		err := c.lastHealthErr
+		c.Unlock()
+		return err
 	}
-	return NewKafkaProducer(topic, producer, c.logger), nil
+	producers := make([]messaging.HealthCheckProducer, 0, len(c.producers))
+	for producer := range c.producers {
+		producers = append(producers, producer)
+	}
+	c.Unlock()
+
+	err := checkProducers(ctx, producers)
+	if ctx.Err() != nil {
+		return err
+	}
+
+	c.Lock()
+	c.lastHealthCheck = now
+	c.lastHealthErr = err
+	c.Unlock()
+	return err
+}
+
+func checkProducers(ctx context.Context, producers []messaging.HealthCheckProducer) error {
+	for _, producer := range producers {
+		if err := producer.Healthy(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (c *clientImpl) initAuth(saramaConfig *sarama.Config) error {
-	tlsConfig, err := c.config.TLS.ToTLSConfig()
+// initAuth sets the TLS and SASL settings of the Kafka config to the sarama config
+func initAuth(saramaConfig *sarama.Config, kafkaConfig *config.KafkaConfig) error {
+	tlsConfig, err := kafkaConfig.TLS.ToTLSConfig()
 	if err != nil {
-		panic(fmt.Sprintf("Error creating Kafka TLS config %v", err))
+		return fmt.Errorf("error creating Kafka TLS config: %w", err)
 	}
 
 	// TLS support
@@ -155,26 +218,26 @@ func (c *clientImpl) initAuth(saramaConfig *sarama.Config) error {
 	saramaConfig.Net.TLS.Config = tlsConfig
 
 	// SASL support
-	saramaConfig.Net.SASL.Enable = c.config.SASL.Enabled
-	saramaConfig.Net.SASL.User = c.config.SASL.User
-	saramaConfig.Net.SASL.Password = c.config.SASL.Password
+	saramaConfig.Net.SASL.Enable = kafkaConfig.SASL.Enabled
+	saramaConfig.Net.SASL.User = kafkaConfig.SASL.User
+	saramaConfig.Net.SASL.Password = kafkaConfig.SASL.Password
 	saramaConfig.Net.SASL.Handshake = true
 
-	if c.config.SASL.Enabled {
-		if c.config.SASL.Algorithm == "sha512" {
+	if kafkaConfig.SASL.Enabled {
+		if kafkaConfig.SASL.Algorithm == "sha512" {
 			saramaConfig.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
 				return &authorization.XDGSCRAMClient{HashGeneratorFcn: authorization.SHA512}
 			}
 			saramaConfig.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
-		} else if c.config.SASL.Algorithm == "sha256" {
+		} else if kafkaConfig.SASL.Algorithm == "sha256" {
 			saramaConfig.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
 				return &authorization.XDGSCRAMClient{HashGeneratorFcn: authorization.SHA256}
 			}
 			saramaConfig.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
-		} else if c.config.SASL.Algorithm == "plain" {
+		} else if kafkaConfig.SASL.Algorithm == "plain" {
 			saramaConfig.Net.SASL.Mechanism = sarama.SASLTypePlaintext
 		} else {
-			return fmt.Errorf("invalid SHA algorithm %s: can be either sha256 or sha512", c.config.SASL.Algorithm)
+			return fmt.Errorf("invalid SHA algorithm %s: can be either sha256 or sha512", kafkaConfig.SASL.Algorithm)
 		}
 	}
 	return nil
@@ -0,0 +1,76 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/messaging"
+)
+
+func TestClientHealthy(t *testing.T) {
+	broker := sarama.NewMockBroker(t, 1)
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetController(broker.BrokerID()).
+			SetLeader("test-topic", 0, broker.BrokerID()),
+	})
+
+	kafkaConfig := &config.KafkaConfig{
+		Clusters: map[string]config.ClusterConfig{"test-cluster": {Brokers: []string{broker.Addr()}}},
+		Topics:   map[string]config.TopicConfig{"test-topic": {Cluster: "test-cluster"}},
+	}
+	client := NewKafkaClient(kafkaConfig, nil, loggerimpl.NewNopLogger(), tally.NoopScope, false).(*clientImpl)
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	client.timeSource = timeSource
+
+	producer, err := client.newProducerByTopic("test-topic")
+	require.NoError(t, err)
+	assert.NoError(t, client.Healthy(context.Background()))
+
+	// the result is cached until the TTL expires
+	broker.Close()
+	assert.NoError(t, client.Healthy(context.Background()))
+	timeSource.Update(timeSource.Now().Add(defaultHealthCheckTTL))
+	assert.ErrorContains(t, client.Healthy(context.Background()), "kafka brokers of topic test-topic are unreachable")
+
+	// errors of ctx are not cached
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	timeSource.Update(timeSource.Now().Add(defaultHealthCheckTTL))
+	assert.ErrorIs(t, client.Healthy(ctx), context.Canceled)
+
+	// closed producers are no longer checked
+	assert.NoError(t, producer.(messaging.CloseableProducer).Close())
+	assert.NoError(t, client.Healthy(context.Background()))
+	assert.Empty(t, client.producers)
+}
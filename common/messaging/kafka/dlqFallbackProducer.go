@@ -0,0 +1,115 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+
+	"github.com/Shopify/sarama"
+	"go.uber.org/multierr"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+)
+
+type (
+	dlqFallbackProducer struct {
+		primary messaging.Producer
+		dlq     messaging.Producer
+		logger  log.Logger
+	}
+)
+
+var _ messaging.CloseableProducer = (*dlqFallbackProducer)(nil)
+
+// NewDLQFallbackProducer creates a producer which publishes the messages which failed to be published by
+// the primary producer to the dlq producer, and returns a *messaging.DeadLetteredError if it succeeded.
+// The primary producer is expected to retry transient failures itself, so messages which failed with a
+// transient broker error, or whose ctx is done, are not dead-lettered and the error is returned instead.
+// If the primary producer is a Kafka producer, messages are serialized by it once and the DLQ receives the
+// same key, value and headers, otherwise the message is passed to the dlq producer as is.
+func NewDLQFallbackProducer(primary messaging.Producer, dlq messaging.Producer, logger log.Logger) messaging.CloseableProducer {
+	return &dlqFallbackProducer{
+		primary: primary,
+		dlq:     dlq,
+		logger:  logger,
+	}
+}
+
+func (p *dlqFallbackProducer) Publish(ctx context.Context, msg interface{}) error {
+	payload, err := p.encode(msg)
+	if err != nil {
+		return err
+	}
+
+	err = p.primary.Publish(ctx, payload)
+	if err == nil || ctx.Err() != nil || isTransientKafkaError(err) {
+		return err
+	}
+
+	if dlqErr := p.dlq.Publish(ctx, payload); dlqErr != nil {
+		p.logger.Error("Failed to publish message to DLQ", tag.Error(dlqErr))
+		return multierr.Append(err, dlqErr)
+	}
+	p.logger.Warn("Failed to publish message, published it to DLQ", tag.Error(err))
+	return &messaging.DeadLetteredError{Err: err}
+}
+
+// encode serializes msg with the primary Kafka producer into a *sarama.ConsumerMessage, which both
+// producers publish with its bytes as is
+func (p *dlqFallbackProducer) encode(msg interface{}) (interface{}, error) {
+	primary, ok := p.primary.(*producerImpl)
+	if !ok {
+		return msg, nil
+	}
+	message, err := primary.encodeMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &sarama.ConsumerMessage{Topic: message.Topic}
+	if message.Key != nil {
+		if payload.Key, err = message.Key.Encode(); err != nil {
+			return nil, err
+		}
+	}
+	if message.Value != nil {
+		if payload.Value, err = message.Value.Encode(); err != nil {
+			return nil, err
+		}
+	}
+	for i := range message.Headers {
+		payload.Headers = append(payload.Headers, &message.Headers[i])
+	}
+	return payload, nil
+}
+
+// Close closes both producers if they are closeable
+func (p *dlqFallbackProducer) Close() error {
+	var err error
+	for _, producer := range []messaging.Producer{p.primary, p.dlq} {
+		if closeableProducer, ok := producer.(messaging.CloseableProducer); ok {
+			err = multierr.Append(err, closeableProducer.Close())
+		}
+	}
+	return err
+}
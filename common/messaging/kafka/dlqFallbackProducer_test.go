@@ -0,0 +1,167 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/messaging"
+)
+
+type fakeProducer struct {
+	sync.Mutex
+	err       error
+	published []interface{}
+}
+
+func (p *fakeProducer) Publish(_ context.Context, msg interface{}) error {
+	p.Lock()
+	defer p.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	p.published = append(p.published, msg)
+	return nil
+}
+
+func (p *fakeProducer) getPublished() []interface{} {
+	p.Lock()
+	defer p.Unlock()
+	return append([]interface{}(nil), p.published...)
+}
+
+func newTestIndexerMessage(workflowID string) *indexer.Message {
+	return &indexer.Message{
+		DomainID:    common.StringPtr("domain-id"),
+		WorkflowID:  common.StringPtr(workflowID),
+		RunID:       common.StringPtr("run-id"),
+		Version:     common.Int64Ptr(1),
+		MessageType: indexer.MessageTypeIndex.Ptr(),
+	}
+}
+
+func TestDLQFallbackProducer_PublishToPrimary(t *testing.T) {
+	primary := &fakeProducer{}
+	dlq := &fakeProducer{}
+	producer := NewDLQFallbackProducer(primary, dlq, testlogger.New(t))
+
+	msg := newTestIndexerMessage("wf-1")
+	require.NoError(t, producer.Publish(context.Background(), msg))
+	assert.Equal(t, []interface{}{msg}, primary.getPublished())
+	assert.Empty(t, dlq.getPublished())
+}
+
+func TestDLQFallbackProducer_PublishToDLQOnFailure(t *testing.T) {
+	publishErr := errors.New("kafka rejected the message")
+	primary := &fakeProducer{err: publishErr}
+	dlq := &fakeProducer{}
+	producer := NewDLQFallbackProducer(primary, dlq, testlogger.New(t))
+
+	msg := &sarama.ConsumerMessage{Key: []byte("key"), Value: []byte("value")}
+	err := producer.Publish(context.Background(), msg)
+	var deadLetteredErr *messaging.DeadLetteredError
+	require.True(t, errors.As(err, &deadLetteredErr))
+	assert.True(t, errors.Is(err, publishErr))
+	assert.Equal(t, []interface{}{msg}, dlq.getPublished())
+}
+
+func TestDLQFallbackProducer_TransientFailure(t *testing.T) {
+	dlq := &fakeProducer{}
+	producer := NewDLQFallbackProducer(&fakeProducer{err: sarama.ErrNotEnoughReplicas}, dlq, testlogger.New(t))
+
+	assert.Equal(t, sarama.ErrNotEnoughReplicas, producer.Publish(context.Background(), newTestIndexerMessage("wf-1")))
+	assert.Empty(t, dlq.getPublished())
+}
+
+func TestDLQFallbackProducer_DLQFailure(t *testing.T) {
+	publishErr := errors.New("kafka rejected the message")
+	dlqErr := errors.New("dlq is down")
+	producer := NewDLQFallbackProducer(&fakeProducer{err: publishErr}, &fakeProducer{err: dlqErr}, testlogger.New(t))
+
+	err := producer.Publish(context.Background(), newTestIndexerMessage("wf-1"))
+	var deadLetteredErr *messaging.DeadLetteredError
+	assert.False(t, errors.As(err, &deadLetteredErr))
+	assert.True(t, errors.Is(err, publishErr))
+	assert.True(t, errors.Is(err, dlqErr))
+}
+
+func TestDLQFallbackProducer_ContextDone(t *testing.T) {
+	dlq := &fakeProducer{}
+	producer := NewDLQFallbackProducer(&fakeProducer{err: context.Canceled}, dlq, testlogger.New(t))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, producer.Publish(ctx, newTestIndexerMessage("wf-1")))
+	assert.Empty(t, dlq.getPublished())
+}
+
+func TestDLQFallbackProducer_PublishesPrimaryPayloadToDLQ(t *testing.T) {
+	syncProducer := mocks.NewSyncProducer(t, nil)
+	defer syncProducer.Close()
+	var sent *sarama.ProducerMessage
+	syncProducer.ExpectSendMessageAndFail(sarama.ErrInvalidMessage)
+	primary := NewKafkaProducer("test-topic", &recordingSyncProducer{SyncProducer: syncProducer, sent: &sent}, loggerimpl.NewNopLogger())
+	dlq := &fakeProducer{}
+	producer := NewDLQFallbackProducer(primary, dlq, testlogger.New(t))
+
+	err := producer.Publish(context.Background(), newTestIndexerMessage("wf-1"))
+	var deadLetteredErr *messaging.DeadLetteredError
+	require.True(t, errors.As(err, &deadLetteredErr))
+	assert.Equal(t, sarama.ErrInvalidMessage, deadLetteredErr.Err)
+
+	require.Len(t, dlq.getPublished(), 1)
+	payload, ok := dlq.getPublished()[0].(*sarama.ConsumerMessage)
+	require.True(t, ok)
+	require.NotNil(t, sent)
+	key, err := sent.Key.Encode()
+	require.NoError(t, err)
+	value, err := sent.Value.Encode()
+	require.NoError(t, err)
+	assert.Equal(t, key, payload.Key)
+	assert.Equal(t, value, payload.Value)
+	require.Len(t, payload.Headers, len(sent.Headers))
+	for i := range sent.Headers {
+		assert.Equal(t, sent.Headers[i], *payload.Headers[i])
+	}
+}
+
+// recordingSyncProducer records the last message sent by the wrapped producer
+type recordingSyncProducer struct {
+	sarama.SyncProducer
+	sent **sarama.ProducerMessage
+}
+
+func (p *recordingSyncProducer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	*p.sent = msg
+	return p.SyncProducer.SendMessage(msg)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Shopify/sarama"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/messaging"
+)
+
+// indexerMessageEncoder is the codec of thrift indexer messages, shared by the producers and DecodeIndexerMessage
+var indexerMessageEncoder codec.BinaryEncoder = codec.NewThriftRWEncoder()
+
+// DecodeIndexerMessage decodes a consumed message published by the producer for an *indexer.Message.
+// Messages whose encoding type header is not thriftrw are rejected, messages without the header
+// were published by older producers and are decoded as thriftrw.
+func DecodeIndexerMessage(msg *sarama.ConsumerMessage) (*indexer.Message, error) {
+	if msg == nil {
+		return nil, errors.New("indexer message is nil")
+	}
+	for _, header := range msg.Headers {
+		if header == nil || string(header.Key) != messaging.HeaderEncodingType {
+			continue
+		}
+		if encodingType := common.EncodingType(header.Value); encodingType != common.EncodingTypeThriftRW {
+			return nil, fmt.Errorf("indexer message of topic %v at offset %v has unexpected encoding type %q", msg.Topic, msg.Offset, encodingType)
+		}
+	}
+
+	var indexMsg indexer.Message
+	if err := indexerMessageEncoder.Decode(msg.Value, &indexMsg); err != nil {
+		return nil, fmt.Errorf("failed to decode indexer message of topic %v at offset %v: %w", msg.Topic, msg.Offset, err)
+	}
+	return &indexMsg, nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/messaging"
+)
+
+func TestDecodeIndexerMessage(t *testing.T) {
+	producer := NewKafkaProducer("test-topic", nil, loggerimpl.NewNopLogger()).(*producerImpl)
+	message := &indexer.Message{
+		DomainID:    common.StringPtr("domain-id"),
+		WorkflowID:  common.StringPtr("workflow-id"),
+		RunID:       common.StringPtr("run-id"),
+		Version:     common.Int64Ptr(1),
+		MessageType: indexer.MessageTypeIndex.Ptr(),
+	}
+
+	producerMsg, err := producer.getProducerMessage(message)
+	require.NoError(t, err)
+	value, err := producerMsg.Value.Encode()
+	require.NoError(t, err)
+	consumerMsg := &sarama.ConsumerMessage{Topic: "test-topic", Value: value}
+	for i := range producerMsg.Headers {
+		consumerMsg.Headers = append(consumerMsg.Headers, &producerMsg.Headers[i])
+	}
+
+	decoded, err := DecodeIndexerMessage(consumerMsg)
+	require.NoError(t, err)
+	assert.Equal(t, message, decoded)
+
+	// messages of older producers have no headers
+	decoded, err = DecodeIndexerMessage(&sarama.ConsumerMessage{Value: value})
+	require.NoError(t, err)
+	assert.Equal(t, message, decoded)
+
+	_, err = DecodeIndexerMessage(&sarama.ConsumerMessage{
+		Value: value,
+		Headers: []*sarama.RecordHeader{
+			{Key: []byte(messaging.HeaderEncodingType), Value: []byte(common.EncodingTypeProto)},
+		},
+	})
+	assert.ErrorContains(t, err, "unexpected encoding type")
+
+	_, err = DecodeIndexerMessage(&sarama.ConsumerMessage{Value: []byte("corrupted")})
+	assert.Error(t, err)
+
+	_, err = DecodeIndexerMessage(nil)
+	assert.Error(t, err)
+}
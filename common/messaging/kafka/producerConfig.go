@@ -0,0 +1,123 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"fmt"
+
+	"github.com/Shopify/sarama"
+
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/messaging"
+)
+
+// NewKafkaProducerFromConfig creates the Kafka producer of the topic from the Kafka config. The sarama config
+// is built with the TLS, SASL, version and producer settings of the config, and the topic settings of the config
+// are applied to the producer before opts. The producer supports health checks and closes its sarama client.
+func NewKafkaProducerFromConfig(
+	topic string,
+	kafkaConfig *config.KafkaConfig,
+	logger log.Logger,
+	opts ...ProducerOption,
+) (messaging.Producer, error) {
+	saramaConfig, err := newProducerSaramaConfig(kafkaConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	brokers := kafkaConfig.GetBrokersForKafkaCluster(kafkaConfig.GetKafkaClusterForTopic(topic))
+	if len(brokers) == 0 {
+		return nil, fmt.Errorf("no Kafka brokers configured for topic %v", topic)
+	}
+	client, err := sarama.NewClient(brokers, saramaConfig)
+	if err != nil {
+		return nil, err
+	}
+	producer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	topicOpts := []ProducerOption{WithHealthCheckClient(client), WithKafkaVersion(saramaConfig.Version)}
+	if domainPartitions := kafkaConfig.GetDomainPartitionsForTopic(topic); domainPartitions > 0 {
+		topicOpts = append(topicOpts, WithDomainPartitionAffinity(domainPartitions))
+	}
+	if kafkaConfig.GetPartitionKeyForTopic(topic) == config.KafkaPartitionKeyDomainID {
+		topicOpts = append(topicOpts, WithPartitionKeyExtractor(DomainIDPartitionKey))
+	}
+	if maxMessageSize := kafkaConfig.GetMaxMessageSizeForTopic(topic); maxMessageSize > 0 {
+		topicOpts = append(topicOpts, WithMaxMessageSize(maxMessageSize))
+	}
+	return NewKafkaProducer(topic, producer, logger, append(topicOpts, opts...)...), nil
+}
+
+// newProducerSaramaConfig returns the sarama config of sync producers built from the Kafka config
+func newProducerSaramaConfig(kafkaConfig *config.KafkaConfig) (*sarama.Config, error) {
+	saramaConfig := sarama.NewConfig()
+	saramaConfig.Producer.Return.Successes = true
+
+	if kafkaConfig.Version != "" {
+		version, err := sarama.ParseKafkaVersion(kafkaConfig.Version)
+		if err != nil {
+			return nil, err
+		}
+		saramaConfig.Version = version
+	}
+
+	requiredAcks, err := toRequiredAcks(kafkaConfig.Producer)
+	if err != nil {
+		return nil, err
+	}
+	saramaConfig.Producer.RequiredAcks = requiredAcks
+	if kafkaConfig.Producer.Idempotent {
+		saramaConfig.Producer.Idempotent = true
+		// sarama can only guarantee the order of messages of an idempotent producer with a single in-flight request
+		saramaConfig.Net.MaxOpenRequests = 1
+	}
+
+	if err := initAuth(saramaConfig, kafkaConfig); err != nil {
+		return nil, err
+	}
+	if err := saramaConfig.Validate(); err != nil {
+		return nil, err
+	}
+	return saramaConfig, nil
+}
+
+func toRequiredAcks(producerConfig config.ProducerConfig) (sarama.RequiredAcks, error) {
+	switch producerConfig.RequiredAcks {
+	case "":
+		if producerConfig.Idempotent {
+			return sarama.WaitForAll, nil
+		}
+		return sarama.WaitForLocal, nil
+	case config.KafkaRequiredAcksNone:
+		return sarama.NoResponse, nil
+	case config.KafkaRequiredAcksLocal:
+		return sarama.WaitForLocal, nil
+	case config.KafkaRequiredAcksAll:
+		return sarama.WaitForAll, nil
+	default:
+		return 0, fmt.Errorf("invalid Kafka producer required acks %q", producerConfig.RequiredAcks)
+	}
+}
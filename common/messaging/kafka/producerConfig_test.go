@@ -0,0 +1,154 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/messaging"
+)
+
+func TestNewProducerSaramaConfig(t *testing.T) {
+	tests := map[string]struct {
+		kafkaConfig config.KafkaConfig
+		validate    func(t *testing.T, saramaConfig *sarama.Config)
+		expectedErr string
+	}{
+		"default": {
+			validate: func(t *testing.T, saramaConfig *sarama.Config) {
+				assert.True(t, saramaConfig.Producer.Return.Successes)
+				assert.Equal(t, sarama.WaitForLocal, saramaConfig.Producer.RequiredAcks)
+				assert.False(t, saramaConfig.Producer.Idempotent)
+				assert.Equal(t, sarama.DefaultVersion, saramaConfig.Version)
+				assert.False(t, saramaConfig.Net.TLS.Enable)
+				assert.False(t, saramaConfig.Net.SASL.Enable)
+			},
+		},
+		"required acks": {
+			kafkaConfig: config.KafkaConfig{Producer: config.ProducerConfig{RequiredAcks: config.KafkaRequiredAcksNone}},
+			validate: func(t *testing.T, saramaConfig *sarama.Config) {
+				assert.Equal(t, sarama.NoResponse, saramaConfig.Producer.RequiredAcks)
+			},
+		},
+		"idempotent": {
+			kafkaConfig: config.KafkaConfig{Version: "2.0.0", Producer: config.ProducerConfig{Idempotent: true}},
+			validate: func(t *testing.T, saramaConfig *sarama.Config) {
+				assert.True(t, saramaConfig.Producer.Idempotent)
+				assert.Equal(t, sarama.WaitForAll, saramaConfig.Producer.RequiredAcks)
+				assert.Equal(t, 1, saramaConfig.Net.MaxOpenRequests)
+				assert.Equal(t, sarama.V2_0_0_0, saramaConfig.Version)
+			},
+		},
+		"idempotent with old version": {
+			kafkaConfig: config.KafkaConfig{Version: "0.10.2.0", Producer: config.ProducerConfig{Idempotent: true}},
+			expectedErr: "Idempotent producer requires Version >= V0_11_0_0",
+		},
+		"idempotent without all acks": {
+			kafkaConfig: config.KafkaConfig{Producer: config.ProducerConfig{Idempotent: true, RequiredAcks: config.KafkaRequiredAcksLocal}},
+			expectedErr: "Idempotent producer requires Producer.RequiredAcks to be WaitForAll",
+		},
+		"invalid required acks": {
+			kafkaConfig: config.KafkaConfig{Producer: config.ProducerConfig{RequiredAcks: "some"}},
+			expectedErr: `invalid Kafka producer required acks "some"`,
+		},
+		"invalid version": {
+			kafkaConfig: config.KafkaConfig{Version: "invalid"},
+			expectedErr: "invalid version",
+		},
+		"SASL SCRAM": {
+			kafkaConfig: config.KafkaConfig{SASL: config.SASL{Enabled: true, User: "user", Password: "password", Algorithm: "sha512"}},
+			validate: func(t *testing.T, saramaConfig *sarama.Config) {
+				assert.True(t, saramaConfig.Net.SASL.Enable)
+				assert.Equal(t, "user", saramaConfig.Net.SASL.User)
+				assert.Equal(t, sarama.SASLMechanism(sarama.SASLTypeSCRAMSHA512), saramaConfig.Net.SASL.Mechanism)
+				assert.NotNil(t, saramaConfig.Net.SASL.SCRAMClientGeneratorFunc)
+			},
+		},
+		"SASL PLAIN": {
+			kafkaConfig: config.KafkaConfig{SASL: config.SASL{Enabled: true, User: "user", Password: "password", Algorithm: "plain"}},
+			validate: func(t *testing.T, saramaConfig *sarama.Config) {
+				assert.Equal(t, sarama.SASLMechanism(sarama.SASLTypePlaintext), saramaConfig.Net.SASL.Mechanism)
+			},
+		},
+		"invalid SASL algorithm": {
+			kafkaConfig: config.KafkaConfig{SASL: config.SASL{Enabled: true, Algorithm: "md5"}},
+			expectedErr: "invalid SHA algorithm md5",
+		},
+		"TLS": {
+			kafkaConfig: config.KafkaConfig{TLS: config.TLS{Enabled: true}},
+			validate: func(t *testing.T, saramaConfig *sarama.Config) {
+				assert.True(t, saramaConfig.Net.TLS.Enable)
+				assert.NotNil(t, saramaConfig.Net.TLS.Config)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			saramaConfig, err := newProducerSaramaConfig(&test.kafkaConfig)
+			if test.expectedErr != "" {
+				assert.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			test.validate(t, saramaConfig)
+		})
+	}
+}
+
+func TestNewKafkaProducerFromConfig(t *testing.T) {
+	broker := sarama.NewMockBroker(t, 1)
+	defer broker.Close()
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetController(broker.BrokerID()).
+			SetLeader("test-topic", 0, broker.BrokerID()),
+		"ProduceRequest": sarama.NewMockProduceResponse(t),
+	})
+
+	kafkaConfig := &config.KafkaConfig{
+		Clusters: map[string]config.ClusterConfig{"test-cluster": {Brokers: []string{broker.Addr()}}},
+		Topics:   map[string]config.TopicConfig{"test-topic": {Cluster: "test-cluster", MaxMessageSize: 1}},
+	}
+	producer, err := NewKafkaProducerFromConfig("test-topic", kafkaConfig, loggerimpl.NewNopLogger())
+	require.NoError(t, err)
+	defer producer.(messaging.CloseableProducer).Close()
+
+	assert.NoError(t, producer.(messaging.HealthCheckProducer).Healthy(context.Background()))
+	err = producer.Publish(context.Background(), &indexer.Message{
+		DomainID:   common.StringPtr("domain-id"),
+		WorkflowID: common.StringPtr("workflow-id"),
+	})
+	assert.ErrorIs(t, err, messaging.ErrMessageSizeLimit)
+
+	_, err = NewKafkaProducerFromConfig("unknown-topic", kafkaConfig, loggerimpl.NewNopLogger())
+	assert.ErrorContains(t, err, "no Kafka brokers configured for topic unknown-topic")
+}
@@ -23,14 +23,21 @@ package kafka
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/Shopify/sarama"
+	"github.com/dgryski/go-farm"
 
 	"github.com/uber/cadence/.gen/go/indexer"
+	indexerv1 "github.com/uber/cadence/.gen/proto/indexer/v1"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
 )
 
 type (
@@ -39,29 +46,214 @@ type (
 		producer   sarama.SyncProducer
 		msgEncoder codec.BinaryEncoder
 		logger     log.Logger
+
+		// partitionsPerDomain is the number of partitions indexer messages of a single domain are spread over,
+		// 0 means messages are partitioned by workflowID
+		partitionsPerDomain int
+
+		// payloadTap, if set, is invoked with every message right before it is sent
+		payloadTap PayloadTap
+
+		// partitionKeyExtractor, if set, overrides the default partition key of messages
+		partitionKeyExtractor PartitionKeyExtractor
+
+		// maxMessageSize is the maximum size of the key and value of a message, 0 means no limit
+		maxMessageSize int
+
+		// historySerializer, if set, serializes history events messages with historyEncoding
+		historySerializer persistence.PayloadSerializer
+		historyEncoding   common.EncodingType
+
+		// throttleRetry retries sending messages which failed with a transient error
+		throttleRetry *backoff.ThrottleRetry
+
+		// client, if set, is the client of the producer used for health checks
+		client sarama.Client
+
+		// onClose, if set, is invoked when the producer is closed
+		onClose func()
+
+		// kafkaVersion is the Kafka version of the sarama config of the producer, messages only have
+		// headers for Kafka 0.11 or newer
+		kafkaVersion sarama.KafkaVersion
 	}
+
+	// ProducerOption is used to customize the Kafka producer
+	ProducerOption func(*producerImpl)
+
+	// PayloadTap receives the topic, key and value bytes of a message about to be sent, used for debugging
+	PayloadTap func(topic string, key []byte, value []byte)
+
+	// PartitionKeyExtractor returns the partition key of a message, an empty key means the default key is used
+	PartitionKeyExtractor func(msg interface{}) (string, error)
 )
 
-var _ messaging.Producer = (*producerImpl)(nil)
+var _ messaging.BatchProducer = (*producerImpl)(nil)
+var _ messaging.MetadataProducer = (*producerImpl)(nil)
+var _ messaging.HealthCheckProducer = (*producerImpl)(nil)
+
+// WithDomainPartitionAffinity makes indexer messages of a domain always go to a stable subset of
+// at most partitionsPerDomain partitions, instead of being partitioned by workflowID
+func WithDomainPartitionAffinity(partitionsPerDomain int) ProducerOption {
+	return func(p *producerImpl) {
+		p.partitionsPerDomain = partitionsPerDomain
+	}
+}
+
+// WithPayloadTap makes the producer invoke tap with the serialized key and value of every message right
+// before it is sent. It is meant for capturing messages to a debug sink and should not be used in production.
+func WithPayloadTap(tap PayloadTap) ProducerOption {
+	return func(p *producerImpl) {
+		p.payloadTap = tap
+	}
+}
+
+// WithPartitionKeyExtractor makes the producer key messages by the key returned by extractor instead of
+// the default key of their type, which is the workflowID for indexer messages. It takes precedence over
+// WithDomainPartitionAffinity, and messages for which extractor returns an empty key keep their default key.
+func WithPartitionKeyExtractor(extractor PartitionKeyExtractor) ProducerOption {
+	return func(p *producerImpl) {
+		p.partitionKeyExtractor = extractor
+	}
+}
+
+// WithMaxMessageSize makes the producer reject messages whose serialized key and value are larger than
+// maxMessageSize bytes with messaging.ErrMessageSizeLimit, without sending them to the broker
+func WithMaxMessageSize(maxMessageSize int) ProducerOption {
+	return func(p *producerImpl) {
+		p.maxMessageSize = maxMessageSize
+	}
+}
+
+// WithHistoryEventsSerializer makes the producer accept []*types.HistoryEvent messages, which are serialized
+// with serializer in the encoding type. With WithMaxMessageSize, the size of JSON encoded history events is
+// estimated before they are serialized, so oversized batches are rejected without building their payload.
+func WithHistoryEventsSerializer(serializer persistence.PayloadSerializer, encodingType common.EncodingType) ProducerOption {
+	return func(p *producerImpl) {
+		p.historySerializer = serializer
+		p.historyEncoding = encodingType
+	}
+}
+
+// WithPublishRetryPolicy makes the producer retry sending a message which failed with a transient
+// broker error according to the policy, see common.CreateKafkaPublishRetryPolicy. Messages are not retried by default.
+func WithPublishRetryPolicy(policy backoff.RetryPolicy) ProducerOption {
+	return func(p *producerImpl) {
+		p.throttleRetry = backoff.NewThrottleRetry(
+			backoff.WithRetryPolicy(policy),
+			backoff.WithRetryableError(isTransientKafkaError),
+		)
+	}
+}
+
+// WithHealthCheckClient makes Healthy check the reachability of the brokers with client, which must be
+// the client the sync producer was created from. The client is closed when the producer is closed.
+func WithHealthCheckClient(client sarama.Client) ProducerOption {
+	return func(p *producerImpl) {
+		p.client = client
+	}
+}
+
+// WithKafkaVersion sets the Kafka version of the sarama config the producer was created with, which is
+// sarama.DefaultVersion by default. Messages are only sent with encoding headers for Kafka 0.11 or newer.
+func WithKafkaVersion(version sarama.KafkaVersion) ProducerOption {
+	return func(p *producerImpl) {
+		p.kafkaVersion = version
+	}
+}
+
+// withOnClose makes the producer invoke onClose when it is closed
+func withOnClose(onClose func()) ProducerOption {
+	return func(p *producerImpl) {
+		p.onClose = onClose
+	}
+}
+
+// DomainIDPartitionKey is a PartitionKeyExtractor keying indexer messages by domainID,
+// so that all messages of a domain land on one partition and are consumed in order
+func DomainIDPartitionKey(msg interface{}) (string, error) {
+	switch message := msg.(type) {
+	case *indexer.Message:
+		return message.GetDomainID(), nil
+	case *indexerv1.Message:
+		return message.GetDomainId(), nil
+	default:
+		return "", nil
+	}
+}
 
 // NewKafkaProducer is used to create the Kafka based producer implementation
-func NewKafkaProducer(topic string, producer sarama.SyncProducer, logger log.Logger) messaging.Producer {
-	return &producerImpl{
-		topic:      topic,
-		producer:   producer,
-		msgEncoder: codec.NewThriftRWEncoder(),
-		logger:     logger.WithTags(tag.KafkaTopicName(topic)),
+func NewKafkaProducer(topic string, producer sarama.SyncProducer, logger log.Logger, opts ...ProducerOption) messaging.Producer {
+	p := &producerImpl{
+		topic:        topic,
+		producer:     producer,
+		msgEncoder:   indexerMessageEncoder,
+		logger:       logger.WithTags(tag.KafkaTopicName(topic)),
+		kafkaVersion: sarama.DefaultVersion,
 	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Publish is used to send messages to other clusters through Kafka topic.
+// It is the same as PublishWithMetadata without the returned metadata.
+func (p *producerImpl) Publish(ctx context.Context, msg interface{}) error {
+	_, err := p.PublishWithMetadata(ctx, msg)
+	return err
 }
 
-// Publish is used to send messages to other clusters through Kafka topic
-// TODO implement context when https://github.com/Shopify/sarama/issues/1849 is supported
-func (p *producerImpl) Publish(_ context.Context, msg interface{}) error {
+// PublishWithMetadata is used to send messages to the Kafka topic and returns the partition and offset
+// the message was written to.
+// If ctx is done before the broker acknowledged the message, Publish returns ctx.Err() without waiting
+// for the send to complete, in which case the message may still be delivered.
+// TODO cancel the send itself when https://github.com/Shopify/sarama/issues/1849 is supported
+func (p *producerImpl) PublishWithMetadata(ctx context.Context, msg interface{}) (messaging.PublishMetadata, error) {
 	message, err := p.getProducerMessage(msg)
 	if err != nil {
-		return err
+		return messaging.PublishMetadata{}, err
+	}
+	if err := ctx.Err(); err != nil {
+		return messaging.PublishMetadata{}, err
+	}
+
+	if p.payloadTap != nil {
+		p.tapMessage(message)
+	}
+
+	type sendResult struct {
+		metadata messaging.PublishMetadata
+		err      error
+	}
+	// buffered so that the send goroutine doesn't leak if ctx is done first
+	resultC := make(chan sendResult, 1)
+	go func() {
+		var result sendResult
+		if p.throttleRetry == nil {
+			result.metadata, result.err = p.sendMessage(message)
+		} else {
+			result.err = p.throttleRetry.Do(ctx, func() error {
+				var err error
+				result.metadata, err = p.sendMessage(message)
+				return err
+			})
+		}
+		resultC <- result
+	}()
+
+	select {
+	case result := <-resultC:
+		return result.metadata, result.err
+	case <-ctx.Done():
+		p.logger.Warn("Context done before message was published to kafka",
+			tag.KafkaPartitionKey(message.Key),
+			tag.Error(ctx.Err()))
+		return messaging.PublishMetadata{}, ctx.Err()
 	}
+}
 
+func (p *producerImpl) sendMessage(message *sarama.ProducerMessage) (messaging.PublishMetadata, error) {
 	partition, offset, err := p.producer.SendMessage(message)
 	if err != nil {
 		p.logger.Warn("Failed to publish message to kafka",
@@ -69,15 +261,139 @@ func (p *producerImpl) Publish(_ context.Context, msg interface{}) error {
 			tag.KafkaPartitionKey(message.Key),
 			tag.KafkaOffset(offset),
 			tag.Error(err))
-		return p.convertErr(err)
+		return messaging.PublishMetadata{}, p.convertErr(err)
+	}
+
+	return messaging.PublishMetadata{Partition: partition, Offset: offset}, nil
+}
+
+// PublishBatch is used to send multiple messages to the Kafka topic in a single request.
+// Messages of unsupported types are not sent, and if any message failed to be published,
+// a *messaging.PublishBatchError mapping the indexes of the failed messages to their errors is returned.
+// Like Publish, it returns ctx.Err() if ctx is done before the broker acknowledged the messages.
+func (p *producerImpl) PublishBatch(ctx context.Context, msgs []interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	batchErr := &messaging.PublishBatchError{Errors: make(map[int]error)}
+	messages := make([]*sarama.ProducerMessage, 0, len(msgs))
+	indexes := make(map[*sarama.ProducerMessage]int, len(msgs))
+	for i, msg := range msgs {
+		message, err := p.getProducerMessage(msg)
+		if err != nil {
+			batchErr.Errors[i] = err
+			continue
+		}
+		if p.payloadTap != nil {
+			p.tapMessage(message)
+		}
+		messages = append(messages, message)
+		indexes[message] = i
+	}
+
+	if len(messages) > 0 {
+		// buffered so that the send goroutine doesn't leak if ctx is done first
+		errC := make(chan error, 1)
+		go func() {
+			errC <- p.producer.SendMessages(messages)
+		}()
+
+		select {
+		case err := <-errC:
+			p.mapBatchErrors(err, indexes, batchErr)
+		case <-ctx.Done():
+			p.logger.Warn("Context done before messages were published to kafka",
+				tag.Counter(len(messages)),
+				tag.Error(ctx.Err()))
+			return ctx.Err()
+		}
 	}
 
+	if len(batchErr.Errors) > 0 {
+		p.logger.Warn("Failed to publish messages to kafka", tag.Counter(len(batchErr.Errors)), tag.Error(batchErr))
+		return batchErr
+	}
 	return nil
 }
 
+// mapBatchErrors adds the errors of the messages which failed to be sent to batchErr
+func (p *producerImpl) mapBatchErrors(err error, indexes map[*sarama.ProducerMessage]int, batchErr *messaging.PublishBatchError) {
+	if err == nil {
+		return
+	}
+
+	var producerErrs sarama.ProducerErrors
+	if !errors.As(err, &producerErrs) {
+		// the error is not specific to a message, so none of them is known to be published
+		for _, i := range indexes {
+			batchErr.Errors[i] = p.convertErr(err)
+		}
+		return
+	}
+	for _, producerErr := range producerErrs {
+		if i, ok := indexes[producerErr.Msg]; ok {
+			batchErr.Errors[i] = p.convertErr(producerErr.Err)
+		}
+	}
+}
+
+// Healthy refreshes the metadata of the topic and looks up the cluster controller to verify the brokers are
+// reachable, without publishing a message. It returns messaging.ErrHealthCheckNotSupported if the producer
+// was created without WithHealthCheckClient.
+func (p *producerImpl) Healthy(ctx context.Context) error {
+	if p.client == nil {
+		return messaging.ErrHealthCheckNotSupported
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// buffered so that the check goroutine doesn't leak if ctx is done first
+	errC := make(chan error, 1)
+	go func() {
+		if err := p.client.RefreshMetadata(p.topic); err != nil {
+			errC <- err
+			return
+		}
+		controller, err := p.client.Controller()
+		if err != nil {
+			errC <- err
+			return
+		}
+		if connected, err := controller.Connected(); !connected {
+			if err == nil {
+				err = sarama.ErrNotConnected
+			}
+			errC <- err
+			return
+		}
+		errC <- nil
+	}()
+
+	select {
+	case err := <-errC:
+		if err != nil {
+			return fmt.Errorf("kafka brokers of topic %v are unreachable: %w", p.topic, err)
+		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("kafka health check of topic %v did not complete: %w", p.topic, ctx.Err())
+	}
+}
+
 // Close is used to close Kafka publisher
 func (p *producerImpl) Close() error {
-	return p.convertErr(p.producer.Close())
+	if p.onClose != nil {
+		p.onClose()
+	}
+	err := p.producer.Close()
+	if p.client != nil {
+		if closeErr := p.client.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	return p.convertErr(err)
 }
 
 func (p *producerImpl) serializeThrift(input codec.ThriftObject) ([]byte, error) {
@@ -92,6 +408,89 @@ func (p *producerImpl) serializeThrift(input codec.ThriftObject) ([]byte, error)
 }
 
 func (p *producerImpl) getProducerMessage(message interface{}) (*sarama.ProducerMessage, error) {
+	if err := p.checkEstimatedSize(message); err != nil {
+		return nil, err
+	}
+	msg, err := p.encodeMessage(message)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.maxMessageSize > 0 {
+		if size := messageSize(msg); size > p.maxMessageSize {
+			p.logger.Warn("Message is too large to be published to kafka",
+				tag.WorkflowID(messageWorkflowID(message)),
+				tag.KafkaPartitionKey(msg.Key),
+				tag.KafkaMessageSize(size))
+			return nil, messaging.ErrMessageSizeLimit
+		}
+	}
+	return msg, nil
+}
+
+// checkEstimatedSize rejects JSON encoded history events whose estimated size is above the max message size
+// before they are serialized. Estimating the size of thriftrw payloads serializes them, so they are only
+// checked once they are serialized, like the other messages.
+func (p *producerImpl) checkEstimatedSize(message interface{}) error {
+	events, ok := message.([]*types.HistoryEvent)
+	if !ok || p.maxMessageSize <= 0 || p.historySerializer == nil || p.historyEncoding != common.EncodingTypeJSON {
+		return nil
+	}
+	size, err := p.historySerializer.EstimateSerializedSize(events, p.historyEncoding)
+	if err != nil {
+		return err
+	}
+	if size > p.maxMessageSize {
+		p.logger.Warn("History events are too large to be published to kafka", tag.KafkaMessageSize(size))
+		return messaging.ErrMessageSizeLimit
+	}
+	return nil
+}
+
+// encodeMessage serializes message and keys it, without checking its size
+func (p *producerImpl) encodeMessage(message interface{}) (*sarama.ProducerMessage, error) {
+	msg, err := p.newProducerMessage(message)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.partitionKeyExtractor != nil {
+		key, err := p.partitionKeyExtractor(message)
+		if err != nil {
+			return nil, err
+		}
+		if key != "" {
+			msg.Key = sarama.StringEncoder(key)
+		}
+	}
+	return msg, nil
+}
+
+func messageSize(msg *sarama.ProducerMessage) int {
+	size := 0
+	if msg.Key != nil {
+		size += msg.Key.Length()
+	}
+	if msg.Value != nil {
+		size += msg.Value.Length()
+	}
+	return size
+}
+
+func messageWorkflowID(message interface{}) string {
+	switch message := message.(type) {
+	case *indexer.Message:
+		return message.GetWorkflowID()
+	case *indexerv1.Message:
+		return message.GetWorkflowExecution().GetWorkflowId()
+	case *indexer.PinotMessage:
+		return message.GetWorkflowID()
+	default:
+		return ""
+	}
+}
+
+func (p *producerImpl) newProducerMessage(message interface{}) (*sarama.ProducerMessage, error) {
 	switch message := message.(type) {
 	case *indexer.Message:
 		payload, err := p.serializeThrift(message)
@@ -99,9 +498,23 @@ func (p *producerImpl) getProducerMessage(message interface{}) (*sarama.Producer
 			return nil, err
 		}
 		msg := &sarama.ProducerMessage{
-			Topic: p.topic,
-			Key:   sarama.StringEncoder(message.GetWorkflowID()),
-			Value: sarama.ByteEncoder(payload),
+			Topic:   p.topic,
+			Key:     p.indexerMessageKey(message.GetDomainID(), message.GetWorkflowID()),
+			Value:   sarama.ByteEncoder(payload),
+			Headers: p.encodingHeaders(common.EncodingTypeThriftRW, messaging.IndexerSchemaVersion),
+		}
+		return msg, nil
+	case *indexerv1.Message:
+		payload, err := message.Marshal()
+		if err != nil {
+			p.logger.Error("Failed to serialize proto message", tag.Error(err))
+			return nil, err
+		}
+		msg := &sarama.ProducerMessage{
+			Topic:   p.topic,
+			Key:     p.indexerMessageKey(message.GetDomainId(), message.GetWorkflowExecution().GetWorkflowId()),
+			Value:   sarama.ByteEncoder(payload),
+			Headers: p.encodingHeaders(common.EncodingTypeProto, messaging.IndexerSchemaVersion),
 		}
 		return msg, nil
 	case *sarama.ConsumerMessage:
@@ -110,12 +523,36 @@ func (p *producerImpl) getProducerMessage(message interface{}) (*sarama.Producer
 			Key:   sarama.ByteEncoder(message.Key),
 			Value: sarama.ByteEncoder(message.Value),
 		}
+		// keep the headers of a dead-lettered message so that its encoding is still known
+		if p.supportsHeaders() {
+			for _, header := range message.Headers {
+				if header != nil {
+					msg.Headers = append(msg.Headers, *header)
+				}
+			}
+		}
 		return msg, nil
 	case *indexer.PinotMessage:
 		msg := &sarama.ProducerMessage{
-			Topic: p.topic,
-			Key:   sarama.StringEncoder(message.GetWorkflowID()),
-			Value: sarama.ByteEncoder(message.GetPayload()),
+			Topic:   p.topic,
+			Key:     sarama.StringEncoder(message.GetWorkflowID()),
+			Value:   sarama.ByteEncoder(message.GetPayload()),
+			Headers: p.encodingHeaders(common.EncodingTypeJSON, messaging.IndexerSchemaVersion),
+		}
+		return msg, nil
+	case []*types.HistoryEvent:
+		if p.historySerializer == nil {
+			return nil, errors.New("unknown producer message type")
+		}
+		blob, err := p.historySerializer.SerializeBatchEvents(message, p.historyEncoding)
+		if err != nil {
+			p.logger.Error("Failed to serialize history events", tag.Error(err))
+			return nil, err
+		}
+		msg := &sarama.ProducerMessage{
+			Topic:   p.topic,
+			Value:   sarama.ByteEncoder(blob.GetData()),
+			Headers: p.encodingHeaders(blob.GetEncoding(), messaging.HistoryEventsSchemaVersion),
 		}
 		return msg, nil
 	default:
@@ -123,6 +560,72 @@ func (p *producerImpl) getProducerMessage(message interface{}) (*sarama.Producer
 	}
 }
 
+// encodingHeaders returns the headers recording the encoding and schema version of a message value, or nil if
+// the Kafka version of the producer is older than 0.11 which doesn't support headers. Consumers which ignore
+// the headers are not affected.
+func (p *producerImpl) encodingHeaders(encodingType common.EncodingType, schemaVersion string) []sarama.RecordHeader {
+	if !p.supportsHeaders() {
+		return nil
+	}
+	return []sarama.RecordHeader{
+		{Key: []byte(messaging.HeaderEncodingType), Value: []byte(encodingType)},
+		{Key: []byte(messaging.HeaderSchemaVersion), Value: []byte(schemaVersion)},
+	}
+}
+
+// supportsHeaders returns whether the Kafka version of the producer supports record headers
+func (p *producerImpl) supportsHeaders() bool {
+	return p.kafkaVersion.IsAtLeast(sarama.V0_11_0_0)
+}
+
+// indexerMessageKey returns the partition key of an indexer message. With domain partition affinity enabled,
+// the key only depends on the domainID and a bucket of the workflowID, so the default hash partitioner
+// deterministically maps all messages of a domain to at most partitionsPerDomain partitions.
+func (p *producerImpl) indexerMessageKey(domainID, workflowID string) sarama.Encoder {
+	if p.partitionsPerDomain <= 0 {
+		return sarama.StringEncoder(workflowID)
+	}
+	bucket := farm.Fingerprint32([]byte(workflowID)) % uint32(p.partitionsPerDomain)
+	return sarama.StringEncoder(fmt.Sprintf("%v-%v", domainID, bucket))
+}
+
+func (p *producerImpl) tapMessage(message *sarama.ProducerMessage) {
+	var key, value []byte
+	var err error
+	if message.Key != nil {
+		if key, err = message.Key.Encode(); err != nil {
+			p.logger.Warn("Failed to encode message key for payload tap", tag.Error(err))
+			return
+		}
+	}
+	if message.Value != nil {
+		if value, err = message.Value.Encode(); err != nil {
+			p.logger.Warn("Failed to encode message value for payload tap", tag.Error(err))
+			return
+		}
+	}
+	p.payloadTap(message.Topic, key, value)
+}
+
+// isTransientKafkaError returns true if sending a message can succeed once retried after the error
+func isTransientKafkaError(err error) bool {
+	switch err {
+	case sarama.ErrOutOfBrokers,
+		sarama.ErrNotConnected,
+		sarama.ErrLeaderNotAvailable,
+		sarama.ErrNotLeaderForPartition,
+		sarama.ErrRequestTimedOut,
+		sarama.ErrBrokerNotAvailable,
+		sarama.ErrNetworkException,
+		sarama.ErrNotEnoughReplicas,
+		sarama.ErrNotEnoughReplicasAfterAppend,
+		sarama.ErrKafkaStorageError:
+		return true
+	default:
+		return false
+	}
+}
+
 func (p *producerImpl) convertErr(err error) error {
 	switch err {
 	case sarama.ErrMessageSizeTooLarge:
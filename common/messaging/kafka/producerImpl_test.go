@@ -0,0 +1,523 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/Shopify/sarama/mocks"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	indexerv1 "github.com/uber/cadence/.gen/proto/indexer/v1"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+const testNumPartitions = 64
+
+func TestProducerDomainPartitionAffinity(t *testing.T) {
+	producer := NewKafkaProducer("test-topic", nil, loggerimpl.NewNopLogger(), WithDomainPartitionAffinity(1)).(*producerImpl)
+
+	partition1 := partitionIndexerMessage(t, producer, "domain-id", "workflow-id-1")
+	partition2 := partitionIndexerMessage(t, producer, "domain-id", "workflow-id-2")
+	assert.Equal(t, partition1, partition2)
+}
+
+func TestProducerDomainPartitionAffinity_PartitionRange(t *testing.T) {
+	partitionsPerDomain := 4
+	producer := NewKafkaProducer("test-topic", nil, loggerimpl.NewNopLogger(), WithDomainPartitionAffinity(partitionsPerDomain)).(*producerImpl)
+
+	partitions := make(map[int32]struct{})
+	for i := 0; i < 100; i++ {
+		partition := partitionIndexerMessage(t, producer, "domain-id", fmt.Sprintf("workflow-id-%v", i))
+		partitions[partition] = struct{}{}
+	}
+	assert.LessOrEqual(t, len(partitions), partitionsPerDomain)
+}
+
+func TestProducerDefaultPartitioning(t *testing.T) {
+	producer := NewKafkaProducer("test-topic", nil, loggerimpl.NewNopLogger()).(*producerImpl)
+
+	msg, err := producer.getProducerMessage(&indexer.Message{
+		DomainID:   common.StringPtr("domain-id"),
+		WorkflowID: common.StringPtr("workflow-id"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, sarama.StringEncoder("workflow-id"), msg.Key)
+}
+
+func TestProducerPartitionKeyExtractor(t *testing.T) {
+	producer := NewKafkaProducer("test-topic", nil, loggerimpl.NewNopLogger(),
+		WithDomainPartitionAffinity(4), WithPartitionKeyExtractor(DomainIDPartitionKey)).(*producerImpl)
+
+	msg, err := producer.getProducerMessage(&indexer.Message{
+		DomainID:   common.StringPtr("domain-id"),
+		WorkflowID: common.StringPtr("workflow-id"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, sarama.StringEncoder("domain-id"), msg.Key)
+
+	// messages the extractor returns an empty key for keep their default key
+	msg, err = producer.getProducerMessage(&sarama.ConsumerMessage{Key: []byte("key"), Value: []byte("value")})
+	require.NoError(t, err)
+	assert.Equal(t, sarama.ByteEncoder("key"), msg.Key)
+
+	extractorErr := errors.New("extractor error")
+	producer = NewKafkaProducer("test-topic", nil, loggerimpl.NewNopLogger(), WithPartitionKeyExtractor(func(interface{}) (string, error) {
+		return "", extractorErr
+	})).(*producerImpl)
+	_, err = producer.getProducerMessage(&indexer.Message{WorkflowID: common.StringPtr("workflow-id")})
+	assert.Equal(t, extractorErr, err)
+}
+
+func TestProducerMaxMessageSize(t *testing.T) {
+	syncProducer := mocks.NewSyncProducer(t, nil)
+	defer syncProducer.Close()
+	producer := NewKafkaProducer("test-topic", syncProducer, loggerimpl.NewNopLogger(), WithMaxMessageSize(10))
+
+	// oversized messages are rejected without being sent
+	err := producer.Publish(context.Background(), &indexer.PinotMessage{
+		WorkflowID: common.StringPtr("workflow-id"),
+		Payload:    []byte("payload"),
+	})
+	assert.Equal(t, messaging.ErrMessageSizeLimit, err)
+
+	syncProducer.ExpectSendMessageAndSucceed()
+	require.NoError(t, producer.Publish(context.Background(), &sarama.ConsumerMessage{Key: []byte("key"), Value: []byte("value")}))
+}
+
+func TestProducerHistoryEvents(t *testing.T) {
+	events := []*types.HistoryEvent{{ID: 1, Version: 2, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()}}
+	serializer := persistence.NewPayloadSerializer()
+	for _, encodingType := range []common.EncodingType{common.EncodingTypeJSON, common.EncodingTypeThriftRW} {
+		t.Run(string(encodingType), func(t *testing.T) {
+			syncProducer := mocks.NewSyncProducer(t, nil)
+			defer syncProducer.Close()
+			producer := NewKafkaProducer("test-topic", syncProducer, loggerimpl.NewNopLogger(), WithHistoryEventsSerializer(serializer, encodingType))
+
+			syncProducer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(value []byte) error {
+				decoded, err := serializer.DeserializeBatchEvents(persistence.NewDataBlob(value, encodingType))
+				if err != nil {
+					return err
+				}
+				if !assert.ObjectsAreEqual(events, decoded) {
+					return fmt.Errorf("decoded events %v don't match %v", decoded, events)
+				}
+				return nil
+			})
+			require.NoError(t, producer.Publish(context.Background(), events))
+
+			size, err := serializer.EstimateSerializedSize(events, encodingType)
+			require.NoError(t, err)
+			producer = NewKafkaProducer("test-topic", syncProducer, loggerimpl.NewNopLogger(),
+				WithHistoryEventsSerializer(serializer, encodingType), WithMaxMessageSize(size-1))
+			assert.Equal(t, messaging.ErrMessageSizeLimit, producer.Publish(context.Background(), events))
+		})
+	}
+
+	// history events are only supported with a serializer
+	producer := NewKafkaProducer("test-topic", nil, loggerimpl.NewNopLogger()).(*producerImpl)
+	_, err := producer.getProducerMessage(events)
+	assert.EqualError(t, err, "unknown producer message type")
+}
+
+func TestProducerProtoIndexerMessage(t *testing.T) {
+	syncProducer := mocks.NewSyncProducer(t, nil)
+	defer syncProducer.Close()
+	producer := NewKafkaProducer("test-topic", syncProducer, loggerimpl.NewNopLogger())
+
+	message := &indexerv1.Message{
+		MessageType: indexerv1.MessageType_MESSAGE_TYPE_INDEX,
+		DomainId:    "domain-id",
+		WorkflowExecution: &apiv1.WorkflowExecution{
+			WorkflowId: "workflow-id",
+			RunId:      "run-id",
+		},
+		Version: 10,
+		Fields: map[string]*indexerv1.Field{
+			"field": {Data: &indexerv1.Field_StringData{StringData: "value"}},
+		},
+	}
+	syncProducer.ExpectSendMessageWithCheckerFunctionAndSucceed(func(value []byte) error {
+		decoded := &indexerv1.Message{}
+		if err := decoded.Unmarshal(value); err != nil {
+			return err
+		}
+		if !proto.Equal(message, decoded) {
+			return fmt.Errorf("decoded message %v doesn't match %v", decoded, message)
+		}
+		return nil
+	})
+	require.NoError(t, producer.Publish(context.Background(), message))
+
+	msg, err := producer.(*producerImpl).getProducerMessage(message)
+	require.NoError(t, err)
+	assert.Equal(t, sarama.StringEncoder("workflow-id"), msg.Key)
+
+	_, err = producer.(*producerImpl).getProducerMessage(&indexerv1.Field{})
+	assert.EqualError(t, err, "unknown producer message type")
+}
+
+func TestProducerMessageEncodingHeaders(t *testing.T) {
+	producer := NewKafkaProducer("test-topic", nil, loggerimpl.NewNopLogger()).(*producerImpl)
+	headers := func(encodingType common.EncodingType) []sarama.RecordHeader {
+		return []sarama.RecordHeader{
+			{Key: []byte(messaging.HeaderEncodingType), Value: []byte(encodingType)},
+			{Key: []byte(messaging.HeaderSchemaVersion), Value: []byte(messaging.IndexerSchemaVersion)},
+		}
+	}
+
+	tests := map[string]struct {
+		message         interface{}
+		expectedHeaders []sarama.RecordHeader
+	}{
+		"thrift indexer message": {
+			message:         &indexer.Message{WorkflowID: common.StringPtr("workflow-id")},
+			expectedHeaders: headers(common.EncodingTypeThriftRW),
+		},
+		"proto indexer message": {
+			message:         &indexerv1.Message{WorkflowExecution: &apiv1.WorkflowExecution{WorkflowId: "workflow-id"}},
+			expectedHeaders: headers(common.EncodingTypeProto),
+		},
+		"pinot message": {
+			message:         &indexer.PinotMessage{WorkflowID: common.StringPtr("workflow-id"), Payload: []byte("{}")},
+			expectedHeaders: headers(common.EncodingTypeJSON),
+		},
+		"dead-lettered message keeps its headers": {
+			message: &sarama.ConsumerMessage{
+				Key:   []byte("key"),
+				Value: []byte("value"),
+				Headers: []*sarama.RecordHeader{
+					{Key: []byte(messaging.HeaderEncodingType), Value: []byte(common.EncodingTypeProto)},
+					{Key: []byte(messaging.HeaderSchemaVersion), Value: []byte(messaging.IndexerSchemaVersion)},
+				},
+			},
+			expectedHeaders: headers(common.EncodingTypeProto),
+		},
+		"dead-lettered message without headers": {
+			message: &sarama.ConsumerMessage{Key: []byte("key"), Value: []byte("value")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			msg, err := producer.getProducerMessage(test.message)
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedHeaders, msg.Headers)
+		})
+	}
+}
+
+func TestProducerMessageEncodingHeadersBeforeKafka011(t *testing.T) {
+	producer := NewKafkaProducer("test-topic", nil, loggerimpl.NewNopLogger(), WithKafkaVersion(sarama.V0_10_2_0)).(*producerImpl)
+
+	messages := map[string]interface{}{
+		"thrift indexer message": &indexer.Message{WorkflowID: common.StringPtr("workflow-id")},
+		"proto indexer message":  &indexerv1.Message{WorkflowExecution: &apiv1.WorkflowExecution{WorkflowId: "workflow-id"}},
+		"pinot message":          &indexer.PinotMessage{WorkflowID: common.StringPtr("workflow-id"), Payload: []byte("{}")},
+		"dead-lettered message": &sarama.ConsumerMessage{
+			Key:     []byte("key"),
+			Value:   []byte("value"),
+			Headers: []*sarama.RecordHeader{{Key: []byte(messaging.HeaderEncodingType), Value: []byte(common.EncodingTypeProto)}},
+		},
+	}
+
+	for name, message := range messages {
+		t.Run(name, func(t *testing.T) {
+			msg, err := producer.getProducerMessage(message)
+			require.NoError(t, err)
+			assert.Empty(t, msg.Headers)
+		})
+	}
+}
+
+func TestProducerPayloadTap(t *testing.T) {
+	type tapped struct {
+		topic string
+		key   []byte
+		value []byte
+	}
+	var got []tapped
+	tap := func(topic string, key []byte, value []byte) {
+		got = append(got, tapped{topic: topic, key: key, value: value})
+	}
+
+	syncProducer := mocks.NewSyncProducer(t, nil)
+	defer syncProducer.Close()
+	producer := NewKafkaProducer("test-topic", syncProducer, loggerimpl.NewNopLogger(), WithPayloadTap(tap))
+
+	indexerMsg := &indexer.Message{
+		DomainID:   common.StringPtr("domain-id"),
+		WorkflowID: common.StringPtr("workflow-id"),
+		RunID:      common.StringPtr("run-id"),
+	}
+	indexerPayload, err := codec.NewThriftRWEncoder().Encode(indexerMsg)
+	require.NoError(t, err)
+
+	messages := []struct {
+		msg   interface{}
+		key   []byte
+		value []byte
+	}{
+		{
+			msg:   indexerMsg,
+			key:   []byte("workflow-id"),
+			value: indexerPayload,
+		},
+		{
+			msg:   &sarama.ConsumerMessage{Key: []byte("consumer-key"), Value: []byte("consumer-value")},
+			key:   []byte("consumer-key"),
+			value: []byte("consumer-value"),
+		},
+		{
+			msg:   &indexer.PinotMessage{WorkflowID: common.StringPtr("pinot-workflow-id"), Payload: []byte("pinot-payload")},
+			key:   []byte("pinot-workflow-id"),
+			value: []byte("pinot-payload"),
+		},
+	}
+	for _, m := range messages {
+		syncProducer.ExpectSendMessageAndSucceed()
+		require.NoError(t, producer.Publish(context.Background(), m.msg))
+	}
+
+	require.Len(t, got, len(messages))
+	for i, m := range messages {
+		assert.Equal(t, "test-topic", got[i].topic)
+		assert.Equal(t, m.key, got[i].key)
+		assert.Equal(t, m.value, got[i].value)
+	}
+}
+
+type blockingSyncProducer struct {
+	sarama.SyncProducer
+	unblockC chan struct{}
+}
+
+func (p *blockingSyncProducer) SendMessage(*sarama.ProducerMessage) (int32, int64, error) {
+	<-p.unblockC
+	return 0, 0, nil
+}
+
+func TestProducerPublishContextDone(t *testing.T) {
+	syncProducer := &blockingSyncProducer{unblockC: make(chan struct{})}
+	defer close(syncProducer.unblockC)
+	producer := NewKafkaProducer("test-topic", syncProducer, loggerimpl.NewNopLogger())
+	msg := &sarama.ConsumerMessage{Key: []byte("key"), Value: []byte("value")}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, producer.Publish(ctx, msg))
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, producer.Publish(ctx, msg))
+}
+
+func TestProducerPublishConvertsError(t *testing.T) {
+	syncProducer := mocks.NewSyncProducer(t, nil)
+	defer syncProducer.Close()
+	producer := NewKafkaProducer("test-topic", syncProducer, loggerimpl.NewNopLogger())
+
+	syncProducer.ExpectSendMessageAndFail(sarama.ErrMessageSizeTooLarge)
+	err := producer.Publish(context.Background(), &sarama.ConsumerMessage{Key: []byte("key"), Value: []byte("value")})
+	assert.Equal(t, messaging.ErrMessageSizeLimit, err)
+}
+
+type partialFailureSyncProducer struct {
+	sarama.SyncProducer
+	sent []*sarama.ProducerMessage
+	// failed maps the keys of the messages which fail to be sent to their error
+	failed map[string]error
+}
+
+func (p *partialFailureSyncProducer) SendMessages(msgs []*sarama.ProducerMessage) error {
+	p.sent = append(p.sent, msgs...)
+	var errs sarama.ProducerErrors
+	for _, msg := range msgs {
+		key, _ := msg.Key.Encode()
+		if err, ok := p.failed[string(key)]; ok {
+			errs = append(errs, &sarama.ProducerError{Msg: msg, Err: err})
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func TestProducerPublishBatch(t *testing.T) {
+	syncProducer := &partialFailureSyncProducer{failed: map[string]error{
+		"key-2": sarama.ErrMessageSizeTooLarge,
+		"key-4": sarama.ErrNotLeaderForPartition,
+	}}
+	producer := NewKafkaProducer("test-topic", syncProducer, loggerimpl.NewNopLogger()).(messaging.BatchProducer)
+
+	var msgs []interface{}
+	for i := 0; i < 5; i++ {
+		msgs = append(msgs, &sarama.ConsumerMessage{Key: []byte(fmt.Sprintf("key-%v", i)), Value: []byte("value")})
+	}
+	msgs = append(msgs, "unsupported message")
+
+	err := producer.PublishBatch(context.Background(), msgs)
+	var batchErr *messaging.PublishBatchError
+	require.ErrorAs(t, err, &batchErr)
+	assert.Equal(t, []int{2, 4, 5}, batchErr.FailedIndexes())
+	assert.Equal(t, messaging.ErrMessageSizeLimit, batchErr.Errors[2])
+	assert.Equal(t, sarama.ErrNotLeaderForPartition, batchErr.Errors[4])
+	assert.Len(t, syncProducer.sent, 5)
+
+	syncProducer.failed = nil
+	assert.NoError(t, producer.PublishBatch(context.Background(), msgs[:5]))
+}
+
+func TestProducerPublishBatchFailure(t *testing.T) {
+	syncProducer := mocks.NewSyncProducer(t, nil)
+	defer syncProducer.Close()
+	producer := NewKafkaProducer("test-topic", syncProducer, loggerimpl.NewNopLogger()).(messaging.BatchProducer)
+
+	syncProducer.ExpectSendMessageAndFail(sarama.ErrOutOfBrokers)
+	syncProducer.ExpectSendMessageAndSucceed()
+	err := producer.PublishBatch(context.Background(), []interface{}{
+		&sarama.ConsumerMessage{Key: []byte("key-0"), Value: []byte("value")},
+		&sarama.ConsumerMessage{Key: []byte("key-1"), Value: []byte("value")},
+	})
+	var batchErr *messaging.PublishBatchError
+	require.ErrorAs(t, err, &batchErr)
+	assert.Equal(t, map[int]error{0: sarama.ErrOutOfBrokers, 1: sarama.ErrOutOfBrokers}, batchErr.Errors)
+}
+
+func partitionIndexerMessage(t *testing.T, producer *producerImpl, domainID, workflowID string) int32 {
+	msg, err := producer.getProducerMessage(&indexer.Message{
+		DomainID:   common.StringPtr(domainID),
+		WorkflowID: common.StringPtr(workflowID),
+	})
+	require.NoError(t, err)
+
+	partition, err := sarama.NewHashPartitioner(producer.topic).Partition(msg, testNumPartitions)
+	require.NoError(t, err)
+	return partition
+}
+
+func TestProducerPublishRetriesTransientError(t *testing.T) {
+	syncProducer := mocks.NewSyncProducer(t, nil)
+	defer syncProducer.Close()
+	policy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	policy.SetMaximumAttempts(2)
+	producer := NewKafkaProducer("test-topic", syncProducer, loggerimpl.NewNopLogger(), WithPublishRetryPolicy(policy))
+	msg := &sarama.ConsumerMessage{Key: []byte("key"), Value: []byte("value")}
+
+	syncProducer.ExpectSendMessageAndFail(sarama.ErrLeaderNotAvailable)
+	syncProducer.ExpectSendMessageAndFail(sarama.ErrRequestTimedOut)
+	syncProducer.ExpectSendMessageAndSucceed()
+	assert.NoError(t, producer.Publish(context.Background(), msg))
+
+	syncProducer.ExpectSendMessageAndFail(sarama.ErrOutOfBrokers)
+	syncProducer.ExpectSendMessageAndFail(sarama.ErrOutOfBrokers)
+	syncProducer.ExpectSendMessageAndFail(sarama.ErrOutOfBrokers)
+	assert.Equal(t, sarama.ErrOutOfBrokers, producer.Publish(context.Background(), msg))
+}
+
+func TestProducerPublishDoesNotRetryNonTransientError(t *testing.T) {
+	syncProducer := mocks.NewSyncProducer(t, nil)
+	defer syncProducer.Close()
+	policy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	policy.SetMaximumAttempts(2)
+	producer := NewKafkaProducer("test-topic", syncProducer, loggerimpl.NewNopLogger(), WithPublishRetryPolicy(policy))
+
+	// the mock fails the test if a second send is attempted without an expectation
+	syncProducer.ExpectSendMessageAndFail(sarama.ErrMessageSizeTooLarge)
+	err := producer.Publish(context.Background(), &sarama.ConsumerMessage{Key: []byte("key"), Value: []byte("value")})
+	assert.Equal(t, messaging.ErrMessageSizeLimit, err)
+}
+
+func TestProducerPublishWithMetadata(t *testing.T) {
+	syncProducer := &metadataSyncProducer{partition: 3, offset: 42}
+	producer := NewKafkaProducer("test-topic", syncProducer, loggerimpl.NewNopLogger()).(messaging.MetadataProducer)
+
+	metadata, err := producer.PublishWithMetadata(context.Background(), &sarama.ConsumerMessage{Key: []byte("key"), Value: []byte("value")})
+	require.NoError(t, err)
+	assert.Equal(t, messaging.PublishMetadata{Partition: 3, Offset: 42}, metadata)
+
+	syncProducer.err = sarama.ErrMessageSizeTooLarge
+	metadata, err = producer.PublishWithMetadata(context.Background(), &sarama.ConsumerMessage{Key: []byte("key"), Value: []byte("value")})
+	assert.Equal(t, messaging.ErrMessageSizeLimit, err)
+	assert.Equal(t, messaging.PublishMetadata{}, metadata)
+}
+
+type metadataSyncProducer struct {
+	sarama.SyncProducer
+	partition int32
+	offset    int64
+	err       error
+}
+
+func (p *metadataSyncProducer) SendMessage(*sarama.ProducerMessage) (int32, int64, error) {
+	if p.err != nil {
+		return -1, -1, p.err
+	}
+	return p.partition, p.offset, nil
+}
+
+func TestProducerHealthy(t *testing.T) {
+	producer := NewKafkaProducer("test-topic", mocks.NewSyncProducer(t, nil), loggerimpl.NewNopLogger())
+	assert.ErrorIs(t, producer.(messaging.HealthCheckProducer).Healthy(context.Background()), messaging.ErrHealthCheckNotSupported)
+
+	broker := sarama.NewMockBroker(t, 1)
+	broker.SetHandlerByMap(map[string]sarama.MockResponse{
+		"MetadataRequest": sarama.NewMockMetadataResponse(t).
+			SetBroker(broker.Addr(), broker.BrokerID()).
+			SetController(broker.BrokerID()).
+			SetLeader("test-topic", 0, broker.BrokerID()),
+	})
+
+	config := sarama.NewConfig()
+	config.Metadata.Retry.Max = 0
+	client, err := sarama.NewClient([]string{broker.Addr()}, config)
+	require.NoError(t, err)
+	producer = NewKafkaProducer("test-topic", mocks.NewSyncProducer(t, nil), loggerimpl.NewNopLogger(), WithHealthCheckClient(client))
+	healthCheckProducer := producer.(messaging.HealthCheckProducer)
+	assert.NoError(t, healthCheckProducer.Healthy(context.Background()))
+
+	broker.Close()
+	err = healthCheckProducer.Healthy(context.Background())
+	assert.ErrorContains(t, err, "kafka brokers of topic test-topic are unreachable")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, healthCheckProducer.Healthy(ctx), context.Canceled)
+
+	assert.NoError(t, producer.(messaging.CloseableProducer).Close())
+	assert.Error(t, healthCheckProducer.Healthy(context.Background()))
+}
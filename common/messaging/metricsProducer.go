@@ -33,6 +33,9 @@ type (
 	}
 )
 
+var _ MetadataProducer = (*metricsProducer)(nil)
+var _ HealthCheckProducer = (*metricsProducer)(nil)
+
 // NewMetricProducer creates a new instance of producer that emits metrics
 func NewMetricProducer(
 	producer Producer,
@@ -45,10 +48,41 @@ func NewMetricProducer(
 }
 
 func (p *metricsProducer) Publish(ctx context.Context, msg interface{}) error {
+	return p.emitMetrics(func() error {
+		return p.producer.Publish(ctx, msg)
+	})
+}
+
+// PublishWithMetadata returns ErrPublishMetadataNotSupported if the wrapped producer is not a MetadataProducer
+func (p *metricsProducer) PublishWithMetadata(ctx context.Context, msg interface{}) (PublishMetadata, error) {
+	metadataProducer, ok := p.producer.(MetadataProducer)
+	if !ok {
+		return PublishMetadata{}, ErrPublishMetadataNotSupported
+	}
+
+	var metadata PublishMetadata
+	err := p.emitMetrics(func() error {
+		var err error
+		metadata, err = metadataProducer.PublishWithMetadata(ctx, msg)
+		return err
+	})
+	return metadata, err
+}
+
+// Healthy returns ErrHealthCheckNotSupported if the wrapped producer is not a HealthCheckProducer
+func (p *metricsProducer) Healthy(ctx context.Context) error {
+	healthCheckProducer, ok := p.producer.(HealthCheckProducer)
+	if !ok {
+		return ErrHealthCheckNotSupported
+	}
+	return healthCheckProducer.Healthy(ctx)
+}
+
+func (p *metricsProducer) emitMetrics(publish func() error) error {
 	p.metricsClient.IncCounter(metrics.MessagingClientPublishScope, metrics.CadenceClientRequests)
 
 	sw := p.metricsClient.StartTimer(metrics.MessagingClientPublishScope, metrics.CadenceClientLatency)
-	err := p.producer.Publish(ctx, msg)
+	err := publish()
 	sw.Stop()
 
 	if err != nil {
@@ -0,0 +1,295 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+type (
+	walProducer struct {
+		producer      Producer
+		path          string
+		newMessage    func() codec.ThriftObject
+		encoder       codec.BinaryEncoder
+		retryInterval time.Duration
+		logger        log.Logger
+
+		sync.Mutex
+		// entries are the encoded messages which are not yet delivered, in publish order
+		entries [][]byte
+		file    *os.File
+
+		closeOnce sync.Once
+		ctx       context.Context
+		cancel    context.CancelFunc
+		shutdownW sync.WaitGroup
+	}
+)
+
+const (
+	// walReplayJitter is the jitter coefficient of the interval between WAL replays
+	walReplayJitter = 0.2
+	// maxWALEntrySize bounds the length of a WAL entry, so a corrupted length header
+	// can't make the WAL load allocate an arbitrarily large buffer
+	maxWALEntrySize = 16 * 1024 * 1024
+)
+
+var errWALProducerClosed = errors.New("WAL producer is closed")
+
+// NewWALProducer creates a producer which appends messages that failed to publish to a local
+// file backed write-ahead log, and replays them in the background every jittered retryInterval.
+// Messages must be thrift objects; newMessage is used to create the value a WAL entry is decoded into.
+// Entries left in the WAL file by a previous instance are loaded and replayed as well.
+// Once the WAL is not empty, new messages are appended to it directly to keep the publish order.
+func NewWALProducer(
+	producer Producer,
+	path string,
+	newMessage func() codec.ThriftObject,
+	retryInterval time.Duration,
+	logger log.Logger,
+) (CloseableProducer, error) {
+	entries, err := readWALEntries(path)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &walProducer{
+		producer:      producer,
+		path:          path,
+		newMessage:    newMessage,
+		encoder:       codec.NewThriftRWEncoder(),
+		retryInterval: retryInterval,
+		logger:        logger,
+		entries:       entries,
+		file:          file,
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+	p.shutdownW.Add(1)
+	go p.replayLoop()
+	return p, nil
+}
+
+func (p *walProducer) Publish(ctx context.Context, msg interface{}) error {
+	p.Lock()
+	pending := len(p.entries) > 0
+	p.Unlock()
+
+	if !pending {
+		err := p.producer.Publish(ctx, msg)
+		if err == nil {
+			return nil
+		}
+		p.logger.Warn("Failed to publish message, appending it to WAL", tag.Error(err))
+	}
+
+	thriftMsg, ok := msg.(codec.ThriftObject)
+	if !ok {
+		return fmt.Errorf("WAL producer does not support message of type %T", msg)
+	}
+	payload, err := p.encoder.Encode(thriftMsg)
+	if err != nil {
+		return err
+	}
+	if len(payload) > maxWALEntrySize {
+		return fmt.Errorf("message of %d bytes exceeds the max WAL entry size of %d bytes", len(payload), maxWALEntrySize)
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	if p.file == nil {
+		return errWALProducerClosed
+	}
+	if err := writeWALEntry(p.file, payload); err != nil {
+		return err
+	}
+	p.entries = append(p.entries, payload)
+	return nil
+}
+
+// Close stops the replay loop, flushes the undelivered messages to the WAL file and
+// closes the wrapped producer if it is closeable
+func (p *walProducer) Close() error {
+	var err error
+	p.closeOnce.Do(func() {
+		p.cancel()
+		p.shutdownW.Wait()
+
+		p.Lock()
+		err = p.rewriteWALLocked()
+		if closeErr := p.file.Close(); err == nil {
+			err = closeErr
+		}
+		p.file = nil
+		p.Unlock()
+
+		if closeableProducer, ok := p.producer.(CloseableProducer); ok {
+			if closeErr := closeableProducer.Close(); err == nil {
+				err = closeErr
+			}
+		}
+	})
+	return err
+}
+
+func (p *walProducer) replayLoop() {
+	defer p.shutdownW.Done()
+
+	for {
+		if err := common.SleepWithJitter(p.ctx, p.retryInterval, walReplayJitter); err != nil {
+			return
+		}
+		p.replay()
+	}
+}
+
+// replay publishes the WAL entries in order until one fails, and removes the delivered entries from the WAL
+func (p *walProducer) replay() {
+	p.Lock()
+	entries := p.entries
+	p.Unlock()
+
+	delivered := 0
+	for _, payload := range entries {
+		msg := p.newMessage()
+		if err := p.encoder.Decode(payload, msg); err != nil {
+			p.logger.Error("Failed to decode WAL entry, dropping it", tag.Error(err))
+			delivered++
+			continue
+		}
+		if err := p.producer.Publish(p.ctx, msg); err != nil {
+			p.logger.Warn("Failed to replay message from WAL", tag.Error(err))
+			break
+		}
+		delivered++
+	}
+	if delivered == 0 {
+		return
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	// new entries may have been appended while replaying, they are kept after the undelivered ones
+	p.entries = p.entries[delivered:]
+	if p.file == nil {
+		return
+	}
+	if err := p.rewriteWALLocked(); err != nil {
+		p.logger.Error("Failed to rewrite WAL file", tag.Error(err))
+	}
+}
+
+// rewriteWALLocked atomically replaces the WAL file with the current entries
+func (p *walProducer) rewriteWALLocked() error {
+	tmpPath := p.path + ".tmp"
+	tmpFile, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	for _, payload := range p.entries {
+		if err := writeWALEntry(tmpFile, payload); err != nil {
+			tmpFile.Close()
+			return err
+		}
+	}
+	if err := tmpFile.Sync(); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, p.path); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(p.path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	p.file.Close()
+	p.file = file
+	return nil
+}
+
+// writeWALEntry writes a length prefixed entry
+func writeWALEntry(w io.Writer, payload []byte) error {
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], uint32(len(payload)))
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(payload)
+	return err
+}
+
+func readWALEntries(path string) ([][]byte, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries [][]byte
+	reader := bufio.NewReader(file)
+	for {
+		var header [4]byte
+		if _, err := io.ReadFull(reader, header[:]); err == io.EOF || err == io.ErrUnexpectedEOF {
+			return entries, nil
+		} else if err != nil {
+			return nil, err
+		}
+		size := binary.BigEndian.Uint32(header[:])
+		if size > maxWALEntrySize {
+			return nil, fmt.Errorf("WAL entry of %d bytes exceeds the max WAL entry size of %d bytes", size, maxWALEntrySize)
+		}
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(reader, payload); err == io.EOF || err == io.ErrUnexpectedEOF {
+			// the last entry was partially written, drop it
+			return entries, nil
+		} else if err != nil {
+			return nil, err
+		}
+		entries = append(entries, payload)
+	}
+}
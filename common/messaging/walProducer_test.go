@@ -0,0 +1,175 @@
+// Copyright (c) 2023 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package messaging
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/log/testlogger"
+)
+
+type fakeProducer struct {
+	sync.Mutex
+	err       error
+	published []interface{}
+	// blockC makes Publish signal it and block until its context is done
+	blockC chan struct{}
+}
+
+func (p *fakeProducer) Publish(ctx context.Context, msg interface{}) error {
+	p.Lock()
+	blockC := p.blockC
+	p.Unlock()
+	if blockC != nil {
+		blockC <- struct{}{}
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	p.Lock()
+	defer p.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	p.published = append(p.published, msg)
+	return nil
+}
+
+func (p *fakeProducer) setErr(err error) {
+	p.Lock()
+	defer p.Unlock()
+	p.err = err
+}
+
+func (p *fakeProducer) getPublished() []interface{} {
+	p.Lock()
+	defer p.Unlock()
+	return append([]interface{}(nil), p.published...)
+}
+
+func newTestIndexerMessage(workflowID string) *indexer.Message {
+	return &indexer.Message{
+		DomainID:   common.StringPtr("domain-id"),
+		WorkflowID: common.StringPtr(workflowID),
+		RunID:      common.StringPtr("run-id"),
+	}
+}
+
+func newIndexerMessage() codec.ThriftObject {
+	return &indexer.Message{}
+}
+
+func TestWALProducer_AppendOnFailure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wal")
+	producer := &fakeProducer{err: errors.New("kafka is down")}
+	producerWithWAL, err := NewWALProducer(producer, path, newIndexerMessage, time.Hour, testlogger.New(t))
+	require.NoError(t, err)
+
+	require.NoError(t, producerWithWAL.Publish(context.Background(), newTestIndexerMessage("wf-1")))
+	require.NoError(t, producerWithWAL.Publish(context.Background(), newTestIndexerMessage("wf-2")))
+	assert.Empty(t, producer.getPublished())
+
+	entries, err := readWALEntries(path)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	// undelivered messages must survive a restart
+	require.NoError(t, producerWithWAL.Close())
+	entries, err = readWALEntries(path)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	restarted, err := NewWALProducer(producer, path, newIndexerMessage, time.Hour, testlogger.New(t))
+	require.NoError(t, err)
+	assert.Len(t, restarted.(*walProducer).entries, 2)
+	require.NoError(t, restarted.Close())
+}
+
+func TestWALProducer_ReplayOnRecovery(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wal")
+	producer := &fakeProducer{err: errors.New("kafka is down")}
+	producerWithWAL, err := NewWALProducer(producer, path, newIndexerMessage, 10*time.Millisecond, testlogger.New(t))
+	require.NoError(t, err)
+	defer producerWithWAL.Close()
+
+	require.NoError(t, producerWithWAL.Publish(context.Background(), newTestIndexerMessage("wf-1")))
+	require.NoError(t, producerWithWAL.Publish(context.Background(), newTestIndexerMessage("wf-2")))
+
+	producer.setErr(nil)
+	require.Eventually(t, func() bool {
+		return len(producer.getPublished()) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	published := producer.getPublished()
+	assert.Equal(t, newTestIndexerMessage("wf-1"), published[0])
+	assert.Equal(t, newTestIndexerMessage("wf-2"), published[1])
+
+	require.Eventually(t, func() bool {
+		entries, err := readWALEntries(path)
+		return err == nil && len(entries) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// with an empty WAL messages are published directly
+	require.NoError(t, producerWithWAL.Publish(context.Background(), newTestIndexerMessage("wf-3")))
+	assert.Len(t, producer.getPublished(), 3)
+}
+
+func TestWALProducer_CloseCancelsReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wal")
+	producer := &fakeProducer{err: errors.New("kafka is down")}
+	producerWithWAL, err := NewWALProducer(producer, path, newIndexerMessage, 10*time.Millisecond, testlogger.New(t))
+	require.NoError(t, err)
+	require.NoError(t, producerWithWAL.Publish(context.Background(), newTestIndexerMessage("wf-1")))
+
+	producer.Lock()
+	producer.blockC = make(chan struct{}, 1)
+	producer.Unlock()
+	<-producer.blockC
+
+	// closing cancels the in-flight replay and keeps the undelivered message in the WAL
+	require.NoError(t, producerWithWAL.Close())
+	entries, err := readWALEntries(path)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+}
+
+func TestWALProducer_EntryTooLarge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wal")
+	var header [4]byte
+	binary.BigEndian.PutUint32(header[:], maxWALEntrySize+1)
+	require.NoError(t, os.WriteFile(path, header[:], 0644))
+
+	_, err := NewWALProducer(&fakeProducer{}, path, newIndexerMessage, time.Hour, testlogger.New(t))
+	assert.Error(t, err)
+}
@@ -121,6 +121,11 @@ func (m *ClientImpl) getBuckets(id int) tally.Buckets {
 	return tally.DefaultBuckets
 }
 
+// EmitShutdownDrainPhase emits a metric when a service enters a shutdown drain phase
+func EmitShutdownDrainPhase(client Client, phase string) {
+	client.Scope(ShutdownDrainScope, DrainPhaseTag(phase)).IncCounter(ShutdownDrainPhaseCounter)
+}
+
 func getMetricDefs(serviceIdx ServiceIdx) map[int]metricDefinition {
 	defs := make(map[int]metricDefinition)
 	for idx, def := range MetricDefs[Common] {
@@ -99,6 +99,11 @@ const (
 
 	MutableStateCacheTypeTagValue = "mutablestate"
 	EventsCacheTypeTagValue       = "events"
+
+	DrainPhaseEvictSelfTagValue     = "evict_self"
+	DrainPhaseWaitDiscoveryTagValue = "wait_discovery"
+	DrainPhaseDrainRequestsTagValue = "drain_requests"
+	DrainPhaseStoppedTagValue       = "stopped"
 )
 
 // Common service base metrics
@@ -221,6 +226,8 @@ const (
 	PersistenceDeleteTaskListScope
 	// PersistenceGetTaskListSizeScope is the metric scope for persistence.TaskManager.GetTaskListSize API
 	PersistenceGetTaskListSizeScope
+	// PersistenceGetTaskListScope is the metric scope for persistence.TaskManager.GetTaskList API
+	PersistenceGetTaskListScope
 	// PersistenceAppendHistoryEventsScope tracks AppendHistoryEvents calls made by service to persistence layer
 	PersistenceAppendHistoryEventsScope
 	// PersistenceGetWorkflowExecutionHistoryScope tracks GetWorkflowExecutionHistory calls made by service to persistence layer
@@ -279,14 +286,26 @@ const (
 	PersistenceEnqueueMessageScope
 	// PersistenceEnqueueMessageToDLQScope tracks Enqueue DLQ calls made by service to persistence layer
 	PersistenceEnqueueMessageToDLQScope
+	// PersistenceEnqueueMessageWithDedupScope tracks EnqueueMessageWithDedup calls made by service to persistence layer
+	PersistenceEnqueueMessageWithDedupScope
 	// PersistenceReadQueueMessagesScope tracks ReadMessages calls made by service to persistence layer
 	PersistenceReadQueueMessagesScope
+	// PersistenceReadQueueMessagesReverseScope tracks ReadMessagesReverse calls made by service to persistence layer
+	PersistenceReadQueueMessagesReverseScope
+	// PersistenceGetQueueMessageScope tracks GetMessage calls made by service to persistence layer
+	PersistenceGetQueueMessageScope
 	// PersistenceReadQueueMessagesFromDLQScope tracks ReadMessagesFromDLQ calls made by service to persistence layer
 	PersistenceReadQueueMessagesFromDLQScope
+	// PersistencePeekDLQMessagesScope tracks PeekDLQMessages calls made by service to persistence layer
+	PersistencePeekDLQMessagesScope
 	// PersistenceDeleteQueueMessagesScope tracks DeleteMessages calls made by service to persistence layer
 	PersistenceDeleteQueueMessagesScope
+	// PersistencePurgeQueueScope tracks PurgeQueue calls made by service to persistence layer
+	PersistencePurgeQueueScope
 	// PersistenceDeleteQueueMessageFromDLQScope tracks DeleteMessageFromDLQ calls made by service to persistence layer
 	PersistenceDeleteQueueMessageFromDLQScope
+	// PersistenceDeleteDLQMessagesWhereScope tracks DeleteDLQMessagesWhere calls made by service to persistence layer
+	PersistenceDeleteDLQMessagesWhereScope
 	// PersistenceRangeDeleteMessagesFromDLQScope tracks RangeDeleteMessagesFromDLQ calls made by service to persistence layer
 	PersistenceRangeDeleteMessagesFromDLQScope
 	// PersistenceUpdateAckLevelScope tracks UpdateAckLevel calls made by service to persistence layer
@@ -299,6 +318,8 @@ const (
 	PersistenceGetDLQAckLevelScope
 	// PersistenceGetDLQSizeScope tracks GetDLQSize calls made by service to persistence layer
 	PersistenceGetDLQSizeScope
+	// PersistenceGetDLQOldestMessageTimestampScope tracks GetDLQOldestMessageTimestamp calls made by service to persistence layer
+	PersistenceGetDLQOldestMessageTimestampScope
 	// PersistenceFetchDynamicConfigScope tracks FetchDynamicConfig calls made by service to persistence layer
 	PersistenceFetchDynamicConfigScope
 	// PersistenceUpdateDynamicConfigScope tracks UpdateDynamicConfig calls made by service to persistence layer
@@ -409,6 +430,10 @@ const (
 	MatchingClientDescribeTaskListScope
 	// MatchingClientListTaskListPartitionsScope tracks RPC calls to matching service
 	MatchingClientListTaskListPartitionsScope
+	// MatchingClientRefreshTaskListScope tracks RPC calls to matching service
+	MatchingClientRefreshTaskListScope
+	// MatchingClientResetTaskListAckLevelScope tracks RPC calls to matching service
+	MatchingClientResetTaskListAckLevelScope
 	// MatchingClientGetTaskListsByDomainScope tracks RPC calls to matching service
 	MatchingClientGetTaskListsByDomainScope
 	// FrontendClientDeprecateDomainScope tracks RPC calls to frontend service
@@ -672,6 +697,8 @@ const (
 	PersistenceGetHistoryTreeScope
 	// PersistenceGetAllHistoryTreeBranchesScope tracks GetHistoryTree calls made by service to persistence layer
 	PersistenceGetAllHistoryTreeBranchesScope
+	// PersistenceErrorInjectionScope tracks the calls made through the persistence error injectors
+	PersistenceErrorInjectionScope
 
 	// ClusterMetadataArchivalConfigScope tracks ArchivalConfig calls to ClusterMetadata
 	ClusterMetadataArchivalConfigScope
@@ -784,6 +811,8 @@ const (
 	GetAvailableIsolationGroupsScope
 	// TaskValidatorScope is the metric for the taskvalidator's workflow check operation.
 	TaskValidatorScope
+	// ShutdownDrainScope is used by services while draining traffic on shutdown
+	ShutdownDrainScope
 	NumCommonScopes
 )
 
@@ -855,6 +884,10 @@ const (
 	GetDomainIsolationGroups
 	// UpdateDomainIsolationGroups is the scope for getting domain isolation groups
 	UpdateDomainIsolationGroups
+	// AdminRefreshTaskListScope is the metric scope for admin.RefreshTaskList
+	AdminRefreshTaskListScope
+	// AdminResetTaskListAckLevelScope is the metric scope for admin.ResetTaskListAckLevel
+	AdminResetTaskListAckLevelScope
 
 	NumAdminScopes
 )
@@ -1249,6 +1282,14 @@ const (
 	MatchingListTaskListPartitionsScope
 	// MatchingGetTaskListsByDomainScope tracks GetTaskListsByDomain API calls received by service
 	MatchingGetTaskListsByDomainScope
+	// MatchingGetTaskListConfigScope tracks GetTaskListConfig API calls received by service
+	MatchingGetTaskListConfigScope
+	// MatchingResetTaskListAckLevelScope tracks ResetTaskListAckLevel API calls received by service
+	MatchingResetTaskListAckLevelScope
+	// MatchingRefreshTaskListScope tracks RefreshTaskList API calls received by service
+	MatchingRefreshTaskListScope
+	// MatchingDescribePollerScope tracks DescribePoller API calls received by service
+	MatchingDescribePollerScope
 
 	NumMatchingScopes
 )
@@ -1343,6 +1384,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceListTaskListScope:                                   {operation: "ListTaskList"},
 		PersistenceDeleteTaskListScope:                                 {operation: "DeleteTaskList"},
 		PersistenceGetTaskListSizeScope:                                {operation: "GetTaskListSize"},
+		PersistenceGetTaskListScope:                                    {operation: "GetTaskList"},
 		PersistenceAppendHistoryEventsScope:                            {operation: "AppendHistoryEvents"},
 		PersistenceGetWorkflowExecutionHistoryScope:                    {operation: "GetWorkflowExecutionHistory"},
 		PersistenceDeleteWorkflowExecutionHistoryScope:                 {operation: "DeleteWorkflowExecutionHistory"},
@@ -1377,18 +1419,26 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceCompleteForkBranchScope:                             {operation: "CompleteForkBranch"},
 		PersistenceGetHistoryTreeScope:                                 {operation: "GetHistoryTree"},
 		PersistenceGetAllHistoryTreeBranchesScope:                      {operation: "GetAllHistoryTreeBranches"},
+		PersistenceErrorInjectionScope:                                 {operation: "PersistenceErrorInjection"},
 		PersistenceEnqueueMessageScope:                                 {operation: "EnqueueMessage"},
 		PersistenceEnqueueMessageToDLQScope:                            {operation: "EnqueueMessageToDLQ"},
+		PersistenceEnqueueMessageWithDedupScope:                        {operation: "EnqueueMessageWithDedup"},
 		PersistenceReadQueueMessagesScope:                              {operation: "ReadQueueMessages"},
+		PersistenceReadQueueMessagesReverseScope:                       {operation: "ReadQueueMessagesReverse"},
+		PersistenceGetQueueMessageScope:                                {operation: "GetQueueMessage"},
 		PersistenceReadQueueMessagesFromDLQScope:                       {operation: "ReadQueueMessagesFromDLQ"},
+		PersistencePeekDLQMessagesScope:                                {operation: "PeekDLQMessages"},
 		PersistenceDeleteQueueMessagesScope:                            {operation: "DeleteQueueMessages"},
+		PersistencePurgeQueueScope:                                     {operation: "PurgeQueue"},
 		PersistenceDeleteQueueMessageFromDLQScope:                      {operation: "DeleteQueueMessageFromDLQ"},
+		PersistenceDeleteDLQMessagesWhereScope:                         {operation: "DeleteDLQMessagesWhere"},
 		PersistenceRangeDeleteMessagesFromDLQScope:                     {operation: "RangeDeleteMessagesFromDLQ"},
 		PersistenceUpdateAckLevelScope:                                 {operation: "UpdateAckLevel"},
 		PersistenceGetAckLevelScope:                                    {operation: "GetAckLevel"},
 		PersistenceUpdateDLQAckLevelScope:                              {operation: "UpdateDLQAckLevel"},
 		PersistenceGetDLQAckLevelScope:                                 {operation: "GetDLQAckLevel"},
 		PersistenceGetDLQSizeScope:                                     {operation: "GetDLQSize"},
+		PersistenceGetDLQOldestMessageTimestampScope:                   {operation: "GetDLQOldestMessageTimestamp"},
 		PersistenceFetchDynamicConfigScope:                             {operation: "FetchDynamicConfig"},
 		PersistenceUpdateDynamicConfigScope:                            {operation: "UpdateDynamicConfig"},
 		PersistenceShardRequestCountScope:                              {operation: "ShardIdPersistenceRequest"},
@@ -1447,6 +1497,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingClientCancelOutstandingPollScope:              {operation: "MatchingClientCancelOutstandingPoll", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientDescribeTaskListScope:                   {operation: "MatchingClientDescribeTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientListTaskListPartitionsScope:             {operation: "MatchingClientListTaskListPartitions", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientRefreshTaskListScope:                    {operation: "MatchingClientRefreshTaskList", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
+		MatchingClientResetTaskListAckLevelScope:              {operation: "MatchingClientResetTaskListAckLevel", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		MatchingClientGetTaskListsByDomainScope:               {operation: "MatchingClientGetTaskListsByDomain", tags: map[string]string{CadenceRoleTagName: MatchingClientRoleTagValue}},
 		FrontendClientDeprecateDomainScope:                    {operation: "FrontendClientDeprecateDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
 		FrontendClientDescribeDomainScope:                     {operation: "FrontendClientDescribeDomain", tags: map[string]string{CadenceRoleTagName: FrontendClientRoleTagValue}},
@@ -1627,6 +1679,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TaskValidatorScope:          {operation: "TaskValidation"},
 		DomainReplicationQueueScope: {operation: "DomainReplicationQueue"},
 		ClusterMetadataScope:        {operation: "ClusterMetadata"},
+		ShutdownDrainScope:          {operation: "ShutdownDrain"},
 	},
 	// Frontend Scope Names
 	Frontend: {
@@ -1664,6 +1717,8 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		UpdateGlobalIsolationGroups:                 {operation: "UpdateGlobalIsolationGroups"},
 		GetDomainIsolationGroups:                    {operation: "GetDomainIsolationGroups"},
 		UpdateDomainIsolationGroups:                 {operation: "UpdateDomainIsolationGroups"},
+		AdminRefreshTaskListScope:                   {operation: "AdminRefreshTaskList"},
+		AdminResetTaskListAckLevelScope:             {operation: "AdminResetTaskListAckLevel"},
 
 		FrontendRestartWorkflowExecutionScope:           {operation: "RestartWorkflowExecution"},
 		FrontendStartWorkflowExecutionScope:             {operation: "StartWorkflowExecution"},
@@ -1859,6 +1914,10 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		MatchingDescribeTaskListScope:          {operation: "DescribeTaskList"},
 		MatchingListTaskListPartitionsScope:    {operation: "ListTaskListPartitions"},
 		MatchingGetTaskListsByDomainScope:      {operation: "GetTaskListsByDomain"},
+		MatchingGetTaskListConfigScope:         {operation: "GetTaskListConfig"},
+		MatchingResetTaskListAckLevelScope:     {operation: "ResetTaskListAckLevel"},
+		MatchingRefreshTaskListScope:           {operation: "RefreshTaskList"},
+		MatchingDescribePollerScope:            {operation: "DescribePoller"},
 	},
 	// Worker Scope Names
 	Worker: {
@@ -1936,6 +1995,9 @@ const (
 	PersistenceErrDBUnavailableCounter
 	PersistenceSampledCounter
 	PersistenceEmptyResponseCounter
+	PersistenceErrorInjectionRequests
+	PersistenceErrorInjectionMaskedStoreErrors
+	PersistencePurgedQueueMessagesCounter
 
 	PersistenceRequestsPerDomain
 	PersistenceRequestsPerShard
@@ -1967,6 +2029,12 @@ const (
 	CadenceDcRedirectionClientLatency
 
 	CadenceAuthorizationLatency
+	CadenceAuthorizationCacheHitCounter
+	CadenceAuthorizationShadowAgreementCounter
+	CadenceAuthorizationShadowDisagreementCounter
+	CadenceAuthorizationShadowFailedCounter
+	CadenceAuthorizationFailOpenCounter
+	CadenceAuthorizationPrincipalThrottledCounter
 
 	DomainCachePrepareCallbacksLatency
 	DomainCacheCallbacksLatency
@@ -2089,6 +2157,8 @@ const (
 	IsolationGroupStateHealthy
 	ValidatedWorkflowCount
 
+	ShutdownDrainPhaseCounter
+
 	NumCommonMetrics // Needs to be last on this list for iota numbering
 )
 
@@ -2387,6 +2457,8 @@ const (
 	AsyncMatchDispatchTimeoutCounterPerTaskList
 	ExpiredTasksPerTaskListCounter
 	ForwardedPerTaskListCounter
+	ForwardingDepthExceededPerTaskListCounter
+	ForwardingDepthPerTaskList
 	ForwardTaskCallsPerTaskList
 	ForwardTaskErrorsPerTaskList
 	ForwardTaskLatencyPerTaskList
@@ -2455,6 +2527,11 @@ const (
 	TaskDeletedCount
 	TaskListProcessedCount
 	TaskListDeletedCount
+	TaskWouldDeleteCount
+	TaskListWouldDeleteCount
+	TaskListSkippedCount
+	TaskNotExpiredCount
+	TaskOldestExpiryGap
 	TaskListOutstandingCount
 	ExecutionsOutstandingCount
 	StartedCount
@@ -2546,6 +2623,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PersistenceErrDBUnavailableCounter:                           {metricName: "persistence_errors_db_unavailable", metricType: Counter},
 		PersistenceSampledCounter:                                    {metricName: "persistence_sampled", metricType: Counter},
 		PersistenceEmptyResponseCounter:                              {metricName: "persistence_empty_response", metricType: Counter},
+		PersistenceErrorInjectionRequests:                            {metricName: "persistence_error_injection_requests", metricType: Counter},
+		PersistenceErrorInjectionMaskedStoreErrors:                   {metricName: "persistence_error_injection_masked_store_errors", metricType: Counter},
+		PersistencePurgedQueueMessagesCounter:                        {metricName: "persistence_purged_queue_messages", metricType: Counter},
 		PersistenceRequestsPerDomain:                                 {metricName: "persistence_requests_per_domain", metricRollupName: "persistence_requests", metricType: Counter},
 		PersistenceRequestsPerShard:                                  {metricName: "persistence_requests_per_shard", metricType: Counter},
 		PersistenceFailuresPerDomain:                                 {metricName: "persistence_errors_per_domain", metricRollupName: "persistence_errors", metricType: Counter},
@@ -2572,6 +2652,12 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CadenceDcRedirectionClientFailures:                           {metricName: "cadence_client_errors_redirection", metricType: Counter},
 		CadenceDcRedirectionClientLatency:                            {metricName: "cadence_client_latency_redirection", metricType: Timer},
 		CadenceAuthorizationLatency:                                  {metricName: "cadence_authorization_latency", metricType: Timer},
+		CadenceAuthorizationCacheHitCounter:                          {metricName: "cadence_authorization_cache_hit", metricType: Counter},
+		CadenceAuthorizationShadowAgreementCounter:                   {metricName: "cadence_authorization_shadow_agreement", metricType: Counter},
+		CadenceAuthorizationShadowDisagreementCounter:                {metricName: "cadence_authorization_shadow_disagreement", metricType: Counter},
+		CadenceAuthorizationShadowFailedCounter:                      {metricName: "cadence_authorization_shadow_failed", metricType: Counter},
+		CadenceAuthorizationFailOpenCounter:                          {metricName: "cadence_authorization_fail_open", metricType: Counter},
+		CadenceAuthorizationPrincipalThrottledCounter:                {metricName: "cadence_authorization_principal_throttled", metricType: Counter},
 		DomainCachePrepareCallbacksLatency:                           {metricName: "domain_cache_prepare_callbacks_latency", metricType: Timer},
 		DomainCacheCallbacksLatency:                                  {metricName: "domain_cache_callbacks_latency", metricType: Timer},
 		DomainCacheCallbacksCount:                                    {metricName: "domain_cache_callbacks_count", metricType: Counter},
@@ -2719,6 +2805,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		IsolationGroupStateDrained:           {metricName: "isolation_group_drained", metricType: Counter},
 		IsolationGroupStateHealthy:           {metricName: "isolation_group_healthy", metricType: Counter},
 		ValidatedWorkflowCount:               {metricName: "task_validator_count", metricType: Counter},
+		ShutdownDrainPhaseCounter:            {metricName: "shutdown_drain_phase", metricType: Counter},
 	},
 	History: {
 		TaskRequests:             {metricName: "task_requests", metricType: Counter},
@@ -3002,6 +3089,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		BufferIsolationGroupMisconfiguredCounter:    {metricName: "buffer_isolation_group_misconfigured_failure_per_tl", metricRollupName: "buffer_isolation_group_misconfigured_failure"},
 		ExpiredTasksPerTaskListCounter:              {metricName: "tasks_expired_per_tl", metricRollupName: "tasks_expired"},
 		ForwardedPerTaskListCounter:                 {metricName: "forwarded_per_tl", metricRollupName: "forwarded"},
+		ForwardingDepthExceededPerTaskListCounter:   {metricName: "forwarding_depth_exceeded_per_tl", metricRollupName: "forwarding_depth_exceeded"},
+		ForwardingDepthPerTaskList:                  {metricName: "forwarding_depth_per_tl", metricRollupName: "forwarding_depth", metricType: Histogram, buckets: ForwardingDepthBuckets},
 		ForwardTaskCallsPerTaskList:                 {metricName: "forward_task_calls_per_tl", metricRollupName: "forward_task_calls"},
 		ForwardTaskErrorsPerTaskList:                {metricName: "forward_task_errors_per_tl", metricRollupName: "forward_task_errors"},
 		ForwardQueryCallsPerTaskList:                {metricName: "forward_query_calls_per_tl", metricRollupName: "forward_query_calls"},
@@ -3070,6 +3159,11 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		TaskDeletedCount:                              {metricName: "task_deleted", metricType: Gauge},
 		TaskListProcessedCount:                        {metricName: "tasklist_processed", metricType: Gauge},
 		TaskListDeletedCount:                          {metricName: "tasklist_deleted", metricType: Gauge},
+		TaskWouldDeleteCount:                          {metricName: "task_would_delete", metricType: Gauge},
+		TaskListWouldDeleteCount:                      {metricName: "tasklist_would_delete", metricType: Gauge},
+		TaskListSkippedCount:                          {metricName: "tasklist_skipped", metricType: Gauge},
+		TaskNotExpiredCount:                           {metricName: "task_not_expired", metricType: Counter},
+		TaskOldestExpiryGap:                           {metricName: "task_oldest_expiry_gap", metricType: Timer},
 		TaskListOutstandingCount:                      {metricName: "tasklist_outstanding", metricType: Gauge},
 		ExecutionsOutstandingCount:                    {metricName: "executions_outstanding", metricType: Gauge},
 		StartedCount:                                  {metricName: "started", metricType: Counter},
@@ -3107,6 +3201,9 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 	},
 }
 
+// ForwardingDepthBuckets contains buckets for the number of partition hops of forwarded matching tasks
+var ForwardingDepthBuckets = tally.ValueBuckets{0, 1, 2, 3, 4, 5, 10, 20}
+
 // PersistenceLatencyBuckets contains duration buckets for measuring persistence latency
 var PersistenceLatencyBuckets = tally.DurationBuckets([]time.Duration{
 	1 * time.Millisecond,
@@ -56,6 +56,10 @@ const (
 	shardID                = "shard_id"
 	matchingHost           = "matching_host"
 	pollerIsolationGroup   = "poller_isolation_group"
+	persistenceMethod      = "persistence_method"
+	errorInjectionResult   = "error_injection_result"
+	apiName                = "api_name"
+	drainPhase             = "drain_phase"
 
 	allValue     = "all"
 	unknownValue = "_unknown_"
@@ -224,6 +228,26 @@ func PollerIsolationGroupTag(value string) Tag {
 	return metricWithUnknown(pollerIsolationGroup, value)
 }
 
+// PersistenceMethodTag returns a new persistence method tag, the method is prefixed with its manager name
+func PersistenceMethodTag(value string) Tag {
+	return metricWithUnknown(persistenceMethod, value)
+}
+
+// ErrorInjectionResultTag returns a new error injection result tag
+func ErrorInjectionResultTag(value string) Tag {
+	return simpleMetric{key: errorInjectionResult, value: value}
+}
+
+// APINameTag returns a new API name tag
+func APINameTag(value string) Tag {
+	return metricWithUnknown(apiName, value)
+}
+
+// DrainPhaseTag returns a new shutdown drain phase tag
+func DrainPhaseTag(value string) Tag {
+	return simpleMetric{key: drainPhase, value: value}
+}
+
 // PartitionConfigTags returns a list of partition config tags
 func PartitionConfigTags(partitionConfig map[string]string) []Tag {
 	tags := make([]Tag, 0, len(partitionConfig))
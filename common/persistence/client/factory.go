@@ -22,6 +22,7 @@ package client
 
 import (
 	"sync"
+	"time"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/config"
@@ -104,6 +105,9 @@ type (
 		datastores    map[storeType]Datastore
 		clusterName   string
 		dc            *p.DynamicConfiguration
+		// faultProvider is shared by all error injectors, so a seed reproduces the injected errors
+		faultProvider   errorinjectors.FaultProvider
+		injectedLatency errorinjectors.LatencyDistribution
 	}
 
 	storeType int
@@ -147,17 +151,63 @@ func NewFactory(
 	dc *p.DynamicConfiguration,
 ) Factory {
 	factory := &factoryImpl{
-		config:        cfg,
-		metricsClient: metricsClient,
-		logger:        logger,
-		clusterName:   clusterName,
-		dc:            dc,
+		config:          cfg,
+		metricsClient:   metricsClient,
+		logger:          logger,
+		clusterName:     clusterName,
+		dc:              dc,
+		faultProvider:   newFaultProvider(cfg, logger),
+		injectedLatency: newInjectedLatency(cfg, logger),
 	}
 	limiters := buildRatelimiters(cfg, persistenceMaxQPS)
 	factory.init(clusterName, limiters)
 	return factory
 }
 
+// newFaultProvider creates the fault provider of the error injectors seeded with the configured seed,
+// or a random one which is logged so that the injected errors can be reproduced from the logs
+func newFaultProvider(cfg *config.Persistence, logger log.Logger) errorinjectors.FaultProvider {
+	seed := cfg.ErrorInjectionSeed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	if cfg.ErrorInjectionRate != nil && cfg.ErrorInjectionRate() != 0 {
+		logger.Info("Persistence error injection enabled", tag.Value(seed))
+	}
+	injectedErrors, err := errorinjectors.WeightedErrorsByName(cfg.ErrorInjectionErrors)
+	if err != nil {
+		logger.Fatal("invalid config: errorInjectionErrors", tag.Error(err))
+	}
+	return errorinjectors.NewFaultProvider(seed, errorinjectors.WithInjectedErrors(injectedErrors...))
+}
+
+// newInjectedLatency returns the distribution of the latency injected by the error injectors, or nil if not configured
+func newInjectedLatency(cfg *config.Persistence, logger log.Logger) errorinjectors.LatencyDistribution {
+	latency := cfg.ErrorInjectionLatency
+	if latency == nil {
+		return nil
+	}
+	switch latency.Distribution {
+	case "fixed":
+		return errorinjectors.FixedLatency(latency.Latency)
+	case "uniform":
+		return errorinjectors.UniformLatency(latency.Min, latency.Max)
+	case "exponential":
+		return errorinjectors.ExponentialLatency(latency.Latency)
+	}
+	logger.Fatal("invalid config: unknown errorInjectionLatency distribution", tag.Value(latency.Distribution))
+	return nil
+}
+
+// errorInjectorOptions returns the options of the error injectors wrapping the persistence managers
+func (f *factoryImpl) errorInjectorOptions() []errorinjectors.InjectorOption {
+	return []errorinjectors.InjectorOption{
+		errorinjectors.WithFaultProvider(f.faultProvider),
+		errorinjectors.WithMetricsClient(f.metricsClient),
+		errorinjectors.WithInjectedLatency(f.injectedLatency),
+	}
+}
+
 // NewTaskManager returns a new task manager
 func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	ds := f.datastores[storeTypeTask]
@@ -167,7 +217,7 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	}
 	result := p.NewTaskManager(store)
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewTaskManager(result, errorRate, f.logger)
+		result = errorinjectors.NewTaskManager(result, errorRate, f.logger, f.errorInjectorOptions()...)
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewTaskManager(result, ds.ratelimit)
@@ -187,7 +237,7 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	}
 	result := p.NewShardManager(store)
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewShardManager(result, errorRate, f.logger)
+		result = errorinjectors.NewShardManager(result, errorRate, f.logger, f.errorInjectorOptions()...)
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewShardManager(result, ds.ratelimit)
@@ -205,9 +255,9 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewHistoryV2ManagerImpl(store, f.logger, f.config.TransactionSizeLimit)
+	result := p.NewHistoryV2ManagerImpl(store, f.logger, f.config.TransactionSizeLimit, f.config.HistoryBlobCompressionThreshold)
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewHistoryManager(result, errorRate, f.logger)
+		result = errorinjectors.NewHistoryManager(result, errorRate, f.logger, f.errorInjectorOptions()...)
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewHistoryManager(result, ds.ratelimit)
@@ -229,7 +279,7 @@ func (f *factoryImpl) NewDomainManager() (p.DomainManager, error) {
 	}
 	result := p.NewDomainManagerImpl(store, f.logger)
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewDomainManager(result, errorRate, f.logger)
+		result = errorinjectors.NewDomainManager(result, errorRate, f.logger, f.errorInjectorOptions()...)
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewDomainManager(result, ds.ratelimit)
@@ -249,7 +299,7 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 	}
 	result := p.NewExecutionManagerImpl(store, f.logger)
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewExecutionManager(result, errorRate, f.logger)
+		result = errorinjectors.NewExecutionManager(result, errorRate, f.logger, f.errorInjectorOptions()...)
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewExecutionManager(result, ds.ratelimit)
@@ -393,7 +443,7 @@ func (f *factoryImpl) newDBVisibilityManager(
 	}
 	result := p.NewVisibilityManagerImpl(store, f.logger)
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewVisibilityManager(result, errorRate, f.logger)
+		result = errorinjectors.NewVisibilityManager(result, errorRate, f.logger, f.errorInjectorOptions()...)
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewVisibilityManager(result, ds.ratelimit)
@@ -420,7 +470,7 @@ func (f *factoryImpl) NewDomainReplicationQueueManager() (p.QueueManager, error)
 	}
 	result := p.NewQueueManager(store)
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewQueueManager(result, errorRate, f.logger)
+		result = errorinjectors.NewQueueManager(result, errorRate, f.logger, f.errorInjectorOptions()...)
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewQueueManager(result, ds.ratelimit)
@@ -440,7 +490,7 @@ func (f *factoryImpl) NewConfigStoreManager() (p.ConfigStoreManager, error) {
 	}
 	result := p.NewConfigStoreManagerImpl(store, f.logger)
 	if errorRate := f.config.ErrorInjectionRate(); errorRate != 0 {
-		result = errorinjectors.NewConfigStoreManager(result, errorRate, f.logger)
+		result = errorinjectors.NewConfigStoreManager(result, errorRate, f.logger, f.errorInjectorOptions()...)
 	}
 	if ds.ratelimit != nil {
 		result = ratelimited.NewConfigStoreManager(result, ds.ratelimit)
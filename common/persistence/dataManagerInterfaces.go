@@ -83,6 +83,16 @@ const (
 	DomainReplicationQueueType QueueType = iota + 1
 )
 
+// String returns the name of the queue type
+func (q QueueType) String() string {
+	switch q {
+	case DomainReplicationQueueType:
+		return "domain-replication"
+	default:
+		return fmt.Sprintf("QueueType(%d)", int(q))
+	}
+}
+
 // Create Workflow Execution Mode
 const (
 	// Fail if current record exists
@@ -430,6 +440,8 @@ type (
 		Expiry                 time.Time
 		CreatedTime            time.Time
 		PartitionConfig        map[string]string
+		// Priority orders tasks within each batch matching reads from the backlog, higher first
+		Priority int32
 	}
 
 	// TaskKey gives primary key info for a specific task
@@ -1205,6 +1217,19 @@ type (
 		TaskListInfo *TaskListInfo
 	}
 
+	// GetTaskListRequest is used to read a task list without leasing it
+	GetTaskListRequest struct {
+		DomainID   string
+		DomainName string
+		TaskList   string
+		TaskType   int
+	}
+
+	// GetTaskListResponse is response to GetTaskListRequest
+	GetTaskListResponse struct {
+		TaskListInfo *TaskListInfo
+	}
+
 	// UpdateTaskListRequest is used to update task list implementation information
 	UpdateTaskListRequest struct {
 		TaskListInfo *TaskListInfo
@@ -1768,6 +1793,9 @@ type (
 		Closeable
 		GetName() string
 		LeaseTaskList(ctx context.Context, request *LeaseTaskListRequest) (*LeaseTaskListResponse, error)
+		// GetTaskList returns the task list, or an EntityNotExistsError if it doesn't exist,
+		// unlike LeaseTaskList it doesn't take the ownership of the task list
+		GetTaskList(ctx context.Context, request *GetTaskListRequest) (*GetTaskListResponse, error)
 		UpdateTaskList(ctx context.Context, request *UpdateTaskListRequest) (*UpdateTaskListResponse, error)
 		ListTaskList(ctx context.Context, request *ListTaskListRequest) (*ListTaskListResponse, error)
 		DeleteTaskList(ctx context.Context, request *DeleteTaskListRequest) error
@@ -1825,17 +1853,33 @@ type (
 	QueueManager interface {
 		Closeable
 		EnqueueMessage(ctx context.Context, messagePayload []byte) error
+		// EnqueueMessageWithDedup enqueues the message unless a message with the same dedup key is still in the queue,
+		// it returns the ID of the newly enqueued message or of the existing one
+		EnqueueMessageWithDedup(ctx context.Context, messagePayload []byte, dedupKey string) (int64, error)
 		ReadMessages(ctx context.Context, lastMessageID int64, maxCount int) ([]*QueueMessage, error)
+		// ReadMessagesReverse returns the most recent maxCount messages in descending ID order
+		ReadMessagesReverse(ctx context.Context, maxCount int) ([]*QueueMessage, error)
+		GetMessage(ctx context.Context, messageID int64) (*QueueMessage, error)
 		DeleteMessagesBefore(ctx context.Context, messageID int64) error
+		// PurgeQueue deletes all the messages of the queue and returns the number of deleted messages,
+		// confirm must be the name of the queue type to guard against accidental purges
+		PurgeQueue(ctx context.Context, confirm string) (int64, error)
 		UpdateAckLevel(ctx context.Context, messageID int64, clusterName string) error
 		GetAckLevels(ctx context.Context) (map[string]int64, error)
 		EnqueueMessageToDLQ(ctx context.Context, messagePayload []byte) error
 		ReadMessagesFromDLQ(ctx context.Context, firstMessageID int64, lastMessageID int64, pageSize int, pageToken []byte) ([]*QueueMessage, []byte, error)
+		// PeekDLQMessages returns the first maxCount messages of the DLQ without any pagination state
+		PeekDLQMessages(ctx context.Context, maxCount int) ([]*QueueMessage, error)
 		DeleteMessageFromDLQ(ctx context.Context, messageID int64) error
 		RangeDeleteMessagesFromDLQ(ctx context.Context, firstMessageID int64, lastMessageID int64) error
+		// DeleteDLQMessagesWhere deletes the DLQ messages matching the predicate and returns the number of deleted messages
+		DeleteDLQMessagesWhere(ctx context.Context, predicate func(*QueueMessage) bool) (int, error)
 		UpdateDLQAckLevel(ctx context.Context, messageID int64, clusterName string) error
 		GetDLQAckLevels(ctx context.Context) (map[string]int64, error)
 		GetDLQSize(ctx context.Context) (int64, error)
+		// GetDLQOldestMessageTimestamp returns the time the oldest DLQ message was enqueued, or a zero time if the DLQ is empty.
+		// On SQL stores, messages enqueued before the queue created_time column was added report the time of the schema upgrade.
+		GetDLQOldestMessageTimestamp(ctx context.Context) (time.Time, error)
 	}
 
 	// QueueMessage is the message that stores in the queue
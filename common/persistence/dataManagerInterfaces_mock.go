@@ -887,6 +887,21 @@ func (mr *MockTaskManagerMockRecorder) GetOrphanTasks(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrphanTasks", reflect.TypeOf((*MockTaskManager)(nil).GetOrphanTasks), arg0, arg1)
 }
 
+// GetTaskList mocks base method.
+func (m *MockTaskManager) GetTaskList(arg0 context.Context, arg1 *GetTaskListRequest) (*GetTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskList", arg0, arg1)
+	ret0, _ := ret[0].(*GetTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskList indicates an expected call of GetTaskList.
+func (mr *MockTaskManagerMockRecorder) GetTaskList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskList", reflect.TypeOf((*MockTaskManager)(nil).GetTaskList), arg0, arg1)
+}
+
 // GetTaskListSize mocks base method.
 func (m *MockTaskManager) GetTaskListSize(arg0 context.Context, arg1 *GetTaskListSizeRequest) (*GetTaskListSizeResponse, error) {
 	m.ctrl.T.Helper()
@@ -1316,6 +1331,21 @@ func (mr *MockQueueManagerMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockQueueManager)(nil).Close))
 }
 
+// DeleteDLQMessagesWhere mocks base method.
+func (m *MockQueueManager) DeleteDLQMessagesWhere(arg0 context.Context, arg1 func(*QueueMessage) bool) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDLQMessagesWhere", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDLQMessagesWhere indicates an expected call of DeleteDLQMessagesWhere.
+func (mr *MockQueueManagerMockRecorder) DeleteDLQMessagesWhere(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDLQMessagesWhere", reflect.TypeOf((*MockQueueManager)(nil).DeleteDLQMessagesWhere), arg0, arg1)
+}
+
 // DeleteMessageFromDLQ mocks base method.
 func (m *MockQueueManager) DeleteMessageFromDLQ(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -1372,6 +1402,21 @@ func (mr *MockQueueManagerMockRecorder) EnqueueMessageToDLQ(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueMessageToDLQ", reflect.TypeOf((*MockQueueManager)(nil).EnqueueMessageToDLQ), arg0, arg1)
 }
 
+// EnqueueMessageWithDedup mocks base method.
+func (m *MockQueueManager) EnqueueMessageWithDedup(arg0 context.Context, arg1 []byte, arg2 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueMessageWithDedup", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnqueueMessageWithDedup indicates an expected call of EnqueueMessageWithDedup.
+func (mr *MockQueueManagerMockRecorder) EnqueueMessageWithDedup(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueMessageWithDedup", reflect.TypeOf((*MockQueueManager)(nil).EnqueueMessageWithDedup), arg0, arg1, arg2)
+}
+
 // GetAckLevels mocks base method.
 func (m *MockQueueManager) GetAckLevels(arg0 context.Context) (map[string]int64, error) {
 	m.ctrl.T.Helper()
@@ -1402,6 +1447,21 @@ func (mr *MockQueueManagerMockRecorder) GetDLQAckLevels(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQAckLevels", reflect.TypeOf((*MockQueueManager)(nil).GetDLQAckLevels), arg0)
 }
 
+// GetDLQOldestMessageTimestamp mocks base method.
+func (m *MockQueueManager) GetDLQOldestMessageTimestamp(arg0 context.Context) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDLQOldestMessageTimestamp", arg0)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDLQOldestMessageTimestamp indicates an expected call of GetDLQOldestMessageTimestamp.
+func (mr *MockQueueManagerMockRecorder) GetDLQOldestMessageTimestamp(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQOldestMessageTimestamp", reflect.TypeOf((*MockQueueManager)(nil).GetDLQOldestMessageTimestamp), arg0)
+}
+
 // GetDLQSize mocks base method.
 func (m *MockQueueManager) GetDLQSize(arg0 context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -1417,6 +1477,51 @@ func (mr *MockQueueManagerMockRecorder) GetDLQSize(arg0 interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQSize", reflect.TypeOf((*MockQueueManager)(nil).GetDLQSize), arg0)
 }
 
+// GetMessage mocks base method.
+func (m *MockQueueManager) GetMessage(arg0 context.Context, arg1 int64) (*QueueMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessage", arg0, arg1)
+	ret0, _ := ret[0].(*QueueMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessage indicates an expected call of GetMessage.
+func (mr *MockQueueManagerMockRecorder) GetMessage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessage", reflect.TypeOf((*MockQueueManager)(nil).GetMessage), arg0, arg1)
+}
+
+// PeekDLQMessages mocks base method.
+func (m *MockQueueManager) PeekDLQMessages(arg0 context.Context, arg1 int) ([]*QueueMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PeekDLQMessages", arg0, arg1)
+	ret0, _ := ret[0].([]*QueueMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PeekDLQMessages indicates an expected call of PeekDLQMessages.
+func (mr *MockQueueManagerMockRecorder) PeekDLQMessages(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeekDLQMessages", reflect.TypeOf((*MockQueueManager)(nil).PeekDLQMessages), arg0, arg1)
+}
+
+// PurgeQueue mocks base method.
+func (m *MockQueueManager) PurgeQueue(arg0 context.Context, arg1 string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeQueue", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeQueue indicates an expected call of PurgeQueue.
+func (mr *MockQueueManagerMockRecorder) PurgeQueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeQueue", reflect.TypeOf((*MockQueueManager)(nil).PurgeQueue), arg0, arg1)
+}
+
 // RangeDeleteMessagesFromDLQ mocks base method.
 func (m *MockQueueManager) RangeDeleteMessagesFromDLQ(arg0 context.Context, arg1, arg2 int64) error {
 	m.ctrl.T.Helper()
@@ -1462,6 +1567,21 @@ func (mr *MockQueueManagerMockRecorder) ReadMessagesFromDLQ(arg0, arg1, arg2, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMessagesFromDLQ", reflect.TypeOf((*MockQueueManager)(nil).ReadMessagesFromDLQ), arg0, arg1, arg2, arg3, arg4)
 }
 
+// ReadMessagesReverse mocks base method.
+func (m *MockQueueManager) ReadMessagesReverse(arg0 context.Context, arg1 int) ([]*QueueMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadMessagesReverse", arg0, arg1)
+	ret0, _ := ret[0].([]*QueueMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadMessagesReverse indicates an expected call of ReadMessagesReverse.
+func (mr *MockQueueManagerMockRecorder) ReadMessagesReverse(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMessagesReverse", reflect.TypeOf((*MockQueueManager)(nil).ReadMessagesReverse), arg0, arg1)
+}
+
 // UpdateAckLevel mocks base method.
 func (m *MockQueueManager) UpdateAckLevel(arg0 context.Context, arg1 int64, arg2 string) error {
 	m.ctrl.T.Helper()
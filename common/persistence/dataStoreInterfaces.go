@@ -54,6 +54,7 @@ type (
 		Closeable
 		GetName() string
 		LeaseTaskList(ctx context.Context, request *LeaseTaskListRequest) (*LeaseTaskListResponse, error)
+		GetTaskList(ctx context.Context, request *GetTaskListRequest) (*GetTaskListResponse, error)
 		UpdateTaskList(ctx context.Context, request *UpdateTaskListRequest) (*UpdateTaskListResponse, error)
 		ListTaskList(ctx context.Context, request *ListTaskListRequest) (*ListTaskListResponse, error)
 		DeleteTaskList(ctx context.Context, request *DeleteTaskListRequest) error
@@ -199,12 +200,17 @@ type (
 	Queue interface {
 		Closeable
 		EnqueueMessage(ctx context.Context, messagePayload []byte) error
+		EnqueueMessageWithDedup(ctx context.Context, messagePayload []byte, dedupKey string) (int64, error)
 		ReadMessages(ctx context.Context, lastMessageID int64, maxCount int) ([]*InternalQueueMessage, error)
+		ReadMessagesReverse(ctx context.Context, maxCount int) ([]*InternalQueueMessage, error)
 		DeleteMessagesBefore(ctx context.Context, messageID int64) error
+		// PurgeMessages deletes the messages up to the last enqueued one and returns the number of deleted messages
+		PurgeMessages(ctx context.Context) (int64, error)
 		UpdateAckLevel(ctx context.Context, messageID int64, clusterName string) error
 		GetAckLevels(ctx context.Context) (map[string]int64, error)
 		EnqueueMessageToDLQ(ctx context.Context, messagePayload []byte) error
 		ReadMessagesFromDLQ(ctx context.Context, firstMessageID int64, lastMessageID int64, pageSize int, pageToken []byte) ([]*InternalQueueMessage, []byte, error)
+		GetDLQOldestMessageTimestamp(ctx context.Context) (time.Time, error)
 		DeleteMessageFromDLQ(ctx context.Context, messageID int64) error
 		RangeDeleteMessagesFromDLQ(ctx context.Context, firstMessageID int64, lastMessageID int64) error
 		UpdateDLQAckLevel(ctx context.Context, messageID int64, clusterName string) error
@@ -890,6 +896,7 @@ type (
 		Expiry                 time.Time
 		CreatedTime            time.Time
 		PartitionConfig        map[string]string
+		Priority               int32
 	}
 
 	// InternalCreateTasksInfo describes a task to be created in InternalCreateTasksRequest
@@ -964,6 +971,8 @@ func (d *DataBlob) GetEncoding() common.EncodingType {
 		return common.EncodingTypeJSON
 	case common.EncodingTypeThriftRW:
 		return common.EncodingTypeThriftRW
+	case common.EncodingTypeThriftRWSnappy:
+		return common.EncodingTypeThriftRWSnappy
 	case common.EncodingTypeEmpty:
 		return common.EncodingTypeEmpty
 	default:
@@ -971,21 +980,36 @@ func (d *DataBlob) GetEncoding() common.EncodingType {
 	}
 }
 
-// ToInternal convert data blob to internal representation
+// ToInternal convert data blob to internal representation, it panics if the blob can't be converted,
+// use ToInternalE for blobs which may be compressed or checksummed
 func (d *DataBlob) ToInternal() *types.DataBlob {
-	switch d.Encoding {
+	blob, err := d.ToInternalE()
+	if err != nil {
+		panic(err.Error())
+	}
+	return blob
+}
+
+// ToInternalE convert data blob to internal representation, compressed and checksummed blobs are unwrapped first.
+// An error is returned if the blob is corrupted or its encoding type is unsupported.
+func (d *DataBlob) ToInternalE() (*types.DataBlob, error) {
+	blob, err := UnwrapDataBlob(d)
+	if err != nil {
+		return nil, err
+	}
+	switch blob.Encoding {
 	case common.EncodingTypeJSON:
 		return &types.DataBlob{
 			EncodingType: types.EncodingTypeJSON.Ptr(),
-			Data:         d.Data,
-		}
+			Data:         blob.Data,
+		}, nil
 	case common.EncodingTypeThriftRW:
 		return &types.DataBlob{
 			EncodingType: types.EncodingTypeThriftRW.Ptr(),
-			Data:         d.Data,
-		}
+			Data:         blob.Data,
+		}, nil
 	default:
-		panic(fmt.Sprintf("DataBlob seeing unsupported enconding type: %v", d.Encoding))
+		return nil, NewCadenceDeserializationError(fmt.Sprintf("DataBlob seeing unsupported enconding type: %v", blob.Encoding))
 	}
 }
 
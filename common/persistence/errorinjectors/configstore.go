@@ -30,14 +30,22 @@ import (
 	"context"
 
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
 
 // injectorConfigStoreManager implements persistence.ConfigStoreManager interface instrumented with error injection.
 type injectorConfigStoreManager struct {
-	wrapped   persistence.ConfigStoreManager
-	errorRate float64
-	logger    log.Logger
+	wrapped          persistence.ConfigStoreManager
+	errorRate        float64
+	methodErrorRates map[string]float64
+	latency          LatencyDistribution
+	failingCalls     *failingCalls
+	logCalls         bool
+	storeErrorFirst  bool
+	faultProvider    FaultProvider
+	metricsClient    metrics.Client
+	logger           log.Logger
 }
 
 // NewConfigStoreManager creates a new instance of ConfigStoreManager with error injection.
@@ -45,44 +53,70 @@ func NewConfigStoreManager(
 	wrapped persistence.ConfigStoreManager,
 	errorRate float64,
 	logger log.Logger,
+	opts ...InjectorOption,
 ) persistence.ConfigStoreManager {
+	options := newInjectorOptions(opts)
 	return &injectorConfigStoreManager{
-		wrapped:   wrapped,
-		errorRate: errorRate,
-		logger:    logger,
+		wrapped:          wrapped,
+		errorRate:        errorRate,
+		methodErrorRates: options.methodErrorRates,
+		latency:          options.latency,
+		failingCalls:     options.failingCalls,
+		logCalls:         options.logCalls,
+		storeErrorFirst:  options.storeErrorFirst,
+		faultProvider:    options.faultProvider,
+		metricsClient:    options.metricsClient,
+		logger:           logger,
 	}
 }
 
+func (c *injectorConfigStoreManager) errorRateFor(methodName string) float64 {
+	if errorRate, ok := c.methodErrorRates[methodName]; ok {
+		return errorRate
+	}
+	return c.errorRate
+}
+
 func (c *injectorConfigStoreManager) Close() {
 	c.wrapped.Close()
 	return
 }
 
 func (c *injectorConfigStoreManager) FetchDynamicConfig(ctx context.Context, cfgType persistence.ConfigType) (fp1 *persistence.FetchDynamicConfigResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "FetchDynamicConfig", c.errorRateFor("FetchDynamicConfig"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ConfigStoreManager.FetchDynamicConfig")
 		fp1, err = c.wrapped.FetchDynamicConfig(ctx, cfgType)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ConfigStoreManager.FetchDynamicConfig", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ConfigStoreManager.FetchDynamicConfig", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ConfigStoreManager.FetchDynamicConfig", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorConfigStoreManager) UpdateDynamicConfig(ctx context.Context, request *persistence.UpdateDynamicConfigRequest, cfgType persistence.ConfigType) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "UpdateDynamicConfig", c.errorRateFor("UpdateDynamicConfig"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ConfigStoreManager.UpdateDynamicConfig")
 		err = c.wrapped.UpdateDynamicConfig(ctx, request, cfgType)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ConfigStoreManager.UpdateDynamicConfig", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ConfigStoreManager.UpdateDynamicConfig", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ConfigStoreManager.UpdateDynamicConfig", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
@@ -30,14 +30,22 @@ import (
 	"context"
 
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
 
 // injectorDomainManager implements persistence.DomainManager interface instrumented with error injection.
 type injectorDomainManager struct {
-	wrapped   persistence.DomainManager
-	errorRate float64
-	logger    log.Logger
+	wrapped          persistence.DomainManager
+	errorRate        float64
+	methodErrorRates map[string]float64
+	latency          LatencyDistribution
+	failingCalls     *failingCalls
+	logCalls         bool
+	storeErrorFirst  bool
+	faultProvider    FaultProvider
+	metricsClient    metrics.Client
+	logger           log.Logger
 }
 
 // NewDomainManager creates a new instance of DomainManager with error injection.
@@ -45,89 +53,130 @@ func NewDomainManager(
 	wrapped persistence.DomainManager,
 	errorRate float64,
 	logger log.Logger,
+	opts ...InjectorOption,
 ) persistence.DomainManager {
+	options := newInjectorOptions(opts)
 	return &injectorDomainManager{
-		wrapped:   wrapped,
-		errorRate: errorRate,
-		logger:    logger,
+		wrapped:          wrapped,
+		errorRate:        errorRate,
+		methodErrorRates: options.methodErrorRates,
+		latency:          options.latency,
+		failingCalls:     options.failingCalls,
+		logCalls:         options.logCalls,
+		storeErrorFirst:  options.storeErrorFirst,
+		faultProvider:    options.faultProvider,
+		metricsClient:    options.metricsClient,
+		logger:           logger,
 	}
 }
 
+func (c *injectorDomainManager) errorRateFor(methodName string) float64 {
+	if errorRate, ok := c.methodErrorRates[methodName]; ok {
+		return errorRate
+	}
+	return c.errorRate
+}
+
 func (c *injectorDomainManager) Close() {
 	c.wrapped.Close()
 	return
 }
 
 func (c *injectorDomainManager) CreateDomain(ctx context.Context, request *persistence.CreateDomainRequest) (cp1 *persistence.CreateDomainResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "CreateDomain", c.errorRateFor("CreateDomain"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "DomainManager.CreateDomain")
 		cp1, err = c.wrapped.CreateDomain(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "DomainManager.CreateDomain", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "DomainManager.CreateDomain", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "DomainManager.CreateDomain", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorDomainManager) DeleteDomain(ctx context.Context, request *persistence.DeleteDomainRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "DeleteDomain", c.errorRateFor("DeleteDomain"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "DomainManager.DeleteDomain")
 		err = c.wrapped.DeleteDomain(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "DomainManager.DeleteDomain", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "DomainManager.DeleteDomain", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "DomainManager.DeleteDomain", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorDomainManager) DeleteDomainByName(ctx context.Context, request *persistence.DeleteDomainByNameRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "DeleteDomainByName", c.errorRateFor("DeleteDomainByName"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "DomainManager.DeleteDomainByName")
 		err = c.wrapped.DeleteDomainByName(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "DomainManager.DeleteDomainByName", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "DomainManager.DeleteDomainByName", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "DomainManager.DeleteDomainByName", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorDomainManager) GetDomain(ctx context.Context, request *persistence.GetDomainRequest) (gp1 *persistence.GetDomainResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetDomain", c.errorRateFor("GetDomain"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "DomainManager.GetDomain")
 		gp1, err = c.wrapped.GetDomain(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "DomainManager.GetDomain", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "DomainManager.GetDomain", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "DomainManager.GetDomain", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorDomainManager) GetMetadata(ctx context.Context) (gp1 *persistence.GetMetadataResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetMetadata", c.errorRateFor("GetMetadata"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "DomainManager.GetMetadata")
 		gp1, err = c.wrapped.GetMetadata(ctx)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "DomainManager.GetMetadata", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "DomainManager.GetMetadata", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "DomainManager.GetMetadata", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
@@ -138,30 +187,40 @@ func (c *injectorDomainManager) GetName() (s1 string) {
 }
 
 func (c *injectorDomainManager) ListDomains(ctx context.Context, request *persistence.ListDomainsRequest) (lp1 *persistence.ListDomainsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ListDomains", c.errorRateFor("ListDomains"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "DomainManager.ListDomains")
 		lp1, err = c.wrapped.ListDomains(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "DomainManager.ListDomains", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "DomainManager.ListDomains", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "DomainManager.ListDomains", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorDomainManager) UpdateDomain(ctx context.Context, request *persistence.UpdateDomainRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "UpdateDomain", c.errorRateFor("UpdateDomain"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "DomainManager.UpdateDomain")
 		err = c.wrapped.UpdateDomain(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "DomainManager.UpdateDomain", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "DomainManager.UpdateDomain", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "DomainManager.UpdateDomain", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
@@ -30,14 +30,22 @@ import (
 	"context"
 
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
 
 // injectorExecutionManager implements persistence.ExecutionManager interface instrumented with error injection.
 type injectorExecutionManager struct {
-	wrapped   persistence.ExecutionManager
-	errorRate float64
-	logger    log.Logger
+	wrapped          persistence.ExecutionManager
+	errorRate        float64
+	methodErrorRates map[string]float64
+	latency          LatencyDistribution
+	failingCalls     *failingCalls
+	logCalls         bool
+	storeErrorFirst  bool
+	faultProvider    FaultProvider
+	metricsClient    metrics.Client
+	logger           log.Logger
 }
 
 // NewExecutionManager creates a new instance of ExecutionManager with error injection.
@@ -45,194 +53,270 @@ func NewExecutionManager(
 	wrapped persistence.ExecutionManager,
 	errorRate float64,
 	logger log.Logger,
+	opts ...InjectorOption,
 ) persistence.ExecutionManager {
+	options := newInjectorOptions(opts)
 	return &injectorExecutionManager{
-		wrapped:   wrapped,
-		errorRate: errorRate,
-		logger:    logger,
+		wrapped:          wrapped,
+		errorRate:        errorRate,
+		methodErrorRates: options.methodErrorRates,
+		latency:          options.latency,
+		failingCalls:     options.failingCalls,
+		logCalls:         options.logCalls,
+		storeErrorFirst:  options.storeErrorFirst,
+		faultProvider:    options.faultProvider,
+		metricsClient:    options.metricsClient,
+		logger:           logger,
 	}
 }
 
+func (c *injectorExecutionManager) errorRateFor(methodName string) float64 {
+	if errorRate, ok := c.methodErrorRates[methodName]; ok {
+		return errorRate
+	}
+	return c.errorRate
+}
+
 func (c *injectorExecutionManager) Close() {
 	c.wrapped.Close()
 	return
 }
 
 func (c *injectorExecutionManager) CompleteCrossClusterTask(ctx context.Context, request *persistence.CompleteCrossClusterTaskRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "CompleteCrossClusterTask", c.errorRateFor("CompleteCrossClusterTask"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.CompleteCrossClusterTask")
 		err = c.wrapped.CompleteCrossClusterTask(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.CompleteCrossClusterTask", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.CompleteCrossClusterTask", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.CompleteCrossClusterTask", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) CompleteReplicationTask(ctx context.Context, request *persistence.CompleteReplicationTaskRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "CompleteReplicationTask", c.errorRateFor("CompleteReplicationTask"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.CompleteReplicationTask")
 		err = c.wrapped.CompleteReplicationTask(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.CompleteReplicationTask", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.CompleteReplicationTask", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.CompleteReplicationTask", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) CompleteTimerTask(ctx context.Context, request *persistence.CompleteTimerTaskRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "CompleteTimerTask", c.errorRateFor("CompleteTimerTask"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.CompleteTimerTask")
 		err = c.wrapped.CompleteTimerTask(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.CompleteTimerTask", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.CompleteTimerTask", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.CompleteTimerTask", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) CompleteTransferTask(ctx context.Context, request *persistence.CompleteTransferTaskRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "CompleteTransferTask", c.errorRateFor("CompleteTransferTask"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.CompleteTransferTask")
 		err = c.wrapped.CompleteTransferTask(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.CompleteTransferTask", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.CompleteTransferTask", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.CompleteTransferTask", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) ConflictResolveWorkflowExecution(ctx context.Context, request *persistence.ConflictResolveWorkflowExecutionRequest) (cp1 *persistence.ConflictResolveWorkflowExecutionResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ConflictResolveWorkflowExecution", c.errorRateFor("ConflictResolveWorkflowExecution"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.ConflictResolveWorkflowExecution")
 		cp1, err = c.wrapped.ConflictResolveWorkflowExecution(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.ConflictResolveWorkflowExecution", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.ConflictResolveWorkflowExecution", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.ConflictResolveWorkflowExecution", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) CreateFailoverMarkerTasks(ctx context.Context, request *persistence.CreateFailoverMarkersRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "CreateFailoverMarkerTasks", c.errorRateFor("CreateFailoverMarkerTasks"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.CreateFailoverMarkerTasks")
 		err = c.wrapped.CreateFailoverMarkerTasks(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.CreateFailoverMarkerTasks", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.CreateFailoverMarkerTasks", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.CreateFailoverMarkerTasks", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) CreateWorkflowExecution(ctx context.Context, request *persistence.CreateWorkflowExecutionRequest) (cp1 *persistence.CreateWorkflowExecutionResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "CreateWorkflowExecution", c.errorRateFor("CreateWorkflowExecution"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.CreateWorkflowExecution")
 		cp1, err = c.wrapped.CreateWorkflowExecution(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.CreateWorkflowExecution", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.CreateWorkflowExecution", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.CreateWorkflowExecution", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) DeleteCurrentWorkflowExecution(ctx context.Context, request *persistence.DeleteCurrentWorkflowExecutionRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "DeleteCurrentWorkflowExecution", c.errorRateFor("DeleteCurrentWorkflowExecution"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.DeleteCurrentWorkflowExecution")
 		err = c.wrapped.DeleteCurrentWorkflowExecution(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.DeleteCurrentWorkflowExecution", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.DeleteCurrentWorkflowExecution", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.DeleteCurrentWorkflowExecution", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) DeleteReplicationTaskFromDLQ(ctx context.Context, request *persistence.DeleteReplicationTaskFromDLQRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "DeleteReplicationTaskFromDLQ", c.errorRateFor("DeleteReplicationTaskFromDLQ"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.DeleteReplicationTaskFromDLQ")
 		err = c.wrapped.DeleteReplicationTaskFromDLQ(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.DeleteReplicationTaskFromDLQ", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.DeleteReplicationTaskFromDLQ", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.DeleteReplicationTaskFromDLQ", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) DeleteWorkflowExecution(ctx context.Context, request *persistence.DeleteWorkflowExecutionRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "DeleteWorkflowExecution", c.errorRateFor("DeleteWorkflowExecution"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.DeleteWorkflowExecution")
 		err = c.wrapped.DeleteWorkflowExecution(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.DeleteWorkflowExecution", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.DeleteWorkflowExecution", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.DeleteWorkflowExecution", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) GetCrossClusterTasks(ctx context.Context, request *persistence.GetCrossClusterTasksRequest) (gp1 *persistence.GetCrossClusterTasksResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetCrossClusterTasks", c.errorRateFor("GetCrossClusterTasks"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.GetCrossClusterTasks")
 		gp1, err = c.wrapped.GetCrossClusterTasks(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.GetCrossClusterTasks", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.GetCrossClusterTasks", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.GetCrossClusterTasks", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) GetCurrentExecution(ctx context.Context, request *persistence.GetCurrentExecutionRequest) (gp1 *persistence.GetCurrentExecutionResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetCurrentExecution", c.errorRateFor("GetCurrentExecution"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.GetCurrentExecution")
 		gp1, err = c.wrapped.GetCurrentExecution(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.GetCurrentExecution", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.GetCurrentExecution", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.GetCurrentExecution", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
@@ -243,45 +327,60 @@ func (c *injectorExecutionManager) GetName() (s1 string) {
 }
 
 func (c *injectorExecutionManager) GetReplicationDLQSize(ctx context.Context, request *persistence.GetReplicationDLQSizeRequest) (gp1 *persistence.GetReplicationDLQSizeResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetReplicationDLQSize", c.errorRateFor("GetReplicationDLQSize"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.GetReplicationDLQSize")
 		gp1, err = c.wrapped.GetReplicationDLQSize(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.GetReplicationDLQSize", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.GetReplicationDLQSize", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.GetReplicationDLQSize", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) GetReplicationTasks(ctx context.Context, request *persistence.GetReplicationTasksRequest) (gp1 *persistence.GetReplicationTasksResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetReplicationTasks", c.errorRateFor("GetReplicationTasks"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.GetReplicationTasks")
 		gp1, err = c.wrapped.GetReplicationTasks(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.GetReplicationTasks", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.GetReplicationTasks", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.GetReplicationTasks", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) GetReplicationTasksFromDLQ(ctx context.Context, request *persistence.GetReplicationTasksFromDLQRequest) (gp1 *persistence.GetReplicationTasksFromDLQResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetReplicationTasksFromDLQ", c.errorRateFor("GetReplicationTasksFromDLQ"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.GetReplicationTasksFromDLQ")
 		gp1, err = c.wrapped.GetReplicationTasksFromDLQ(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.GetReplicationTasksFromDLQ", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.GetReplicationTasksFromDLQ", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.GetReplicationTasksFromDLQ", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
@@ -292,195 +391,260 @@ func (c *injectorExecutionManager) GetShardID() (i1 int) {
 }
 
 func (c *injectorExecutionManager) GetTimerIndexTasks(ctx context.Context, request *persistence.GetTimerIndexTasksRequest) (gp1 *persistence.GetTimerIndexTasksResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetTimerIndexTasks", c.errorRateFor("GetTimerIndexTasks"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.GetTimerIndexTasks")
 		gp1, err = c.wrapped.GetTimerIndexTasks(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.GetTimerIndexTasks", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.GetTimerIndexTasks", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.GetTimerIndexTasks", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) GetTransferTasks(ctx context.Context, request *persistence.GetTransferTasksRequest) (gp1 *persistence.GetTransferTasksResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetTransferTasks", c.errorRateFor("GetTransferTasks"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.GetTransferTasks")
 		gp1, err = c.wrapped.GetTransferTasks(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.GetTransferTasks", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.GetTransferTasks", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.GetTransferTasks", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) GetWorkflowExecution(ctx context.Context, request *persistence.GetWorkflowExecutionRequest) (gp1 *persistence.GetWorkflowExecutionResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetWorkflowExecution", c.errorRateFor("GetWorkflowExecution"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.GetWorkflowExecution")
 		gp1, err = c.wrapped.GetWorkflowExecution(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.GetWorkflowExecution", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.GetWorkflowExecution", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.GetWorkflowExecution", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) IsWorkflowExecutionExists(ctx context.Context, request *persistence.IsWorkflowExecutionExistsRequest) (ip1 *persistence.IsWorkflowExecutionExistsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "IsWorkflowExecutionExists", c.errorRateFor("IsWorkflowExecutionExists"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.IsWorkflowExecutionExists")
 		ip1, err = c.wrapped.IsWorkflowExecutionExists(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.IsWorkflowExecutionExists", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.IsWorkflowExecutionExists", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.IsWorkflowExecutionExists", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) ListConcreteExecutions(ctx context.Context, request *persistence.ListConcreteExecutionsRequest) (lp1 *persistence.ListConcreteExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ListConcreteExecutions", c.errorRateFor("ListConcreteExecutions"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.ListConcreteExecutions")
 		lp1, err = c.wrapped.ListConcreteExecutions(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.ListConcreteExecutions", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.ListConcreteExecutions", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.ListConcreteExecutions", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) ListCurrentExecutions(ctx context.Context, request *persistence.ListCurrentExecutionsRequest) (lp1 *persistence.ListCurrentExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ListCurrentExecutions", c.errorRateFor("ListCurrentExecutions"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.ListCurrentExecutions")
 		lp1, err = c.wrapped.ListCurrentExecutions(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.ListCurrentExecutions", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.ListCurrentExecutions", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.ListCurrentExecutions", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) PutReplicationTaskToDLQ(ctx context.Context, request *persistence.PutReplicationTaskToDLQRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "PutReplicationTaskToDLQ", c.errorRateFor("PutReplicationTaskToDLQ"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.PutReplicationTaskToDLQ")
 		err = c.wrapped.PutReplicationTaskToDLQ(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.PutReplicationTaskToDLQ", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.PutReplicationTaskToDLQ", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.PutReplicationTaskToDLQ", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) RangeCompleteCrossClusterTask(ctx context.Context, request *persistence.RangeCompleteCrossClusterTaskRequest) (rp1 *persistence.RangeCompleteCrossClusterTaskResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "RangeCompleteCrossClusterTask", c.errorRateFor("RangeCompleteCrossClusterTask"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.RangeCompleteCrossClusterTask")
 		rp1, err = c.wrapped.RangeCompleteCrossClusterTask(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.RangeCompleteCrossClusterTask", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.RangeCompleteCrossClusterTask", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.RangeCompleteCrossClusterTask", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) RangeCompleteReplicationTask(ctx context.Context, request *persistence.RangeCompleteReplicationTaskRequest) (rp1 *persistence.RangeCompleteReplicationTaskResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "RangeCompleteReplicationTask", c.errorRateFor("RangeCompleteReplicationTask"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.RangeCompleteReplicationTask")
 		rp1, err = c.wrapped.RangeCompleteReplicationTask(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.RangeCompleteReplicationTask", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.RangeCompleteReplicationTask", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.RangeCompleteReplicationTask", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) RangeCompleteTimerTask(ctx context.Context, request *persistence.RangeCompleteTimerTaskRequest) (rp1 *persistence.RangeCompleteTimerTaskResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "RangeCompleteTimerTask", c.errorRateFor("RangeCompleteTimerTask"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.RangeCompleteTimerTask")
 		rp1, err = c.wrapped.RangeCompleteTimerTask(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.RangeCompleteTimerTask", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.RangeCompleteTimerTask", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.RangeCompleteTimerTask", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) RangeCompleteTransferTask(ctx context.Context, request *persistence.RangeCompleteTransferTaskRequest) (rp1 *persistence.RangeCompleteTransferTaskResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "RangeCompleteTransferTask", c.errorRateFor("RangeCompleteTransferTask"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.RangeCompleteTransferTask")
 		rp1, err = c.wrapped.RangeCompleteTransferTask(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.RangeCompleteTransferTask", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.RangeCompleteTransferTask", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.RangeCompleteTransferTask", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) RangeDeleteReplicationTaskFromDLQ(ctx context.Context, request *persistence.RangeDeleteReplicationTaskFromDLQRequest) (rp1 *persistence.RangeDeleteReplicationTaskFromDLQResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "RangeDeleteReplicationTaskFromDLQ", c.errorRateFor("RangeDeleteReplicationTaskFromDLQ"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.RangeDeleteReplicationTaskFromDLQ")
 		rp1, err = c.wrapped.RangeDeleteReplicationTaskFromDLQ(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.RangeDeleteReplicationTaskFromDLQ", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.RangeDeleteReplicationTaskFromDLQ", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.RangeDeleteReplicationTaskFromDLQ", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorExecutionManager) UpdateWorkflowExecution(ctx context.Context, request *persistence.UpdateWorkflowExecutionRequest) (up1 *persistence.UpdateWorkflowExecutionResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "UpdateWorkflowExecution", c.errorRateFor("UpdateWorkflowExecution"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ExecutionManager.UpdateWorkflowExecution")
 		up1, err = c.wrapped.UpdateWorkflowExecution(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ExecutionManager.UpdateWorkflowExecution", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ExecutionManager.UpdateWorkflowExecution", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ExecutionManager.UpdateWorkflowExecution", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
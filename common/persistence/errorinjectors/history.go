@@ -30,14 +30,22 @@ import (
 	"context"
 
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
 
 // injectorHistoryManager implements persistence.HistoryManager interface instrumented with error injection.
 type injectorHistoryManager struct {
-	wrapped   persistence.HistoryManager
-	errorRate float64
-	logger    log.Logger
+	wrapped          persistence.HistoryManager
+	errorRate        float64
+	methodErrorRates map[string]float64
+	latency          LatencyDistribution
+	failingCalls     *failingCalls
+	logCalls         bool
+	storeErrorFirst  bool
+	faultProvider    FaultProvider
+	metricsClient    metrics.Client
+	logger           log.Logger
 }
 
 // NewHistoryManager creates a new instance of HistoryManager with error injection.
@@ -45,24 +53,45 @@ func NewHistoryManager(
 	wrapped persistence.HistoryManager,
 	errorRate float64,
 	logger log.Logger,
+	opts ...InjectorOption,
 ) persistence.HistoryManager {
+	options := newInjectorOptions(opts)
 	return &injectorHistoryManager{
-		wrapped:   wrapped,
-		errorRate: errorRate,
-		logger:    logger,
+		wrapped:          wrapped,
+		errorRate:        errorRate,
+		methodErrorRates: options.methodErrorRates,
+		latency:          options.latency,
+		failingCalls:     options.failingCalls,
+		logCalls:         options.logCalls,
+		storeErrorFirst:  options.storeErrorFirst,
+		faultProvider:    options.faultProvider,
+		metricsClient:    options.metricsClient,
+		logger:           logger,
 	}
 }
 
+func (c *injectorHistoryManager) errorRateFor(methodName string) float64 {
+	if errorRate, ok := c.methodErrorRates[methodName]; ok {
+		return errorRate
+	}
+	return c.errorRate
+}
+
 func (c *injectorHistoryManager) AppendHistoryNodes(ctx context.Context, request *persistence.AppendHistoryNodesRequest) (ap1 *persistence.AppendHistoryNodesResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "AppendHistoryNodes", c.errorRateFor("AppendHistoryNodes"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "HistoryManager.AppendHistoryNodes")
 		ap1, err = c.wrapped.AppendHistoryNodes(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "HistoryManager.AppendHistoryNodes", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "HistoryManager.AppendHistoryNodes", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "HistoryManager.AppendHistoryNodes", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
@@ -74,60 +103,80 @@ func (c *injectorHistoryManager) Close() {
 }
 
 func (c *injectorHistoryManager) DeleteHistoryBranch(ctx context.Context, request *persistence.DeleteHistoryBranchRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "DeleteHistoryBranch", c.errorRateFor("DeleteHistoryBranch"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "HistoryManager.DeleteHistoryBranch")
 		err = c.wrapped.DeleteHistoryBranch(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "HistoryManager.DeleteHistoryBranch", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "HistoryManager.DeleteHistoryBranch", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "HistoryManager.DeleteHistoryBranch", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorHistoryManager) ForkHistoryBranch(ctx context.Context, request *persistence.ForkHistoryBranchRequest) (fp1 *persistence.ForkHistoryBranchResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ForkHistoryBranch", c.errorRateFor("ForkHistoryBranch"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "HistoryManager.ForkHistoryBranch")
 		fp1, err = c.wrapped.ForkHistoryBranch(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "HistoryManager.ForkHistoryBranch", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "HistoryManager.ForkHistoryBranch", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "HistoryManager.ForkHistoryBranch", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorHistoryManager) GetAllHistoryTreeBranches(ctx context.Context, request *persistence.GetAllHistoryTreeBranchesRequest) (gp1 *persistence.GetAllHistoryTreeBranchesResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetAllHistoryTreeBranches", c.errorRateFor("GetAllHistoryTreeBranches"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "HistoryManager.GetAllHistoryTreeBranches")
 		gp1, err = c.wrapped.GetAllHistoryTreeBranches(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "HistoryManager.GetAllHistoryTreeBranches", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "HistoryManager.GetAllHistoryTreeBranches", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "HistoryManager.GetAllHistoryTreeBranches", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorHistoryManager) GetHistoryTree(ctx context.Context, request *persistence.GetHistoryTreeRequest) (gp1 *persistence.GetHistoryTreeResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetHistoryTree", c.errorRateFor("GetHistoryTree"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "HistoryManager.GetHistoryTree")
 		gp1, err = c.wrapped.GetHistoryTree(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "HistoryManager.GetHistoryTree", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "HistoryManager.GetHistoryTree", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "HistoryManager.GetHistoryTree", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
@@ -138,45 +187,60 @@ func (c *injectorHistoryManager) GetName() (s1 string) {
 }
 
 func (c *injectorHistoryManager) ReadHistoryBranch(ctx context.Context, request *persistence.ReadHistoryBranchRequest) (rp1 *persistence.ReadHistoryBranchResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ReadHistoryBranch", c.errorRateFor("ReadHistoryBranch"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "HistoryManager.ReadHistoryBranch")
 		rp1, err = c.wrapped.ReadHistoryBranch(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "HistoryManager.ReadHistoryBranch", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "HistoryManager.ReadHistoryBranch", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "HistoryManager.ReadHistoryBranch", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorHistoryManager) ReadHistoryBranchByBatch(ctx context.Context, request *persistence.ReadHistoryBranchRequest) (rp1 *persistence.ReadHistoryBranchByBatchResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ReadHistoryBranchByBatch", c.errorRateFor("ReadHistoryBranchByBatch"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "HistoryManager.ReadHistoryBranchByBatch")
 		rp1, err = c.wrapped.ReadHistoryBranchByBatch(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "HistoryManager.ReadHistoryBranchByBatch", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "HistoryManager.ReadHistoryBranchByBatch", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "HistoryManager.ReadHistoryBranchByBatch", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorHistoryManager) ReadRawHistoryBranch(ctx context.Context, request *persistence.ReadHistoryBranchRequest) (rp1 *persistence.ReadRawHistoryBranchResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ReadRawHistoryBranch", c.errorRateFor("ReadRawHistoryBranch"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "HistoryManager.ReadRawHistoryBranch")
 		rp1, err = c.wrapped.ReadRawHistoryBranch(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "HistoryManager.ReadRawHistoryBranch", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "HistoryManager.ReadRawHistoryBranch", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "HistoryManager.ReadRawHistoryBranch", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
@@ -27,15 +27,22 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
 
+	"github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
 )
 
 var _staticMethods = map[string]bool{
@@ -88,7 +95,7 @@ func TestInjectorsWithoutErrors(t *testing.T) {
 
 func TestInjectorsWith100ErrorRate(t *testing.T) {
 	oldRandomStubFunc := _randomStubFunc
-	_randomStubFunc = func() bool {
+	_randomStubFunc = func(FaultProvider) bool {
 		return false
 	}
 	defer func() { _randomStubFunc = oldRandomStubFunc }()
@@ -169,6 +176,318 @@ func TestInjectorsWithUnderlyingErrors(t *testing.T) {
 	}
 }
 
+func TestInjectorsCountMaskedStoreErrors(t *testing.T) {
+	oldRandomStubFunc := _randomStubFunc
+	_randomStubFunc = func(FaultProvider) bool {
+		return true
+	}
+	defer func() { _randomStubFunc = oldRandomStubFunc }()
+
+	ctrl := gomock.NewController(t)
+	mocked := persistence.NewMockQueueManager(ctrl)
+	storeErr := fmt.Errorf("real store error")
+	forwardedCalls := int64(0)
+	mocked.EXPECT().GetDLQSize(gomock.Any()).Do(func(ctx context.Context) {
+		forwardedCalls++
+	}).Return(int64(0), storeErr).AnyTimes()
+
+	scope := tally.NewTestScope("test", nil)
+	// We cannot use test logger here, since logger.Error will fail the test.
+	injector := NewQueueManager(mocked, 1, loggerimpl.NewNopLogger(), WithMetricsClient(metrics.NewClient(scope, metrics.History)))
+	for i := 0; i < 100; i++ {
+		_, err := injector.GetDLQSize(context.Background())
+		require.True(t, isFakeError(err), "expected fake error, got %v", err)
+	}
+
+	require.NotZero(t, forwardedCalls)
+	assert.Equal(t, forwardedCalls, maskedStoreErrors(scope, "QueueManager.GetDLQSize"))
+}
+
+func TestInjectorsWithStoreErrorPrecedence(t *testing.T) {
+	oldRandomStubFunc := _randomStubFunc
+	_randomStubFunc = func(FaultProvider) bool {
+		return true
+	}
+	defer func() { _randomStubFunc = oldRandomStubFunc }()
+
+	ctrl := gomock.NewController(t)
+	mocked := persistence.NewMockQueueManager(ctrl)
+	storeErr := fmt.Errorf("real store error")
+	mocked.EXPECT().GetDLQSize(gomock.Any()).Return(int64(0), storeErr).AnyTimes()
+	mocked.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	scope := tally.NewTestScope("test", nil)
+	// We cannot use test logger here, since logger.Error will fail the test.
+	injector := NewQueueManager(mocked, 1, loggerimpl.NewNopLogger(), WithMetricsClient(metrics.NewClient(scope, metrics.History)), WithStoreErrorPrecedence())
+	for i := 0; i < 100; i++ {
+		// the call is either failed with a fake error without being forwarded, or forwarded and fails with the store error
+		_, err := injector.GetDLQSize(context.Background())
+		require.True(t, err == storeErr || isFakeError(err), "unexpected error %v", err)
+		assert.False(t, err == ErrFakeTimeout || err == errors.ErrFakeUnhandled, "fake error %v of a forwarded call masked the store error", err)
+
+		// fake errors are returned if the forwarded call succeeded
+		err = injector.EnqueueMessage(context.Background(), nil)
+		require.True(t, isFakeError(err), "expected fake error, got %v", err)
+	}
+	assert.Zero(t, maskedStoreErrors(scope, "QueueManager.GetDLQSize"))
+}
+
+func TestInjectorsWithSeededFaultProvider(t *testing.T) {
+	injectedErrors := func(seed int64) []error {
+		ctrl := gomock.NewController(t)
+		mocked := persistence.NewMockQueueManager(ctrl)
+		mocked.EXPECT().GetDLQSize(gomock.Any()).Return(int64(0), nil).AnyTimes()
+
+		// We cannot use test logger here, since logger.Error will fail the test.
+		injector := NewQueueManager(mocked, 0.5, loggerimpl.NewNopLogger(), WithFaultProvider(NewFaultProvider(seed)))
+		var errs []error
+		for i := 0; i < 100; i++ {
+			_, err := injector.GetDLQSize(context.Background())
+			errs = append(errs, err)
+		}
+		return errs
+	}
+
+	errs := injectedErrors(42)
+	assert.Equal(t, errs, injectedErrors(42))
+	assert.NotEqual(t, errs, injectedErrors(43))
+}
+
+func TestInjectorsWithoutFaultProvider(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mocked := persistence.NewMockQueueManager(ctrl)
+	mocked.EXPECT().GetDLQSize(gomock.Any()).Return(int64(0), nil).AnyTimes()
+
+	// We cannot use test logger here, since logger.Error will fail the test.
+	injector := NewQueueManager(mocked, 1, loggerimpl.NewNopLogger(), WithFaultProvider(nil))
+	assert.NotPanics(t, func() {
+		_, err := injector.GetDLQSize(context.Background())
+		assert.True(t, isFakeError(err), "expected fake error, got %v", err)
+	})
+}
+
+func TestInjectorsWithMethodErrorRates(t *testing.T) {
+	oldRandomStubFunc := _randomStubFunc
+	_randomStubFunc = func(FaultProvider) bool {
+		return false
+	}
+	defer func() { _randomStubFunc = oldRandomStubFunc }()
+
+	ctrl := gomock.NewController(t)
+	mocked := persistence.NewMockQueueManager(ctrl)
+	mocked.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).Return(nil).Times(10)
+
+	// We cannot use test logger here, since logger.Error will fail the test.
+	injector := NewQueueManager(mocked, 0, loggerimpl.NewNopLogger(), WithMethodErrorRates(map[string]float64{"ReadMessagesFromDLQ": 1}))
+	for i := 0; i < 10; i++ {
+		_, _, err := injector.ReadMessagesFromDLQ(context.Background(), 0, 10, 10, nil)
+		assert.True(t, isFakeError(err), "expected fake error, got %v", err)
+
+		assert.NoError(t, injector.EnqueueMessage(context.Background(), nil))
+	}
+}
+
+func TestInjectorsWithFirstCallsFailing(t *testing.T) {
+	transientErr := &types.ServiceBusyError{Message: "transient"}
+	outageErr := &types.InternalServiceError{Message: "outage"}
+	messages := []*persistence.QueueMessage{{ID: 1}}
+
+	ctrl := gomock.NewController(t)
+	mocked := persistence.NewMockQueueManager(ctrl)
+	mocked.EXPECT().ReadMessages(gomock.Any(), gomock.Any(), gomock.Any()).Return(messages, nil).Times(2)
+	mocked.EXPECT().GetDLQSize(gomock.Any()).Return(int64(1), nil).Times(2)
+	mocked.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	// We cannot use test logger here, since logger.Error will fail the test.
+	injector := NewQueueManager(mocked, 0, loggerimpl.NewNopLogger(),
+		WithFirstCallsFailing(1, outageErr),
+		WithMethodFirstCallsFailing("ReadMessages", 3, transientErr),
+		WithMethodFirstCallsFailing("EnqueueMessage", 0, transientErr),
+	)
+
+	for i := 0; i < 3; i++ {
+		_, err := injector.ReadMessages(context.Background(), 0, 10)
+		assert.Equal(t, transientErr, err, "call %v", i)
+	}
+	for i := 0; i < 2; i++ {
+		result, err := injector.ReadMessages(context.Background(), 0, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, messages, result)
+	}
+
+	_, err := injector.GetDLQSize(context.Background())
+	assert.Equal(t, outageErr, err)
+	for i := 0; i < 2; i++ {
+		_, err = injector.GetDLQSize(context.Background())
+		assert.NoError(t, err)
+	}
+
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, injector.EnqueueMessage(context.Background(), nil))
+	}
+}
+
+func TestInjectorsWithCallLogging(t *testing.T) {
+	storeErr := fmt.Errorf("real store error")
+	ctrl := gomock.NewController(t)
+	mocked := persistence.NewMockQueueManager(ctrl)
+	mocked.EXPECT().GetDLQSize(gomock.Any()).Return(int64(1), nil).Times(1)
+	mocked.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).Return(storeErr).Times(1)
+
+	core, logs := observer.New(zap.DebugLevel)
+	injector := NewQueueManager(mocked, 0, loggerimpl.NewLogger(zap.New(core)), WithCallLogging())
+	_, err := injector.GetDLQSize(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, storeErr, injector.EnqueueMessage(context.Background(), nil))
+
+	entries := logs.AllUntimed()
+	require.Len(t, entries, 2)
+	for i, operation := range []string{"get-dlq-size", "enqueue-message"} {
+		assert.Equal(t, zap.DebugLevel, entries[i].Level)
+		assert.Equal(t, msgForwardedCall, entries[i].Message)
+		fields := entries[i].ContextMap()
+		assert.Equal(t, operation, fields["store-operation"])
+		assert.Contains(t, fields, "store-latency")
+	}
+	assert.NotContains(t, entries[0].ContextMap(), "error")
+	assert.Equal(t, storeErr.Error(), entries[1].ContextMap()["error"])
+
+	// calls are not logged by default
+	mocked.EXPECT().GetDLQSize(gomock.Any()).Return(int64(1), nil).Times(1)
+	core, logs = observer.New(zap.DebugLevel)
+	injector = NewQueueManager(mocked, 0, loggerimpl.NewLogger(zap.New(core)))
+	_, err = injector.GetDLQSize(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, logs.Len())
+}
+
+func TestInjectorsWithInjectedErrors(t *testing.T) {
+	serviceBusyErr := &types.ServiceBusyError{Message: "service busy"}
+	shardOwnershipLostErr := &types.ShardOwnershipLostError{Message: "shard ownership lost"}
+	unusedErr := &types.InternalServiceError{Message: "unused"}
+
+	ctrl := gomock.NewController(t)
+	mocked := persistence.NewMockQueueManager(ctrl)
+
+	faultProvider := NewFaultProvider(0, WithInjectedErrors(
+		WeightedError{Err: serviceBusyErr, Weight: 3},
+		WeightedError{Err: shardOwnershipLostErr, Weight: 1},
+		WeightedError{Err: unusedErr, Weight: 0},
+	))
+	// We cannot use test logger here, since logger.Error will fail the test.
+	injector := NewQueueManager(mocked, 1, loggerimpl.NewNopLogger(), WithFaultProvider(faultProvider))
+	counts := make(map[error]int)
+	for i := 0; i < 1000; i++ {
+		_, err := injector.ReadMessages(context.Background(), 0, 10)
+		counts[err]++
+	}
+
+	assert.Len(t, counts, 2)
+	assert.Greater(t, counts[serviceBusyErr], counts[shardOwnershipLostErr])
+	assert.NotZero(t, counts[shardOwnershipLostErr])
+}
+
+func TestWeightedErrorsByName(t *testing.T) {
+	weightedErrors, err := WeightedErrorsByName(map[string]int{
+		"Timeout":            1,
+		"ShardOwnershipLost": 2,
+		"ServiceBusy":        3,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []WeightedError{
+		{Err: errors.ErrFakeServiceBusy, Weight: 3},
+		{Err: ErrFakeShardOwnershipLost, Weight: 2},
+		{Err: ErrFakeTimeout, Weight: 1},
+	}, weightedErrors)
+
+	weightedErrors, err = WeightedErrorsByName(nil)
+	require.NoError(t, err)
+	assert.Empty(t, weightedErrors)
+
+	_, err = WeightedErrorsByName(map[string]int{"Timeout": 1, "Unknown": 1})
+	assert.Error(t, err)
+}
+
+func TestInjectorsWithInjectedLatency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mocked := persistence.NewMockQueueManager(ctrl)
+	mocked.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	latency := 10 * time.Millisecond
+	injector := NewQueueManager(mocked, 0, testlogger.New(t), WithInjectedLatency(FixedLatency(latency)))
+
+	start := time.Now()
+	require.NoError(t, injector.EnqueueMessage(context.Background(), nil))
+	assert.GreaterOrEqual(t, time.Since(start), latency)
+
+	// the call is not forwarded if the context expires during the injected latency
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	err := injector.EnqueueMessage(ctx, nil)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestLatencyDistributions(t *testing.T) {
+	faultProvider := NewFaultProvider(0)
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, time.Second, FixedLatency(time.Second)(faultProvider))
+
+		uniform := UniformLatency(time.Second, 2*time.Second)(faultProvider)
+		assert.GreaterOrEqual(t, uniform, time.Second)
+		assert.Less(t, uniform, 2*time.Second)
+
+		assert.GreaterOrEqual(t, ExponentialLatency(time.Second)(faultProvider), time.Duration(0))
+	}
+	assert.Equal(t, time.Second, UniformLatency(time.Second, time.Second)(faultProvider))
+}
+
+func TestInjectorsEmitMetrics(t *testing.T) {
+	oldRandomStubFunc := _randomStubFunc
+	_randomStubFunc = func(FaultProvider) bool {
+		return true
+	}
+	defer func() { _randomStubFunc = oldRandomStubFunc }()
+
+	ctrl := gomock.NewController(t)
+	mocked := persistence.NewMockQueueManager(ctrl)
+	mocked.EXPECT().EnqueueMessage(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mocked.EXPECT().GetDLQSize(gomock.Any()).Return(int64(0), nil).AnyTimes()
+
+	scope := tally.NewTestScope("test", nil)
+	faultProvider := NewFaultProvider(0, WithInjectedErrors(WeightedError{Err: ErrFakeTimeout, Weight: 1}))
+	// We cannot use test logger here, since logger.Error will fail the test.
+	injector := NewQueueManager(mocked, 0, loggerimpl.NewNopLogger(),
+		WithFaultProvider(faultProvider),
+		WithMetricsClient(metrics.NewClient(scope, metrics.History)),
+		WithMethodErrorRates(map[string]float64{"GetDLQSize": 1}),
+	)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, injector.EnqueueMessage(context.Background(), nil))
+		_, err := injector.GetDLQSize(context.Background())
+		require.Equal(t, ErrFakeTimeout, err)
+	}
+
+	counts := make(map[string]int64)
+	for _, counter := range scope.Snapshot().Counters() {
+		if counter.Name() == "test.persistence_error_injection_requests" {
+			counts[counter.Tags()["persistence_method"]+":"+counter.Tags()["error_injection_result"]] = counter.Value()
+		}
+	}
+	assert.Equal(t, map[string]int64{
+		"QueueManager.EnqueueMessage:forwarded":      3,
+		"QueueManager.GetDLQSize:injected_forwarded": 3,
+	}, counts)
+}
+
+func maskedStoreErrors(scope tally.TestScope, objectMethod string) int64 {
+	for _, counter := range scope.Snapshot().Counters() {
+		if counter.Name() == "test.persistence_error_injection_masked_store_errors" && counter.Tags()["persistence_method"] == objectMethod {
+			return counter.Value()
+		}
+	}
+	return 0
+}
+
 func builderForPassThrough(t *testing.T, injector any, errorRate float64, logger log.Logger, expectCalls bool, expectedErr error) (object any) {
 	ctrl := gomock.NewController(t)
 	switch injector.(type) {
@@ -213,12 +532,19 @@ func builderForPassThrough(t *testing.T, injector any, errorRate float64, logger
 			mocked.EXPECT().UpdateAckLevel(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedErr)
 			mocked.EXPECT().GetAckLevels(gomock.Any()).Return(map[string]int64{}, expectedErr)
 			mocked.EXPECT().DeleteMessagesBefore(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().DeleteDLQMessagesWhere(gomock.Any(), gomock.Any()).Return(0, expectedErr)
 			mocked.EXPECT().DeleteMessageFromDLQ(gomock.Any(), gomock.Any()).Return(expectedErr)
 			mocked.EXPECT().EnqueueMessageToDLQ(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().EnqueueMessageWithDedup(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), expectedErr)
 			mocked.EXPECT().GetDLQAckLevels(gomock.Any()).Return(map[string]int64{}, expectedErr)
+			mocked.EXPECT().GetDLQOldestMessageTimestamp(gomock.Any()).Return(time.Time{}, expectedErr)
 			mocked.EXPECT().GetDLQSize(gomock.Any()).Return(int64(0), expectedErr)
+			mocked.EXPECT().GetMessage(gomock.Any(), gomock.Any()).Return(&persistence.QueueMessage{}, expectedErr)
+			mocked.EXPECT().PeekDLQMessages(gomock.Any(), gomock.Any()).Return([]*persistence.QueueMessage{}, expectedErr)
+			mocked.EXPECT().PurgeQueue(gomock.Any(), gomock.Any()).Return(int64(0), expectedErr)
 			mocked.EXPECT().RangeDeleteMessagesFromDLQ(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedErr)
 			mocked.EXPECT().ReadMessagesFromDLQ(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*persistence.QueueMessage{}, nil, expectedErr)
+			mocked.EXPECT().ReadMessagesReverse(gomock.Any(), gomock.Any()).Return([]*persistence.QueueMessage{}, expectedErr)
 			mocked.EXPECT().UpdateDLQAckLevel(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedErr)
 		}
 	case *injectorShardManager:
@@ -241,6 +567,7 @@ func builderForPassThrough(t *testing.T, injector any, errorRate float64, logger
 			mocked.EXPECT().GetOrphanTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetOrphanTasksResponse{}, expectedErr)
 			mocked.EXPECT().GetTaskListSize(gomock.Any(), gomock.Any()).Return(&persistence.GetTaskListSizeResponse{}, expectedErr)
 			mocked.EXPECT().LeaseTaskList(gomock.Any(), gomock.Any()).Return(&persistence.LeaseTaskListResponse{}, expectedErr)
+			mocked.EXPECT().GetTaskList(gomock.Any(), gomock.Any()).Return(&persistence.GetTaskListResponse{}, expectedErr)
 			mocked.EXPECT().ListTaskList(gomock.Any(), gomock.Any()).Return(&persistence.ListTaskListResponse{}, expectedErr)
 			mocked.EXPECT().UpdateTaskList(gomock.Any(), gomock.Any()).Return(&persistence.UpdateTaskListResponse{}, expectedErr)
 		}
@@ -28,16 +28,25 @@ package errorinjectors
 
 import (
 	"context"
+	"time"
 
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
 
 // injectorQueueManager implements persistence.QueueManager interface instrumented with error injection.
 type injectorQueueManager struct {
-	wrapped   persistence.QueueManager
-	errorRate float64
-	logger    log.Logger
+	wrapped          persistence.QueueManager
+	errorRate        float64
+	methodErrorRates map[string]float64
+	latency          LatencyDistribution
+	failingCalls     *failingCalls
+	logCalls         bool
+	storeErrorFirst  bool
+	faultProvider    FaultProvider
+	metricsClient    metrics.Client
+	logger           log.Logger
 }
 
 // NewQueueManager creates a new instance of QueueManager with error injection.
@@ -45,194 +54,410 @@ func NewQueueManager(
 	wrapped persistence.QueueManager,
 	errorRate float64,
 	logger log.Logger,
+	opts ...InjectorOption,
 ) persistence.QueueManager {
+	options := newInjectorOptions(opts)
 	return &injectorQueueManager{
-		wrapped:   wrapped,
-		errorRate: errorRate,
-		logger:    logger,
+		wrapped:          wrapped,
+		errorRate:        errorRate,
+		methodErrorRates: options.methodErrorRates,
+		latency:          options.latency,
+		failingCalls:     options.failingCalls,
+		logCalls:         options.logCalls,
+		storeErrorFirst:  options.storeErrorFirst,
+		faultProvider:    options.faultProvider,
+		metricsClient:    options.metricsClient,
+		logger:           logger,
 	}
 }
 
+func (c *injectorQueueManager) errorRateFor(methodName string) float64 {
+	if errorRate, ok := c.methodErrorRates[methodName]; ok {
+		return errorRate
+	}
+	return c.errorRate
+}
+
 func (c *injectorQueueManager) Close() {
 	c.wrapped.Close()
 	return
 }
 
+func (c *injectorQueueManager) DeleteDLQMessagesWhere(ctx context.Context, predicate func(*persistence.QueueMessage) bool) (i1 int, err error) {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "DeleteDLQMessagesWhere", c.errorRateFor("DeleteDLQMessagesWhere"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.DeleteDLQMessagesWhere")
+		i1, err = c.wrapped.DeleteDLQMessagesWhere(ctx, predicate)
+		endCall(err)
+	}
+
+	emitMetrics(c.metricsClient, "QueueManager.DeleteDLQMessagesWhere", fakeErr, forwardCall)
+	if fakeErr != nil {
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.DeleteDLQMessagesWhere", fakeErr, forwardCall, err, c.storeErrorFirst)
+		return
+	}
+	return
+}
+
 func (c *injectorQueueManager) DeleteMessageFromDLQ(ctx context.Context, messageID int64) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "DeleteMessageFromDLQ", c.errorRateFor("DeleteMessageFromDLQ"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.DeleteMessageFromDLQ")
 		err = c.wrapped.DeleteMessageFromDLQ(ctx, messageID)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "QueueManager.DeleteMessageFromDLQ", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "QueueManager.DeleteMessageFromDLQ", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.DeleteMessageFromDLQ", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorQueueManager) DeleteMessagesBefore(ctx context.Context, messageID int64) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "DeleteMessagesBefore", c.errorRateFor("DeleteMessagesBefore"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.DeleteMessagesBefore")
 		err = c.wrapped.DeleteMessagesBefore(ctx, messageID)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "QueueManager.DeleteMessagesBefore", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "QueueManager.DeleteMessagesBefore", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.DeleteMessagesBefore", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorQueueManager) EnqueueMessage(ctx context.Context, messagePayload []byte) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "EnqueueMessage", c.errorRateFor("EnqueueMessage"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.EnqueueMessage")
 		err = c.wrapped.EnqueueMessage(ctx, messagePayload)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "QueueManager.EnqueueMessage", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "QueueManager.EnqueueMessage", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.EnqueueMessage", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorQueueManager) EnqueueMessageToDLQ(ctx context.Context, messagePayload []byte) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "EnqueueMessageToDLQ", c.errorRateFor("EnqueueMessageToDLQ"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.EnqueueMessageToDLQ")
 		err = c.wrapped.EnqueueMessageToDLQ(ctx, messagePayload)
+		endCall(err)
+	}
+
+	emitMetrics(c.metricsClient, "QueueManager.EnqueueMessageToDLQ", fakeErr, forwardCall)
+	if fakeErr != nil {
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.EnqueueMessageToDLQ", fakeErr, forwardCall, err, c.storeErrorFirst)
+		return
+	}
+	return
+}
+
+func (c *injectorQueueManager) EnqueueMessageWithDedup(ctx context.Context, messagePayload []byte, dedupKey string) (i1 int64, err error) {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "EnqueueMessageWithDedup", c.errorRateFor("EnqueueMessageWithDedup"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.EnqueueMessageWithDedup")
+		i1, err = c.wrapped.EnqueueMessageWithDedup(ctx, messagePayload, dedupKey)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "QueueManager.EnqueueMessageWithDedup", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "QueueManager.EnqueueMessageToDLQ", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.EnqueueMessageWithDedup", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorQueueManager) GetAckLevels(ctx context.Context) (m1 map[string]int64, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetAckLevels", c.errorRateFor("GetAckLevels"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.GetAckLevels")
 		m1, err = c.wrapped.GetAckLevels(ctx)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "QueueManager.GetAckLevels", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "QueueManager.GetAckLevels", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.GetAckLevels", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorQueueManager) GetDLQAckLevels(ctx context.Context) (m1 map[string]int64, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetDLQAckLevels", c.errorRateFor("GetDLQAckLevels"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.GetDLQAckLevels")
 		m1, err = c.wrapped.GetDLQAckLevels(ctx)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "QueueManager.GetDLQAckLevels", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "QueueManager.GetDLQAckLevels", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.GetDLQAckLevels", fakeErr, forwardCall, err, c.storeErrorFirst)
+		return
+	}
+	return
+}
+
+func (c *injectorQueueManager) GetDLQOldestMessageTimestamp(ctx context.Context) (t1 time.Time, err error) {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetDLQOldestMessageTimestamp", c.errorRateFor("GetDLQOldestMessageTimestamp"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.GetDLQOldestMessageTimestamp")
+		t1, err = c.wrapped.GetDLQOldestMessageTimestamp(ctx)
+		endCall(err)
+	}
+
+	emitMetrics(c.metricsClient, "QueueManager.GetDLQOldestMessageTimestamp", fakeErr, forwardCall)
+	if fakeErr != nil {
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.GetDLQOldestMessageTimestamp", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorQueueManager) GetDLQSize(ctx context.Context) (i1 int64, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetDLQSize", c.errorRateFor("GetDLQSize"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.GetDLQSize")
 		i1, err = c.wrapped.GetDLQSize(ctx)
+		endCall(err)
+	}
+
+	emitMetrics(c.metricsClient, "QueueManager.GetDLQSize", fakeErr, forwardCall)
+	if fakeErr != nil {
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.GetDLQSize", fakeErr, forwardCall, err, c.storeErrorFirst)
+		return
+	}
+	return
+}
+
+func (c *injectorQueueManager) GetMessage(ctx context.Context, messageID int64) (qp1 *persistence.QueueMessage, err error) {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetMessage", c.errorRateFor("GetMessage"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.GetMessage")
+		qp1, err = c.wrapped.GetMessage(ctx, messageID)
+		endCall(err)
+	}
+
+	emitMetrics(c.metricsClient, "QueueManager.GetMessage", fakeErr, forwardCall)
+	if fakeErr != nil {
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.GetMessage", fakeErr, forwardCall, err, c.storeErrorFirst)
+		return
+	}
+	return
+}
+
+func (c *injectorQueueManager) PeekDLQMessages(ctx context.Context, maxCount int) (qpa1 []*persistence.QueueMessage, err error) {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "PeekDLQMessages", c.errorRateFor("PeekDLQMessages"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.PeekDLQMessages")
+		qpa1, err = c.wrapped.PeekDLQMessages(ctx, maxCount)
+		endCall(err)
+	}
+
+	emitMetrics(c.metricsClient, "QueueManager.PeekDLQMessages", fakeErr, forwardCall)
+	if fakeErr != nil {
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.PeekDLQMessages", fakeErr, forwardCall, err, c.storeErrorFirst)
+		return
+	}
+	return
+}
+
+func (c *injectorQueueManager) PurgeQueue(ctx context.Context, confirm string) (i1 int64, err error) {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "PurgeQueue", c.errorRateFor("PurgeQueue"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.PurgeQueue")
+		i1, err = c.wrapped.PurgeQueue(ctx, confirm)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "QueueManager.PurgeQueue", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "QueueManager.GetDLQSize", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.PurgeQueue", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorQueueManager) RangeDeleteMessagesFromDLQ(ctx context.Context, firstMessageID int64, lastMessageID int64) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "RangeDeleteMessagesFromDLQ", c.errorRateFor("RangeDeleteMessagesFromDLQ"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.RangeDeleteMessagesFromDLQ")
 		err = c.wrapped.RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "QueueManager.RangeDeleteMessagesFromDLQ", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "QueueManager.RangeDeleteMessagesFromDLQ", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.RangeDeleteMessagesFromDLQ", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorQueueManager) ReadMessages(ctx context.Context, lastMessageID int64, maxCount int) (qpa1 []*persistence.QueueMessage, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ReadMessages", c.errorRateFor("ReadMessages"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.ReadMessages")
 		qpa1, err = c.wrapped.ReadMessages(ctx, lastMessageID, maxCount)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "QueueManager.ReadMessages", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "QueueManager.ReadMessages", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.ReadMessages", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorQueueManager) ReadMessagesFromDLQ(ctx context.Context, firstMessageID int64, lastMessageID int64, pageSize int, pageToken []byte) (qpa1 []*persistence.QueueMessage, ba1 []byte, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ReadMessagesFromDLQ", c.errorRateFor("ReadMessagesFromDLQ"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.ReadMessagesFromDLQ")
 		qpa1, ba1, err = c.wrapped.ReadMessagesFromDLQ(ctx, firstMessageID, lastMessageID, pageSize, pageToken)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "QueueManager.ReadMessagesFromDLQ", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "QueueManager.ReadMessagesFromDLQ", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.ReadMessagesFromDLQ", fakeErr, forwardCall, err, c.storeErrorFirst)
+		return
+	}
+	return
+}
+
+func (c *injectorQueueManager) ReadMessagesReverse(ctx context.Context, maxCount int) (qpa1 []*persistence.QueueMessage, err error) {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ReadMessagesReverse", c.errorRateFor("ReadMessagesReverse"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.ReadMessagesReverse")
+		qpa1, err = c.wrapped.ReadMessagesReverse(ctx, maxCount)
+		endCall(err)
+	}
+
+	emitMetrics(c.metricsClient, "QueueManager.ReadMessagesReverse", fakeErr, forwardCall)
+	if fakeErr != nil {
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.ReadMessagesReverse", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorQueueManager) UpdateAckLevel(ctx context.Context, messageID int64, clusterName string) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "UpdateAckLevel", c.errorRateFor("UpdateAckLevel"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.UpdateAckLevel")
 		err = c.wrapped.UpdateAckLevel(ctx, messageID, clusterName)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "QueueManager.UpdateAckLevel", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "QueueManager.UpdateAckLevel", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.UpdateAckLevel", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorQueueManager) UpdateDLQAckLevel(ctx context.Context, messageID int64, clusterName string) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "UpdateDLQAckLevel", c.errorRateFor("UpdateDLQAckLevel"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "QueueManager.UpdateDLQAckLevel")
 		err = c.wrapped.UpdateDLQAckLevel(ctx, messageID, clusterName)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "QueueManager.UpdateDLQAckLevel", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "QueueManager.UpdateDLQAckLevel", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "QueueManager.UpdateDLQAckLevel", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
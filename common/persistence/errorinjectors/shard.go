@@ -30,14 +30,22 @@ import (
 	"context"
 
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
 
 // injectorShardManager implements persistence.ShardManager interface instrumented with error injection.
 type injectorShardManager struct {
-	wrapped   persistence.ShardManager
-	errorRate float64
-	logger    log.Logger
+	wrapped          persistence.ShardManager
+	errorRate        float64
+	methodErrorRates map[string]float64
+	latency          LatencyDistribution
+	failingCalls     *failingCalls
+	logCalls         bool
+	storeErrorFirst  bool
+	faultProvider    FaultProvider
+	metricsClient    metrics.Client
+	logger           log.Logger
 }
 
 // NewShardManager creates a new instance of ShardManager with error injection.
@@ -45,29 +53,50 @@ func NewShardManager(
 	wrapped persistence.ShardManager,
 	errorRate float64,
 	logger log.Logger,
+	opts ...InjectorOption,
 ) persistence.ShardManager {
+	options := newInjectorOptions(opts)
 	return &injectorShardManager{
-		wrapped:   wrapped,
-		errorRate: errorRate,
-		logger:    logger,
+		wrapped:          wrapped,
+		errorRate:        errorRate,
+		methodErrorRates: options.methodErrorRates,
+		latency:          options.latency,
+		failingCalls:     options.failingCalls,
+		logCalls:         options.logCalls,
+		storeErrorFirst:  options.storeErrorFirst,
+		faultProvider:    options.faultProvider,
+		metricsClient:    options.metricsClient,
+		logger:           logger,
 	}
 }
 
+func (c *injectorShardManager) errorRateFor(methodName string) float64 {
+	if errorRate, ok := c.methodErrorRates[methodName]; ok {
+		return errorRate
+	}
+	return c.errorRate
+}
+
 func (c *injectorShardManager) Close() {
 	c.wrapped.Close()
 	return
 }
 
 func (c *injectorShardManager) CreateShard(ctx context.Context, request *persistence.CreateShardRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "CreateShard", c.errorRateFor("CreateShard"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ShardManager.CreateShard")
 		err = c.wrapped.CreateShard(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ShardManager.CreateShard", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ShardManager.CreateShard", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ShardManager.CreateShard", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
@@ -78,30 +107,40 @@ func (c *injectorShardManager) GetName() (s1 string) {
 }
 
 func (c *injectorShardManager) GetShard(ctx context.Context, request *persistence.GetShardRequest) (gp1 *persistence.GetShardResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetShard", c.errorRateFor("GetShard"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ShardManager.GetShard")
 		gp1, err = c.wrapped.GetShard(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ShardManager.GetShard", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ShardManager.GetShard", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ShardManager.GetShard", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorShardManager) UpdateShard(ctx context.Context, request *persistence.UpdateShardRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "UpdateShard", c.errorRateFor("UpdateShard"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "ShardManager.UpdateShard")
 		err = c.wrapped.UpdateShard(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "ShardManager.UpdateShard", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "ShardManager.UpdateShard", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "ShardManager.UpdateShard", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
@@ -30,14 +30,22 @@ import (
 	"context"
 
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
 
 // injectorTaskManager implements persistence.TaskManager interface instrumented with error injection.
 type injectorTaskManager struct {
-	wrapped   persistence.TaskManager
-	errorRate float64
-	logger    log.Logger
+	wrapped          persistence.TaskManager
+	errorRate        float64
+	methodErrorRates map[string]float64
+	latency          LatencyDistribution
+	failingCalls     *failingCalls
+	logCalls         bool
+	storeErrorFirst  bool
+	faultProvider    FaultProvider
+	metricsClient    metrics.Client
+	logger           log.Logger
 }
 
 // NewTaskManager creates a new instance of TaskManager with error injection.
@@ -45,74 +53,110 @@ func NewTaskManager(
 	wrapped persistence.TaskManager,
 	errorRate float64,
 	logger log.Logger,
+	opts ...InjectorOption,
 ) persistence.TaskManager {
+	options := newInjectorOptions(opts)
 	return &injectorTaskManager{
-		wrapped:   wrapped,
-		errorRate: errorRate,
-		logger:    logger,
+		wrapped:          wrapped,
+		errorRate:        errorRate,
+		methodErrorRates: options.methodErrorRates,
+		latency:          options.latency,
+		failingCalls:     options.failingCalls,
+		logCalls:         options.logCalls,
+		storeErrorFirst:  options.storeErrorFirst,
+		faultProvider:    options.faultProvider,
+		metricsClient:    options.metricsClient,
+		logger:           logger,
 	}
 }
 
+func (c *injectorTaskManager) errorRateFor(methodName string) float64 {
+	if errorRate, ok := c.methodErrorRates[methodName]; ok {
+		return errorRate
+	}
+	return c.errorRate
+}
+
 func (c *injectorTaskManager) Close() {
 	c.wrapped.Close()
 	return
 }
 
 func (c *injectorTaskManager) CompleteTask(ctx context.Context, request *persistence.CompleteTaskRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "CompleteTask", c.errorRateFor("CompleteTask"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "TaskManager.CompleteTask")
 		err = c.wrapped.CompleteTask(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "TaskManager.CompleteTask", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "TaskManager.CompleteTask", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "TaskManager.CompleteTask", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorTaskManager) CompleteTasksLessThan(ctx context.Context, request *persistence.CompleteTasksLessThanRequest) (cp1 *persistence.CompleteTasksLessThanResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "CompleteTasksLessThan", c.errorRateFor("CompleteTasksLessThan"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "TaskManager.CompleteTasksLessThan")
 		cp1, err = c.wrapped.CompleteTasksLessThan(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "TaskManager.CompleteTasksLessThan", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "TaskManager.CompleteTasksLessThan", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "TaskManager.CompleteTasksLessThan", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorTaskManager) CreateTasks(ctx context.Context, request *persistence.CreateTasksRequest) (cp1 *persistence.CreateTasksResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "CreateTasks", c.errorRateFor("CreateTasks"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "TaskManager.CreateTasks")
 		cp1, err = c.wrapped.CreateTasks(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "TaskManager.CreateTasks", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "TaskManager.CreateTasks", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "TaskManager.CreateTasks", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorTaskManager) DeleteTaskList(ctx context.Context, request *persistence.DeleteTaskListRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "DeleteTaskList", c.errorRateFor("DeleteTaskList"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "TaskManager.DeleteTaskList")
 		err = c.wrapped.DeleteTaskList(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "TaskManager.DeleteTaskList", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "TaskManager.DeleteTaskList", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "TaskManager.DeleteTaskList", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
@@ -123,90 +167,140 @@ func (c *injectorTaskManager) GetName() (s1 string) {
 }
 
 func (c *injectorTaskManager) GetOrphanTasks(ctx context.Context, request *persistence.GetOrphanTasksRequest) (gp1 *persistence.GetOrphanTasksResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetOrphanTasks", c.errorRateFor("GetOrphanTasks"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "TaskManager.GetOrphanTasks")
 		gp1, err = c.wrapped.GetOrphanTasks(ctx, request)
+		endCall(err)
+	}
+
+	emitMetrics(c.metricsClient, "TaskManager.GetOrphanTasks", fakeErr, forwardCall)
+	if fakeErr != nil {
+		err = resolveFakeError(c.metricsClient, c.logger, "TaskManager.GetOrphanTasks", fakeErr, forwardCall, err, c.storeErrorFirst)
+		return
+	}
+	return
+}
+
+func (c *injectorTaskManager) GetTaskList(ctx context.Context, request *persistence.GetTaskListRequest) (gp1 *persistence.GetTaskListResponse, err error) {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
 	}
 
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetTaskList", c.errorRateFor("GetTaskList"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "TaskManager.GetTaskList")
+		gp1, err = c.wrapped.GetTaskList(ctx, request)
+		endCall(err)
+	}
+
+	emitMetrics(c.metricsClient, "TaskManager.GetTaskList", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "TaskManager.GetOrphanTasks", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "TaskManager.GetTaskList", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorTaskManager) GetTaskListSize(ctx context.Context, request *persistence.GetTaskListSizeRequest) (gp1 *persistence.GetTaskListSizeResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetTaskListSize", c.errorRateFor("GetTaskListSize"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "TaskManager.GetTaskListSize")
 		gp1, err = c.wrapped.GetTaskListSize(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "TaskManager.GetTaskListSize", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "TaskManager.GetTaskListSize", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "TaskManager.GetTaskListSize", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorTaskManager) GetTasks(ctx context.Context, request *persistence.GetTasksRequest) (gp1 *persistence.GetTasksResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetTasks", c.errorRateFor("GetTasks"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "TaskManager.GetTasks")
 		gp1, err = c.wrapped.GetTasks(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "TaskManager.GetTasks", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "TaskManager.GetTasks", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "TaskManager.GetTasks", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorTaskManager) LeaseTaskList(ctx context.Context, request *persistence.LeaseTaskListRequest) (lp1 *persistence.LeaseTaskListResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "LeaseTaskList", c.errorRateFor("LeaseTaskList"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "TaskManager.LeaseTaskList")
 		lp1, err = c.wrapped.LeaseTaskList(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "TaskManager.LeaseTaskList", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "TaskManager.LeaseTaskList", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "TaskManager.LeaseTaskList", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorTaskManager) ListTaskList(ctx context.Context, request *persistence.ListTaskListRequest) (lp1 *persistence.ListTaskListResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ListTaskList", c.errorRateFor("ListTaskList"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "TaskManager.ListTaskList")
 		lp1, err = c.wrapped.ListTaskList(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "TaskManager.ListTaskList", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "TaskManager.ListTaskList", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "TaskManager.ListTaskList", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorTaskManager) UpdateTaskList(ctx context.Context, request *persistence.UpdateTaskListRequest) (up1 *persistence.UpdateTaskListResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "UpdateTaskList", c.errorRateFor("UpdateTaskList"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "TaskManager.UpdateTaskList")
 		up1, err = c.wrapped.UpdateTaskList(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "TaskManager.UpdateTaskList", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "TaskManager.UpdateTaskList", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "TaskManager.UpdateTaskList", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
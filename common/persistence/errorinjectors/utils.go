@@ -23,43 +23,221 @@
 package errorinjectors
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"math/rand"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/uber/cadence/common/errors"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
 
+type (
+	// FaultProvider is the source of randomness deciding which calls get a fake error injected.
+	// Injectors sharing a FaultProvider created with NewFaultProvider and a fixed seed
+	// inject a deterministic sequence of errors for a given sequence of calls.
+	FaultProvider interface {
+		// Float64 returns a pseudo-random number in [0.0,1.0)
+		Float64() float64
+		// Intn returns a pseudo-random number in [0,n)
+		Intn(n int) int
+	}
+
+	// WeightedError is an error injected with a probability proportional to its weight
+	WeightedError struct {
+		Err    error
+		Weight int
+	}
+
+	// FaultProviderOption is used to customize the FaultProvider
+	FaultProviderOption func(*lockedFaultProvider)
+
+	// LatencyDistribution samples the latency injected before forwarding a call using the FaultProvider
+	LatencyDistribution func(faultProvider FaultProvider) time.Duration
+
+	// fakeErrorChooser is implemented by fault providers choosing the injected errors
+	fakeErrorChooser interface {
+		chooseFakeError() error
+	}
+
+	// randomFaultProvider is the FaultProvider of injectors created without one, backed by the global math/rand source
+	randomFaultProvider struct{}
+
+	// lockedFaultProvider is a FaultProvider safe for concurrent use
+	lockedFaultProvider struct {
+		sync.Mutex
+		rand *rand.Rand
+		// injectedErrors replaces the default fake errors if not empty
+		injectedErrors []WeightedError
+		totalWeight    int
+	}
+)
+
+// WithInjectedErrors makes the injectors using the FaultProvider inject the given errors, each chosen
+// with a probability proportional to its weight, instead of the default fake errors.
+// Errors with a weight <= 0 are never injected.
+func WithInjectedErrors(injectedErrors ...WeightedError) FaultProviderOption {
+	return func(p *lockedFaultProvider) {
+		for _, injectedErr := range injectedErrors {
+			if injectedErr.Weight > 0 {
+				p.injectedErrors = append(p.injectedErrors, injectedErr)
+				p.totalWeight += injectedErr.Weight
+			}
+		}
+	}
+}
+
+// WeightedErrorsByName returns the errors to inject with WithInjectedErrors given their weights keyed by name,
+// ordered by name so that a seeded FaultProvider injects the same errors in every run
+func WeightedErrorsByName(weights map[string]int) ([]WeightedError, error) {
+	names := make([]string, 0, len(weights))
+	for name := range weights {
+		if _, ok := namedErrors[name]; !ok {
+			return nil, fmt.Errorf("unknown injected error %q", name)
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	weightedErrors := make([]WeightedError, 0, len(names))
+	for _, name := range names {
+		weightedErrors = append(weightedErrors, WeightedError{Err: namedErrors[name], Weight: weights[name]})
+	}
+	return weightedErrors, nil
+}
+
+// FixedLatency returns a distribution which always samples the given latency
+func FixedLatency(latency time.Duration) LatencyDistribution {
+	return func(FaultProvider) time.Duration {
+		return latency
+	}
+}
+
+// UniformLatency returns a distribution which samples latencies uniformly in [min, max)
+func UniformLatency(min, max time.Duration) LatencyDistribution {
+	return func(faultProvider FaultProvider) time.Duration {
+		if max <= min {
+			return min
+		}
+		return min + time.Duration(faultProvider.Float64()*float64(max-min))
+	}
+}
+
+// ExponentialLatency returns a distribution which samples exponentially distributed latencies with the given mean
+func ExponentialLatency(mean time.Duration) LatencyDistribution {
+	return func(faultProvider FaultProvider) time.Duration {
+		return time.Duration(-math.Log(1-faultProvider.Float64()) * float64(mean))
+	}
+}
+
+// NewFaultProvider returns a FaultProvider safe for concurrent use, seeded with the given seed
+func NewFaultProvider(seed int64, opts ...FaultProviderOption) FaultProvider {
+	p := &lockedFaultProvider{rand: rand.New(rand.NewSource(seed))}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+func (randomFaultProvider) Float64() float64 {
+	return rand.Float64()
+}
+
+func (randomFaultProvider) Intn(n int) int {
+	return rand.Intn(n)
+}
+
+func (p *lockedFaultProvider) Float64() float64 {
+	p.Lock()
+	defer p.Unlock()
+	return p.rand.Float64()
+}
+
+func (p *lockedFaultProvider) Intn(n int) int {
+	p.Lock()
+	defer p.Unlock()
+	return p.rand.Intn(n)
+}
+
+func (p *lockedFaultProvider) chooseFakeError() error {
+	if p.totalWeight == 0 {
+		return fakeErrors[p.Intn(len(fakeErrors))]
+	}
+
+	n := p.Intn(p.totalWeight)
+	for _, injectedErr := range p.injectedErrors {
+		if n < injectedErr.Weight {
+			return injectedErr.Err
+		}
+		n -= injectedErr.Weight
+	}
+	return nil
+}
+
 // _randomStubFunc is a stub randomized function that could be overriden in tests.
 // It introduces randomness to timeout and unhandled errors, to mimic retriable db isues.
-var _randomStubFunc = func() bool {
+var _randomStubFunc = func(faultProvider FaultProvider) bool {
 	// forward the call with 50% chance
-	return rand.Intn(2) == 0
+	return faultProvider.Intn(2) == 0
 }
 
 func shouldForwardCallToPersistence(
 	err error,
+	faultProvider FaultProvider,
 ) bool {
 	if err == nil {
 		return true
 	}
 
 	if err == ErrFakeTimeout || err == errors.ErrFakeUnhandled {
-		return _randomStubFunc()
+		return _randomStubFunc(faultProvider)
 	}
 
 	return false
 }
 
+// injectLatency delays the call by a latency sampled from the distribution using the fault provider,
+// and returns the context error if the context expires before the delay elapsed
+func injectLatency(
+	ctx context.Context,
+	distribution LatencyDistribution,
+	faultProvider FaultProvider,
+) error {
+	if distribution == nil {
+		return nil
+	}
+	latency := distribution(faultProvider)
+	if latency <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(latency)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 func generateFakeError(
 	errorRate float64,
+	faultProvider FaultProvider,
 ) error {
-	randFl := rand.Float64()
+	randFl := faultProvider.Float64()
 	if randFl < errorRate {
-		return fakeErrors[rand.Intn(len(fakeErrors))]
+		if chooser, ok := faultProvider.(fakeErrorChooser); ok {
+			return chooser.chooseFakeError()
+		}
+		return fakeErrors[faultProvider.Intn(len(fakeErrors))]
 	}
 
 	return nil
@@ -68,6 +246,22 @@ func generateFakeError(
 var (
 	// ErrFakeTimeout is a fake persistence timeout error.
 	ErrFakeTimeout = &persistence.TimeoutError{Msg: "Fake Persistence Timeout Error."}
+	// ErrFakeShardOwnershipLost is a fake persistence shard ownership lost error.
+	ErrFakeShardOwnershipLost = &persistence.ShardOwnershipLostError{Msg: "Fake Persistence Shard Ownership Lost Error."}
+	// ErrFakeConditionFailed is a fake persistence condition failed error.
+	ErrFakeConditionFailed = &persistence.ConditionFailedError{Msg: "Fake Persistence Condition Failed Error."}
+)
+
+var (
+	// namedErrors are the errors which can be injected by name
+	namedErrors = map[string]error{
+		"ServiceBusy":        errors.ErrFakeServiceBusy,
+		"InternalService":    errors.ErrFakeInternalService,
+		"Timeout":            ErrFakeTimeout,
+		"Unhandled":          errors.ErrFakeUnhandled,
+		"ShardOwnershipLost": ErrFakeShardOwnershipLost,
+		"ConditionFailed":    ErrFakeConditionFailed,
+	}
 )
 
 var (
@@ -90,10 +284,83 @@ func isFakeError(err error) bool {
 }
 
 const (
-	msgInjectedFakeErr = "Injected fake persistence error"
+	// errorInjectionResultForwarded is the result of calls forwarded to persistence without an injected error
+	errorInjectionResultForwarded = "forwarded"
+	// errorInjectionResultInjected is the result of calls failed with an injected error without being forwarded
+	errorInjectionResultInjected = "injected"
+	// errorInjectionResultInjectedForwarded is the result of calls forwarded to persistence whose result
+	// was replaced by an injected error
+	errorInjectionResultInjectedForwarded = "injected_forwarded"
+)
+
+const (
+	msgInjectedFakeErr               = "Injected fake persistence error"
+	msgInjectedFakeErrMaskedStoreErr = "Injected fake persistence error masked a real persistence error"
+	msgInjectedFakeErrOverridden     = "Injected fake persistence error was overridden by a real persistence error"
+	msgForwardedCall                 = "Forwarded persistence call"
 )
 
-func logErr(logger log.Logger, objectMethod string, fakeErr error, forwardCall bool, err error) {
+// emitMetrics counts the call to the persistence method by whether an error was injected and the call forwarded
+func emitMetrics(metricsClient metrics.Client, objectMethod string, fakeErr error, forwardCall bool) {
+	if metricsClient == nil {
+		return
+	}
+
+	result := errorInjectionResultForwarded
+	if fakeErr != nil {
+		result = errorInjectionResultInjected
+		if forwardCall {
+			result = errorInjectionResultInjectedForwarded
+		}
+	}
+	metricsClient.Scope(
+		metrics.PersistenceErrorInjectionScope,
+		metrics.PersistenceMethodTag(objectMethod),
+		metrics.ErrorInjectionResultTag(result),
+	).IncCounter(metrics.PersistenceErrorInjectionRequests)
+}
+
+// resolveFakeError logs the error injected into the call and returns the error the call fails with,
+// which is the real persistence error if storeErrorFirst is enabled and the forwarded call failed
+func resolveFakeError(
+	metricsClient metrics.Client,
+	logger log.Logger,
+	objectMethod string,
+	fakeErr error,
+	forwardCall bool,
+	err error,
+	storeErrorFirst bool,
+) error {
+	if storeErrorFirst && forwardCall && err != nil {
+		logger.Error(msgInjectedFakeErrOverridden,
+			getOperationFromMethodName(objectMethod),
+			tag.Error(fakeErr),
+			tag.StoreError(err),
+		)
+		return err
+	}
+
+	logErr(metricsClient, logger, objectMethod, fakeErr, forwardCall, err)
+	return fakeErr
+}
+
+func logErr(metricsClient metrics.Client, logger log.Logger, objectMethod string, fakeErr error, forwardCall bool, err error) {
+	if forwardCall && err != nil {
+		// the call would have failed anyway, so the injected error doesn't exercise a new failure
+		if metricsClient != nil {
+			metricsClient.Scope(
+				metrics.PersistenceErrorInjectionScope,
+				metrics.PersistenceMethodTag(objectMethod),
+			).IncCounter(metrics.PersistenceErrorInjectionMaskedStoreErrors)
+		}
+		logger.Error(msgInjectedFakeErrMaskedStoreErr,
+			getOperationFromMethodName(objectMethod),
+			tag.Error(fakeErr),
+			tag.StoreError(err),
+		)
+		return
+	}
+
 	logger.Error(msgInjectedFakeErr,
 		getOperationFromMethodName(objectMethod),
 		tag.Error(fakeErr),
@@ -102,6 +369,26 @@ func logErr(logger log.Logger, objectMethod string, fakeErr error, forwardCall b
 	)
 }
 
+// startForwardedCall returns the function to call with the result of a call forwarded to persistence,
+// which logs the call if logCalls is enabled
+func startForwardedCall(logCalls bool, logger log.Logger, objectMethod string) func(err error) {
+	if !logCalls {
+		return func(error) {}
+	}
+
+	start := time.Now()
+	return func(err error) {
+		tags := []tag.Tag{
+			getOperationFromMethodName(objectMethod),
+			tag.StoreLatency(time.Since(start)),
+		}
+		if err != nil {
+			tags = append(tags, tag.StoreError(err))
+		}
+		logger.Debug(msgForwardedCall, tags...)
+	}
+}
+
 func getOperationFromMethodName(op string) tag.Tag {
 	var t *tag.Tag
 	switch {
@@ -300,6 +587,8 @@ func taskManagerTags(op string) *tag.Tag {
 	switch op {
 	case "TaskManager.LeaseTaskList":
 		return &tag.StoreOperationLeaseTaskList
+	case "TaskManager.GetTaskList":
+		return &tag.StoreOperationGetTaskList
 	case "TaskManager.UpdateTaskList":
 		return &tag.StoreOperationUpdateTaskList
 	case "TaskManager.CreateTasks":
@@ -326,10 +615,14 @@ func queueManagerTags(op string) *tag.Tag {
 	switch op {
 	case "QueueManager.EnqueueMessage":
 		return &tag.StoreOperationEnqueueMessage
+	case "QueueManager.EnqueueMessageWithDedup":
+		return &tag.StoreOperationEnqueueMessageWithDedup
 	case "QueueManager.EnqueueMessageToDLQ":
 		return &tag.StoreOperationEnqueueMessageToDLQ
 	case "QueueManager.DeleteMessageFromDLQ":
 		return &tag.StoreOperationDeleteMessageFromDLQ
+	case "QueueManager.DeleteDLQMessagesWhere":
+		return &tag.StoreOperationDeleteDLQMessagesWhere
 	case "QueueManager.RangeDeleteMessagesFromDLQ":
 		return &tag.StoreOperationRangeDeleteMessagesFromDLQ
 	case "QueueManager.UpdateAckLevel":
@@ -342,12 +635,172 @@ func queueManagerTags(op string) *tag.Tag {
 		return &tag.StoreOperationGetDLQAckLevels
 	case "QueueManager.GetDLQSize":
 		return &tag.StoreOperationGetDLQSize
+	case "QueueManager.GetDLQOldestMessageTimestamp":
+		return &tag.StoreOperationGetDLQOldestMessageTime
 	case "QueueManager.DeleteMessagesBefore":
 		return &tag.StoreOperationDeleteMessagesBefore
+	case "QueueManager.PurgeQueue":
+		return &tag.StoreOperationPurgeQueue
 	case "QueueManager.ReadMessages":
 		return &tag.StoreOperationReadMessages
 	case "QueueManager.ReadMessagesFromDLQ":
 		return &tag.StoreOperationReadMessagesFromDLQ
+	case "QueueManager.ReadMessagesReverse":
+		return &tag.StoreOperationReadMessagesReverse
+	case "QueueManager.PeekDLQMessages":
+		return &tag.StoreOperationPeekDLQMessages
+	case "QueueManager.GetMessage":
+		return &tag.StoreOperationGetMessage
 	}
 	return nil
 }
+
+type (
+	// InjectorOption is used to customize the error injectors
+	InjectorOption func(*injectorOptions)
+
+	injectorOptions struct {
+		faultProvider    FaultProvider
+		metricsClient    metrics.Client
+		methodErrorRates map[string]float64
+		latency          LatencyDistribution
+		failingCalls     *failingCalls
+		logCalls         bool
+		storeErrorFirst  bool
+	}
+
+	// failingCalls deterministically fails the first calls of methods with an error, independently of the error rate
+	failingCalls struct {
+		sync.Mutex
+		defaultRule failingCallsRule
+		methodRules map[string]failingCallsRule
+		// calls is the number of calls of each method so far
+		calls map[string]int
+	}
+
+	failingCallsRule struct {
+		n   int
+		err error
+	}
+)
+
+// WithFaultProvider makes the injector decide which calls get a fake error injected using the given FaultProvider,
+// injectors without one use the global math/rand source
+func WithFaultProvider(faultProvider FaultProvider) InjectorOption {
+	return func(o *injectorOptions) {
+		if faultProvider != nil {
+			o.faultProvider = faultProvider
+		}
+	}
+}
+
+// WithMetricsClient makes the injector count the calls to each method by whether an error was injected
+// and the call forwarded to persistence
+func WithMetricsClient(metricsClient metrics.Client) InjectorOption {
+	return func(o *injectorOptions) {
+		o.metricsClient = metricsClient
+	}
+}
+
+// WithMethodErrorRates overrides the error rate of the injector for the methods it contains, keyed by method name
+func WithMethodErrorRates(methodErrorRates map[string]float64) InjectorOption {
+	return func(o *injectorOptions) {
+		o.methodErrorRates = methodErrorRates
+	}
+}
+
+// WithInjectedLatency makes the injector delay every call by a latency sampled from the distribution
+// before forwarding it. If the context of the call expires during the delay, the call is not forwarded
+// and the context error is returned.
+func WithInjectedLatency(latency LatencyDistribution) InjectorOption {
+	return func(o *injectorOptions) {
+		o.latency = latency
+	}
+}
+
+// WithFirstCallsFailing makes the first n calls of every method of the injector fail with err without being
+// forwarded to persistence, and the subsequent calls pass through with the configured error rate.
+// Calls are counted per method, so that the injector simulates a short outage followed by a recovery.
+func WithFirstCallsFailing(n int, err error) InjectorOption {
+	return func(o *injectorOptions) {
+		o.getFailingCalls().defaultRule = failingCallsRule{n: n, err: err}
+	}
+}
+
+// WithMethodFirstCallsFailing is the same as WithFirstCallsFailing for a single method, keyed by method name,
+// it overrides WithFirstCallsFailing for the method
+func WithMethodFirstCallsFailing(methodName string, n int, err error) InjectorOption {
+	return func(o *injectorOptions) {
+		o.getFailingCalls().methodRules[methodName] = failingCallsRule{n: n, err: err}
+	}
+}
+
+// WithCallLogging makes the injector log every call forwarded to persistence at debug level with its method,
+// latency and error, including calls without an injected error, so that the injector can be used to trace
+// persistence calls with an error rate of 0
+func WithCallLogging() InjectorOption {
+	return func(o *injectorOptions) {
+		o.logCalls = true
+	}
+}
+
+// WithStoreErrorPrecedence makes the injector return the real error of a call forwarded to persistence
+// instead of the injected error when both occurred, the injected error is only logged. Chaos runs with this
+// option don't mask genuine persistence errors with fake ones.
+func WithStoreErrorPrecedence() InjectorOption {
+	return func(o *injectorOptions) {
+		o.storeErrorFirst = true
+	}
+}
+
+func newInjectorOptions(opts []InjectorOption) *injectorOptions {
+	o := &injectorOptions{faultProvider: randomFaultProvider{}}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+func (o *injectorOptions) getFailingCalls() *failingCalls {
+	if o.failingCalls == nil {
+		o.failingCalls = &failingCalls{
+			methodRules: make(map[string]failingCallsRule),
+			calls:       make(map[string]int),
+		}
+	}
+	return o.failingCalls
+}
+
+// next counts a call of the method and returns the error it must fail with, or nil if it passes through
+func (f *failingCalls) next(methodName string) error {
+	if f == nil {
+		return nil
+	}
+
+	f.Lock()
+	defer f.Unlock()
+	rule, ok := f.methodRules[methodName]
+	if !ok {
+		rule = f.defaultRule
+	}
+	if f.calls[methodName] >= rule.n {
+		return nil
+	}
+	f.calls[methodName]++
+	return rule.err
+}
+
+// injectFakeError returns whether the call to the method is forwarded to persistence and the error injected
+// into it, if any. Calls failed by failingCalls are never forwarded.
+func injectFakeError(
+	failingCalls *failingCalls,
+	methodName string,
+	errorRate float64,
+	faultProvider FaultProvider,
+) (bool, error) {
+	if err := failingCalls.next(methodName); err != nil {
+		return false, err
+	}
+	fakeErr := generateFakeError(errorRate, faultProvider)
+	return shouldForwardCallToPersistence(fakeErr, faultProvider), fakeErr
+}
@@ -30,14 +30,22 @@ import (
 	"context"
 
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 )
 
 // injectorVisibilityManager implements persistence.VisibilityManager interface instrumented with error injection.
 type injectorVisibilityManager struct {
-	wrapped   persistence.VisibilityManager
-	errorRate float64
-	logger    log.Logger
+	wrapped          persistence.VisibilityManager
+	errorRate        float64
+	methodErrorRates map[string]float64
+	latency          LatencyDistribution
+	failingCalls     *failingCalls
+	logCalls         bool
+	storeErrorFirst  bool
+	faultProvider    FaultProvider
+	metricsClient    metrics.Client
+	logger           log.Logger
 }
 
 // NewVisibilityManager creates a new instance of VisibilityManager with error injection.
@@ -45,74 +53,110 @@ func NewVisibilityManager(
 	wrapped persistence.VisibilityManager,
 	errorRate float64,
 	logger log.Logger,
+	opts ...InjectorOption,
 ) persistence.VisibilityManager {
+	options := newInjectorOptions(opts)
 	return &injectorVisibilityManager{
-		wrapped:   wrapped,
-		errorRate: errorRate,
-		logger:    logger,
+		wrapped:          wrapped,
+		errorRate:        errorRate,
+		methodErrorRates: options.methodErrorRates,
+		latency:          options.latency,
+		failingCalls:     options.failingCalls,
+		logCalls:         options.logCalls,
+		storeErrorFirst:  options.storeErrorFirst,
+		faultProvider:    options.faultProvider,
+		metricsClient:    options.metricsClient,
+		logger:           logger,
 	}
 }
 
+func (c *injectorVisibilityManager) errorRateFor(methodName string) float64 {
+	if errorRate, ok := c.methodErrorRates[methodName]; ok {
+		return errorRate
+	}
+	return c.errorRate
+}
+
 func (c *injectorVisibilityManager) Close() {
 	c.wrapped.Close()
 	return
 }
 
 func (c *injectorVisibilityManager) CountWorkflowExecutions(ctx context.Context, request *persistence.CountWorkflowExecutionsRequest) (cp1 *persistence.CountWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "CountWorkflowExecutions", c.errorRateFor("CountWorkflowExecutions"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "VisibilityManager.CountWorkflowExecutions")
 		cp1, err = c.wrapped.CountWorkflowExecutions(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "VisibilityManager.CountWorkflowExecutions", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "VisibilityManager.CountWorkflowExecutions", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "VisibilityManager.CountWorkflowExecutions", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorVisibilityManager) DeleteUninitializedWorkflowExecution(ctx context.Context, request *persistence.VisibilityDeleteWorkflowExecutionRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "DeleteUninitializedWorkflowExecution", c.errorRateFor("DeleteUninitializedWorkflowExecution"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "VisibilityManager.DeleteUninitializedWorkflowExecution")
 		err = c.wrapped.DeleteUninitializedWorkflowExecution(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "VisibilityManager.DeleteUninitializedWorkflowExecution", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "VisibilityManager.DeleteUninitializedWorkflowExecution", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "VisibilityManager.DeleteUninitializedWorkflowExecution", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorVisibilityManager) DeleteWorkflowExecution(ctx context.Context, request *persistence.VisibilityDeleteWorkflowExecutionRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "DeleteWorkflowExecution", c.errorRateFor("DeleteWorkflowExecution"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "VisibilityManager.DeleteWorkflowExecution")
 		err = c.wrapped.DeleteWorkflowExecution(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "VisibilityManager.DeleteWorkflowExecution", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "VisibilityManager.DeleteWorkflowExecution", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "VisibilityManager.DeleteWorkflowExecution", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorVisibilityManager) GetClosedWorkflowExecution(ctx context.Context, request *persistence.GetClosedWorkflowExecutionRequest) (gp1 *persistence.GetClosedWorkflowExecutionResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "GetClosedWorkflowExecution", c.errorRateFor("GetClosedWorkflowExecution"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "VisibilityManager.GetClosedWorkflowExecution")
 		gp1, err = c.wrapped.GetClosedWorkflowExecution(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "VisibilityManager.GetClosedWorkflowExecution", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "VisibilityManager.GetClosedWorkflowExecution", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "VisibilityManager.GetClosedWorkflowExecution", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
@@ -123,195 +167,260 @@ func (c *injectorVisibilityManager) GetName() (s1 string) {
 }
 
 func (c *injectorVisibilityManager) ListClosedWorkflowExecutions(ctx context.Context, request *persistence.ListWorkflowExecutionsRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ListClosedWorkflowExecutions", c.errorRateFor("ListClosedWorkflowExecutions"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "VisibilityManager.ListClosedWorkflowExecutions")
 		lp1, err = c.wrapped.ListClosedWorkflowExecutions(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "VisibilityManager.ListClosedWorkflowExecutions", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "VisibilityManager.ListClosedWorkflowExecutions", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "VisibilityManager.ListClosedWorkflowExecutions", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorVisibilityManager) ListClosedWorkflowExecutionsByStatus(ctx context.Context, request *persistence.ListClosedWorkflowExecutionsByStatusRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ListClosedWorkflowExecutionsByStatus", c.errorRateFor("ListClosedWorkflowExecutionsByStatus"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "VisibilityManager.ListClosedWorkflowExecutionsByStatus")
 		lp1, err = c.wrapped.ListClosedWorkflowExecutionsByStatus(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "VisibilityManager.ListClosedWorkflowExecutionsByStatus", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "VisibilityManager.ListClosedWorkflowExecutionsByStatus", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "VisibilityManager.ListClosedWorkflowExecutionsByStatus", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorVisibilityManager) ListClosedWorkflowExecutionsByType(ctx context.Context, request *persistence.ListWorkflowExecutionsByTypeRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ListClosedWorkflowExecutionsByType", c.errorRateFor("ListClosedWorkflowExecutionsByType"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "VisibilityManager.ListClosedWorkflowExecutionsByType")
 		lp1, err = c.wrapped.ListClosedWorkflowExecutionsByType(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "VisibilityManager.ListClosedWorkflowExecutionsByType", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "VisibilityManager.ListClosedWorkflowExecutionsByType", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "VisibilityManager.ListClosedWorkflowExecutionsByType", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorVisibilityManager) ListClosedWorkflowExecutionsByWorkflowID(ctx context.Context, request *persistence.ListWorkflowExecutionsByWorkflowIDRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ListClosedWorkflowExecutionsByWorkflowID", c.errorRateFor("ListClosedWorkflowExecutionsByWorkflowID"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "VisibilityManager.ListClosedWorkflowExecutionsByWorkflowID")
 		lp1, err = c.wrapped.ListClosedWorkflowExecutionsByWorkflowID(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "VisibilityManager.ListClosedWorkflowExecutionsByWorkflowID", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "VisibilityManager.ListClosedWorkflowExecutionsByWorkflowID", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "VisibilityManager.ListClosedWorkflowExecutionsByWorkflowID", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorVisibilityManager) ListOpenWorkflowExecutions(ctx context.Context, request *persistence.ListWorkflowExecutionsRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ListOpenWorkflowExecutions", c.errorRateFor("ListOpenWorkflowExecutions"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "VisibilityManager.ListOpenWorkflowExecutions")
 		lp1, err = c.wrapped.ListOpenWorkflowExecutions(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "VisibilityManager.ListOpenWorkflowExecutions", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "VisibilityManager.ListOpenWorkflowExecutions", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "VisibilityManager.ListOpenWorkflowExecutions", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorVisibilityManager) ListOpenWorkflowExecutionsByType(ctx context.Context, request *persistence.ListWorkflowExecutionsByTypeRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ListOpenWorkflowExecutionsByType", c.errorRateFor("ListOpenWorkflowExecutionsByType"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "VisibilityManager.ListOpenWorkflowExecutionsByType")
 		lp1, err = c.wrapped.ListOpenWorkflowExecutionsByType(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "VisibilityManager.ListOpenWorkflowExecutionsByType", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "VisibilityManager.ListOpenWorkflowExecutionsByType", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "VisibilityManager.ListOpenWorkflowExecutionsByType", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorVisibilityManager) ListOpenWorkflowExecutionsByWorkflowID(ctx context.Context, request *persistence.ListWorkflowExecutionsByWorkflowIDRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ListOpenWorkflowExecutionsByWorkflowID", c.errorRateFor("ListOpenWorkflowExecutionsByWorkflowID"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "VisibilityManager.ListOpenWorkflowExecutionsByWorkflowID")
 		lp1, err = c.wrapped.ListOpenWorkflowExecutionsByWorkflowID(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "VisibilityManager.ListOpenWorkflowExecutionsByWorkflowID", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "VisibilityManager.ListOpenWorkflowExecutionsByWorkflowID", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "VisibilityManager.ListOpenWorkflowExecutionsByWorkflowID", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorVisibilityManager) ListWorkflowExecutions(ctx context.Context, request *persistence.ListWorkflowExecutionsByQueryRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ListWorkflowExecutions", c.errorRateFor("ListWorkflowExecutions"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "VisibilityManager.ListWorkflowExecutions")
 		lp1, err = c.wrapped.ListWorkflowExecutions(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "VisibilityManager.ListWorkflowExecutions", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "VisibilityManager.ListWorkflowExecutions", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "VisibilityManager.ListWorkflowExecutions", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorVisibilityManager) RecordWorkflowExecutionClosed(ctx context.Context, request *persistence.RecordWorkflowExecutionClosedRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "RecordWorkflowExecutionClosed", c.errorRateFor("RecordWorkflowExecutionClosed"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "VisibilityManager.RecordWorkflowExecutionClosed")
 		err = c.wrapped.RecordWorkflowExecutionClosed(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "VisibilityManager.RecordWorkflowExecutionClosed", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "VisibilityManager.RecordWorkflowExecutionClosed", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "VisibilityManager.RecordWorkflowExecutionClosed", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorVisibilityManager) RecordWorkflowExecutionStarted(ctx context.Context, request *persistence.RecordWorkflowExecutionStartedRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "RecordWorkflowExecutionStarted", c.errorRateFor("RecordWorkflowExecutionStarted"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "VisibilityManager.RecordWorkflowExecutionStarted")
 		err = c.wrapped.RecordWorkflowExecutionStarted(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "VisibilityManager.RecordWorkflowExecutionStarted", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "VisibilityManager.RecordWorkflowExecutionStarted", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "VisibilityManager.RecordWorkflowExecutionStarted", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorVisibilityManager) RecordWorkflowExecutionUninitialized(ctx context.Context, request *persistence.RecordWorkflowExecutionUninitializedRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "RecordWorkflowExecutionUninitialized", c.errorRateFor("RecordWorkflowExecutionUninitialized"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "VisibilityManager.RecordWorkflowExecutionUninitialized")
 		err = c.wrapped.RecordWorkflowExecutionUninitialized(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "VisibilityManager.RecordWorkflowExecutionUninitialized", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "VisibilityManager.RecordWorkflowExecutionUninitialized", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "VisibilityManager.RecordWorkflowExecutionUninitialized", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorVisibilityManager) ScanWorkflowExecutions(ctx context.Context, request *persistence.ListWorkflowExecutionsByQueryRequest) (lp1 *persistence.ListWorkflowExecutionsResponse, err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "ScanWorkflowExecutions", c.errorRateFor("ScanWorkflowExecutions"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "VisibilityManager.ScanWorkflowExecutions")
 		lp1, err = c.wrapped.ScanWorkflowExecutions(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "VisibilityManager.ScanWorkflowExecutions", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "VisibilityManager.ScanWorkflowExecutions", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "VisibilityManager.ScanWorkflowExecutions", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
 }
 
 func (c *injectorVisibilityManager) UpsertWorkflowExecution(ctx context.Context, request *persistence.UpsertWorkflowExecutionRequest) (err error) {
-	fakeErr := generateFakeError(c.errorRate)
-	var forwardCall bool
-	if forwardCall = shouldForwardCallToPersistence(fakeErr); forwardCall {
+	if err = injectLatency(ctx, c.latency, c.faultProvider); err != nil {
+		return
+	}
+
+	forwardCall, fakeErr := injectFakeError(c.failingCalls, "UpsertWorkflowExecution", c.errorRateFor("UpsertWorkflowExecution"), c.faultProvider)
+	if forwardCall {
+		endCall := startForwardedCall(c.logCalls, c.logger, "VisibilityManager.UpsertWorkflowExecution")
 		err = c.wrapped.UpsertWorkflowExecution(ctx, request)
+		endCall(err)
 	}
 
+	emitMetrics(c.metricsClient, "VisibilityManager.UpsertWorkflowExecution", fakeErr, forwardCall)
 	if fakeErr != nil {
-		logErr(c.logger, "VisibilityManager.UpsertWorkflowExecution", fakeErr, forwardCall, err)
-		err = fakeErr
+		err = resolveFakeError(c.metricsClient, c.logger, "VisibilityManager.UpsertWorkflowExecution", fakeErr, forwardCall, err, c.storeErrorFirst)
 		return
 	}
 	return
@@ -39,7 +39,7 @@ import (
 type (
 	// historyManagerImpl implements HistoryManager based on HistoryStore and PayloadSerializer
 	historyV2ManagerImpl struct {
-		historySerializer     PayloadSerializer
+		historySerializer     *serializerImpl
 		persistence           HistoryStore
 		logger                log.Logger
 		thriftEncoder         codec.BinaryEncoder
@@ -64,10 +64,11 @@ func NewHistoryV2ManagerImpl(
 	persistence HistoryStore,
 	logger log.Logger,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
+	compressionThreshold dynamicconfig.IntPropertyFn,
 ) HistoryManager {
 
 	return &historyV2ManagerImpl{
-		historySerializer:     NewPayloadSerializer(),
+		historySerializer:     newPayloadSerializer(WithHistoryEventsCompression(compressionThreshold)),
 		persistence:           persistence,
 		logger:                logger,
 		thriftEncoder:         codec.NewThriftRWEncoder(),
@@ -225,7 +226,7 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(
 	}
 
 	// nodeID will be the first eventID
-	blob, err := m.historySerializer.SerializeBatchEvents(request.Events, request.Encoding)
+	blob, plainBlob, err := m.historySerializer.serializeBatchEventsForStore(request.Events, request.Encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -255,8 +256,9 @@ func (m *historyV2ManagerImpl) AppendHistoryNodes(
 
 	err = m.persistence.AppendHistoryNodes(ctx, req)
 
+	// compression and checksums are transparent to callers, which may forward the blob to other clusters
 	return &AppendHistoryNodesResponse{
-		DataBlob: *blob,
+		DataBlob: *plainBlob,
 	}, err
 }
 
@@ -304,6 +306,12 @@ func (m *historyV2ManagerImpl) ReadRawHistoryBranch(
 	if err != nil {
 		return nil, err
 	}
+	// raw history is sent to other clusters, which may not be able to decompress it or verify its checksum
+	for i, dataBlob := range dataBlobs {
+		if dataBlobs[i], err = UnwrapDataBlob(dataBlob); err != nil {
+			return nil, err
+		}
+	}
 
 	nextPageToken, err := m.serializeToken(token)
 	if err != nil {
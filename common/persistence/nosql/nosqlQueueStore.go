@@ -23,9 +23,11 @@ package nosql
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/persistence"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
@@ -107,6 +109,40 @@ func (q *nosqlQueueStore) EnqueueMessage(
 	return err
 }
 
+// EnqueueMessageWithDedup enqueues the message unless a message with the dedup key is still in the queue.
+// The dedup key is claimed with a conditional insert before the message is written, so concurrent enqueues
+// with the same key agree on a single message ID. If writing the message fails, the claim is released and
+// the error is returned, but a concurrent enqueue may already have returned the claimed ID.
+func (q *nosqlQueueStore) EnqueueMessageWithDedup(
+	ctx context.Context,
+	messagePayload []byte,
+	dedupKey string,
+) (int64, error) {
+	lastMessageID, err := q.getLastMessageID(ctx, q.queueType)
+	if err != nil {
+		return emptyMessageID, err
+	}
+	ackLevels, err := q.GetAckLevels(ctx)
+	if err != nil {
+		return emptyMessageID, err
+	}
+
+	messageID := getNextID(ackLevels, lastMessageID)
+	claimedMessageID, err := q.claimDedupKey(ctx, dedupKey, messageID)
+	if err != nil || claimedMessageID != messageID {
+		return claimedMessageID, err
+	}
+
+	if _, err := q.tryEnqueue(ctx, q.queueType, messageID, messagePayload); err != nil {
+		// the dedup key must not keep pointing to the message ID, which may be taken by another message
+		if releaseErr := q.db.DeleteQueueDedupKey(ctx, q.queueType, dedupKey, messageID); releaseErr != nil {
+			q.logger.Warn("Failed to release queue dedup key", tag.Error(releaseErr))
+		}
+		return emptyMessageID, err
+	}
+	return messageID, nil
+}
+
 func (q *nosqlQueueStore) EnqueueMessageToDLQ(
 	ctx context.Context,
 	messagePayload []byte,
@@ -160,6 +196,44 @@ func (q *nosqlQueueStore) getLastMessageID(
 	return msgID, nil
 }
 
+// claimDedupKey points the dedup key to messageID unless it points to a message which is still in the queue
+// or is being enqueued, and returns the message ID the dedup key points to
+func (q *nosqlQueueStore) claimDedupKey(
+	ctx context.Context,
+	dedupKey string,
+	messageID int64,
+) (int64, error) {
+
+	existingMessageID, err := q.db.InsertQueueDedupKey(ctx, q.queueType, dedupKey, messageID)
+	if err != nil {
+		return emptyMessageID, convertCommonErrors(q.db, fmt.Sprintf("InsertQueueDedupKey, Type: %v", q.queueType), err)
+	}
+	// IDs from messageID on were claimed after the last message was read, so their messages may not be written yet
+	if existingMessageID >= messageID {
+		return existingMessageID, nil
+	}
+
+	messages, err := q.db.SelectMessagesFrom(ctx, q.queueType, existingMessageID-1, 1)
+	if err != nil {
+		return emptyMessageID, convertCommonErrors(q.db, fmt.Sprintf("ReadMessages, Type: %v", q.queueType), err)
+	}
+	if len(messages) > 0 && messages[0].ID == existingMessageID {
+		return existingMessageID, nil
+	}
+
+	// the message of the dedup key was already deleted from the queue
+	err = q.db.UpdateQueueDedupKeyCas(ctx, q.queueType, dedupKey, existingMessageID, messageID)
+	if err != nil {
+		if _, ok := err.(*nosqlplugin.ConditionFailure); ok {
+			return emptyMessageID, &persistence.ConditionFailedError{Msg: fmt.Sprintf("dedup key %v was claimed concurrently", dedupKey)}
+		}
+
+		return emptyMessageID, convertCommonErrors(q.db, fmt.Sprintf("UpdateQueueDedupKey, Type: %v", q.queueType), err)
+	}
+
+	return messageID, nil
+}
+
 func (q *nosqlQueueStore) ReadMessages(
 	ctx context.Context,
 	lastMessageID int64,
@@ -181,6 +255,39 @@ func (q *nosqlQueueStore) ReadMessages(
 	return result, nil
 }
 
+func (q *nosqlQueueStore) GetDLQOldestMessageTimestamp(
+	ctx context.Context,
+) (time.Time, error) {
+	timestamp, err := q.db.SelectOldestMessageTimestamp(ctx, q.getDLQTypeFromQueueType())
+	if err != nil {
+		if q.db.IsNotFoundError(err) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, convertCommonErrors(q.db, "GetDLQOldestMessageTimestamp", err)
+	}
+	return timestamp, nil
+}
+
+func (q *nosqlQueueStore) ReadMessagesReverse(
+	ctx context.Context,
+	maxCount int,
+) ([]*persistence.InternalQueueMessage, error) {
+	messages, err := q.db.SelectLastMessages(ctx, q.queueType, maxCount)
+	if err != nil {
+		return nil, convertCommonErrors(q.db, "ReadMessagesReverse", err)
+	}
+	var result []*persistence.InternalQueueMessage
+	for _, msg := range messages {
+		result = append(result, &persistence.InternalQueueMessage{
+			ID:        msg.ID,
+			QueueType: q.queueType,
+			Payload:   msg.Payload,
+		})
+	}
+
+	return result, nil
+}
+
 func (q *nosqlQueueStore) ReadMessagesFromDLQ(
 	ctx context.Context,
 	firstMessageID int64,
@@ -221,6 +328,26 @@ func (q *nosqlQueueStore) DeleteMessagesBefore(
 	return nil
 }
 
+// PurgeMessages counts the messages up to the last enqueued one before deleting them,
+// messages enqueued concurrently are neither counted nor deleted
+func (q *nosqlQueueStore) PurgeMessages(
+	ctx context.Context,
+) (int64, error) {
+	lastMessageID, err := q.getLastMessageID(ctx, q.queueType)
+	if err != nil || lastMessageID == emptyMessageID {
+		return 0, err
+	}
+
+	count, err := q.db.GetQueueSizeUntil(ctx, q.queueType, lastMessageID)
+	if err != nil {
+		return 0, convertCommonErrors(q.db, "PurgeMessages", err)
+	}
+	if err := q.db.DeleteMessagesBefore(ctx, q.queueType, lastMessageID+1); err != nil {
+		return 0, convertCommonErrors(q.db, "PurgeMessages", err)
+	}
+	return count, nil
+}
+
 func (q *nosqlQueueStore) DeleteMessageFromDLQ(
 	ctx context.Context,
 	messageID int64,
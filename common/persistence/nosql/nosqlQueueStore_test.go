@@ -23,9 +23,16 @@
 package nosql
 
 import (
+	"context"
+	"errors"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
 )
 
 func TestGetNextID(t *testing.T) {
@@ -57,3 +64,99 @@ func TestGetNextID(t *testing.T) {
 		})
 	}
 }
+
+func TestEnqueueMessageWithDedup(t *testing.T) {
+	queueType := persistence.DomainReplicationQueueType
+	payload := []byte("payload")
+	dedupKey := "dedup-key"
+
+	tests := map[string]struct {
+		setupMock  func(db *nosqlplugin.MockDB)
+		expectedID int64
+		expectErr  bool
+	}{
+		"dedup key claimed": {
+			setupMock: func(db *nosqlplugin.MockDB) {
+				db.EXPECT().InsertQueueDedupKey(gomock.Any(), queueType, dedupKey, int64(6)).Return(int64(6), nil)
+				db.EXPECT().InsertIntoQueue(gomock.Any(), &nosqlplugin.QueueMessageRow{QueueType: queueType, ID: 6, Payload: payload}).Return(nil)
+			},
+			expectedID: 6,
+		},
+		"dedup key claimed by a message in the queue": {
+			setupMock: func(db *nosqlplugin.MockDB) {
+				db.EXPECT().InsertQueueDedupKey(gomock.Any(), queueType, dedupKey, int64(6)).Return(int64(3), nil)
+				db.EXPECT().SelectMessagesFrom(gomock.Any(), queueType, int64(2), 1).Return([]*nosqlplugin.QueueMessageRow{{QueueType: queueType, ID: 3}}, nil)
+			},
+			expectedID: 3,
+		},
+		"dedup key claimed by a concurrent enqueue": {
+			setupMock: func(db *nosqlplugin.MockDB) {
+				db.EXPECT().InsertQueueDedupKey(gomock.Any(), queueType, dedupKey, int64(6)).Return(int64(7), nil)
+			},
+			expectedID: 7,
+		},
+		"dedup key of a deleted message is reclaimed": {
+			setupMock: func(db *nosqlplugin.MockDB) {
+				db.EXPECT().InsertQueueDedupKey(gomock.Any(), queueType, dedupKey, int64(6)).Return(int64(3), nil)
+				db.EXPECT().SelectMessagesFrom(gomock.Any(), queueType, int64(2), 1).Return([]*nosqlplugin.QueueMessageRow{{QueueType: queueType, ID: 5}}, nil)
+				db.EXPECT().UpdateQueueDedupKeyCas(gomock.Any(), queueType, dedupKey, int64(3), int64(6)).Return(nil)
+				db.EXPECT().InsertIntoQueue(gomock.Any(), &nosqlplugin.QueueMessageRow{QueueType: queueType, ID: 6, Payload: payload}).Return(nil)
+			},
+			expectedID: 6,
+		},
+		"dedup key of a deleted message is reclaimed concurrently": {
+			setupMock: func(db *nosqlplugin.MockDB) {
+				db.EXPECT().InsertQueueDedupKey(gomock.Any(), queueType, dedupKey, int64(6)).Return(int64(3), nil)
+				db.EXPECT().SelectMessagesFrom(gomock.Any(), queueType, int64(2), 1).Return(nil, nil)
+				db.EXPECT().UpdateQueueDedupKeyCas(gomock.Any(), queueType, dedupKey, int64(3), int64(6)).Return(nosqlplugin.NewConditionFailure("queue_dedup"))
+			},
+			expectedID: emptyMessageID,
+			expectErr:  true,
+		},
+		"dedup key is released if the message ID is taken": {
+			setupMock: func(db *nosqlplugin.MockDB) {
+				db.EXPECT().InsertQueueDedupKey(gomock.Any(), queueType, dedupKey, int64(6)).Return(int64(6), nil)
+				db.EXPECT().InsertIntoQueue(gomock.Any(), gomock.Any()).Return(nosqlplugin.NewConditionFailure("queue"))
+				db.EXPECT().DeleteQueueDedupKey(gomock.Any(), queueType, dedupKey, int64(6)).Return(nil)
+			},
+			expectedID: emptyMessageID,
+			expectErr:  true,
+		},
+		"dedup key insert fails": {
+			setupMock: func(db *nosqlplugin.MockDB) {
+				db.EXPECT().InsertQueueDedupKey(gomock.Any(), queueType, dedupKey, int64(6)).Return(int64(0), errors.New("db error"))
+				db.EXPECT().IsNotFoundError(gomock.Any()).Return(false).AnyTimes()
+				db.EXPECT().IsTimeoutError(gomock.Any()).Return(false).AnyTimes()
+				db.EXPECT().IsThrottlingError(gomock.Any()).Return(false).AnyTimes()
+				db.EXPECT().IsDBUnavailableError(gomock.Any()).Return(false).AnyTimes()
+			},
+			expectedID: emptyMessageID,
+			expectErr:  true,
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			db := nosqlplugin.NewMockDB(ctrl)
+			db.EXPECT().SelectLastEnqueuedMessageID(gomock.Any(), queueType).Return(int64(5), nil)
+			db.EXPECT().SelectQueueMetadata(gomock.Any(), queueType).Return(&nosqlplugin.QueueMetadataRow{
+				QueueType:        queueType,
+				ClusterAckLevels: map[string]int64{"a": 4},
+			}, nil)
+			td.setupMock(db)
+
+			store := &nosqlQueueStore{
+				queueType:  queueType,
+				nosqlStore: nosqlStore{logger: log.NewNoop(), db: db},
+			}
+			messageID, err := store.EnqueueMessageWithDedup(context.Background(), payload, dedupKey)
+			if td.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, td.expectedID, messageID)
+		})
+	}
+}
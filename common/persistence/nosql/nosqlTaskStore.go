@@ -175,6 +175,34 @@ func (t *nosqlTaskStore) LeaseTaskList(
 	return &p.LeaseTaskListResponse{TaskListInfo: tli}, nil
 }
 
+func (t *nosqlTaskStore) GetTaskList(
+	ctx context.Context,
+	request *p.GetTaskListRequest,
+) (*p.GetTaskListResponse, error) {
+	storeShard, err := t.GetStoreShardByTaskList(request.DomainID, request.TaskList, request.TaskType)
+	if err != nil {
+		return nil, err
+	}
+	currTL, err := storeShard.db.SelectTaskList(ctx, &nosqlplugin.TaskListFilter{
+		DomainID:     request.DomainID,
+		TaskListName: request.TaskList,
+		TaskListType: request.TaskType,
+	})
+	if err != nil {
+		return nil, convertCommonErrors(storeShard.db, "GetTaskList", err)
+	}
+	tli := &p.TaskListInfo{
+		DomainID:    request.DomainID,
+		Name:        request.TaskList,
+		TaskType:    request.TaskType,
+		RangeID:     currTL.RangeID,
+		AckLevel:    currTL.AckLevel,
+		Kind:        currTL.TaskListKind,
+		LastUpdated: currTL.LastUpdatedTime,
+	}
+	return &p.GetTaskListResponse{TaskListInfo: tli}, nil
+}
+
 func (t *nosqlTaskStore) UpdateTaskList(
 	ctx context.Context,
 	request *p.UpdateTaskListRequest,
@@ -270,6 +298,7 @@ func (t *nosqlTaskStore) CreateTasks(
 			ScheduledID:     t.Data.ScheduleID,
 			CreatedTime:     now,
 			PartitionConfig: t.Data.PartitionConfig,
+			Priority:        t.Data.Priority,
 		}
 		ttl := int(t.Data.ScheduleToStartTimeout.Seconds())
 		tasks = append(tasks, &nosqlplugin.TaskRowForInsert{
@@ -357,6 +386,7 @@ func toTaskInfo(t *nosqlplugin.TaskRow) *p.InternalTaskInfo {
 		ScheduleID:      t.ScheduledID,
 		CreatedTime:     t.CreatedTime,
 		PartitionConfig: t.PartitionConfig,
+		Priority:        t.Priority,
 	}
 }
 
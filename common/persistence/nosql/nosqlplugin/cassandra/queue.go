@@ -23,6 +23,7 @@ package cassandra
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
@@ -62,6 +63,79 @@ func (db *cdb) SelectLastEnqueuedMessageID(
 	return result["message_id"].(int64), nil
 }
 
+// **Conditionally** insert a dedup key pointing to messageID if the dedup key doesn't exist yet
+// Return the message ID the dedup key points to, which is messageID if the row was inserted
+func (db *cdb) InsertQueueDedupKey(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	dedupKey string,
+	messageID int64,
+) (int64, error) {
+	query := db.session.Query(templateInsertQueueDedupKeyQuery, queueType, dedupKey, messageID).WithContext(ctx)
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return 0, err
+	}
+
+	if !applied {
+		return previous["message_id"].(int64), nil
+	}
+	return messageID, nil
+}
+
+// **Conditionally** update a dedup key to point to messageID if it still points to previousMessageID
+// it should return ConditionFailure if the condition is not met
+func (db *cdb) UpdateQueueDedupKeyCas(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	dedupKey string,
+	previousMessageID int64,
+	messageID int64,
+) error {
+	query := db.session.Query(templateUpdateQueueDedupKeyQuery, messageID, queueType, dedupKey, previousMessageID).WithContext(ctx)
+	previous := make(map[string]interface{})
+	applied, err := query.MapScanCAS(previous)
+	if err != nil {
+		return err
+	}
+
+	if !applied {
+		return nosqlplugin.NewConditionFailure("queue_dedup")
+	}
+	return nil
+}
+
+// Delete a dedup key if it still points to messageID
+func (db *cdb) DeleteQueueDedupKey(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	dedupKey string,
+	messageID int64,
+) error {
+	query := db.session.Query(templateDeleteQueueDedupKeyQuery, queueType, dedupKey, messageID).WithContext(ctx)
+	previous := make(map[string]interface{})
+	// it's ok if the query is not applied, which means that the dedup key points to another message already.
+	_, err := query.MapScanCAS(previous)
+	return err
+}
+
+// Get the time the first message of the queue was inserted
+func (db *cdb) SelectOldestMessageTimestamp(
+	ctx context.Context,
+	queueType persistence.QueueType,
+) (time.Time, error) {
+	// messages are clustered by ID, so the first row is the oldest message
+	query := db.session.Query(templateGetOldestMessageWriteTimeQuery, queueType).WithContext(ctx)
+	result := make(map[string]interface{})
+	err := query.MapScan(result)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.UnixMicro(result["write_time"].(int64)), nil
+}
+
 // Read queue messages starting from the exclusiveBeginMessageID
 func (db *cdb) SelectMessagesFrom(
 	ctx context.Context,
@@ -97,6 +171,38 @@ func (db *cdb) SelectMessagesFrom(
 	return result, nil
 }
 
+// Read the last maxRows queue messages in descending ID order
+func (db *cdb) SelectLastMessages(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	maxRows int,
+) ([]*nosqlplugin.QueueMessageRow, error) {
+	query := db.session.Query(templateGetLastMessagesQuery,
+		queueType,
+		maxRows,
+	).WithContext(ctx)
+
+	iter := query.Iter()
+	if iter == nil {
+		return nil, fmt.Errorf("SelectLastMessages operation failed. Not able to create query iterator")
+	}
+
+	var result []*nosqlplugin.QueueMessageRow
+	message := make(map[string]interface{})
+	for iter.MapScan(message) {
+		payload := getMessagePayload(message)
+		id := getMessageID(message)
+		result = append(result, &nosqlplugin.QueueMessageRow{ID: id, Payload: payload})
+		message = make(map[string]interface{})
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
 func (db *cdb) SelectMessagesBetween(
 	ctx context.Context,
@@ -252,6 +358,21 @@ func (db *cdb) GetQueueSize(
 	return result["count"].(int64), nil
 }
 
+func (db *cdb) GetQueueSizeUntil(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	inclusiveEndMessageID int64,
+) (int64, error) {
+
+	query := db.session.Query(templateGetQueueSizeUntilQuery, queueType, inclusiveEndMessageID).WithContext(ctx)
+	result := make(map[string]interface{})
+
+	if err := query.MapScan(result); err != nil {
+		return 0, err
+	}
+	return result["count"].(int64), nil
+}
+
 func getMessagePayload(
 	message map[string]interface{},
 ) []byte {
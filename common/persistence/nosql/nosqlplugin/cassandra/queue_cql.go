@@ -24,7 +24,9 @@ package cassandra
 const (
 	templateEnqueueMessageQuery             = `INSERT INTO queue (queue_type, message_id, message_payload) VALUES(?, ?, ?) IF NOT EXISTS`
 	templateGetLastMessageIDQuery           = `SELECT message_id FROM queue WHERE queue_type=? ORDER BY message_id DESC LIMIT 1`
+	templateGetOldestMessageWriteTimeQuery  = `SELECT WRITETIME(message_payload) AS write_time FROM queue WHERE queue_type = ? LIMIT 1`
 	templateGetMessagesQuery                = `SELECT message_id, message_payload FROM queue WHERE queue_type = ? and message_id > ? LIMIT ?`
+	templateGetLastMessagesQuery            = `SELECT message_id, message_payload FROM queue WHERE queue_type = ? ORDER BY message_id DESC LIMIT ?`
 	templateGetMessagesFromDLQQuery         = `SELECT message_id, message_payload FROM queue WHERE queue_type = ? and message_id > ? and message_id <= ?`
 	templateRangeDeleteMessagesBeforeQuery  = `DELETE FROM queue WHERE queue_type = ? and message_id < ?`
 	templateRangeDeleteMessagesBetweenQuery = `DELETE FROM queue WHERE queue_type = ? and message_id > ? and message_id <= ?`
@@ -33,4 +35,8 @@ const (
 	templateInsertQueueMetadataQuery        = `INSERT INTO queue_metadata (queue_type, cluster_ack_level, version) VALUES(?, ?, ?) IF NOT EXISTS`
 	templateUpdateQueueMetadataQuery        = `UPDATE queue_metadata SET cluster_ack_level = ?, version = ? WHERE queue_type = ? IF version = ?`
 	templateGetQueueSizeQuery               = `SELECT COUNT(1) AS count FROM queue WHERE queue_type=?`
+	templateGetQueueSizeUntilQuery          = `SELECT COUNT(1) AS count FROM queue WHERE queue_type = ? and message_id <= ?`
+	templateInsertQueueDedupKeyQuery        = `INSERT INTO queue_dedup (queue_type, dedup_key, message_id) VALUES(?, ?, ?) IF NOT EXISTS`
+	templateUpdateQueueDedupKeyQuery        = `UPDATE queue_dedup SET message_id = ? WHERE queue_type = ? and dedup_key = ? IF message_id = ?`
+	templateDeleteQueueDedupKeyQuery        = `DELETE FROM queue_dedup WHERE queue_type = ? and dedup_key = ? IF message_id = ?`
 )
@@ -258,7 +258,8 @@ func (db *cdb) InsertTasks(
 				task.RunID,
 				scheduleID,
 				task.CreatedTime,
-				task.PartitionConfig)
+				task.PartitionConfig,
+				task.Priority)
 		} else {
 			if ttl > maxCassandraTTL {
 				ttl = maxCassandraTTL
@@ -275,6 +276,7 @@ func (db *cdb) InsertTasks(
 				scheduleID,
 				task.CreatedTime,
 				task.PartitionConfig,
+				task.Priority,
 				ttl)
 		}
 	}
@@ -376,6 +378,11 @@ func createTaskInfo(
 			info.CreatedTime = v.(time.Time)
 		case "partition_config":
 			info.PartitionConfig = v.(map[string]string)
+		case "priority":
+			// tasks written before the priority was added have no priority
+			if priority, ok := v.(int); ok {
+				info.Priority = int32(priority)
+			}
 		}
 	}
 
@@ -22,6 +22,7 @@ package dynamodb
 
 import (
 	"context"
+	"time"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
@@ -44,6 +45,45 @@ func (db *ddb) SelectLastEnqueuedMessageID(
 	panic("TODO")
 }
 
+// Insert a dedup key pointing to messageID if the dedup key doesn't exist yet
+func (db *ddb) InsertQueueDedupKey(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	dedupKey string,
+	messageID int64,
+) (int64, error) {
+	panic("TODO")
+}
+
+// Update a dedup key to point to messageID if it still points to previousMessageID
+func (db *ddb) UpdateQueueDedupKeyCas(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	dedupKey string,
+	previousMessageID int64,
+	messageID int64,
+) error {
+	panic("TODO")
+}
+
+// Delete a dedup key if it still points to messageID
+func (db *ddb) DeleteQueueDedupKey(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	dedupKey string,
+	messageID int64,
+) error {
+	panic("TODO")
+}
+
+// Get the time the first message of the queue was inserted
+func (db *ddb) SelectOldestMessageTimestamp(
+	ctx context.Context,
+	queueType persistence.QueueType,
+) (time.Time, error) {
+	panic("TODO")
+}
+
 // Read queue messages starting from the exclusiveBeginMessageID
 func (db *ddb) SelectMessagesFrom(
 	ctx context.Context,
@@ -54,6 +94,15 @@ func (db *ddb) SelectMessagesFrom(
 	panic("TODO")
 }
 
+// Read the last maxRows queue messages in descending ID order
+func (db *ddb) SelectLastMessages(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	maxRows int,
+) ([]*nosqlplugin.QueueMessageRow, error) {
+	panic("TODO")
+}
+
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
 func (db *ddb) SelectMessagesBetween(
 	ctx context.Context,
@@ -123,3 +172,11 @@ func (db *ddb) GetQueueSize(
 ) (int64, error) {
 	panic("TODO")
 }
+
+func (db *ddb) GetQueueSizeUntil(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	inclusiveEndMessageID int64,
+) (int64, error) {
+	panic("TODO")
+}
@@ -112,10 +112,11 @@ type (
 	/***
 	 * MessageQueueCRUD is for the message queue storage system
 	 *
-	 * Recommendation: use two tables(queue_message,and queue_metadata) to implement this interface
+	 * Recommendation: use three tables(queue_message, queue_dedup and queue_metadata) to implement this interface
 	 *
 	 * Significant columns:
 	 * queue_message partition key: (queueType), range key: (messageID)
+	 * queue_dedup partition key: (queueType, dedupKey), range key: N/A, query condition column(messageID)
 	 * queue_metadata partition key: (queueType), range key: N/A, query condition column(version)
 	 */
 	MessageQueueCRUD interface {
@@ -124,8 +125,21 @@ type (
 		InsertIntoQueue(ctx context.Context, row *QueueMessageRow) error
 		// Get the ID of last message inserted into the queue
 		SelectLastEnqueuedMessageID(ctx context.Context, queueType persistence.QueueType) (int64, error)
+		// **Conditionally** insert a dedup key pointing to messageID if the dedup key doesn't exist yet
+		// Return the message ID the dedup key points to, which is messageID if the row was inserted
+		InsertQueueDedupKey(ctx context.Context, queueType persistence.QueueType, dedupKey string, messageID int64) (int64, error)
+		// **Conditionally** update a dedup key to point to messageID if it still points to previousMessageID
+		// Must return conditionFailed error if the condition is not met
+		UpdateQueueDedupKeyCas(ctx context.Context, queueType persistence.QueueType, dedupKey string, previousMessageID int64, messageID int64) error
+		// Delete a dedup key if it still points to messageID
+		DeleteQueueDedupKey(ctx context.Context, queueType persistence.QueueType, dedupKey string, messageID int64) error
+		// Get the time the first message of the queue was inserted
+		// Must return NotFound error if the queue is empty
+		SelectOldestMessageTimestamp(ctx context.Context, queueType persistence.QueueType) (time.Time, error)
 		// Read queue messages starting from the exclusiveBeginMessageID
 		SelectMessagesFrom(ctx context.Context, queueType persistence.QueueType, exclusiveBeginMessageID int64, maxRows int) ([]*QueueMessageRow, error)
+		// Read the last maxRows queue messages in descending ID order
+		SelectLastMessages(ctx context.Context, queueType persistence.QueueType, maxRows int) ([]*QueueMessageRow, error)
 		// Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
 		SelectMessagesBetween(ctx context.Context, request SelectMessagesBetweenRequest) (*SelectMessagesBetweenResponse, error)
 		// Delete all messages before exclusiveBeginMessageID
@@ -145,6 +159,8 @@ type (
 		SelectQueueMetadata(ctx context.Context, queueType persistence.QueueType) (*QueueMetadataRow, error)
 		// GetQueueSize return the queue size
 		GetQueueSize(ctx context.Context, queueType persistence.QueueType) (int64, error)
+		// GetQueueSizeUntil return the number of messages up to the inclusiveEndMessageID
+		GetQueueSizeUntil(ctx context.Context, queueType persistence.QueueType, inclusiveEndMessageID int64) (int64, error)
 	}
 
 	/***
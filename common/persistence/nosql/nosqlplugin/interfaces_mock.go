@@ -275,6 +275,20 @@ func (mr *MockDBMockRecorder) DeleteMessagesInRange(ctx, queueType, exclusiveBeg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessagesInRange", reflect.TypeOf((*MockDB)(nil).DeleteMessagesInRange), ctx, queueType, exclusiveBeginMessageID, inclusiveEndMessageID)
 }
 
+// DeleteQueueDedupKey mocks base method.
+func (m *MockDB) DeleteQueueDedupKey(ctx context.Context, queueType persistence.QueueType, dedupKey string, messageID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQueueDedupKey", ctx, queueType, dedupKey, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQueueDedupKey indicates an expected call of DeleteQueueDedupKey.
+func (mr *MockDBMockRecorder) DeleteQueueDedupKey(ctx, queueType, dedupKey, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQueueDedupKey", reflect.TypeOf((*MockDB)(nil).DeleteQueueDedupKey), ctx, queueType, dedupKey, messageID)
+}
+
 // DeleteReplicationDLQTask mocks base method.
 func (m *MockDB) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
 	m.ctrl.T.Helper()
@@ -388,6 +402,21 @@ func (mr *MockDBMockRecorder) GetQueueSize(ctx, queueType interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueSize", reflect.TypeOf((*MockDB)(nil).GetQueueSize), ctx, queueType)
 }
 
+// GetQueueSizeUntil mocks base method.
+func (m *MockDB) GetQueueSizeUntil(ctx context.Context, queueType persistence.QueueType, inclusiveEndMessageID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueueSizeUntil", ctx, queueType, inclusiveEndMessageID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueueSizeUntil indicates an expected call of GetQueueSizeUntil.
+func (mr *MockDBMockRecorder) GetQueueSizeUntil(ctx, queueType, inclusiveEndMessageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueSizeUntil", reflect.TypeOf((*MockDB)(nil).GetQueueSizeUntil), ctx, queueType, inclusiveEndMessageID)
+}
+
 // GetTasksCount mocks base method.
 func (m *MockDB) GetTasksCount(ctx context.Context, filter *TasksFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -459,6 +488,21 @@ func (mr *MockDBMockRecorder) InsertIntoQueue(ctx, row interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoQueue", reflect.TypeOf((*MockDB)(nil).InsertIntoQueue), ctx, row)
 }
 
+// InsertQueueDedupKey mocks base method.
+func (m *MockDB) InsertQueueDedupKey(ctx context.Context, queueType persistence.QueueType, dedupKey string, messageID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertQueueDedupKey", ctx, queueType, dedupKey, messageID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertQueueDedupKey indicates an expected call of InsertQueueDedupKey.
+func (mr *MockDBMockRecorder) InsertQueueDedupKey(ctx, queueType, dedupKey, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertQueueDedupKey", reflect.TypeOf((*MockDB)(nil).InsertQueueDedupKey), ctx, queueType, dedupKey, messageID)
+}
+
 // InsertQueueMetadata mocks base method.
 func (m *MockDB) InsertQueueMetadata(ctx context.Context, queueType persistence.QueueType, version int64) error {
 	m.ctrl.T.Helper()
@@ -927,6 +971,21 @@ func (mr *MockDBMockRecorder) SelectLastEnqueuedMessageID(ctx, queueType interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLastEnqueuedMessageID", reflect.TypeOf((*MockDB)(nil).SelectLastEnqueuedMessageID), ctx, queueType)
 }
 
+// SelectLastMessages mocks base method.
+func (m *MockDB) SelectLastMessages(ctx context.Context, queueType persistence.QueueType, maxRows int) ([]*QueueMessageRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectLastMessages", ctx, queueType, maxRows)
+	ret0, _ := ret[0].([]*QueueMessageRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectLastMessages indicates an expected call of SelectLastMessages.
+func (mr *MockDBMockRecorder) SelectLastMessages(ctx, queueType, maxRows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLastMessages", reflect.TypeOf((*MockDB)(nil).SelectLastMessages), ctx, queueType, maxRows)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MockDB) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -972,6 +1031,21 @@ func (mr *MockDBMockRecorder) SelectMessagesFrom(ctx, queueType, exclusiveBeginM
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMessagesFrom", reflect.TypeOf((*MockDB)(nil).SelectMessagesFrom), ctx, queueType, exclusiveBeginMessageID, maxRows)
 }
 
+// SelectOldestMessageTimestamp mocks base method.
+func (m *MockDB) SelectOldestMessageTimestamp(ctx context.Context, queueType persistence.QueueType) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectOldestMessageTimestamp", ctx, queueType)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectOldestMessageTimestamp indicates an expected call of SelectOldestMessageTimestamp.
+func (mr *MockDBMockRecorder) SelectOldestMessageTimestamp(ctx, queueType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectOldestMessageTimestamp", reflect.TypeOf((*MockDB)(nil).SelectOldestMessageTimestamp), ctx, queueType)
+}
+
 // SelectOneClosedWorkflow mocks base method.
 func (m *MockDB) SelectOneClosedWorkflow(ctx context.Context, domainID, workflowID, runID string) (*VisibilityRow, error) {
 	m.ctrl.T.Helper()
@@ -1171,6 +1245,20 @@ func (mr *MockDBMockRecorder) UpdateDomain(ctx, row interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomain", reflect.TypeOf((*MockDB)(nil).UpdateDomain), ctx, row)
 }
 
+// UpdateQueueDedupKeyCas mocks base method.
+func (m *MockDB) UpdateQueueDedupKeyCas(ctx context.Context, queueType persistence.QueueType, dedupKey string, previousMessageID, messageID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQueueDedupKeyCas", ctx, queueType, dedupKey, previousMessageID, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateQueueDedupKeyCas indicates an expected call of UpdateQueueDedupKeyCas.
+func (mr *MockDBMockRecorder) UpdateQueueDedupKeyCas(ctx, queueType, dedupKey, previousMessageID, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQueueDedupKeyCas", reflect.TypeOf((*MockDB)(nil).UpdateQueueDedupKeyCas), ctx, queueType, dedupKey, previousMessageID, messageID)
+}
+
 // UpdateQueueMetadataCas mocks base method.
 func (m *MockDB) UpdateQueueMetadataCas(ctx context.Context, row QueueMetadataRow) error {
 	m.ctrl.T.Helper()
@@ -1390,6 +1478,20 @@ func (mr *MocktableCRUDMockRecorder) DeleteMessagesInRange(ctx, queueType, exclu
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessagesInRange", reflect.TypeOf((*MocktableCRUD)(nil).DeleteMessagesInRange), ctx, queueType, exclusiveBeginMessageID, inclusiveEndMessageID)
 }
 
+// DeleteQueueDedupKey mocks base method.
+func (m *MocktableCRUD) DeleteQueueDedupKey(ctx context.Context, queueType persistence.QueueType, dedupKey string, messageID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQueueDedupKey", ctx, queueType, dedupKey, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQueueDedupKey indicates an expected call of DeleteQueueDedupKey.
+func (mr *MocktableCRUDMockRecorder) DeleteQueueDedupKey(ctx, queueType, dedupKey, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQueueDedupKey", reflect.TypeOf((*MocktableCRUD)(nil).DeleteQueueDedupKey), ctx, queueType, dedupKey, messageID)
+}
+
 // DeleteReplicationDLQTask mocks base method.
 func (m *MocktableCRUD) DeleteReplicationDLQTask(ctx context.Context, shardID int, sourceCluster string, taskID int64) error {
 	m.ctrl.T.Helper()
@@ -1503,6 +1605,21 @@ func (mr *MocktableCRUDMockRecorder) GetQueueSize(ctx, queueType interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueSize", reflect.TypeOf((*MocktableCRUD)(nil).GetQueueSize), ctx, queueType)
 }
 
+// GetQueueSizeUntil mocks base method.
+func (m *MocktableCRUD) GetQueueSizeUntil(ctx context.Context, queueType persistence.QueueType, inclusiveEndMessageID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueueSizeUntil", ctx, queueType, inclusiveEndMessageID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueueSizeUntil indicates an expected call of GetQueueSizeUntil.
+func (mr *MocktableCRUDMockRecorder) GetQueueSizeUntil(ctx, queueType, inclusiveEndMessageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueSizeUntil", reflect.TypeOf((*MocktableCRUD)(nil).GetQueueSizeUntil), ctx, queueType, inclusiveEndMessageID)
+}
+
 // GetTasksCount mocks base method.
 func (m *MocktableCRUD) GetTasksCount(ctx context.Context, filter *TasksFilter) (int64, error) {
 	m.ctrl.T.Helper()
@@ -1574,6 +1691,21 @@ func (mr *MocktableCRUDMockRecorder) InsertIntoQueue(ctx, row interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoQueue", reflect.TypeOf((*MocktableCRUD)(nil).InsertIntoQueue), ctx, row)
 }
 
+// InsertQueueDedupKey mocks base method.
+func (m *MocktableCRUD) InsertQueueDedupKey(ctx context.Context, queueType persistence.QueueType, dedupKey string, messageID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertQueueDedupKey", ctx, queueType, dedupKey, messageID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertQueueDedupKey indicates an expected call of InsertQueueDedupKey.
+func (mr *MocktableCRUDMockRecorder) InsertQueueDedupKey(ctx, queueType, dedupKey, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertQueueDedupKey", reflect.TypeOf((*MocktableCRUD)(nil).InsertQueueDedupKey), ctx, queueType, dedupKey, messageID)
+}
+
 // InsertQueueMetadata mocks base method.
 func (m *MocktableCRUD) InsertQueueMetadata(ctx context.Context, queueType persistence.QueueType, version int64) error {
 	m.ctrl.T.Helper()
@@ -1972,6 +2104,21 @@ func (mr *MocktableCRUDMockRecorder) SelectLastEnqueuedMessageID(ctx, queueType
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLastEnqueuedMessageID", reflect.TypeOf((*MocktableCRUD)(nil).SelectLastEnqueuedMessageID), ctx, queueType)
 }
 
+// SelectLastMessages mocks base method.
+func (m *MocktableCRUD) SelectLastMessages(ctx context.Context, queueType persistence.QueueType, maxRows int) ([]*QueueMessageRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectLastMessages", ctx, queueType, maxRows)
+	ret0, _ := ret[0].([]*QueueMessageRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectLastMessages indicates an expected call of SelectLastMessages.
+func (mr *MocktableCRUDMockRecorder) SelectLastMessages(ctx, queueType, maxRows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLastMessages", reflect.TypeOf((*MocktableCRUD)(nil).SelectLastMessages), ctx, queueType, maxRows)
+}
+
 // SelectLatestConfig mocks base method.
 func (m *MocktableCRUD) SelectLatestConfig(ctx context.Context, rowType int) (*persistence.InternalConfigStoreEntry, error) {
 	m.ctrl.T.Helper()
@@ -2017,6 +2164,21 @@ func (mr *MocktableCRUDMockRecorder) SelectMessagesFrom(ctx, queueType, exclusiv
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMessagesFrom", reflect.TypeOf((*MocktableCRUD)(nil).SelectMessagesFrom), ctx, queueType, exclusiveBeginMessageID, maxRows)
 }
 
+// SelectOldestMessageTimestamp mocks base method.
+func (m *MocktableCRUD) SelectOldestMessageTimestamp(ctx context.Context, queueType persistence.QueueType) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectOldestMessageTimestamp", ctx, queueType)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectOldestMessageTimestamp indicates an expected call of SelectOldestMessageTimestamp.
+func (mr *MocktableCRUDMockRecorder) SelectOldestMessageTimestamp(ctx, queueType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectOldestMessageTimestamp", reflect.TypeOf((*MocktableCRUD)(nil).SelectOldestMessageTimestamp), ctx, queueType)
+}
+
 // SelectOneClosedWorkflow mocks base method.
 func (m *MocktableCRUD) SelectOneClosedWorkflow(ctx context.Context, domainID, workflowID, runID string) (*VisibilityRow, error) {
 	m.ctrl.T.Helper()
@@ -2216,6 +2378,20 @@ func (mr *MocktableCRUDMockRecorder) UpdateDomain(ctx, row interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomain", reflect.TypeOf((*MocktableCRUD)(nil).UpdateDomain), ctx, row)
 }
 
+// UpdateQueueDedupKeyCas mocks base method.
+func (m *MocktableCRUD) UpdateQueueDedupKeyCas(ctx context.Context, queueType persistence.QueueType, dedupKey string, previousMessageID, messageID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQueueDedupKeyCas", ctx, queueType, dedupKey, previousMessageID, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateQueueDedupKeyCas indicates an expected call of UpdateQueueDedupKeyCas.
+func (mr *MocktableCRUDMockRecorder) UpdateQueueDedupKeyCas(ctx, queueType, dedupKey, previousMessageID, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQueueDedupKeyCas", reflect.TypeOf((*MocktableCRUD)(nil).UpdateQueueDedupKeyCas), ctx, queueType, dedupKey, previousMessageID, messageID)
+}
+
 // UpdateQueueMetadataCas mocks base method.
 func (m *MocktableCRUD) UpdateQueueMetadataCas(ctx context.Context, row QueueMetadataRow) error {
 	m.ctrl.T.Helper()
@@ -2556,6 +2732,20 @@ func (mr *MockMessageQueueCRUDMockRecorder) DeleteMessagesInRange(ctx, queueType
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessagesInRange", reflect.TypeOf((*MockMessageQueueCRUD)(nil).DeleteMessagesInRange), ctx, queueType, exclusiveBeginMessageID, inclusiveEndMessageID)
 }
 
+// DeleteQueueDedupKey mocks base method.
+func (m *MockMessageQueueCRUD) DeleteQueueDedupKey(ctx context.Context, queueType persistence.QueueType, dedupKey string, messageID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQueueDedupKey", ctx, queueType, dedupKey, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQueueDedupKey indicates an expected call of DeleteQueueDedupKey.
+func (mr *MockMessageQueueCRUDMockRecorder) DeleteQueueDedupKey(ctx, queueType, dedupKey, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQueueDedupKey", reflect.TypeOf((*MockMessageQueueCRUD)(nil).DeleteQueueDedupKey), ctx, queueType, dedupKey, messageID)
+}
+
 // GetQueueSize mocks base method.
 func (m *MockMessageQueueCRUD) GetQueueSize(ctx context.Context, queueType persistence.QueueType) (int64, error) {
 	m.ctrl.T.Helper()
@@ -2571,6 +2761,21 @@ func (mr *MockMessageQueueCRUDMockRecorder) GetQueueSize(ctx, queueType interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueSize", reflect.TypeOf((*MockMessageQueueCRUD)(nil).GetQueueSize), ctx, queueType)
 }
 
+// GetQueueSizeUntil mocks base method.
+func (m *MockMessageQueueCRUD) GetQueueSizeUntil(ctx context.Context, queueType persistence.QueueType, inclusiveEndMessageID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueueSizeUntil", ctx, queueType, inclusiveEndMessageID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQueueSizeUntil indicates an expected call of GetQueueSizeUntil.
+func (mr *MockMessageQueueCRUDMockRecorder) GetQueueSizeUntil(ctx, queueType, inclusiveEndMessageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueueSizeUntil", reflect.TypeOf((*MockMessageQueueCRUD)(nil).GetQueueSizeUntil), ctx, queueType, inclusiveEndMessageID)
+}
+
 // InsertIntoQueue mocks base method.
 func (m *MockMessageQueueCRUD) InsertIntoQueue(ctx context.Context, row *QueueMessageRow) error {
 	m.ctrl.T.Helper()
@@ -2585,6 +2790,21 @@ func (mr *MockMessageQueueCRUDMockRecorder) InsertIntoQueue(ctx, row interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertIntoQueue", reflect.TypeOf((*MockMessageQueueCRUD)(nil).InsertIntoQueue), ctx, row)
 }
 
+// InsertQueueDedupKey mocks base method.
+func (m *MockMessageQueueCRUD) InsertQueueDedupKey(ctx context.Context, queueType persistence.QueueType, dedupKey string, messageID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertQueueDedupKey", ctx, queueType, dedupKey, messageID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertQueueDedupKey indicates an expected call of InsertQueueDedupKey.
+func (mr *MockMessageQueueCRUDMockRecorder) InsertQueueDedupKey(ctx, queueType, dedupKey, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertQueueDedupKey", reflect.TypeOf((*MockMessageQueueCRUD)(nil).InsertQueueDedupKey), ctx, queueType, dedupKey, messageID)
+}
+
 // InsertQueueMetadata mocks base method.
 func (m *MockMessageQueueCRUD) InsertQueueMetadata(ctx context.Context, queueType persistence.QueueType, version int64) error {
 	m.ctrl.T.Helper()
@@ -2614,6 +2834,21 @@ func (mr *MockMessageQueueCRUDMockRecorder) SelectLastEnqueuedMessageID(ctx, que
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLastEnqueuedMessageID", reflect.TypeOf((*MockMessageQueueCRUD)(nil).SelectLastEnqueuedMessageID), ctx, queueType)
 }
 
+// SelectLastMessages mocks base method.
+func (m *MockMessageQueueCRUD) SelectLastMessages(ctx context.Context, queueType persistence.QueueType, maxRows int) ([]*QueueMessageRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectLastMessages", ctx, queueType, maxRows)
+	ret0, _ := ret[0].([]*QueueMessageRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectLastMessages indicates an expected call of SelectLastMessages.
+func (mr *MockMessageQueueCRUDMockRecorder) SelectLastMessages(ctx, queueType, maxRows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectLastMessages", reflect.TypeOf((*MockMessageQueueCRUD)(nil).SelectLastMessages), ctx, queueType, maxRows)
+}
+
 // SelectMessagesBetween mocks base method.
 func (m *MockMessageQueueCRUD) SelectMessagesBetween(ctx context.Context, request SelectMessagesBetweenRequest) (*SelectMessagesBetweenResponse, error) {
 	m.ctrl.T.Helper()
@@ -2644,6 +2879,21 @@ func (mr *MockMessageQueueCRUDMockRecorder) SelectMessagesFrom(ctx, queueType, e
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectMessagesFrom", reflect.TypeOf((*MockMessageQueueCRUD)(nil).SelectMessagesFrom), ctx, queueType, exclusiveBeginMessageID, maxRows)
 }
 
+// SelectOldestMessageTimestamp mocks base method.
+func (m *MockMessageQueueCRUD) SelectOldestMessageTimestamp(ctx context.Context, queueType persistence.QueueType) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectOldestMessageTimestamp", ctx, queueType)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectOldestMessageTimestamp indicates an expected call of SelectOldestMessageTimestamp.
+func (mr *MockMessageQueueCRUDMockRecorder) SelectOldestMessageTimestamp(ctx, queueType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectOldestMessageTimestamp", reflect.TypeOf((*MockMessageQueueCRUD)(nil).SelectOldestMessageTimestamp), ctx, queueType)
+}
+
 // SelectQueueMetadata mocks base method.
 func (m *MockMessageQueueCRUD) SelectQueueMetadata(ctx context.Context, queueType persistence.QueueType) (*QueueMetadataRow, error) {
 	m.ctrl.T.Helper()
@@ -2659,6 +2909,20 @@ func (mr *MockMessageQueueCRUDMockRecorder) SelectQueueMetadata(ctx, queueType i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectQueueMetadata", reflect.TypeOf((*MockMessageQueueCRUD)(nil).SelectQueueMetadata), ctx, queueType)
 }
 
+// UpdateQueueDedupKeyCas mocks base method.
+func (m *MockMessageQueueCRUD) UpdateQueueDedupKeyCas(ctx context.Context, queueType persistence.QueueType, dedupKey string, previousMessageID, messageID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQueueDedupKeyCas", ctx, queueType, dedupKey, previousMessageID, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateQueueDedupKeyCas indicates an expected call of UpdateQueueDedupKeyCas.
+func (mr *MockMessageQueueCRUDMockRecorder) UpdateQueueDedupKeyCas(ctx, queueType, dedupKey, previousMessageID, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQueueDedupKeyCas", reflect.TypeOf((*MockMessageQueueCRUD)(nil).UpdateQueueDedupKeyCas), ctx, queueType, dedupKey, previousMessageID, messageID)
+}
+
 // UpdateQueueMetadataCas mocks base method.
 func (m *MockMessageQueueCRUD) UpdateQueueMetadataCas(ctx context.Context, row QueueMetadataRow) error {
 	m.ctrl.T.Helper()
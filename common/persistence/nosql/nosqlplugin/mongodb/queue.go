@@ -23,6 +23,7 @@ package mongodb
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/nosql/nosqlplugin"
@@ -45,6 +46,45 @@ func (db *mdb) SelectLastEnqueuedMessageID(
 	panic("TODO")
 }
 
+// Insert a dedup key pointing to messageID if the dedup key doesn't exist yet
+func (db *mdb) InsertQueueDedupKey(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	dedupKey string,
+	messageID int64,
+) (int64, error) {
+	panic("TODO")
+}
+
+// Update a dedup key to point to messageID if it still points to previousMessageID
+func (db *mdb) UpdateQueueDedupKeyCas(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	dedupKey string,
+	previousMessageID int64,
+	messageID int64,
+) error {
+	panic("TODO")
+}
+
+// Delete a dedup key if it still points to messageID
+func (db *mdb) DeleteQueueDedupKey(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	dedupKey string,
+	messageID int64,
+) error {
+	panic("TODO")
+}
+
+// Get the time the first message of the queue was inserted
+func (db *mdb) SelectOldestMessageTimestamp(
+	ctx context.Context,
+	queueType persistence.QueueType,
+) (time.Time, error) {
+	panic("TODO")
+}
+
 // Read queue messages starting from the exclusiveBeginMessageID
 func (db *mdb) SelectMessagesFrom(
 	ctx context.Context,
@@ -55,6 +95,15 @@ func (db *mdb) SelectMessagesFrom(
 	panic("TODO")
 }
 
+// Read the last maxRows queue messages in descending ID order
+func (db *mdb) SelectLastMessages(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	maxRows int,
+) ([]*nosqlplugin.QueueMessageRow, error) {
+	panic("TODO")
+}
+
 // Read queue message starting from exclusiveBeginMessageID int64, inclusiveEndMessageID int64
 func (db *mdb) SelectMessagesBetween(
 	ctx context.Context,
@@ -126,3 +175,11 @@ func (db *mdb) GetQueueSize(
 ) (int64, error) {
 	panic("TODO")
 }
+
+func (db *mdb) GetQueueSizeUntil(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	inclusiveEndMessageID int64,
+) (int64, error) {
+	panic("TODO")
+}
@@ -152,6 +152,7 @@ type (
 		ScheduledID     int64
 		CreatedTime     time.Time
 		PartitionConfig map[string]string
+		Priority        int32
 	}
 
 	// TaskListFilter is for filtering tasklist
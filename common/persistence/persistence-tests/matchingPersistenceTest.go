@@ -140,6 +140,48 @@ func (s *MatchingPersistenceSuite) TestGetDecisionTasks() {
 	s.Equal(partitionConfig, tasks1Response.Tasks[0].PartitionConfig)
 }
 
+// TestTaskPriority test
+func (s *MatchingPersistenceSuite) TestTaskPriority() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	domainID := uuid.New()
+	workflowExecution := types.WorkflowExecution{WorkflowID: "task-priority-test", RunID: uuid.New()}
+	taskList := "task-priority-tl"
+	leaseResponse, err := s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeDecision,
+	})
+	s.NoError(err)
+	leaseResponse.TaskListInfo.LastUpdated = time.Time{}
+
+	taskID := s.GetNextSequenceNumber()
+	_, err = s.TaskMgr.CreateTasks(ctx, &p.CreateTasksRequest{
+		TaskListInfo: leaseResponse.TaskListInfo,
+		Tasks: []*p.CreateTaskInfo{
+			{
+				TaskID:    taskID,
+				Execution: workflowExecution,
+				Data: &p.TaskInfo{
+					DomainID:   domainID,
+					WorkflowID: workflowExecution.WorkflowID,
+					RunID:      workflowExecution.RunID,
+					TaskID:     taskID,
+					ScheduleID: 5,
+					Priority:   7,
+				},
+			},
+		},
+	})
+	s.NoError(err)
+
+	resp, err := s.GetTasks(ctx, domainID, taskList, p.TaskListTypeDecision, 1)
+	s.NoError(err)
+	s.Equal(1, len(resp.Tasks))
+	s.Equal(int32(7), resp.Tasks[0].Priority)
+}
+
 func (s *MatchingPersistenceSuite) TestGetTaskListSize() {
 	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
 	defer cancel()
@@ -392,6 +434,43 @@ func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskList() {
 }
 
 // TestLeaseAndUpdateTaskListSticky test
+// TestGetTaskList test
+func (s *MatchingPersistenceSuite) TestGetTaskList() {
+	domainID := uuid.New()
+	taskList := "get-task-list-test"
+
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	_, err := s.TaskMgr.GetTaskList(ctx, &p.GetTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeDecision,
+	})
+	s.IsType(&types.EntityNotExistsError{}, err)
+
+	leaseResp, err := s.TaskMgr.LeaseTaskList(ctx, &p.LeaseTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeDecision,
+	})
+	s.NoError(err)
+
+	resp, err := s.TaskMgr.GetTaskList(ctx, &p.GetTaskListRequest{
+		DomainID: domainID,
+		TaskList: taskList,
+		TaskType: p.TaskListTypeDecision,
+	})
+	s.NoError(err)
+	tli := resp.TaskListInfo
+	s.Equal(domainID, tli.DomainID)
+	s.Equal(taskList, tli.Name)
+	s.Equal(p.TaskListTypeDecision, tli.TaskType)
+	s.Equal(leaseResp.TaskListInfo.RangeID, tli.RangeID)
+	s.Equal(leaseResp.TaskListInfo.AckLevel, tli.AckLevel)
+	s.False(tli.LastUpdated.IsZero())
+}
+
 func (s *MatchingPersistenceSuite) TestLeaseAndUpdateTaskListSticky() {
 	domainID := uuid.New()
 	taskList := "aaaaaaa"
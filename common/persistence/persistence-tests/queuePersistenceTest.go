@@ -28,6 +28,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
 )
 
 type (
@@ -94,6 +96,65 @@ func (s *QueuePersistenceSuite) TestDomainReplicationQueue() {
 	s.Len(result, numMessages)
 }
 
+// TestGetMessage tests looking up a single domain replication queue message by ID
+func (s *QueuePersistenceSuite) TestGetMessage() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	err := s.Publish(ctx, []byte{1, 2, 3})
+	s.Require().NoError(err)
+
+	messages, err := s.GetReplicationMessages(ctx, -1, 1000)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(messages)
+	lastMessage := messages[len(messages)-1]
+
+	message, err := s.DomainReplicationQueueMgr.GetMessage(ctx, lastMessage.ID)
+	s.NoError(err)
+	s.Equal(lastMessage, message)
+
+	_, err = s.DomainReplicationQueueMgr.GetMessage(ctx, lastMessage.ID+1)
+	s.IsType(&types.EntityNotExistsError{}, err)
+}
+
+// TestEnqueueMessageWithDedup tests that a dedup key is only enqueued once
+func (s *QueuePersistenceSuite) TestEnqueueMessageWithDedup() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	messageID, err := s.DomainReplicationQueueMgr.EnqueueMessageWithDedup(ctx, []byte{1}, "dedup-key-1")
+	s.Require().NoError(err)
+
+	duplicateMessageID, err := s.DomainReplicationQueueMgr.EnqueueMessageWithDedup(ctx, []byte{2}, "dedup-key-1")
+	s.Require().NoError(err)
+	s.Equal(messageID, duplicateMessageID)
+
+	message, err := s.DomainReplicationQueueMgr.GetMessage(ctx, messageID)
+	s.Require().NoError(err)
+	s.Equal([]byte{1}, message.Payload)
+
+	otherMessageID, err := s.DomainReplicationQueueMgr.EnqueueMessageWithDedup(ctx, []byte{3}, "dedup-key-2")
+	s.Require().NoError(err)
+	s.Greater(otherMessageID, messageID)
+}
+
+// TestReadMessagesReverse tests reading the most recent domain replication queue messages
+func (s *QueuePersistenceSuite) TestReadMessagesReverse() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	for i := 0; i < 3; i++ {
+		s.Require().NoError(s.Publish(ctx, []byte{byte(i)}))
+	}
+
+	messages, err := s.DomainReplicationQueueMgr.ReadMessagesReverse(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Len(messages, 2)
+	s.Equal([]byte{2}, messages[0].Payload)
+	s.Equal([]byte{1}, messages[1].Payload)
+	s.Greater(messages[0].ID, messages[1].ID)
+}
+
 // TestQueueMetadataOperations tests queue metadata operations
 func (s *QueuePersistenceSuite) TestQueueMetadataOperations() {
 	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
@@ -195,6 +256,37 @@ func (s *QueuePersistenceSuite) TestDomainReplicationDLQ() {
 	s.Equal(len(result4), 0)
 }
 
+// TestPeekDomainDLQMessages tests that peeking the domain DLQ always returns its head
+func (s *QueuePersistenceSuite) TestPeekDomainDLQMessages() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	for i := 0; i < 3; i++ {
+		s.Require().NoError(s.PublishToDomainDLQ(ctx, []byte{byte(i)}))
+	}
+
+	head, _, err := s.GetMessagesFromDomainDLQ(ctx, -1, 1<<63-1, 2, nil)
+	s.Require().NoError(err)
+
+	for i := 0; i < 2; i++ {
+		messages, err := s.DomainReplicationQueueMgr.PeekDLQMessages(ctx, 2)
+		s.Require().NoError(err)
+		s.Equal(head, messages)
+	}
+}
+
+// TestGetDomainDLQOldestMessageTimestamp tests the enqueue time of the oldest domain DLQ message
+func (s *QueuePersistenceSuite) TestGetDomainDLQOldestMessageTimestamp() {
+	ctx, cancel := context.WithTimeout(context.Background(), testContextTimeout)
+	defer cancel()
+
+	s.Require().NoError(s.PublishToDomainDLQ(ctx, []byte{1}))
+
+	timestamp, err := s.DomainReplicationQueueMgr.GetDLQOldestMessageTimestamp(ctx)
+	s.Require().NoError(err)
+	s.False(timestamp.IsZero())
+}
+
 // TestDomainDLQMetadataOperations tests queue metadata operations
 func (s *QueuePersistenceSuite) TestDomainDLQMetadataOperations() {
 	clusterName := "test"
@@ -1045,6 +1045,23 @@ func (p *taskPersistenceClient) GetTaskListSize(
 	return resp, nil
 }
 
+func (p *taskPersistenceClient) GetTaskList(
+	ctx context.Context,
+	request *GetTaskListRequest,
+) (*GetTaskListResponse, error) {
+	var resp *GetTaskListResponse
+	op := func() error {
+		var err error
+		resp, err = p.persistence.GetTaskList(ctx, request)
+		return err
+	}
+	err := p.call(metrics.PersistenceGetTaskListScope, op)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (p *taskPersistenceClient) UpdateTaskList(
 	ctx context.Context,
 	request *UpdateTaskListRequest,
@@ -1590,6 +1607,24 @@ func (p *queuePersistenceClient) EnqueueMessage(
 	return p.call(metrics.PersistenceEnqueueMessageScope, op)
 }
 
+func (p *queuePersistenceClient) EnqueueMessageWithDedup(
+	ctx context.Context,
+	message []byte,
+	dedupKey string,
+) (int64, error) {
+	var resp int64
+	op := func() error {
+		var err error
+		resp, err = p.persistence.EnqueueMessageWithDedup(ctx, message, dedupKey)
+		return err
+	}
+	err := p.call(metrics.PersistenceEnqueueMessageWithDedupScope, op)
+	if err != nil {
+		return 0, err
+	}
+	return resp, nil
+}
+
 func (p *queuePersistenceClient) ReadMessages(
 	ctx context.Context,
 	lastMessageID int64,
@@ -1611,6 +1646,45 @@ func (p *queuePersistenceClient) ReadMessages(
 	return resp, nil
 }
 
+func (p *queuePersistenceClient) PurgeQueue(
+	ctx context.Context,
+	confirm string,
+) (int64, error) {
+	var resp int64
+	op := func() error {
+		var err error
+		resp, err = p.persistence.PurgeQueue(ctx, confirm)
+		return err
+	}
+	err := p.call(metrics.PersistencePurgeQueueScope, op)
+	if err != nil {
+		return 0, err
+	}
+	p.metricClient.AddCounter(metrics.PersistencePurgeQueueScope, metrics.PersistencePurgedQueueMessagesCounter, resp)
+	p.logger.Warn("Purged queue", tag.Counter(int(resp)))
+	return resp, nil
+}
+
+func (p *queuePersistenceClient) ReadMessagesReverse(
+	ctx context.Context,
+	maxCount int,
+) ([]*QueueMessage, error) {
+	var resp []*QueueMessage
+	op := func() error {
+		var err error
+		resp, err = p.persistence.ReadMessagesReverse(ctx, maxCount)
+		if err == nil && len(resp) == 0 {
+			p.metricClient.IncCounter(metrics.PersistenceReadQueueMessagesReverseScope, metrics.PersistenceEmptyResponseCounter)
+		}
+		return err
+	}
+	err := p.call(metrics.PersistenceReadQueueMessagesReverseScope, op)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (p *queuePersistenceClient) UpdateAckLevel(
 	ctx context.Context,
 	messageID int64,
@@ -1679,6 +1753,20 @@ func (p *queuePersistenceClient) ReadMessagesFromDLQ(
 	return result, token, nil
 }
 
+func (p *queuePersistenceClient) DeleteDLQMessagesWhere(
+	ctx context.Context,
+	predicate func(*QueueMessage) bool,
+) (int, error) {
+	var resp int
+	op := func() error {
+		var err error
+		resp, err = p.persistence.DeleteDLQMessagesWhere(ctx, predicate)
+		return err
+	}
+	err := p.call(metrics.PersistenceDeleteDLQMessagesWhereScope, op)
+	return resp, err
+}
+
 func (p *queuePersistenceClient) DeleteMessageFromDLQ(
 	ctx context.Context,
 	messageID int64,
@@ -1727,6 +1815,56 @@ func (p *queuePersistenceClient) GetDLQAckLevels(
 	return resp, nil
 }
 
+func (p *queuePersistenceClient) GetMessage(
+	ctx context.Context,
+	messageID int64,
+) (*QueueMessage, error) {
+	var resp *QueueMessage
+	op := func() error {
+		var err error
+		resp, err = p.persistence.GetMessage(ctx, messageID)
+		return err
+	}
+	err := p.call(metrics.PersistenceGetQueueMessageScope, op)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (p *queuePersistenceClient) PeekDLQMessages(
+	ctx context.Context,
+	maxCount int,
+) ([]*QueueMessage, error) {
+	var resp []*QueueMessage
+	op := func() error {
+		var err error
+		resp, err = p.persistence.PeekDLQMessages(ctx, maxCount)
+		return err
+	}
+	err := p.call(metrics.PersistencePeekDLQMessagesScope, op)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (p *queuePersistenceClient) GetDLQOldestMessageTimestamp(
+	ctx context.Context,
+) (time.Time, error) {
+	var resp time.Time
+	op := func() error {
+		var err error
+		resp, err = p.persistence.GetDLQOldestMessageTimestamp(ctx)
+		return err
+	}
+	err := p.call(metrics.PersistenceGetDLQOldestMessageTimestampScope, op)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return resp, nil
+}
+
 func (p *queuePersistenceClient) GetDLQSize(
 	ctx context.Context,
 ) (int64, error) {
@@ -22,23 +22,50 @@
 
 package persistence
 
-import "context"
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/uber/cadence/common/types"
+)
 
 type (
 	queueManager struct {
 		persistence Queue
+		queueType   QueueType
 	}
+
+	// QueueManagerOption is used to configure the QueueManager
+	QueueManagerOption func(*queueManager)
 )
 
+const dlqDeletePageSize = 1000
+
 var _ QueueManager = (*queueManager)(nil)
 
+// WithQueueType sets the type of the queue the QueueManager manages, which is
+// DomainReplicationQueueType by default. PurgeQueue must be confirmed with its name.
+func WithQueueType(queueType QueueType) QueueManagerOption {
+	return func(q *queueManager) {
+		q.queueType = queueType
+	}
+}
+
 // NewQueueManager returns a new QueueManager
 func NewQueueManager(
 	persistence Queue,
+	opts ...QueueManagerOption,
 ) QueueManager {
-	return &queueManager{
+	q := &queueManager{
 		persistence: persistence,
+		queueType:   DomainReplicationQueueType,
+	}
+	for _, opt := range opts {
+		opt(q)
 	}
+	return q
 }
 
 func (q *queueManager) Close() {
@@ -49,6 +76,13 @@ func (q *queueManager) EnqueueMessage(ctx context.Context, messagePayload []byte
 	return q.persistence.EnqueueMessage(ctx, messagePayload)
 }
 
+func (q *queueManager) EnqueueMessageWithDedup(ctx context.Context, messagePayload []byte, dedupKey string) (int64, error) {
+	if dedupKey == "" {
+		return 0, &types.BadRequestError{Message: "dedup key is not set"}
+	}
+	return q.persistence.EnqueueMessageWithDedup(ctx, messagePayload, dedupKey)
+}
+
 func (q *queueManager) ReadMessages(ctx context.Context, lastMessageID int64, maxCount int) ([]*QueueMessage, error) {
 	resp, err := q.persistence.ReadMessages(ctx, lastMessageID, maxCount)
 	if err != nil {
@@ -61,10 +95,43 @@ func (q *queueManager) ReadMessages(ctx context.Context, lastMessageID int64, ma
 	return output, nil
 }
 
+func (q *queueManager) ReadMessagesReverse(ctx context.Context, maxCount int) ([]*QueueMessage, error) {
+	resp, err := q.persistence.ReadMessagesReverse(ctx, maxCount)
+	if err != nil {
+		return nil, err
+	}
+	var output []*QueueMessage
+	for _, message := range resp {
+		output = append(output, q.fromInternalQueueMessage(message))
+	}
+	return output, nil
+}
+
+func (q *queueManager) GetMessage(ctx context.Context, messageID int64) (*QueueMessage, error) {
+	// message IDs are strictly increasing, so the first message after messageID-1 is the one we look for if it exists
+	resp, err := q.persistence.ReadMessages(ctx, messageID-1, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp) == 0 || resp[0].ID != messageID {
+		return nil, &types.EntityNotExistsError{
+			Message: fmt.Sprintf("Queue message %v does not exist.", messageID),
+		}
+	}
+	return q.fromInternalQueueMessage(resp[0]), nil
+}
+
 func (q *queueManager) DeleteMessagesBefore(ctx context.Context, messageID int64) error {
 	return q.persistence.DeleteMessagesBefore(ctx, messageID)
 }
 
+func (q *queueManager) PurgeQueue(ctx context.Context, confirm string) (int64, error) {
+	if confirm != q.queueType.String() {
+		return 0, &types.BadRequestError{Message: fmt.Sprintf("purging the queue requires confirming its name %q", q.queueType.String())}
+	}
+	return q.persistence.PurgeMessages(ctx)
+}
+
 func (q *queueManager) UpdateAckLevel(ctx context.Context, messageID int64, clusterName string) error {
 	return q.persistence.UpdateAckLevel(ctx, messageID, clusterName)
 }
@@ -77,6 +144,10 @@ func (q *queueManager) EnqueueMessageToDLQ(ctx context.Context, messagePayload [
 	return q.persistence.EnqueueMessageToDLQ(ctx, messagePayload)
 }
 
+func (q *queueManager) GetDLQOldestMessageTimestamp(ctx context.Context) (time.Time, error) {
+	return q.persistence.GetDLQOldestMessageTimestamp(ctx)
+}
+
 func (q *queueManager) ReadMessagesFromDLQ(ctx context.Context, firstMessageID int64, lastMessageID int64, pageSize int, pageToken []byte) ([]*QueueMessage, []byte, error) {
 	resp, data, err := q.persistence.ReadMessagesFromDLQ(ctx, firstMessageID, lastMessageID, pageSize, pageToken)
 	if resp == nil {
@@ -89,6 +160,18 @@ func (q *queueManager) ReadMessagesFromDLQ(ctx context.Context, firstMessageID i
 	return output, data, err
 }
 
+func (q *queueManager) PeekDLQMessages(ctx context.Context, maxCount int) ([]*QueueMessage, error) {
+	// the page token is dropped, so the next peek starts from the head of the DLQ again
+	messages, _, err := q.ReadMessagesFromDLQ(ctx, -1, math.MaxInt64, maxCount, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(messages) > maxCount {
+		messages = messages[:maxCount]
+	}
+	return messages, nil
+}
+
 func (q *queueManager) DeleteMessageFromDLQ(ctx context.Context, messageID int64) error {
 	return q.persistence.DeleteMessageFromDLQ(ctx, messageID)
 }
@@ -97,6 +180,35 @@ func (q *queueManager) RangeDeleteMessagesFromDLQ(ctx context.Context, firstMess
 	return q.persistence.RangeDeleteMessagesFromDLQ(ctx, firstMessageID, lastMessageID)
 }
 
+func (q *queueManager) DeleteDLQMessagesWhere(ctx context.Context, predicate func(*QueueMessage) bool) (int, error) {
+	deleted := 0
+	var pageToken []byte
+	for {
+		if err := ctx.Err(); err != nil {
+			return deleted, err
+		}
+
+		messages, nextPageToken, err := q.ReadMessagesFromDLQ(ctx, -1, math.MaxInt64, dlqDeletePageSize, pageToken)
+		if err != nil {
+			return deleted, err
+		}
+		for _, message := range messages {
+			if !predicate(message) {
+				continue
+			}
+			if err := q.persistence.DeleteMessageFromDLQ(ctx, message.ID); err != nil {
+				return deleted, err
+			}
+			deleted++
+		}
+
+		if len(nextPageToken) == 0 {
+			return deleted, nil
+		}
+		pageToken = nextPageToken
+	}
+}
+
 func (q *queueManager) UpdateDLQAckLevel(ctx context.Context, messageID int64, clusterName string) error {
 	return q.persistence.UpdateDLQAckLevel(ctx, messageID, clusterName)
 }
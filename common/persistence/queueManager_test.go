@@ -0,0 +1,179 @@
+// The MIT License (MIT)
+//
+// Copyright (c) 2017-2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package persistence
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/types"
+)
+
+type fakeQueue struct {
+	Queue
+	messages    []*InternalQueueMessage
+	dlqMessages []*InternalQueueMessage
+}
+
+func (q *fakeQueue) ReadMessages(_ context.Context, lastMessageID int64, maxCount int) ([]*InternalQueueMessage, error) {
+	var result []*InternalQueueMessage
+	for _, message := range q.messages {
+		if message.ID > lastMessageID && len(result) < maxCount {
+			result = append(result, message)
+		}
+	}
+	return result, nil
+}
+
+func (q *fakeQueue) ReadMessagesFromDLQ(_ context.Context, firstMessageID int64, lastMessageID int64, pageSize int, pageToken []byte) ([]*InternalQueueMessage, []byte, error) {
+	if len(pageToken) != 0 {
+		firstMessageID, _ = strconv.ParseInt(string(pageToken), 10, 64)
+	}
+	var result []*InternalQueueMessage
+	for _, message := range q.dlqMessages {
+		if message.ID > firstMessageID && message.ID <= lastMessageID && len(result) < pageSize {
+			result = append(result, message)
+		}
+	}
+	var nextPageToken []byte
+	if len(result) == pageSize {
+		nextPageToken = []byte(strconv.FormatInt(result[len(result)-1].ID, 10))
+	}
+	return result, nextPageToken, nil
+}
+
+func (q *fakeQueue) DeleteMessageFromDLQ(_ context.Context, messageID int64) error {
+	for i, message := range q.dlqMessages {
+		if message.ID == messageID {
+			q.dlqMessages = append(q.dlqMessages[:i], q.dlqMessages[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (q *fakeQueue) PurgeMessages(_ context.Context) (int64, error) {
+	deleted := int64(len(q.messages))
+	q.messages = nil
+	return deleted, nil
+}
+
+func TestQueueManager_GetMessage(t *testing.T) {
+	queue := &fakeQueue{
+		messages: []*InternalQueueMessage{
+			{ID: 1, QueueType: DomainReplicationQueueType, Payload: []byte("message-1")},
+			{ID: 3, QueueType: DomainReplicationQueueType, Payload: []byte("message-3")},
+		},
+	}
+	manager := NewQueueManager(queue)
+
+	message, err := manager.GetMessage(context.Background(), 3)
+	require.NoError(t, err)
+	assert.Equal(t, &QueueMessage{ID: 3, QueueType: DomainReplicationQueueType, Payload: []byte("message-3")}, message)
+
+	for _, messageID := range []int64{0, 2, 4} {
+		_, err = manager.GetMessage(context.Background(), messageID)
+		assert.IsType(t, &types.EntityNotExistsError{}, err)
+	}
+}
+
+func TestQueueManager_EnqueueMessageWithDedup(t *testing.T) {
+	manager := NewQueueManager(&fakeQueue{})
+
+	// the fake queue doesn't implement EnqueueMessageWithDedup, so the call must not reach it
+	_, err := manager.EnqueueMessageWithDedup(context.Background(), []byte("message"), "")
+	assert.IsType(t, &types.BadRequestError{}, err)
+}
+
+func TestQueueManager_PeekDLQMessages(t *testing.T) {
+	queue := &fakeQueue{
+		dlqMessages: []*InternalQueueMessage{
+			{ID: 1, QueueType: DomainReplicationQueueType, Payload: []byte("message-1")},
+			{ID: 2, QueueType: DomainReplicationQueueType, Payload: []byte("message-2")},
+			{ID: 5, QueueType: DomainReplicationQueueType, Payload: []byte("message-5")},
+		},
+	}
+	manager := NewQueueManager(queue)
+
+	expected := []*QueueMessage{
+		{ID: 1, QueueType: DomainReplicationQueueType, Payload: []byte("message-1")},
+		{ID: 2, QueueType: DomainReplicationQueueType, Payload: []byte("message-2")},
+	}
+	for i := 0; i < 2; i++ {
+		messages, err := manager.PeekDLQMessages(context.Background(), 2)
+		require.NoError(t, err)
+		assert.Equal(t, expected, messages)
+	}
+}
+
+func TestQueueManager_DeleteDLQMessagesWhere(t *testing.T) {
+	queue := &fakeQueue{}
+	for i := int64(0); i < 2*dlqDeletePageSize+1; i++ {
+		queue.dlqMessages = append(queue.dlqMessages, &InternalQueueMessage{ID: i, QueueType: DomainReplicationQueueType})
+	}
+	manager := NewQueueManager(queue)
+
+	deleted, err := manager.DeleteDLQMessagesWhere(context.Background(), func(message *QueueMessage) bool {
+		return message.ID%2 == 0
+	})
+	require.NoError(t, err)
+	assert.Equal(t, dlqDeletePageSize+1, deleted)
+	require.Len(t, queue.dlqMessages, dlqDeletePageSize)
+	for _, message := range queue.dlqMessages {
+		assert.Equal(t, int64(1), message.ID%2)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	deleted, err = manager.DeleteDLQMessagesWhere(ctx, func(*QueueMessage) bool { return true })
+	assert.Equal(t, context.Canceled, err)
+	assert.Zero(t, deleted)
+	assert.Len(t, queue.dlqMessages, dlqDeletePageSize)
+}
+
+func TestQueueManager_PurgeQueue(t *testing.T) {
+	queue := &fakeQueue{}
+	for i := int64(0); i < 3; i++ {
+		queue.messages = append(queue.messages, &InternalQueueMessage{ID: i, QueueType: DomainReplicationQueueType})
+	}
+	manager := NewQueueManager(queue)
+
+	_, err := manager.PurgeQueue(context.Background(), "wrong-queue")
+	assert.IsType(t, &types.BadRequestError{}, err)
+	assert.Len(t, queue.messages, 3)
+	_, err = NewQueueManager(queue, WithQueueType(-DomainReplicationQueueType)).PurgeQueue(context.Background(), "domain-replication")
+	assert.IsType(t, &types.BadRequestError{}, err)
+
+	deleted, err := manager.PurgeQueue(context.Background(), "domain-replication")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+	assert.Empty(t, queue.messages)
+
+	deleted, err = manager.PurgeQueue(context.Background(), "domain-replication")
+	require.NoError(t, err)
+	assert.Zero(t, deleted)
+}
@@ -28,6 +28,7 @@ package ratelimited
 
 import (
 	"context"
+	"time"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
@@ -55,6 +56,14 @@ func (c *ratelimitedQueueManager) Close() {
 	return
 }
 
+func (c *ratelimitedQueueManager) DeleteDLQMessagesWhere(ctx context.Context, predicate func(*persistence.QueueMessage) bool) (i1 int, err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.DeleteDLQMessagesWhere(ctx, predicate)
+}
+
 func (c *ratelimitedQueueManager) DeleteMessageFromDLQ(ctx context.Context, messageID int64) (err error) {
 	if ok := c.rateLimiter.Allow(); !ok {
 		err = ErrPersistenceLimitExceeded
@@ -87,6 +96,14 @@ func (c *ratelimitedQueueManager) EnqueueMessageToDLQ(ctx context.Context, messa
 	return c.wrapped.EnqueueMessageToDLQ(ctx, messagePayload)
 }
 
+func (c *ratelimitedQueueManager) EnqueueMessageWithDedup(ctx context.Context, messagePayload []byte, dedupKey string) (i1 int64, err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.EnqueueMessageWithDedup(ctx, messagePayload, dedupKey)
+}
+
 func (c *ratelimitedQueueManager) GetAckLevels(ctx context.Context) (m1 map[string]int64, err error) {
 	if ok := c.rateLimiter.Allow(); !ok {
 		err = ErrPersistenceLimitExceeded
@@ -103,6 +120,14 @@ func (c *ratelimitedQueueManager) GetDLQAckLevels(ctx context.Context) (m1 map[s
 	return c.wrapped.GetDLQAckLevels(ctx)
 }
 
+func (c *ratelimitedQueueManager) GetDLQOldestMessageTimestamp(ctx context.Context) (t1 time.Time, err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.GetDLQOldestMessageTimestamp(ctx)
+}
+
 func (c *ratelimitedQueueManager) GetDLQSize(ctx context.Context) (i1 int64, err error) {
 	if ok := c.rateLimiter.Allow(); !ok {
 		err = ErrPersistenceLimitExceeded
@@ -111,6 +136,30 @@ func (c *ratelimitedQueueManager) GetDLQSize(ctx context.Context) (i1 int64, err
 	return c.wrapped.GetDLQSize(ctx)
 }
 
+func (c *ratelimitedQueueManager) GetMessage(ctx context.Context, messageID int64) (qp1 *persistence.QueueMessage, err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.GetMessage(ctx, messageID)
+}
+
+func (c *ratelimitedQueueManager) PeekDLQMessages(ctx context.Context, maxCount int) (qpa1 []*persistence.QueueMessage, err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.PeekDLQMessages(ctx, maxCount)
+}
+
+func (c *ratelimitedQueueManager) PurgeQueue(ctx context.Context, confirm string) (i1 int64, err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.PurgeQueue(ctx, confirm)
+}
+
 func (c *ratelimitedQueueManager) RangeDeleteMessagesFromDLQ(ctx context.Context, firstMessageID int64, lastMessageID int64) (err error) {
 	if ok := c.rateLimiter.Allow(); !ok {
 		err = ErrPersistenceLimitExceeded
@@ -135,6 +184,14 @@ func (c *ratelimitedQueueManager) ReadMessagesFromDLQ(ctx context.Context, first
 	return c.wrapped.ReadMessagesFromDLQ(ctx, firstMessageID, lastMessageID, pageSize, pageToken)
 }
 
+func (c *ratelimitedQueueManager) ReadMessagesReverse(ctx context.Context, maxCount int) (qpa1 []*persistence.QueueMessage, err error) {
+	if ok := c.rateLimiter.Allow(); !ok {
+		err = ErrPersistenceLimitExceeded
+		return
+	}
+	return c.wrapped.ReadMessagesReverse(ctx, maxCount)
+}
+
 func (c *ratelimitedQueueManager) UpdateAckLevel(ctx context.Context, messageID int64, clusterName string) (err error) {
 	if ok := c.rateLimiter.Allow(); !ok {
 		err = ErrPersistenceLimitExceeded
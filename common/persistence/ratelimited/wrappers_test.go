@@ -28,6 +28,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -198,12 +199,19 @@ func builderForPassThrough(t *testing.T, injector any, limiter quotas.Limiter, e
 			mocked.EXPECT().UpdateAckLevel(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedErr)
 			mocked.EXPECT().GetAckLevels(gomock.Any()).Return(map[string]int64{}, expectedErr)
 			mocked.EXPECT().DeleteMessagesBefore(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().DeleteDLQMessagesWhere(gomock.Any(), gomock.Any()).Return(0, expectedErr)
 			mocked.EXPECT().DeleteMessageFromDLQ(gomock.Any(), gomock.Any()).Return(expectedErr)
 			mocked.EXPECT().EnqueueMessageToDLQ(gomock.Any(), gomock.Any()).Return(expectedErr)
+			mocked.EXPECT().EnqueueMessageWithDedup(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), expectedErr)
 			mocked.EXPECT().GetDLQAckLevels(gomock.Any()).Return(map[string]int64{}, expectedErr)
+			mocked.EXPECT().GetDLQOldestMessageTimestamp(gomock.Any()).Return(time.Time{}, expectedErr)
 			mocked.EXPECT().GetDLQSize(gomock.Any()).Return(int64(0), expectedErr)
+			mocked.EXPECT().GetMessage(gomock.Any(), gomock.Any()).Return(&persistence.QueueMessage{}, expectedErr)
+			mocked.EXPECT().PeekDLQMessages(gomock.Any(), gomock.Any()).Return([]*persistence.QueueMessage{}, expectedErr)
+			mocked.EXPECT().PurgeQueue(gomock.Any(), gomock.Any()).Return(int64(0), expectedErr)
 			mocked.EXPECT().RangeDeleteMessagesFromDLQ(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedErr)
 			mocked.EXPECT().ReadMessagesFromDLQ(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*persistence.QueueMessage{}, nil, expectedErr)
+			mocked.EXPECT().ReadMessagesReverse(gomock.Any(), gomock.Any()).Return([]*persistence.QueueMessage{}, expectedErr)
 			mocked.EXPECT().UpdateDLQAckLevel(gomock.Any(), gomock.Any(), gomock.Any()).Return(expectedErr)
 		}
 	case *ratelimitedShardManager:
@@ -226,6 +234,7 @@ func builderForPassThrough(t *testing.T, injector any, limiter quotas.Limiter, e
 			mocked.EXPECT().GetOrphanTasks(gomock.Any(), gomock.Any()).Return(&persistence.GetOrphanTasksResponse{}, expectedErr)
 			mocked.EXPECT().GetTaskListSize(gomock.Any(), gomock.Any()).Return(&persistence.GetTaskListSizeResponse{}, expectedErr)
 			mocked.EXPECT().LeaseTaskList(gomock.Any(), gomock.Any()).Return(&persistence.LeaseTaskListResponse{}, expectedErr)
+			mocked.EXPECT().GetTaskList(gomock.Any(), gomock.Any()).Return(&persistence.GetTaskListResponse{}, expectedErr)
 			mocked.EXPECT().ListTaskList(gomock.Any(), gomock.Any()).Return(&persistence.ListTaskListResponse{}, expectedErr)
 			mocked.EXPECT().UpdateTaskList(gomock.Any(), gomock.Any()).Return(&persistence.UpdateTaskListResponse{}, expectedErr)
 		}
@@ -131,6 +131,7 @@ var expectedNil = map[string]map[string]any{
 		"GetCreatedTimestamp": zeroUnix,
 		"GetExpiryTimestamp":  zeroUnix,
 		"GetPartitionConfig":  map[string]string(nil),
+		"GetPriority":         int32(0),
 		"GetRunID":            []uint8(nil),
 		"GetScheduleID":       int64(0),
 		"GetWorkflowID":       "",
@@ -356,6 +357,7 @@ var expectedEmpty = map[string]map[string]any{
 		"GetCreatedTimestamp": time.Time{},
 		"GetExpiryTimestamp":  time.Time{},
 		"GetPartitionConfig":  map[string]string(nil),
+		"GetPriority":         int32(0),
 		"GetRunID":            []uint8(nil),
 		"GetScheduleID":       int64(0),
 		"GetWorkflowID":       "",
@@ -583,6 +585,7 @@ var expectedNonEmpty = map[string]map[string]any{
 		"GetCreatedTimestamp": taskInfoCreateTime,
 		"GetExpiryTimestamp":  taskInfoExpiryTime,
 		"GetPartitionConfig":  map[string]string{"key": "value"},
+		"GetPriority":         int32(3),
 		"GetRunID":            []byte(taskInfoRunID),
 		"GetScheduleID":       int64(1),
 		"GetWorkflowID":       "workflowID",
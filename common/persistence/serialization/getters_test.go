@@ -188,6 +188,7 @@ func TestGettersForInfos(t *testing.T) {
 			PartitionConfig: map[string]string{
 				"key": "value",
 			},
+			Priority: 3,
 		},
 		&TimerInfo{
 			Version:         1,
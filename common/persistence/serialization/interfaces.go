@@ -255,6 +255,7 @@ type (
 		ExpiryTimestamp  time.Time
 		CreatedTimestamp time.Time
 		PartitionConfig  map[string]string
+		Priority         int32
 	}
 
 	// TaskListInfo blob in a serialization agnostic format
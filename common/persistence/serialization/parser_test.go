@@ -211,6 +211,7 @@ func TestParserRoundTrip(t *testing.T) {
 			ExpiryTimestamp:  now,
 			CreatedTimestamp: now,
 			PartitionConfig:  map[string]string{"test_partition_key": "test_partition_value"},
+			Priority:         3,
 		},
 		&TaskListInfo{
 			Kind:            1,
@@ -531,6 +531,7 @@ func taskInfoToThrift(info *TaskInfo) *sqlblobs.TaskInfo {
 		ExpiryTimeNanos:  timeToUnixNanoPtr(info.ExpiryTimestamp),
 		CreatedTimeNanos: timeToUnixNanoPtr(info.CreatedTimestamp),
 		PartitionConfig:  info.PartitionConfig,
+		Priority:         common.Int32Ptr(info.Priority),
 	}
 }
 
@@ -545,6 +546,7 @@ func taskInfoFromThrift(info *sqlblobs.TaskInfo) *TaskInfo {
 		ExpiryTimestamp:  timeFromUnixNano(info.GetExpiryTimeNanos()),
 		CreatedTimestamp: timeFromUnixNano(info.GetCreatedTimeNanos()),
 		PartitionConfig:  info.PartitionConfig,
+		Priority:         info.GetPriority(),
 	}
 }
 
@@ -429,6 +429,7 @@ func TestTaskInfo(t *testing.T) {
 		ExpiryTimestamp:  time.Now(),
 		CreatedTimestamp: time.Now(),
 		PartitionConfig:  map[string]string{"zone": "dca1"},
+		Priority:         3,
 	}
 	actual := taskInfoFromThrift(taskInfoToThrift(expected))
 	assert.Equal(t, expected.WorkflowID, actual.WorkflowID)
@@ -437,6 +438,7 @@ func TestTaskInfo(t *testing.T) {
 	assert.Equal(t, expected.ExpiryTimestamp.Sub(actual.ExpiryTimestamp), time.Duration(0))
 	assert.Equal(t, expected.CreatedTimestamp.Sub(actual.CreatedTimestamp), time.Duration(0))
 	assert.Equal(t, expected.PartitionConfig, actual.PartitionConfig)
+	assert.Equal(t, expected.Priority, actual.Priority)
 }
 
 func TestTaskListInfo(t *testing.T) {
@@ -21,19 +21,91 @@
 package persistence
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"hash/crc32"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/golang/snappy"
+	"go.uber.org/thriftrw/protocol/stream"
+	"go.uber.org/thriftrw/wire"
+
+	checksumgen "github.com/uber/cadence/.gen/go/checksum"
 	"github.com/uber/cadence/.gen/go/config"
 	"github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/replicator"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
 	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/common/types/mapper/thrift"
 )
 
+const (
+	// snappyMaxExpansion bounds the ratio of the decoded and encoded length of a snappy block,
+	// the densest snappy element copies 64 bytes with a 3 byte tag
+	snappyMaxExpansion = 32
+
+	// memoFieldsThriftID is the thrift field ID of workflow.Memo.Fields
+	memoFieldsThriftID = 10
+
+	// thrift field IDs of timerInfoThrift, the IDs of the fields shared with sqlblobs.TimerInfo are
+	// the same so the timer info blobs persisted by SQL stores can be decoded as well
+	timerInfoVersionThriftID         = 10
+	timerInfoStartedIDThriftID       = 12
+	timerInfoExpiryTimeNanosThriftID = 14
+	timerInfoTaskStatusThriftID      = 16
+	timerInfoTimerIDThriftID         = 18
+
+	// checksumMagic marks a payload of history events prefixed with its CRC32C checksum, it can never be
+	// the first byte of a thriftrw encoded struct or a JSON document so only those encodings are checksummed
+	checksumMagic = byte(0xc3)
+	// checksumHeaderSize is the size of the magic byte and the checksum prefixed to a payload
+	checksumHeaderSize = 5
+)
+
+const (
+	// BlobKindHistoryEvents is the kind for a batch of history events
+	BlobKindHistoryEvents BlobKind = iota
+	// BlobKindHistoryEvent is the kind for a single history event
+	BlobKindHistoryEvent
+	// BlobKindVisibilityMemo is the kind for visibility memo fields
+	BlobKindVisibilityMemo
+	// BlobKindResetPoints is the kind for workflow reset points
+	BlobKindResetPoints
+	// BlobKindBadBinaries is the kind for domain bad binaries
+	BlobKindBadBinaries
+	// BlobKindVersionHistories is the kind for workflow version histories
+	BlobKindVersionHistories
+	// BlobKindPendingFailoverMarkers is the kind for shard pending failover markers
+	BlobKindPendingFailoverMarkers
+	// BlobKindProcessingQueueStates is the kind for shard processing queue states
+	BlobKindProcessingQueueStates
+	// BlobKindDynamicConfigBlob is the kind for dynamic config snapshots
+	BlobKindDynamicConfigBlob
+	// BlobKindIsolationGroups is the kind for domain isolation group configuration
+	BlobKindIsolationGroups
+	// BlobKindChecksum is the kind for mutable state checksums
+	BlobKindChecksum
+	// BlobKindPendingActivityInfo is the kind for pending activity infos
+	BlobKindPendingActivityInfo
+	// BlobKindTimerInfo is the kind for user timer infos
+	BlobKindTimerInfo
+)
+
+var errMemoFieldDecoderStreamOnly = errors.New("memo field decoder only supports stream decoding")
+
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
 type (
 	// PayloadSerializer is used by persistence to serialize/deserialize history event(s) and others
 	// It will only be used inside persistence, so that serialize/deserialize is transparent for application
@@ -41,6 +113,9 @@ type (
 		// serialize/deserialize history events
 		SerializeBatchEvents(batch []*types.HistoryEvent, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeBatchEvents(data *DataBlob) ([]*types.HistoryEvent, error)
+		// DeserializeBatchEventsWithGaps deserializes a batch of history events which may not be contiguous,
+		// and returns the ranges of event IDs missing between the first and the last event of the batch
+		DeserializeBatchEventsWithGaps(data *DataBlob) ([]*types.HistoryEvent, []EventIDRange, error)
 
 		// serialize/deserialize a single history event
 		SerializeEvent(event *types.HistoryEvent, encodingType common.EncodingType) (*DataBlob, error)
@@ -49,6 +124,8 @@ type (
 		// serialize/deserialize visibility memo fields
 		SerializeVisibilityMemo(memo *types.Memo, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeVisibilityMemo(data *DataBlob) (*types.Memo, error)
+		// GetMemoField decodes a single field of a visibility memo without deserializing the whole memo
+		GetMemoField(data *DataBlob, fieldName string) ([]byte, bool, error)
 
 		// serialize/deserialize reset points
 		SerializeResetPoints(event *types.ResetPoints, encodingType common.EncodingType) (*DataBlob, error)
@@ -76,6 +153,53 @@ type (
 
 		SerializeIsolationGroups(event *types.IsolationGroupConfiguration, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeIsolationGroups(data *DataBlob) (*types.IsolationGroupConfiguration, error)
+
+		// serialize/deserialize mutable state checksum
+		SerializeChecksum(sum *checksum.Checksum, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeChecksum(data *DataBlob) (*checksum.Checksum, error)
+
+		// serialize/deserialize pending activity info
+		SerializePendingActivityInfo(info *types.PendingActivityInfo, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializePendingActivityInfo(data *DataBlob) (*types.PendingActivityInfo, error)
+
+		// serialize/deserialize user timer info
+		SerializeTimerInfo(info *TimerInfo, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeTimerInfo(data *DataBlob) (*TimerInfo, error)
+
+		// PreferredEncoding returns the recommended encoding type for the given kind of payload
+		PreferredEncoding(kind BlobKind) common.EncodingType
+
+		// EstimateSerializedSize returns the length of the payload the corresponding Serialize* call would produce
+		// for the object, including the checksum and compression of history events, without keeping the bytes.
+		// Payloads of the built-in JSON codec are written to a counting writer instead of being returned, which
+		// saves copying them although encoding/json still buffers the encoded value internally,
+		// other encodings are serialized and measured.
+		EstimateSerializedSize(obj interface{}, encodingType common.EncodingType) (int, error)
+
+		// ReencodeBlob converts a blob of the given kind to the encoding type, e.g. to migrate JSON payloads to thriftrw.
+		// Nil blobs stay nil, empty blobs stay empty and blobs already in the encoding type are returned as is.
+		ReencodeBlob(kind BlobKind, blob *DataBlob, encodingType common.EncodingType) (*DataBlob, error)
+
+		// RegisterEncoding registers the codec used to serialize/deserialize payloads of the encoding type,
+		// replacing the codec previously registered for it
+		RegisterEncoding(encodingType common.EncodingType, codec EncodingCodec)
+	}
+
+	// EncodingCodec encodes and decodes payloads of a single encoding type
+	EncodingCodec interface {
+		// Encode encodes the input, which is one of the types accepted by the PayloadSerializer
+		Encode(input interface{}) ([]byte, error)
+		// Decode decodes the data into target, which is a pointer to one of the types returned by the PayloadSerializer
+		Decode(data []byte, target interface{}) error
+	}
+
+	// BlobKind identifies the kind of payload stored in a DataBlob
+	BlobKind int
+
+	// EventIDRange is an inclusive range of history event IDs
+	EventIDRange struct {
+		FirstEventID int64
+		LastEventID  int64
 	}
 
 	// CadenceSerializationError is an error type for cadence serialization
@@ -88,25 +212,116 @@ type (
 		msg string
 	}
 
+	// CorruptedBlobError is returned when the checksum of a blob doesn't match its payload
+	CorruptedBlobError struct {
+		Encoding         common.EncodingType
+		Length           int
+		ExpectedChecksum uint32
+		ActualChecksum   uint32
+	}
+
 	// UnknownEncodingTypeError is an error type for unknown or unsupported encoding type
 	UnknownEncodingTypeError struct {
 		encodingType common.EncodingType
 	}
 
+	// UnknownFieldError is returned by a strict decoding serializer when a JSON payload
+	// has a field the target type doesn't know, e.g. it was written by a newer schema
+	UnknownFieldError struct {
+		Field string
+	}
+
+	// PayloadSerializerOption is used to customize the PayloadSerializer
+	PayloadSerializerOption func(*serializerImpl)
+
 	serializerImpl struct {
 		thriftrwEncoder codec.BinaryEncoder
+
+		sync.RWMutex
+		codecs map[common.EncodingType]EncodingCodec
+
+		// compressionThreshold is the minimum size of thriftrw encoded history events to be compressed,
+		// nil or a value <= 0 disables compression
+		compressionThreshold dynamicconfig.IntPropertyFn
+		// checksum enables prefixing encoded history events with their checksum
+		checksum bool
+	}
+
+	// timerInfoThrift is the thriftrw representation of TimerInfo
+	timerInfoThrift struct {
+		TimerInfo
+	}
+
+	// memoFieldDecoder is a partial thrift decoder for workflow.Memo which only keeps the value of a single field
+	memoFieldDecoder struct {
+		fieldName string
+		value     []byte
+		found     bool
 	}
 )
 
+// WithHistoryEventsCompression makes SerializeBatchEvents and SerializeEvent compress thriftrw encoded
+// history events with snappy if the encoded size is at least threshold bytes, a threshold <= 0 disables compression.
+// Compressed blobs are always decompressed on deserialization, regardless of this option.
+func WithHistoryEventsCompression(threshold dynamicconfig.IntPropertyFn) PayloadSerializerOption {
+	return func(t *serializerImpl) {
+		t.compressionThreshold = threshold
+	}
+}
+
+// WithHistoryEventsChecksum makes SerializeBatchEvents and SerializeEvent prefix thriftrw and JSON encoded
+// history events with their CRC32C checksum. Checksums of history events are always verified on deserialization,
+// regardless of this option, and blobs without a checksum are still deserialized. Other payloads and
+// encodings registered with RegisterEncoding are never checksummed.
+func WithHistoryEventsChecksum() PayloadSerializerOption {
+	return func(t *serializerImpl) {
+		t.checksum = true
+	}
+}
+
+// NewPayloadSerializerWithOptions returns a PayloadSerializer, if strictDecode is set JSON payloads fail to
+// deserialize with an UnknownFieldError when they have fields the target type doesn't know, instead of silently
+// dropping them. It lets tooling check the forward-compatibility of payloads while the server keeps decoding leniently.
+// ThriftRW payloads are not affected as thrift always skips unknown fields.
+func NewPayloadSerializerWithOptions(strictDecode bool) PayloadSerializer {
+	t := newPayloadSerializer()
+	if strictDecode {
+		t.RegisterEncoding(common.EncodingTypeJSON, jsonCodec{disallowUnknownFields: true})
+	}
+	return t
+}
+
 // NewPayloadSerializer returns a PayloadSerializer
-func NewPayloadSerializer() PayloadSerializer {
-	return &serializerImpl{
+func NewPayloadSerializer(opts ...PayloadSerializerOption) PayloadSerializer {
+	return newPayloadSerializer(opts...)
+}
+
+func newPayloadSerializer(opts ...PayloadSerializerOption) *serializerImpl {
+	t := &serializerImpl{
 		thriftrwEncoder: codec.NewThriftRWEncoder(),
+		codecs:          make(map[common.EncodingType]EncodingCodec),
 	}
+	t.RegisterEncoding(common.EncodingTypeThriftRW, &thriftrwCodec{serializer: t})
+	t.RegisterEncoding(common.EncodingTypeJSON, jsonCodec{})
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
 }
 
 func (t *serializerImpl) SerializeBatchEvents(events []*types.HistoryEvent, encodingType common.EncodingType) (*DataBlob, error) {
-	return t.serialize(events, encodingType)
+	blob, _, err := t.serializeBatchEventsForStore(events, encodingType)
+	return blob, err
+}
+
+// serializeBatchEventsForStore returns the blob of the events to be persisted, which may be checksummed and
+// compressed, along with the plain encoded blob of the events
+func (t *serializerImpl) serializeBatchEventsForStore(events []*types.HistoryEvent, encodingType common.EncodingType) (*DataBlob, *DataBlob, error) {
+	blob, err := t.serialize(events, encodingType)
+	if err != nil {
+		return nil, nil, err
+	}
+	return t.compress(t.addChecksum(blob)), blob, nil
 }
 
 func (t *serializerImpl) DeserializeBatchEvents(data *DataBlob) ([]*types.HistoryEvent, error) {
@@ -121,11 +336,35 @@ func (t *serializerImpl) DeserializeBatchEvents(data *DataBlob) ([]*types.Histor
 	return events, err
 }
 
+func (t *serializerImpl) DeserializeBatchEventsWithGaps(data *DataBlob) ([]*types.HistoryEvent, []EventIDRange, error) {
+	events, err := t.DeserializeBatchEvents(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	var gaps []EventIDRange
+	for i := 1; i < len(events); i++ {
+		prevID, currID := events[i-1].ID, events[i].ID
+		if currID <= prevID {
+			return nil, nil, NewCadenceDeserializationError(
+				fmt.Sprintf("DeserializeBatchEventsWithGaps event ID %v is not greater than previous event ID %v", currID, prevID),
+			)
+		}
+		if currID > prevID+1 {
+			gaps = append(gaps, EventIDRange{FirstEventID: prevID + 1, LastEventID: currID - 1})
+		}
+	}
+	return events, gaps, nil
+}
+
 func (t *serializerImpl) SerializeEvent(event *types.HistoryEvent, encodingType common.EncodingType) (*DataBlob, error) {
 	if event == nil {
 		return nil, nil
 	}
-	return t.serialize(event, encodingType)
+	blob, err := t.serialize(event, encodingType)
+	if err != nil {
+		return nil, err
+	}
+	return t.compress(t.addChecksum(blob)), nil
 }
 
 func (t *serializerImpl) DeserializeEvent(data *DataBlob) (*types.HistoryEvent, error) {
@@ -178,6 +417,49 @@ func (t *serializerImpl) DeserializeVisibilityMemo(data *DataBlob) (*types.Memo,
 	return &memo, err
 }
 
+func (t *serializerImpl) GetMemoField(data *DataBlob, fieldName string) ([]byte, bool, error) {
+	if data == nil || len(data.Data) == 0 {
+		return nil, false, nil
+	}
+
+	if encoding, _, ok := t.getCodec(data.Encoding); ok && encoding == data.Encoding && data.GetEncoding() == common.EncodingTypeUnknown {
+		// custom encodings can only be decoded as a whole
+		memo, err := t.DeserializeVisibilityMemo(data)
+		if err != nil {
+			return nil, false, err
+		}
+		value, ok := memo.GetFields()[fieldName]
+		return value, ok, nil
+	}
+
+	var err error
+	switch data.GetEncoding() {
+	case common.EncodingTypeThriftRW:
+		decoder := &memoFieldDecoder{fieldName: fieldName}
+		if err = t.thriftrwEncoder.Decode(data.Data, decoder); err == nil {
+			return decoder.value, decoder.found, nil
+		}
+	case common.EncodingTypeJSON, common.EncodingTypeUnknown, common.EncodingTypeEmpty: // For backward-compatibility
+		var memo struct {
+			Fields map[string]json.RawMessage `json:"fields,omitempty"`
+		}
+		if err = json.Unmarshal(data.Data, &memo); err == nil {
+			raw, ok := memo.Fields[fieldName]
+			if !ok {
+				return nil, false, nil
+			}
+			var value []byte
+			if err = json.Unmarshal(raw, &value); err == nil {
+				return value, true, nil
+			}
+		}
+	default:
+		return nil, false, NewUnknownEncodingTypeError(data.GetEncoding())
+	}
+
+	return nil, false, NewCadenceDeserializationError(fmt.Sprintf("GetMemoField encoding: \"%v\", error: %v", data.Encoding, err.Error()))
+}
+
 func (t *serializerImpl) SerializeVersionHistories(histories *types.VersionHistories, encodingType common.EncodingType) (*DataBlob, error) {
 	if histories == nil {
 		return nil, nil
@@ -284,30 +566,524 @@ func (t *serializerImpl) DeserializeIsolationGroups(data *DataBlob) (*types.Isol
 	return &cfg, err
 }
 
-func (t *serializerImpl) serialize(input interface{}, encodingType common.EncodingType) (*DataBlob, error) {
-	if input == nil {
+func (t *serializerImpl) SerializeChecksum(sum *checksum.Checksum, encodingType common.EncodingType) (*DataBlob, error) {
+	if sum == nil {
 		return nil, nil
 	}
+	return t.serialize(sum, encodingType)
+}
 
-	var data []byte
-	var err error
+func (t *serializerImpl) DeserializeChecksum(data *DataBlob) (*checksum.Checksum, error) {
+	if data == nil {
+		return nil, nil
+	}
 
-	switch encodingType {
-	case common.EncodingTypeThriftRW:
-		data, err = t.thriftrwEncode(input)
-	case common.EncodingTypeJSON, common.EncodingTypeUnknown, common.EncodingTypeEmpty: // For backward-compatibility
-		encodingType = common.EncodingTypeJSON
-		data, err = json.Marshal(input)
+	var sum checksum.Checksum
+	if len(data.Data) == 0 {
+		return &sum, nil
+	}
+
+	err := t.deserialize(data, &sum)
+	return &sum, err
+}
+
+func (t *serializerImpl) SerializePendingActivityInfo(info *types.PendingActivityInfo, encodingType common.EncodingType) (*DataBlob, error) {
+	if info == nil {
+		return nil, nil
+	}
+	return t.serialize(info, encodingType)
+}
+
+func (t *serializerImpl) DeserializePendingActivityInfo(data *DataBlob) (*types.PendingActivityInfo, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	var info types.PendingActivityInfo
+	if len(data.Data) == 0 {
+		return &info, nil
+	}
+
+	err := t.deserialize(data, &info)
+	return &info, err
+}
+
+func (t *serializerImpl) SerializeTimerInfo(info *TimerInfo, encodingType common.EncodingType) (*DataBlob, error) {
+	if info == nil {
+		return nil, nil
+	}
+	return t.serialize(info, encodingType)
+}
+
+func (t *serializerImpl) DeserializeTimerInfo(data *DataBlob) (*TimerInfo, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	var info TimerInfo
+	if len(data.Data) == 0 {
+		return &info, nil
+	}
+
+	err := t.deserialize(data, &info)
+	return &info, err
+}
+
+func (t *serializerImpl) PreferredEncoding(kind BlobKind) common.EncodingType {
+	switch kind {
+	case BlobKindDynamicConfigBlob:
+		return common.EncodingTypeJSON
 	default:
-		return nil, NewUnknownEncodingTypeError(encodingType)
+		return common.EncodingTypeThriftRW
+	}
+}
+
+func (t *serializerImpl) EstimateSerializedSize(obj interface{}, encodingType common.EncodingType) (int, error) {
+	if obj == nil {
+		return 0, nil
+	}
+	if v := reflect.ValueOf(obj); v.Kind() == reflect.Ptr && v.IsNil() {
+		return 0, nil
+	}
+
+	isHistory := false
+	switch obj.(type) {
+	case []*types.HistoryEvent, *types.HistoryEvent:
+		isHistory = true
+	}
+
+	encodingType, codec, ok := t.getCodec(encodingType)
+	if !ok {
+		return 0, NewUnknownEncodingTypeError(encodingType)
+	}
+	if _, ok := codec.(jsonCodec); ok {
+		counter := &byteCounter{}
+		if err := json.NewEncoder(counter).Encode(obj); err != nil {
+			return 0, NewCadenceSerializationError(err.Error())
+		}
+		// json.Encoder terminates the value with a newline which json.Marshal doesn't
+		size := counter.n - 1
+		if isHistory && t.checksum && isChecksummedEncoding(encodingType) {
+			size += checksumHeaderSize
+		}
+		return size, nil
+	}
+
+	blob, err := t.serialize(obj, encodingType)
+	if err != nil {
+		return 0, err
+	}
+	if isHistory {
+		blob = t.compress(t.addChecksum(blob))
+	}
+	if blob == nil {
+		return 0, nil
+	}
+	return len(blob.Data), nil
+}
+
+func (t *serializerImpl) ReencodeBlob(kind BlobKind, blob *DataBlob, encodingType common.EncodingType) (*DataBlob, error) {
+	if blob == nil {
+		return nil, nil
+	}
+	if blob.Encoding == encodingType {
+		return blob, nil
+	}
+	if len(blob.Data) == 0 {
+		return &DataBlob{Encoding: encodingType}, nil
 	}
 
+	switch kind {
+	case BlobKindHistoryEvents:
+		events, err := t.DeserializeBatchEvents(blob)
+		if err != nil {
+			return nil, err
+		}
+		return t.SerializeBatchEvents(events, encodingType)
+	case BlobKindHistoryEvent:
+		event, err := t.DeserializeEvent(blob)
+		if err != nil {
+			return nil, err
+		}
+		return t.SerializeEvent(event, encodingType)
+	case BlobKindVisibilityMemo:
+		memo, err := t.DeserializeVisibilityMemo(blob)
+		if err != nil {
+			return nil, err
+		}
+		return t.SerializeVisibilityMemo(memo, encodingType)
+	case BlobKindResetPoints:
+		rp, err := t.DeserializeResetPoints(blob)
+		if err != nil {
+			return nil, err
+		}
+		return t.SerializeResetPoints(rp, encodingType)
+	case BlobKindBadBinaries:
+		bb, err := t.DeserializeBadBinaries(blob)
+		if err != nil {
+			return nil, err
+		}
+		return t.SerializeBadBinaries(bb, encodingType)
+	case BlobKindVersionHistories:
+		histories, err := t.DeserializeVersionHistories(blob)
+		if err != nil {
+			return nil, err
+		}
+		return t.SerializeVersionHistories(histories, encodingType)
+	case BlobKindPendingFailoverMarkers:
+		markers, err := t.DeserializePendingFailoverMarkers(blob)
+		if err != nil {
+			return nil, err
+		}
+		return t.SerializePendingFailoverMarkers(markers, encodingType)
+	case BlobKindProcessingQueueStates:
+		states, err := t.DeserializeProcessingQueueStates(blob)
+		if err != nil {
+			return nil, err
+		}
+		return t.SerializeProcessingQueueStates(states, encodingType)
+	case BlobKindDynamicConfigBlob:
+		dcBlob, err := t.DeserializeDynamicConfigBlob(blob)
+		if err != nil {
+			return nil, err
+		}
+		return t.SerializeDynamicConfigBlob(dcBlob, encodingType)
+	case BlobKindIsolationGroups:
+		cfg, err := t.DeserializeIsolationGroups(blob)
+		if err != nil {
+			return nil, err
+		}
+		return t.SerializeIsolationGroups(cfg, encodingType)
+	case BlobKindChecksum:
+		sum, err := t.DeserializeChecksum(blob)
+		if err != nil {
+			return nil, err
+		}
+		return t.SerializeChecksum(sum, encodingType)
+	case BlobKindPendingActivityInfo:
+		info, err := t.DeserializePendingActivityInfo(blob)
+		if err != nil {
+			return nil, err
+		}
+		return t.SerializePendingActivityInfo(info, encodingType)
+	case BlobKindTimerInfo:
+		info, err := t.DeserializeTimerInfo(blob)
+		if err != nil {
+			return nil, err
+		}
+		return t.SerializeTimerInfo(info, encodingType)
+	default:
+		return nil, NewCadenceSerializationError(fmt.Sprintf("ReencodeBlob unknown blob kind: %v", kind))
+	}
+}
+
+func (t *serializerImpl) RegisterEncoding(encodingType common.EncodingType, codec EncodingCodec) {
+	t.Lock()
+	defer t.Unlock()
+	t.codecs[encodingType] = codec
+}
+
+// getCodec returns the codec registered for the encoding type, unknown and empty encoding types are JSON for backward-compatibility
+func (t *serializerImpl) getCodec(encodingType common.EncodingType) (common.EncodingType, EncodingCodec, bool) {
+	t.RLock()
+	defer t.RUnlock()
+	if codec, ok := t.codecs[encodingType]; ok {
+		return encodingType, codec, true
+	}
+	switch encodingType {
+	case common.EncodingTypeUnknown, common.EncodingTypeEmpty:
+		codec, ok := t.codecs[common.EncodingTypeJSON]
+		return common.EncodingTypeJSON, codec, ok
+	}
+	return encodingType, nil, false
+}
+
+func (t *serializerImpl) serialize(input interface{}, encodingType common.EncodingType) (*DataBlob, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	encodingType, codec, ok := t.getCodec(encodingType)
+	if !ok {
+		return nil, NewUnknownEncodingTypeError(encodingType)
+	}
+	data, err := codec.Encode(input)
 	if err != nil {
 		return nil, NewCadenceSerializationError(err.Error())
 	}
 	return NewDataBlob(data, encodingType), nil
 }
 
+// compress compresses a thriftrw encoded blob if it is not smaller than the compression threshold
+func (t *serializerImpl) compress(blob *DataBlob) *DataBlob {
+	if blob == nil || blob.Encoding != common.EncodingTypeThriftRW || t.compressionThreshold == nil {
+		return blob
+	}
+	threshold := t.compressionThreshold()
+	if threshold <= 0 || len(blob.Data) < threshold {
+		return blob
+	}
+	return NewDataBlob(snappy.Encode(nil, blob.Data), common.EncodingTypeThriftRWSnappy)
+}
+
+// addChecksum prefixes the payload of the blob with its checksum if enabled and supported by its encoding
+func (t *serializerImpl) addChecksum(blob *DataBlob) *DataBlob {
+	if blob == nil || !t.checksum || !isChecksummedEncoding(blob.Encoding) {
+		return blob
+	}
+	data := make([]byte, checksumHeaderSize+len(blob.Data))
+	data[0] = checksumMagic
+	binary.BigEndian.PutUint32(data[1:checksumHeaderSize], crc32.Checksum(blob.Data, crc32cTable))
+	copy(data[checksumHeaderSize:], blob.Data)
+	return NewDataBlob(data, blob.Encoding)
+}
+
+// UnwrapDataBlob returns the plain thriftrw or JSON encoded form of a blob, decompressing it and
+// verifying and removing its checksum. Blobs which are neither compressed nor checksummed are returned as is.
+func UnwrapDataBlob(blob *DataBlob) (*DataBlob, error) {
+	if blob == nil {
+		return nil, nil
+	}
+	payload, encoding, err := unwrapPayload(blob)
+	if err != nil {
+		return nil, err
+	}
+	if encoding == blob.Encoding && len(payload) == len(blob.Data) {
+		return blob, nil
+	}
+	return NewDataBlob(payload, encoding), nil
+}
+
+// isChecksummedEncoding returns whether payloads of the encoding may be prefixed with a checksum, which is only
+// the case for the built-in encodings of history events whose payloads can't start with checksumMagic
+func isChecksummedEncoding(encoding common.EncodingType) bool {
+	return encoding == common.EncodingTypeThriftRW || encoding == common.EncodingTypeJSON
+}
+
+// isHistoryEvents returns whether the deserialization target is history events, the only payloads which are
+// compressed or checksummed
+func isHistoryEvents(target interface{}) bool {
+	switch target.(type) {
+	case *[]*types.HistoryEvent, *types.HistoryEvent:
+		return true
+	}
+	return false
+}
+
+// DetectEncoding returns the encoding of the payload of the blob without deserializing it.
+// The encoding of blobs with an empty or unknown encoding type is detected from the payload header,
+// EncodingTypeEmpty is returned for blobs without payload and EncodingTypeUnknown if it can't be detected.
+func DetectEncoding(blob *DataBlob) common.EncodingType {
+	if blob == nil || len(blob.Data) == 0 {
+		return common.EncodingTypeEmpty
+	}
+	switch encoding := blob.GetEncoding(); encoding {
+	case common.EncodingTypeEmpty, common.EncodingTypeUnknown:
+	default:
+		return encoding
+	}
+
+	payload := blob.Data
+	if payload[0] == checksumMagic && len(payload) > checksumHeaderSize {
+		payload = payload[checksumHeaderSize:]
+	}
+	if codec.HasThriftRWPreamble(payload) {
+		return common.EncodingTypeThriftRW
+	}
+	if trimmed := bytes.TrimLeft(payload, " \t\r\n"); len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[' || trimmed[0] == '"') {
+		return common.EncodingTypeJSON
+	}
+	return common.EncodingTypeUnknown
+}
+
+// decodeWithRecover decodes the payload with the codec, converting a panic on malformed input into an error
+func decodeWithRecover(codec EncodingCodec, payload []byte, target interface{}) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic while decoding malformed payload: %v", r)
+		}
+	}()
+	return codec.Decode(payload, target)
+}
+
+// unwrapPayload decompresses the payload of a blob and verifies and removes its checksum
+func unwrapPayload(blob *DataBlob) ([]byte, common.EncodingType, error) {
+	payload := blob.Data
+	encoding := blob.Encoding
+	if blob.GetEncoding() == common.EncodingTypeThriftRWSnappy {
+		// snappy allocates the decoded length declared in the header upfront, reject lengths a valid
+		// block can't expand to so that a malformed header doesn't make it allocate gigabytes
+		decodedLen, err := snappy.DecodedLen(payload)
+		if err == nil && decodedLen > len(payload)*snappyMaxExpansion {
+			err = fmt.Errorf("decoded length %v is too large for %v bytes", decodedLen, len(payload))
+		}
+		if err != nil {
+			return nil, encoding, NewCadenceDeserializationError(fmt.Sprintf("failed to decompress blob encoding: \"%v\", error: %v", blob.Encoding, err.Error()))
+		}
+		if payload, err = snappy.Decode(nil, payload); err != nil {
+			return nil, encoding, NewCadenceDeserializationError(fmt.Sprintf("failed to decompress blob encoding: \"%v\", error: %v", blob.Encoding, err.Error()))
+		}
+		encoding = common.EncodingTypeThriftRW
+	}
+
+	if !isChecksummedEncoding(encoding) || len(payload) == 0 || payload[0] != checksumMagic {
+		return payload, encoding, nil
+	}
+	if len(payload) < checksumHeaderSize {
+		return nil, encoding, &CorruptedBlobError{Encoding: blob.Encoding, Length: len(blob.Data)}
+	}
+	expected := binary.BigEndian.Uint32(payload[1:checksumHeaderSize])
+	payload = payload[checksumHeaderSize:]
+	if actual := crc32.Checksum(payload, crc32cTable); actual != expected {
+		return nil, encoding, &CorruptedBlobError{
+			Encoding:         blob.Encoding,
+			Length:           len(blob.Data),
+			ExpectedChecksum: expected,
+			ActualChecksum:   actual,
+		}
+	}
+	return payload, encoding, nil
+}
+
+type (
+	// thriftrwCodec is the built-in codec of EncodingTypeThriftRW
+	thriftrwCodec struct {
+		serializer *serializerImpl
+	}
+
+	// byteCounter is an io.Writer which only counts the bytes written to it
+	byteCounter struct {
+		n int
+	}
+
+	// jsonCodec is the built-in codec of EncodingTypeJSON, its output is deterministic
+	// as encoding/json sorts map keys, so identical payloads are encoded to identical bytes
+	jsonCodec struct {
+		disallowUnknownFields bool
+	}
+)
+
+func (c *byteCounter) Write(p []byte) (int, error) {
+	c.n += len(p)
+	return len(p), nil
+}
+
+func (c *thriftrwCodec) Encode(input interface{}) ([]byte, error) {
+	return c.serializer.thriftrwEncode(input)
+}
+
+func (c *thriftrwCodec) Decode(data []byte, target interface{}) error {
+	return c.serializer.thriftrwDecode(data, target)
+}
+
+func (c jsonCodec) Encode(input interface{}) ([]byte, error) {
+	return json.Marshal(input)
+}
+
+func (c jsonCodec) Decode(data []byte, target interface{}) error {
+	if err := json.Unmarshal(data, target); err != nil || !c.disallowUnknownFields {
+		return err
+	}
+
+	// encoding/json doesn't have a typed error for unknown fields, so the fields of
+	// the payload are matched against the target type the same way json.Unmarshal does
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	if field := unknownJSONField(value, reflect.TypeOf(target)); field != "" {
+		return &UnknownFieldError{Field: field}
+	}
+	return nil
+}
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// unknownJSONField returns the dot separated path of the first field of the decoded JSON value which
+// json.Unmarshal drops when decoding it into a value of type t, or an empty string if there is none
+func unknownJSONField(value interface{}, t reflect.Type) string {
+	for t.Kind() == reflect.Ptr {
+		if t.Implements(jsonUnmarshalerType) {
+			return ""
+		}
+		t = t.Elem()
+	}
+	if t.Implements(jsonUnmarshalerType) || reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+		return ""
+	}
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		if t.Kind() != reflect.Struct && t.Kind() != reflect.Map {
+			return ""
+		}
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			var elemType reflect.Type
+			if t.Kind() == reflect.Map {
+				elemType = t.Elem()
+			} else {
+				field, ok := jsonStructField(t, key)
+				if !ok {
+					return key
+				}
+				elemType = field.Type
+			}
+			if field := unknownJSONField(value[key], elemType); field != "" {
+				return key + "." + field
+			}
+		}
+	case []interface{}:
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return ""
+		}
+		for _, elem := range value {
+			if field := unknownJSONField(elem, t.Elem()); field != "" {
+				return field
+			}
+		}
+	}
+	return ""
+}
+
+// jsonStructField returns the field of the struct type which json.Unmarshal decodes the JSON key into,
+// including the fields promoted from embedded structs, keys are matched case-insensitively like json.Unmarshal does
+func jsonStructField(t reflect.Type, key string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				if promoted, ok := jsonStructField(embedded, key); ok {
+					return promoted, true
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if strings.EqualFold(name, key) {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
 func (t *serializerImpl) thriftrwEncode(input interface{}) ([]byte, error) {
 
 	switch input := input.(type) {
@@ -331,6 +1107,12 @@ func (t *serializerImpl) thriftrwEncode(input interface{}) ([]byte, error) {
 		return t.thriftrwEncoder.Encode(thrift.FromDynamicConfigBlob(input))
 	case *types.IsolationGroupConfiguration:
 		return t.thriftrwEncoder.Encode(thrift.FromIsolationGroupConfig(input))
+	case *checksum.Checksum:
+		return t.thriftrwEncoder.Encode(fromChecksum(input))
+	case *types.PendingActivityInfo:
+		return t.thriftrwEncoder.Encode(thrift.FromPendingActivityInfo(input))
+	case *TimerInfo:
+		return t.thriftrwEncoder.Encode(&timerInfoThrift{TimerInfo: *input})
 	default:
 		return nil, nil
 	}
@@ -343,18 +1125,28 @@ func (t *serializerImpl) deserialize(data *DataBlob, target interface{}) error {
 	if len(data.Data) == 0 {
 		return NewCadenceDeserializationError("DeserializeEvent empty data")
 	}
-	var err error
+	payload, encoding := data.Data, data.Encoding
+	if isHistoryEvents(target) {
+		var err error
+		if payload, encoding, err = unwrapPayload(data); err != nil {
+			return err
+		}
+	}
 
-	switch data.GetEncoding() {
-	case common.EncodingTypeThriftRW:
-		err = t.thriftrwDecode(data.Data, target)
-	case common.EncodingTypeJSON, common.EncodingTypeUnknown, common.EncodingTypeEmpty: // For backward-compatibility
-		err = json.Unmarshal(data.Data, target)
-	default:
-		return NewUnknownEncodingTypeError(data.GetEncoding())
+	_, codec, ok := t.getCodec(encoding)
+	if !ok {
+		// encodings not recognized by DataBlob are JSON for backward-compatibility
+		_, codec, ok = t.getCodec(data.GetEncoding())
+	}
+	if !ok {
+		return NewUnknownEncodingTypeError(encoding)
 	}
 
-	if err != nil {
+	if err := decodeWithRecover(codec, payload, target); err != nil {
+		var unknownFieldErr *UnknownFieldError
+		if errors.As(err, &unknownFieldErr) {
+			return err
+		}
 		return NewCadenceDeserializationError(fmt.Sprintf("DeserializeBatchEvents encoding: \"%v\", error: %v", data.Encoding, err.Error()))
 	}
 	return nil
@@ -432,11 +1224,245 @@ func (t *serializerImpl) thriftrwDecode(data []byte, target interface{}) error {
 		}
 		*target = *thrift.ToIsolationGroupConfig(&thriftTarget)
 		return nil
+	case *checksum.Checksum:
+		thriftTarget := checksumgen.Checksum{}
+		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
+			return err
+		}
+		*target = toChecksum(&thriftTarget)
+		return nil
+	case *types.PendingActivityInfo:
+		thriftTarget := workflow.PendingActivityInfo{}
+		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
+			return err
+		}
+		*target = *thrift.ToPendingActivityInfo(&thriftTarget)
+		return nil
+	case *TimerInfo:
+		thriftTarget := timerInfoThrift{}
+		if err := t.thriftrwEncoder.Decode(data, &thriftTarget); err != nil {
+			return err
+		}
+		*target = thriftTarget.TimerInfo
+		return nil
 	default:
 		return nil
 	}
 }
 
+// Decode reads a thrift encoded workflow.Memo, keeping only the value of the requested field
+// and skipping over all other values without allocating them
+func (d *memoFieldDecoder) Decode(sr stream.Reader) error {
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+	for ok {
+		if fh.ID == memoFieldsThriftID && fh.Type == wire.TMap {
+			if err := d.decodeFields(sr); err != nil {
+				return err
+			}
+		} else if err := sr.Skip(fh.Type); err != nil {
+			return err
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	return sr.ReadStructEnd()
+}
+
+func (d *memoFieldDecoder) decodeFields(sr stream.Reader) error {
+	mh, err := sr.ReadMapBegin()
+	if err != nil {
+		return err
+	}
+	if mh.KeyType != wire.TBinary || mh.ValueType != wire.TBinary {
+		return fmt.Errorf("unexpected memo fields map type: map<%v, %v>", mh.KeyType, mh.ValueType)
+	}
+
+	for i := 0; i < mh.Length; i++ {
+		key, err := sr.ReadString()
+		if err != nil {
+			return err
+		}
+		if key != d.fieldName {
+			if err := sr.Skip(wire.TBinary); err != nil {
+				return err
+			}
+			continue
+		}
+		if d.value, err = sr.ReadBinary(); err != nil {
+			return err
+		}
+		d.found = true
+	}
+
+	return sr.ReadMapEnd()
+}
+
+// FromWire is not supported, memoFieldDecoder can only be used with stream decoding
+func (d *memoFieldDecoder) FromWire(w wire.Value) error {
+	return errMemoFieldDecoderStreamOnly
+}
+
+// ToWire is not supported, memoFieldDecoder is decode only
+func (d *memoFieldDecoder) ToWire() (wire.Value, error) {
+	return wire.Value{}, errMemoFieldDecoderStreamOnly
+}
+
+// Encode is not supported, memoFieldDecoder is decode only
+func (d *memoFieldDecoder) Encode(stream.Writer) error {
+	return errMemoFieldDecoderStreamOnly
+}
+
+// ToWire converts the timer info to its wire representation
+func (c *timerInfoThrift) ToWire() (wire.Value, error) {
+	fields := []wire.Field{
+		{ID: timerInfoVersionThriftID, Value: wire.NewValueI64(c.Version)},
+		{ID: timerInfoStartedIDThriftID, Value: wire.NewValueI64(c.StartedID)},
+		{ID: timerInfoExpiryTimeNanosThriftID, Value: wire.NewValueI64(c.ExpiryTime.UnixNano())},
+		{ID: timerInfoTaskStatusThriftID, Value: wire.NewValueI64(c.TaskStatus)},
+		{ID: timerInfoTimerIDThriftID, Value: wire.NewValueString(c.TimerID)},
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields}), nil
+}
+
+// FromWire reads the timer info from its wire representation, unknown fields are ignored
+func (c *timerInfoThrift) FromWire(w wire.Value) error {
+	for _, field := range w.GetStruct().Fields {
+		switch {
+		case field.ID == timerInfoVersionThriftID && field.Value.Type() == wire.TI64:
+			c.Version = field.Value.GetI64()
+		case field.ID == timerInfoStartedIDThriftID && field.Value.Type() == wire.TI64:
+			c.StartedID = field.Value.GetI64()
+		case field.ID == timerInfoExpiryTimeNanosThriftID && field.Value.Type() == wire.TI64:
+			c.ExpiryTime = time.Unix(0, field.Value.GetI64())
+		case field.ID == timerInfoTaskStatusThriftID && field.Value.Type() == wire.TI64:
+			c.TaskStatus = field.Value.GetI64()
+		case field.ID == timerInfoTimerIDThriftID && field.Value.Type() == wire.TBinary:
+			c.TimerID = field.Value.GetString()
+		}
+	}
+	return nil
+}
+
+// Encode writes the timer info with the stream writer
+func (c *timerInfoThrift) Encode(sw stream.Writer) error {
+	if err := sw.WriteStructBegin(); err != nil {
+		return err
+	}
+	for _, field := range []struct {
+		id    int16
+		value int64
+	}{
+		{timerInfoVersionThriftID, c.Version},
+		{timerInfoStartedIDThriftID, c.StartedID},
+		{timerInfoExpiryTimeNanosThriftID, c.ExpiryTime.UnixNano()},
+		{timerInfoTaskStatusThriftID, c.TaskStatus},
+	} {
+		if err := writeI64Field(sw, field.id, field.value); err != nil {
+			return err
+		}
+	}
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: timerInfoTimerIDThriftID, Type: wire.TBinary}); err != nil {
+		return err
+	}
+	if err := sw.WriteString(c.TimerID); err != nil {
+		return err
+	}
+	if err := sw.WriteFieldEnd(); err != nil {
+		return err
+	}
+	return sw.WriteStructEnd()
+}
+
+// Decode reads the timer info with the stream reader, unknown fields are skipped
+func (c *timerInfoThrift) Decode(sr stream.Reader) error {
+	if err := sr.ReadStructBegin(); err != nil {
+		return err
+	}
+
+	fh, ok, err := sr.ReadFieldBegin()
+	if err != nil {
+		return err
+	}
+	for ok {
+		switch {
+		case fh.ID == timerInfoVersionThriftID && fh.Type == wire.TI64:
+			if c.Version, err = sr.ReadInt64(); err != nil {
+				return err
+			}
+		case fh.ID == timerInfoStartedIDThriftID && fh.Type == wire.TI64:
+			if c.StartedID, err = sr.ReadInt64(); err != nil {
+				return err
+			}
+		case fh.ID == timerInfoExpiryTimeNanosThriftID && fh.Type == wire.TI64:
+			v, err := sr.ReadInt64()
+			if err != nil {
+				return err
+			}
+			c.ExpiryTime = time.Unix(0, v)
+		case fh.ID == timerInfoTaskStatusThriftID && fh.Type == wire.TI64:
+			if c.TaskStatus, err = sr.ReadInt64(); err != nil {
+				return err
+			}
+		case fh.ID == timerInfoTimerIDThriftID && fh.Type == wire.TBinary:
+			if c.TimerID, err = sr.ReadString(); err != nil {
+				return err
+			}
+		default:
+			if err := sr.Skip(fh.Type); err != nil {
+				return err
+			}
+		}
+
+		if err := sr.ReadFieldEnd(); err != nil {
+			return err
+		}
+		if fh, ok, err = sr.ReadFieldBegin(); err != nil {
+			return err
+		}
+	}
+
+	return sr.ReadStructEnd()
+}
+
+func writeI64Field(sw stream.Writer, id int16, value int64) error {
+	if err := sw.WriteFieldBegin(stream.FieldHeader{ID: id, Type: wire.TI64}); err != nil {
+		return err
+	}
+	if err := sw.WriteInt64(value); err != nil {
+		return err
+	}
+	return sw.WriteFieldEnd()
+}
+
+func fromChecksum(sum *checksum.Checksum) *checksumgen.Checksum {
+	return &checksumgen.Checksum{
+		Version: common.Int32Ptr(int32(sum.Version)),
+		Flavor:  common.Int32Ptr(int32(sum.Flavor)),
+		Value:   sum.Value,
+	}
+}
+
+func toChecksum(sum *checksumgen.Checksum) checksum.Checksum {
+	return checksum.Checksum{
+		Version: int(sum.GetVersion()),
+		Flavor:  checksum.Flavor(sum.GetFlavor()),
+		Value:   sum.Value,
+	}
+}
+
 // NewUnknownEncodingTypeError returns a new instance of encoding type error
 func NewUnknownEncodingTypeError(encodingType common.EncodingType) error {
 	return &UnknownEncodingTypeError{encodingType: encodingType}
@@ -446,6 +1472,10 @@ func (e *UnknownEncodingTypeError) Error() string {
 	return fmt.Sprintf("unknown or unsupported encoding type %v", e.encodingType)
 }
 
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("cadence deserialization error: unknown field %q", e.Field)
+}
+
 // NewCadenceSerializationError returns a CadenceSerializationError
 func NewCadenceSerializationError(msg string) *CadenceSerializationError {
 	return &CadenceSerializationError{msg: msg}
@@ -463,3 +1493,8 @@ func NewCadenceDeserializationError(msg string) *CadenceDeserializationError {
 func (e *CadenceDeserializationError) Error() string {
 	return fmt.Sprintf("cadence deserialization error: %v", e.msg)
 }
+
+func (e *CorruptedBlobError) Error() string {
+	return fmt.Sprintf("corrupted blob, encoding: %v, length: %v, expected checksum: %v, actual checksum: %v",
+		e.Encoding, e.Length, e.ExpectedChecksum, e.ActualChecksum)
+}
@@ -22,6 +22,9 @@ package persistence
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -31,6 +34,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/checksum"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/testlogger"
@@ -198,6 +202,31 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 
 	isolationGroupCfg1 := &types.IsolationGroupConfiguration{}
 
+	mutableStateChecksum := &checksum.Checksum{
+		Version: 1,
+		Flavor:  checksum.FlavorIEEECRC32OverThriftBinary,
+		Value:   []byte{0x12, 0x34, 0x56, 0x78},
+	}
+
+	pendingActivityInfo := &types.PendingActivityInfo{
+		ActivityID:         "activity-id",
+		ActivityType:       &types.ActivityType{Name: "activity-type"},
+		State:              types.PendingActivityStateStarted.Ptr(),
+		HeartbeatDetails:   []byte("heartbeat-details"),
+		Attempt:            2,
+		MaximumAttempts:    5,
+		LastFailureReason:  common.StringPtr("failure-reason"),
+		LastWorkerIdentity: "worker-identity",
+	}
+
+	timerInfo := &TimerInfo{
+		Version:    3,
+		TimerID:    "timer-id",
+		StartedID:  12,
+		ExpiryTime: time.Unix(0, 1700000000123456789).UTC(),
+		TaskStatus: 1,
+	}
+
 	for i := 0; i < concurrency; i++ {
 
 		go func() {
@@ -348,6 +377,30 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 			s.Nil(err)
 			s.Equal(memo0, memo3)
 
+			// get single visibility memo field
+
+			for _, blob := range []*DataBlob{mJSON, mThrift, mEmpty} {
+				field, ok, err := serializer.GetMemoField(blob, "TestField")
+				s.Nil(err)
+				s.True(ok)
+				s.Equal(memo1.Fields["TestField"], field)
+
+				field, ok, err = serializer.GetMemoField(blob, "MissingField")
+				s.Nil(err)
+				s.False(ok)
+				s.Nil(field)
+			}
+
+			nilField, ok, err := serializer.GetMemoField(nilMemo, "TestField")
+			s.Nil(err)
+			s.False(ok)
+			s.Nil(nilField)
+
+			_, _, err = serializer.GetMemoField(&DataBlob{Data: mThrift.Data, Encoding: common.EncodingTypeGob}, "TestField")
+			s.NotNil(err)
+			_, ok = err.(*UnknownEncodingTypeError)
+			s.True(ok)
+
 			// serialize reset points
 
 			nilResetPoints, err := serializer.SerializeResetPoints(nil, common.EncodingTypeThriftRW)
@@ -581,6 +634,125 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 			isolationGroups2FromJSON, err := serializer.DeserializeIsolationGroups(nil)
 			s.Nil(err)
 			s.Nil(isolationGroups2FromJSON)
+
+			// serialize checksum
+
+			nilChecksum, err := serializer.SerializeChecksum(nil, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.Nil(nilChecksum)
+
+			_, err = serializer.SerializeChecksum(mutableStateChecksum, common.EncodingTypeGob)
+			s.NotNil(err)
+			_, ok = err.(*UnknownEncodingTypeError)
+			s.True(ok)
+
+			checksumJSON, err := serializer.SerializeChecksum(mutableStateChecksum, common.EncodingTypeJSON)
+			s.Nil(err)
+			s.NotNil(checksumJSON)
+
+			checksumThrift, err := serializer.SerializeChecksum(mutableStateChecksum, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.NotNil(checksumThrift)
+
+			checksumEmpty, err := serializer.SerializeChecksum(mutableStateChecksum, common.EncodingType(""))
+			s.Nil(err)
+			s.NotNil(checksumEmpty)
+
+			// deserialize checksum
+
+			dNilChecksum, err := serializer.DeserializeChecksum(nil)
+			s.Nil(err)
+			s.Nil(dNilChecksum)
+
+			dChecksumJSON, err := serializer.DeserializeChecksum(checksumJSON)
+			s.Nil(err)
+			s.Equal(mutableStateChecksum, dChecksumJSON)
+
+			dChecksumThrift, err := serializer.DeserializeChecksum(checksumThrift)
+			s.Nil(err)
+			s.Equal(mutableStateChecksum, dChecksumThrift)
+
+			dChecksumEmpty, err := serializer.DeserializeChecksum(checksumEmpty)
+			s.Nil(err)
+			s.Equal(mutableStateChecksum, dChecksumEmpty)
+
+			// serialize pending activity info
+
+			nilPendingActivityInfo, err := serializer.SerializePendingActivityInfo(nil, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.Nil(nilPendingActivityInfo)
+
+			_, err = serializer.SerializePendingActivityInfo(pendingActivityInfo, common.EncodingTypeGob)
+			s.NotNil(err)
+			_, ok = err.(*UnknownEncodingTypeError)
+			s.True(ok)
+
+			pendingActivityInfoJSON, err := serializer.SerializePendingActivityInfo(pendingActivityInfo, common.EncodingTypeJSON)
+			s.Nil(err)
+			s.NotNil(pendingActivityInfoJSON)
+
+			pendingActivityInfoThrift, err := serializer.SerializePendingActivityInfo(pendingActivityInfo, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.NotNil(pendingActivityInfoThrift)
+
+			// deserialize pending activity info
+
+			dNilPendingActivityInfo, err := serializer.DeserializePendingActivityInfo(nil)
+			s.Nil(err)
+			s.Nil(dNilPendingActivityInfo)
+
+			dPendingActivityInfoJSON, err := serializer.DeserializePendingActivityInfo(pendingActivityInfoJSON)
+			s.Nil(err)
+			s.Equal(pendingActivityInfo, dPendingActivityInfoJSON)
+
+			dPendingActivityInfoThrift, err := serializer.DeserializePendingActivityInfo(pendingActivityInfoThrift)
+			s.Nil(err)
+			s.Equal(pendingActivityInfo, dPendingActivityInfoThrift)
+
+			dPendingActivityInfoEmpty, err := serializer.DeserializePendingActivityInfo(&DataBlob{Encoding: common.EncodingTypeThriftRW})
+			s.Nil(err)
+			s.Equal(&types.PendingActivityInfo{}, dPendingActivityInfoEmpty)
+
+			// serialize timer info
+
+			nilTimerInfo, err := serializer.SerializeTimerInfo(nil, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.Nil(nilTimerInfo)
+
+			_, err = serializer.SerializeTimerInfo(timerInfo, common.EncodingTypeGob)
+			s.NotNil(err)
+			_, ok = err.(*UnknownEncodingTypeError)
+			s.True(ok)
+
+			timerInfoJSON, err := serializer.SerializeTimerInfo(timerInfo, common.EncodingTypeJSON)
+			s.Nil(err)
+			s.NotNil(timerInfoJSON)
+
+			timerInfoThrift, err := serializer.SerializeTimerInfo(timerInfo, common.EncodingTypeThriftRW)
+			s.Nil(err)
+			s.NotNil(timerInfoThrift)
+
+			// deserialize timer info
+
+			dNilTimerInfo, err := serializer.DeserializeTimerInfo(nil)
+			s.Nil(err)
+			s.Nil(dNilTimerInfo)
+
+			dTimerInfoJSON, err := serializer.DeserializeTimerInfo(timerInfoJSON)
+			s.Nil(err)
+			s.True(timerInfo.ExpiryTime.Equal(dTimerInfoJSON.ExpiryTime))
+			dTimerInfoJSON.ExpiryTime = timerInfo.ExpiryTime
+			s.Equal(timerInfo, dTimerInfoJSON)
+
+			dTimerInfoThrift, err := serializer.DeserializeTimerInfo(timerInfoThrift)
+			s.Nil(err)
+			s.True(timerInfo.ExpiryTime.Equal(dTimerInfoThrift.ExpiryTime))
+			dTimerInfoThrift.ExpiryTime = timerInfo.ExpiryTime
+			s.Equal(timerInfo, dTimerInfoThrift)
+
+			dTimerInfoEmpty, err := serializer.DeserializeTimerInfo(&DataBlob{Encoding: common.EncodingTypeThriftRW})
+			s.Nil(err)
+			s.Equal(&TimerInfo{}, dTimerInfoEmpty)
 		}()
 	}
 
@@ -589,6 +761,67 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 	s.True(succ, "test timed out")
 }
 
+func (s *cadenceSerializerSuite) TestDeserializeUnknownFields() {
+	event := &types.HistoryEvent{
+		ID:        1,
+		Version:   2,
+		EventType: types.EventTypeWorkflowExecutionStarted.Ptr(),
+	}
+	data, err := json.Marshal(event)
+	s.NoError(err)
+	var fields map[string]interface{}
+	s.NoError(json.Unmarshal(data, &fields))
+	fields["fieldFromNewerSchema"] = "value"
+	dataWithUnknownField, err := json.Marshal(fields)
+	s.NoError(err)
+
+	lenient := NewPayloadSerializerWithOptions(false)
+	strict := NewPayloadSerializerWithOptions(true)
+
+	// payloads without unknown fields are decoded in both modes
+	for _, serializer := range []PayloadSerializer{lenient, strict} {
+		decoded, err := serializer.DeserializeEvent(NewDataBlob(data, common.EncodingTypeJSON))
+		s.NoError(err)
+		s.Equal(event, decoded)
+	}
+
+	// unknown fields are dropped in lenient mode
+	decoded, err := lenient.DeserializeEvent(NewDataBlob(dataWithUnknownField, common.EncodingTypeJSON))
+	s.NoError(err)
+	s.Equal(event, decoded)
+
+	// unknown fields fail the strict mode with a typed error
+	_, err = strict.DeserializeEvent(NewDataBlob(dataWithUnknownField, common.EncodingTypeJSON))
+	var unknownFieldErr *UnknownFieldError
+	s.True(errors.As(err, &unknownFieldErr))
+	s.Equal("fieldFromNewerSchema", unknownFieldErr.Field)
+
+	batchData, err := json.Marshal([]map[string]interface{}{fields})
+	s.NoError(err)
+	_, err = strict.DeserializeBatchEvents(NewDataBlob(batchData, common.EncodingTypeJSON))
+	s.True(errors.As(err, &unknownFieldErr))
+
+	// unknown fields of nested structs are reported with their path
+	nestedData := []byte(`{"eventId":1,"workflowExecutionStartedEventAttributes":{"taskList":{"name":"tl","newKind":1}}}`)
+	_, err = lenient.DeserializeEvent(NewDataBlob(nestedData, common.EncodingTypeJSON))
+	s.NoError(err)
+	_, err = strict.DeserializeEvent(NewDataBlob(nestedData, common.EncodingTypeJSON))
+	s.True(errors.As(err, &unknownFieldErr))
+	s.Equal("workflowExecutionStartedEventAttributes.taskList.newKind", unknownFieldErr.Field)
+
+	// other decoding errors are still deserialization errors
+	_, err = strict.DeserializeEvent(NewDataBlob([]byte(`{} {}`), common.EncodingTypeJSON))
+	var deserializationErr *CadenceDeserializationError
+	s.True(errors.As(err, &deserializationErr))
+
+	// thriftrw payloads are not affected
+	thriftBlob, err := strict.SerializeEvent(event, common.EncodingTypeThriftRW)
+	s.NoError(err)
+	decoded, err = strict.DeserializeEvent(thriftBlob)
+	s.NoError(err)
+	s.Equal(event, decoded)
+}
+
 func TestDataBlob_GetData(t *testing.T) {
 
 	tests := map[string]struct {
@@ -615,3 +848,563 @@ func TestDataBlob_GetData(t *testing.T) {
 		})
 	}
 }
+
+func TestSerializer_PreferredEncoding(t *testing.T) {
+	serializer := NewPayloadSerializer()
+
+	tests := map[BlobKind]common.EncodingType{
+		BlobKindHistoryEvents:          common.EncodingTypeThriftRW,
+		BlobKindHistoryEvent:           common.EncodingTypeThriftRW,
+		BlobKindVisibilityMemo:         VisibilityEncoding,
+		BlobKindResetPoints:            common.EncodingTypeThriftRW,
+		BlobKindBadBinaries:            common.EncodingTypeThriftRW,
+		BlobKindVersionHistories:       common.EncodingTypeThriftRW,
+		BlobKindPendingFailoverMarkers: common.EncodingTypeThriftRW,
+		BlobKindProcessingQueueStates:  common.EncodingTypeThriftRW,
+		BlobKindDynamicConfigBlob:      common.EncodingTypeJSON,
+		BlobKindIsolationGroups:        common.EncodingTypeThriftRW,
+		BlobKindChecksum:               common.EncodingTypeThriftRW,
+		BlobKindPendingActivityInfo:    common.EncodingTypeThriftRW,
+		BlobKindTimerInfo:              common.EncodingTypeThriftRW,
+	}
+
+	for kind, expected := range tests {
+		assert.Equal(t, expected, serializer.PreferredEncoding(kind), "blob kind %v", kind)
+	}
+}
+
+func TestSerializer_JSONEncodingIsDeterministic(t *testing.T) {
+	serializer := NewPayloadSerializer()
+	newMemo := func(reverse bool) *types.Memo {
+		fields := make(map[string][]byte)
+		for i := 0; i < 50; i++ {
+			key := i
+			if reverse {
+				key = 49 - i
+			}
+			fields[fmt.Sprintf("key-%02d", key)] = []byte(fmt.Sprintf("value-%02d", key))
+		}
+		return &types.Memo{Fields: fields}
+	}
+	newEvent := func(reverse bool) *types.HistoryEvent {
+		return &types.HistoryEvent{
+			ID:        1,
+			EventType: types.EventTypeWorkflowExecutionStarted.Ptr(),
+			WorkflowExecutionStartedEventAttributes: &types.WorkflowExecutionStartedEventAttributes{
+				WorkflowType: &types.WorkflowType{Name: "workflow-type"},
+				Memo:         newMemo(reverse),
+			},
+		}
+	}
+
+	expectedEvent, err := serializer.SerializeEvent(newEvent(false), common.EncodingTypeJSON)
+	require.NoError(t, err)
+	expectedMemo, err := serializer.SerializeVisibilityMemo(newMemo(false), common.EncodingTypeJSON)
+	require.NoError(t, err)
+	assert.True(t, strings.Index(string(expectedMemo.Data), "key-00") < strings.Index(string(expectedMemo.Data), "key-49"))
+
+	for i := 0; i < 10; i++ {
+		event, err := serializer.SerializeEvent(newEvent(i%2 == 1), common.EncodingTypeJSON)
+		require.NoError(t, err)
+		assert.Equal(t, expectedEvent.Data, event.Data)
+
+		memo, err := serializer.SerializeVisibilityMemo(newMemo(i%2 == 1), common.EncodingTypeJSON)
+		require.NoError(t, err)
+		assert.Equal(t, expectedMemo.Data, memo.Data)
+	}
+
+	decodedEvent, err := serializer.DeserializeEvent(expectedEvent)
+	require.NoError(t, err)
+	assert.Equal(t, newEvent(false), decodedEvent)
+	decodedMemo, err := serializer.DeserializeVisibilityMemo(expectedMemo)
+	require.NoError(t, err)
+	assert.Equal(t, newMemo(false), decodedMemo)
+}
+
+func TestDetectEncoding(t *testing.T) {
+	serializer := NewPayloadSerializer()
+	checksumSerializer := NewPayloadSerializer(WithHistoryEventsChecksum())
+	event := &types.HistoryEvent{ID: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()}
+
+	thriftBlob, err := serializer.SerializeEvent(event, common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	jsonBlob, err := serializer.SerializeEvent(event, common.EncodingTypeJSON)
+	require.NoError(t, err)
+	checksumBlob, err := checksumSerializer.SerializeEvent(event, common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		blob     *DataBlob
+		expected common.EncodingType
+	}{
+		"nil blob": {
+			blob:     nil,
+			expected: common.EncodingTypeEmpty,
+		},
+		"no payload": {
+			blob:     &DataBlob{Encoding: common.EncodingTypeThriftRW},
+			expected: common.EncodingTypeEmpty,
+		},
+		"thriftrw": {
+			blob:     thriftBlob,
+			expected: common.EncodingTypeThriftRW,
+		},
+		"json": {
+			blob:     jsonBlob,
+			expected: common.EncodingTypeJSON,
+		},
+		"thriftrw with empty encoding": {
+			blob:     &DataBlob{Data: thriftBlob.Data},
+			expected: common.EncodingTypeThriftRW,
+		},
+		"thriftrw with checksum and unknown encoding": {
+			blob:     &DataBlob{Encoding: "unknown", Data: checksumBlob.Data},
+			expected: common.EncodingTypeThriftRW,
+		},
+		"json with empty encoding": {
+			blob:     &DataBlob{Data: jsonBlob.Data},
+			expected: common.EncodingTypeJSON,
+		},
+		"undetectable payload": {
+			blob:     &DataBlob{Data: []byte("payload")},
+			expected: common.EncodingTypeUnknown,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, DetectEncoding(tt.blob))
+		})
+	}
+}
+
+func TestSerializer_DeserializeBatchEventsWithGaps(t *testing.T) {
+	serializer := NewPayloadSerializer()
+	newEvents := func(ids ...int64) []*types.HistoryEvent {
+		var events []*types.HistoryEvent
+		for _, id := range ids {
+			events = append(events, &types.HistoryEvent{
+				ID:        id,
+				Version:   1,
+				EventType: types.EventTypeDecisionTaskScheduled.Ptr(),
+			})
+		}
+		return events
+	}
+
+	for _, encoding := range []common.EncodingType{common.EncodingTypeThriftRW, common.EncodingTypeJSON} {
+		// events 3-4 and 6-7 are missing
+		events := newEvents(1, 2, 5, 8, 9)
+		blob, err := serializer.SerializeBatchEvents(events, encoding)
+		require.NoError(t, err)
+
+		gotEvents, gaps, err := serializer.DeserializeBatchEventsWithGaps(blob)
+		require.NoError(t, err)
+		assert.Equal(t, events, gotEvents)
+		assert.Equal(t, []EventIDRange{
+			{FirstEventID: 3, LastEventID: 4},
+			{FirstEventID: 6, LastEventID: 7},
+		}, gaps)
+
+		blob, err = serializer.SerializeBatchEvents(newEvents(1, 2, 3), encoding)
+		require.NoError(t, err)
+		_, gaps, err = serializer.DeserializeBatchEventsWithGaps(blob)
+		require.NoError(t, err)
+		assert.Empty(t, gaps)
+
+		blob, err = serializer.SerializeBatchEvents(newEvents(1, 3, 2), encoding)
+		require.NoError(t, err)
+		_, _, err = serializer.DeserializeBatchEventsWithGaps(blob)
+		assert.IsType(t, &CadenceDeserializationError{}, err)
+	}
+}
+
+func TestSerializer_HistoryEventsCompression(t *testing.T) {
+	events := []*types.HistoryEvent{
+		{
+			ID:        1,
+			Version:   1,
+			EventType: types.EventTypeActivityTaskCompleted.Ptr(),
+			ActivityTaskCompletedEventAttributes: &types.ActivityTaskCompletedEventAttributes{
+				Result: []byte(strings.Repeat("result", 100)),
+			},
+		},
+	}
+	legacySerializer := NewPayloadSerializer()
+	serializer := NewPayloadSerializer(WithHistoryEventsCompression(dynamicconfig.GetIntPropertyFn(100)))
+
+	legacyBlob, err := legacySerializer.SerializeBatchEvents(events, common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	assert.Equal(t, common.EncodingTypeThriftRW, legacyBlob.Encoding)
+
+	blob, err := serializer.SerializeBatchEvents(events, common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	assert.Equal(t, common.EncodingTypeThriftRWSnappy, blob.Encoding)
+	assert.Less(t, len(blob.Data), len(legacyBlob.Data))
+
+	// both serializers can read compressed and legacy uncompressed blobs
+	for _, s := range []PayloadSerializer{legacySerializer, serializer} {
+		for _, b := range []*DataBlob{legacyBlob, blob} {
+			got, err := s.DeserializeBatchEvents(b)
+			require.NoError(t, err)
+			assert.Equal(t, events, got)
+		}
+	}
+
+	decompressed, err := UnwrapDataBlob(blob)
+	require.NoError(t, err)
+	assert.Equal(t, legacyBlob, decompressed)
+	decompressed, err = UnwrapDataBlob(legacyBlob)
+	require.NoError(t, err)
+	assert.Equal(t, legacyBlob, decompressed)
+	assert.Equal(t, legacyBlob.ToInternal(), blob.ToInternal())
+	internalBlob, err := blob.ToInternalE()
+	require.NoError(t, err)
+	assert.Equal(t, legacyBlob.ToInternal(), internalBlob)
+
+	// corrupted blobs fail to convert without panicking
+	corruptedBlob := NewDataBlob([]byte("not snappy"), common.EncodingTypeThriftRWSnappy)
+	_, err = corruptedBlob.ToInternalE()
+	assert.Error(t, err)
+	assert.Panics(t, func() { corruptedBlob.ToInternal() })
+
+	storedBlob, plainBlob, err := serializer.(*serializerImpl).serializeBatchEventsForStore(events, common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	assert.Equal(t, blob, storedBlob)
+	assert.Equal(t, legacyBlob, plainBlob)
+
+	eventBlob, err := serializer.SerializeEvent(events[0], common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	assert.Equal(t, common.EncodingTypeThriftRWSnappy, eventBlob.Encoding)
+	event, err := serializer.DeserializeEvent(eventBlob)
+	require.NoError(t, err)
+	assert.Equal(t, events[0], event)
+
+	// blobs below the threshold and JSON blobs are not compressed
+	smallEvent := &types.HistoryEvent{ID: 2, Version: 1, EventType: types.EventTypeDecisionTaskScheduled.Ptr()}
+	smallBlob, err := serializer.SerializeEvent(smallEvent, common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	assert.Equal(t, common.EncodingTypeThriftRW, smallBlob.Encoding)
+	jsonBlob, err := serializer.SerializeBatchEvents(events, common.EncodingTypeJSON)
+	require.NoError(t, err)
+	assert.Equal(t, common.EncodingTypeJSON, jsonBlob.Encoding)
+
+	// nil inputs remain nil
+	nilBlob, err := serializer.SerializeEvent(nil, common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	assert.Nil(t, nilBlob)
+	nilEvents, err := serializer.DeserializeBatchEvents(nil)
+	require.NoError(t, err)
+	assert.Nil(t, nilEvents)
+	nilEvent, err := serializer.DeserializeEvent(nil)
+	require.NoError(t, err)
+	assert.Nil(t, nilEvent)
+	decompressed, err = UnwrapDataBlob(nil)
+	require.NoError(t, err)
+	assert.Nil(t, decompressed)
+}
+
+func TestSerializer_HistoryEventsChecksum(t *testing.T) {
+	events := []*types.HistoryEvent{
+		{ID: 1, Version: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
+		{ID: 2, Version: 1, EventType: types.EventTypeDecisionTaskScheduled.Ptr()},
+	}
+	legacySerializer := NewPayloadSerializer()
+	serializers := map[string]PayloadSerializer{
+		"checksum":                 NewPayloadSerializer(WithHistoryEventsChecksum()),
+		"checksum and compression": NewPayloadSerializer(WithHistoryEventsChecksum(), WithHistoryEventsCompression(dynamicconfig.GetIntPropertyFn(1))),
+	}
+
+	for name, serializer := range serializers {
+		t.Run(name, func(t *testing.T) {
+			for _, encoding := range []common.EncodingType{common.EncodingTypeThriftRW, common.EncodingTypeJSON} {
+				legacyBlob, err := legacySerializer.SerializeBatchEvents(events, encoding)
+				require.NoError(t, err)
+				blob, err := serializer.SerializeBatchEvents(events, encoding)
+				require.NoError(t, err)
+				assert.NotEqual(t, legacyBlob, blob)
+
+				for _, b := range []*DataBlob{legacyBlob, blob} {
+					got, err := serializer.DeserializeBatchEvents(b)
+					require.NoError(t, err)
+					assert.Equal(t, events, got)
+				}
+
+				unwrapped, err := UnwrapDataBlob(blob)
+				require.NoError(t, err)
+				assert.Equal(t, legacyBlob, unwrapped)
+
+				eventBlob, err := serializer.SerializeEvent(events[0], encoding)
+				require.NoError(t, err)
+				event, err := legacySerializer.DeserializeEvent(eventBlob)
+				require.NoError(t, err)
+				assert.Equal(t, events[0], event)
+			}
+		})
+	}
+
+	blob, err := serializers["checksum"].SerializeBatchEvents(events, common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	blob.Data[len(blob.Data)-1] ^= 0xff
+	_, err = legacySerializer.DeserializeBatchEvents(blob)
+	var corruptedErr *CorruptedBlobError
+	require.ErrorAs(t, err, &corruptedErr)
+	assert.Equal(t, common.EncodingTypeThriftRW, corruptedErr.Encoding)
+	assert.Equal(t, len(blob.Data), corruptedErr.Length)
+	assert.NotEqual(t, corruptedErr.ExpectedChecksum, corruptedErr.ActualChecksum)
+
+	eventBlob, err := serializers["checksum"].SerializeEvent(events[0], common.EncodingTypeThriftRW)
+	require.NoError(t, err)
+	eventBlob.Data[len(eventBlob.Data)-1] ^= 0xff
+	_, err = legacySerializer.DeserializeEvent(eventBlob)
+	assert.ErrorAs(t, err, &corruptedErr)
+	_, err = UnwrapDataBlob(eventBlob)
+	assert.ErrorAs(t, err, &corruptedErr)
+}
+
+type testPrefixCodec struct {
+	prefix string
+}
+
+func (c testPrefixCodec) Encode(input interface{}) ([]byte, error) {
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(c.prefix), data...), nil
+}
+
+func (c testPrefixCodec) Decode(data []byte, target interface{}) error {
+	if !strings.HasPrefix(string(data), c.prefix) {
+		return errors.New("missing prefix")
+	}
+	return json.Unmarshal(data[len(c.prefix):], target)
+}
+
+func TestSerializer_RegisterEncoding(t *testing.T) {
+	const customEncoding = common.EncodingType("custom")
+	event := &types.HistoryEvent{ID: 1, Version: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()}
+	serializer := NewPayloadSerializer()
+
+	_, err := serializer.SerializeEvent(event, customEncoding)
+	assert.IsType(t, &UnknownEncodingTypeError{}, err)
+
+	serializer.RegisterEncoding(customEncoding, testPrefixCodec{prefix: "custom:"})
+	blob, err := serializer.SerializeEvent(event, customEncoding)
+	require.NoError(t, err)
+	assert.Equal(t, customEncoding, blob.Encoding)
+	assert.True(t, strings.HasPrefix(string(blob.Data), "custom:"))
+	got, err := serializer.DeserializeEvent(blob)
+	require.NoError(t, err)
+	assert.Equal(t, event, got)
+
+	memo := &types.Memo{Fields: map[string][]byte{"key": []byte("value")}}
+	memoBlob, err := serializer.SerializeVisibilityMemo(memo, customEncoding)
+	require.NoError(t, err)
+	value, ok, err := serializer.GetMemoField(memoBlob, "key")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("value"), value)
+
+	// the built-in codecs are registered through the same path
+	serializer.RegisterEncoding(common.EncodingTypeJSON, testPrefixCodec{prefix: "json:"})
+	jsonBlob, err := serializer.SerializeEvent(event, common.EncodingTypeJSON)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(jsonBlob.Data), "json:"))
+	got, err = serializer.DeserializeEvent(jsonBlob)
+	require.NoError(t, err)
+	assert.Equal(t, event, got)
+}
+
+func TestSerializer_ChecksumOnlyForBuiltInHistoryEncodings(t *testing.T) {
+	const customEncoding = common.EncodingType("custom")
+	prefix := string([]byte{checksumMagic, 1, 2, 3, 4})
+	event := &types.HistoryEvent{ID: 1, Version: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()}
+	serializer := NewPayloadSerializer(WithHistoryEventsChecksum())
+	serializer.RegisterEncoding(customEncoding, testPrefixCodec{prefix: prefix})
+
+	// payloads of custom encodings starting with the checksum magic byte are passed to the codec as is
+	blob, err := serializer.SerializeEvent(event, customEncoding)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(blob.Data), prefix))
+	got, err := serializer.DeserializeEvent(blob)
+	require.NoError(t, err)
+	assert.Equal(t, event, got)
+
+	// so are payloads which are not history events
+	resetPoints := &types.ResetPoints{Points: []*types.ResetPointInfo{{BinaryChecksum: "checksum"}}}
+	resetPointsBlob, err := serializer.SerializeResetPoints(resetPoints, customEncoding)
+	require.NoError(t, err)
+	gotResetPoints, err := serializer.DeserializeResetPoints(resetPointsBlob)
+	require.NoError(t, err)
+	assert.Equal(t, resetPoints, gotResetPoints)
+}
+
+func TestSerializer_EstimateSerializedSize(t *testing.T) {
+	events := []*types.HistoryEvent{
+		{ID: 1, Version: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
+		{ID: 2, Version: 1, EventType: types.EventTypeDecisionTaskScheduled.Ptr()},
+	}
+	memo := &types.Memo{Fields: map[string][]byte{"key": []byte("<value>")}}
+	serializers := map[string]PayloadSerializer{
+		"default":                  NewPayloadSerializer(),
+		"checksum and compression": NewPayloadSerializer(WithHistoryEventsChecksum(), WithHistoryEventsCompression(dynamicconfig.GetIntPropertyFn(1))),
+	}
+
+	for name, serializer := range serializers {
+		t.Run(name, func(t *testing.T) {
+			for _, encoding := range []common.EncodingType{common.EncodingTypeThriftRW, common.EncodingTypeJSON, common.EncodingTypeEmpty} {
+				blob, err := serializer.SerializeBatchEvents(events, encoding)
+				require.NoError(t, err)
+				size, err := serializer.EstimateSerializedSize(events, encoding)
+				require.NoError(t, err)
+				assert.Equal(t, len(blob.Data), size, "encoding %v", encoding)
+
+				blob, err = serializer.SerializeEvent(events[0], encoding)
+				require.NoError(t, err)
+				size, err = serializer.EstimateSerializedSize(events[0], encoding)
+				require.NoError(t, err)
+				assert.Equal(t, len(blob.Data), size, "encoding %v", encoding)
+
+				blob, err = serializer.SerializeVisibilityMemo(memo, encoding)
+				require.NoError(t, err)
+				size, err = serializer.EstimateSerializedSize(memo, encoding)
+				require.NoError(t, err)
+				assert.Equal(t, len(blob.Data), size, "encoding %v", encoding)
+			}
+		})
+	}
+
+	serializer := NewPayloadSerializer()
+	size, err := serializer.EstimateSerializedSize(nil, common.EncodingTypeThriftRW)
+	assert.NoError(t, err)
+	assert.Zero(t, size)
+	size, err = serializer.EstimateSerializedSize((*types.HistoryEvent)(nil), common.EncodingTypeThriftRW)
+	assert.NoError(t, err)
+	assert.Zero(t, size)
+	_, err = serializer.EstimateSerializedSize(events, common.EncodingTypeGob)
+	var unknownErr *UnknownEncodingTypeError
+	assert.ErrorAs(t, err, &unknownErr)
+}
+
+func TestSerializer_ReencodeBlob(t *testing.T) {
+	serializer := NewPayloadSerializer()
+	events := []*types.HistoryEvent{
+		{ID: 1, Version: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()},
+		{ID: 2, Version: 1, EventType: types.EventTypeDecisionTaskScheduled.Ptr()},
+	}
+	memo := &types.Memo{Fields: map[string][]byte{"key": []byte("<value>")}}
+	histories := &types.VersionHistories{
+		CurrentVersionHistoryIndex: 0,
+		Histories: []*types.VersionHistory{{
+			BranchToken: []byte("token"),
+			Items:       []*types.VersionHistoryItem{{EventID: 2, Version: 1}},
+		}},
+	}
+
+	tests := map[string]struct {
+		kind        BlobKind
+		serialize   func(common.EncodingType) (*DataBlob, error)
+		deserialize func(*DataBlob) (interface{}, error)
+	}{
+		"history events": {
+			kind:        BlobKindHistoryEvents,
+			serialize:   func(e common.EncodingType) (*DataBlob, error) { return serializer.SerializeBatchEvents(events, e) },
+			deserialize: func(b *DataBlob) (interface{}, error) { return serializer.DeserializeBatchEvents(b) },
+		},
+		"history event": {
+			kind:        BlobKindHistoryEvent,
+			serialize:   func(e common.EncodingType) (*DataBlob, error) { return serializer.SerializeEvent(events[0], e) },
+			deserialize: func(b *DataBlob) (interface{}, error) { return serializer.DeserializeEvent(b) },
+		},
+		"visibility memo": {
+			kind:        BlobKindVisibilityMemo,
+			serialize:   func(e common.EncodingType) (*DataBlob, error) { return serializer.SerializeVisibilityMemo(memo, e) },
+			deserialize: func(b *DataBlob) (interface{}, error) { return serializer.DeserializeVisibilityMemo(b) },
+		},
+		"version histories": {
+			kind: BlobKindVersionHistories,
+			serialize: func(e common.EncodingType) (*DataBlob, error) {
+				return serializer.SerializeVersionHistories(histories, e)
+			},
+			deserialize: func(b *DataBlob) (interface{}, error) { return serializer.DeserializeVersionHistories(b) },
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			jsonBlob, err := tc.serialize(common.EncodingTypeJSON)
+			require.NoError(t, err)
+			thriftBlob, err := tc.serialize(common.EncodingTypeThriftRW)
+			require.NoError(t, err)
+
+			reencoded, err := serializer.ReencodeBlob(tc.kind, jsonBlob, common.EncodingTypeThriftRW)
+			require.NoError(t, err)
+			assert.Equal(t, thriftBlob, reencoded)
+
+			reencoded, err = serializer.ReencodeBlob(tc.kind, thriftBlob, common.EncodingTypeJSON)
+			require.NoError(t, err)
+			expected, err := tc.deserialize(jsonBlob)
+			require.NoError(t, err)
+			got, err := tc.deserialize(reencoded)
+			require.NoError(t, err)
+			assert.Equal(t, expected, got)
+
+			reencoded, err = serializer.ReencodeBlob(tc.kind, thriftBlob, common.EncodingTypeThriftRW)
+			require.NoError(t, err)
+			assert.Same(t, thriftBlob, reencoded)
+		})
+	}
+
+	reencoded, err := serializer.ReencodeBlob(BlobKindVisibilityMemo, nil, common.EncodingTypeThriftRW)
+	assert.NoError(t, err)
+	assert.Nil(t, reencoded)
+
+	reencoded, err = serializer.ReencodeBlob(BlobKindVisibilityMemo, &DataBlob{Encoding: common.EncodingTypeJSON}, common.EncodingTypeThriftRW)
+	assert.NoError(t, err)
+	assert.Equal(t, &DataBlob{Encoding: common.EncodingTypeThriftRW}, reencoded)
+
+	_, err = serializer.ReencodeBlob(BlobKindVisibilityMemo, NewDataBlob([]byte("{"), common.EncodingTypeJSON), common.EncodingTypeThriftRW)
+	var deserializationErr *CadenceDeserializationError
+	assert.ErrorAs(t, err, &deserializationErr)
+
+	_, err = serializer.ReencodeBlob(BlobKind(-1), NewDataBlob([]byte("{}"), common.EncodingTypeJSON), common.EncodingTypeThriftRW)
+	var serializationErr *CadenceSerializationError
+	assert.ErrorAs(t, err, &serializationErr)
+}
+
+func FuzzDeserializeEvent(f *testing.F) {
+	serializer := NewPayloadSerializer()
+	encodings := []common.EncodingType{
+		common.EncodingTypeThriftRW,
+		common.EncodingTypeThriftRWSnappy,
+		common.EncodingTypeJSON,
+		common.EncodingTypeEmpty,
+	}
+
+	event := &types.HistoryEvent{ID: 1, Version: 1, EventType: types.EventTypeWorkflowExecutionStarted.Ptr()}
+	for _, s := range []PayloadSerializer{serializer, NewPayloadSerializer(WithHistoryEventsChecksum(), WithHistoryEventsCompression(dynamicconfig.GetIntPropertyFn(1)))} {
+		for _, encoding := range []common.EncodingType{common.EncodingTypeThriftRW, common.EncodingTypeJSON} {
+			blob, err := s.SerializeEvent(event, encoding)
+			require.NoError(f, err)
+			f.Add(blob.Data)
+		}
+	}
+	f.Add([]byte{})
+	f.Add([]byte{0x0c, 0x00, 0x01, 0xff, 0xff, 0xff, 0xff})
+	f.Add([]byte(`{"id":`))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, encoding := range encodings {
+			_, err := serializer.DeserializeEvent(&DataBlob{Encoding: encoding, Data: data})
+			if err == nil {
+				continue
+			}
+			var deserializationErr *CadenceDeserializationError
+			var corruptedErr *CorruptedBlobError
+			if !errors.As(err, &deserializationErr) && !errors.As(err, &corruptedErr) {
+				t.Errorf("encoding %v: unexpected error type %T: %v", encoding, err, err)
+			}
+		}
+	})
+}
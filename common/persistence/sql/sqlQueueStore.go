@@ -24,6 +24,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/persistence"
@@ -78,6 +79,58 @@ func (q *sqlQueueStore) EnqueueMessage(
 	})
 }
 
+func (q *sqlQueueStore) EnqueueMessageWithDedup(
+	ctx context.Context,
+	messagePayload []byte,
+	dedupKey string,
+) (int64, error) {
+	var messageID int64
+	err := q.txExecute(ctx, sqlplugin.DbDefaultShard, "EnqueueMessageWithDedup", func(tx sqlplugin.Tx) error {
+		// locking the last message serializes the enqueues, so the dedup key lookup can't race with another insert
+		lastMessageID, err := tx.GetLastEnqueuedMessageIDForUpdate(ctx, q.queueType)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				lastMessageID = -1
+			} else {
+				return err
+			}
+		}
+
+		messageID, err = tx.GetMessageIDByDedupKey(ctx, q.queueType, dedupKey)
+		if err == nil {
+			return nil
+		}
+		if err != sql.ErrNoRows {
+			return err
+		}
+
+		ackLevels, err := tx.GetAckLevels(ctx, q.queueType, true)
+		if err != nil {
+			return err
+		}
+
+		messageID = getNextID(ackLevels, lastMessageID)
+		row := newQueueRow(q.queueType, messageID, messagePayload)
+		row.DedupKey = &dedupKey
+		_, err = tx.InsertIntoQueue(ctx, row)
+		if err != nil && q.db.IsDupEntryError(err) {
+			return &persistence.ConditionFailedError{Msg: fmt.Sprintf("message with dedup key %v already exists", dedupKey)}
+		}
+		return err
+	})
+	if _, ok := err.(*persistence.ConditionFailedError); ok {
+		// the unique dedup key index rejected a concurrent enqueue, which didn't lock the same last message
+		existingMessageID, lookupErr := q.db.GetMessageIDByDedupKey(ctx, q.queueType, dedupKey)
+		if lookupErr == nil {
+			return existingMessageID, nil
+		}
+	}
+	if err != nil {
+		return -1, err
+	}
+	return messageID, nil
+}
+
 func (q *sqlQueueStore) ReadMessages(
 	ctx context.Context,
 	lastMessageID int64,
@@ -96,13 +149,30 @@ func (q *sqlQueueStore) ReadMessages(
 	return messages, nil
 }
 
+func (q *sqlQueueStore) ReadMessagesReverse(
+	ctx context.Context,
+	maxCount int,
+) ([]*persistence.InternalQueueMessage, error) {
+
+	rows, err := q.db.GetLastMessagesFromQueue(ctx, q.queueType, maxCount)
+	if err != nil {
+		return nil, convertCommonErrors(q.db, "ReadMessagesReverse", "", err)
+	}
+
+	var messages []*persistence.InternalQueueMessage
+	for _, row := range rows {
+		messages = append(messages, &persistence.InternalQueueMessage{ID: row.MessageID, Payload: row.MessagePayload})
+	}
+	return messages, nil
+}
+
 func newQueueRow(
 	queueType persistence.QueueType,
 	messageID int64,
 	payload []byte,
 ) *sqlplugin.QueueRow {
 
-	return &sqlplugin.QueueRow{QueueType: queueType, MessageID: messageID, MessagePayload: payload}
+	return &sqlplugin.QueueRow{QueueType: queueType, MessageID: messageID, MessagePayload: payload, CreatedTime: time.Now()}
 }
 
 func (q *sqlQueueStore) DeleteMessagesBefore(
@@ -117,6 +187,33 @@ func (q *sqlQueueStore) DeleteMessagesBefore(
 	return nil
 }
 
+// PurgeMessages locks the last enqueued message, so the affected rows are exactly the deleted messages
+func (q *sqlQueueStore) PurgeMessages(
+	ctx context.Context,
+) (int64, error) {
+	var deleted int64
+	err := q.txExecute(ctx, sqlplugin.DbDefaultShard, "PurgeMessages", func(tx sqlplugin.Tx) error {
+		lastMessageID, err := tx.GetLastEnqueuedMessageIDForUpdate(ctx, q.queueType)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil
+			}
+			return err
+		}
+
+		result, err := tx.DeleteMessagesBefore(ctx, q.queueType, lastMessageID+1)
+		if err != nil {
+			return err
+		}
+		deleted, err = result.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
+
 func (q *sqlQueueStore) UpdateAckLevel(
 	ctx context.Context,
 	messageID int64,
@@ -171,6 +268,20 @@ func (q *sqlQueueStore) EnqueueMessageToDLQ(
 	})
 }
 
+func (q *sqlQueueStore) GetDLQOldestMessageTimestamp(
+	ctx context.Context,
+) (time.Time, error) {
+
+	createdTime, err := q.db.GetOldestMessageCreatedTime(ctx, q.getDLQTypeFromQueueType())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return time.Time{}, nil
+		}
+		return time.Time{}, convertCommonErrors(q.db, "GetDLQOldestMessageTimestamp", "", err)
+	}
+	return createdTime, nil
+}
+
 func (q *sqlQueueStore) ReadMessagesFromDLQ(
 	ctx context.Context,
 	firstMessageID int64,
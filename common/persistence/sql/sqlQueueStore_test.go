@@ -23,9 +23,18 @@
 package sql
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 )
 
 func TestGetNextID(t *testing.T) {
@@ -57,3 +66,71 @@ func TestGetNextID(t *testing.T) {
 		})
 	}
 }
+
+func TestEnqueueMessageWithDedup(t *testing.T) {
+	tests := map[string]struct {
+		mockSetup         func(*sqlplugin.MockDB, *sqlplugin.MockTx)
+		expectedMessageID int64
+	}{
+		"enqueued": {
+			mockSetup: func(db *sqlplugin.MockDB, tx *sqlplugin.MockTx) {
+				tx.EXPECT().GetMessageIDByDedupKey(gomock.Any(), persistence.DomainReplicationQueueType, "key").Return(int64(0), sql.ErrNoRows)
+				tx.EXPECT().InsertIntoQueue(gomock.Any(), gomock.Any()).Return(nil, nil)
+				tx.EXPECT().Commit().Return(nil)
+			},
+			expectedMessageID: 6,
+		},
+		"already enqueued": {
+			mockSetup: func(db *sqlplugin.MockDB, tx *sqlplugin.MockTx) {
+				tx.EXPECT().GetMessageIDByDedupKey(gomock.Any(), persistence.DomainReplicationQueueType, "key").Return(int64(3), nil)
+				tx.EXPECT().Commit().Return(nil)
+			},
+			expectedMessageID: 3,
+		},
+		"concurrently enqueued": {
+			mockSetup: func(db *sqlplugin.MockDB, tx *sqlplugin.MockTx) {
+				dupErr := errors.New("duplicate entry")
+				tx.EXPECT().GetMessageIDByDedupKey(gomock.Any(), persistence.DomainReplicationQueueType, "key").Return(int64(0), sql.ErrNoRows)
+				tx.EXPECT().InsertIntoQueue(gomock.Any(), gomock.Any()).Return(nil, dupErr)
+				db.EXPECT().IsDupEntryError(dupErr).Return(true)
+				tx.EXPECT().Rollback().Return(nil)
+				db.EXPECT().GetMessageIDByDedupKey(gomock.Any(), persistence.DomainReplicationQueueType, "key").Return(int64(6), nil)
+			},
+			expectedMessageID: 6,
+		},
+	}
+
+	for name, td := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			db := sqlplugin.NewMockDB(ctrl)
+			tx := sqlplugin.NewMockTx(ctrl)
+			db.EXPECT().BeginTx(gomock.Any(), sqlplugin.DbDefaultShard).Return(tx, nil)
+			tx.EXPECT().GetLastEnqueuedMessageIDForUpdate(gomock.Any(), persistence.DomainReplicationQueueType).Return(int64(5), nil)
+			tx.EXPECT().GetAckLevels(gomock.Any(), persistence.DomainReplicationQueueType, true).Return(map[string]int64{}, nil).AnyTimes()
+			td.mockSetup(db, tx)
+
+			store, err := newQueueStore(db, testlogger.New(t), persistence.DomainReplicationQueueType)
+			require.NoError(t, err)
+			messageID, err := store.EnqueueMessageWithDedup(context.Background(), []byte("payload"), "key")
+			require.NoError(t, err)
+			assert.Equal(t, td.expectedMessageID, messageID)
+		})
+	}
+}
+
+func TestPurgeMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	db := sqlplugin.NewMockDB(ctrl)
+	tx := sqlplugin.NewMockTx(ctrl)
+	db.EXPECT().BeginTx(gomock.Any(), sqlplugin.DbDefaultShard).Return(tx, nil)
+	tx.EXPECT().GetLastEnqueuedMessageIDForUpdate(gomock.Any(), persistence.DomainReplicationQueueType).Return(int64(9), nil)
+	tx.EXPECT().DeleteMessagesBefore(gomock.Any(), persistence.DomainReplicationQueueType, int64(10)).Return(&sqlResult{rowsAffected: 4}, nil)
+	tx.EXPECT().Commit().Return(nil)
+
+	store, err := newQueueStore(db, testlogger.New(t), persistence.DomainReplicationQueueType)
+	require.NoError(t, err)
+	deleted, err := store.PurgeMessages(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), deleted)
+}
@@ -275,6 +275,43 @@ type taskListPageToken struct {
 // DomainID translates into byte array in SQL. The minUUID is not the minimum byte array.
 // This API could return incomplete result set.
 // https://github.com/uber/cadence/issues/3911
+func (m *sqlTaskStore) GetTaskList(
+	ctx context.Context,
+	request *persistence.GetTaskListRequest,
+) (*persistence.GetTaskListResponse, error) {
+	dbShardID := sqlplugin.GetDBShardIDFromDomainIDAndTasklist(request.DomainID, request.TaskList, m.db.GetTotalNumDBShards())
+	domainID := serialization.MustParseUUID(request.DomainID)
+	rows, err := m.db.SelectFromTaskLists(ctx, &sqlplugin.TaskListsFilter{
+		ShardID:  dbShardID,
+		DomainID: &domainID,
+		Name:     &request.TaskList,
+		TaskType: common.Int64Ptr(int64(request.TaskType))})
+	if err != nil {
+		return nil, convertCommonErrors(m.db, "GetTaskList", fmt.Sprintf("Failed to get task list %v of type %v.", request.TaskList, request.TaskType), err)
+	}
+	if len(rows) == 0 {
+		return nil, &types.EntityNotExistsError{
+			Message: fmt.Sprintf("GetTaskList failed. Task list %v of type %v doesn't exist.", request.TaskList, request.TaskType),
+		}
+	}
+
+	row := rows[0]
+	tlInfo, err := m.parser.TaskListInfoFromBlob(row.Data, row.DataEncoding)
+	if err != nil {
+		return nil, err
+	}
+	return &persistence.GetTaskListResponse{TaskListInfo: &persistence.TaskListInfo{
+		DomainID:    request.DomainID,
+		Name:        request.TaskList,
+		TaskType:    request.TaskType,
+		RangeID:     row.RangeID,
+		AckLevel:    tlInfo.GetAckLevel(),
+		Kind:        int(tlInfo.GetKind()),
+		Expiry:      tlInfo.GetExpiryTimestamp(),
+		LastUpdated: tlInfo.GetLastUpdated(),
+	}}, nil
+}
+
 func (m *sqlTaskStore) ListTaskList(
 	ctx context.Context,
 	request *persistence.ListTaskListRequest,
@@ -409,6 +446,7 @@ func (m *sqlTaskStore) CreateTasks(
 			ExpiryTimestamp:  expiryTime,
 			CreatedTimestamp: time.Now(),
 			PartitionConfig:  v.Data.PartitionConfig,
+			Priority:         v.Data.Priority,
 		})
 		if err != nil {
 			return nil, err
@@ -496,6 +534,7 @@ func (m *sqlTaskStore) GetTasks(
 			Expiry:          info.GetExpiryTimestamp(),
 			CreatedTime:     info.GetCreatedTimestamp(),
 			PartitionConfig: info.GetPartitionConfig(),
+			Priority:        info.GetPriority(),
 		}
 	}
 
@@ -489,6 +489,36 @@ func (mr *MocktableCRUDMockRecorder) GetLastEnqueuedMessageIDForUpdate(ctx, queu
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastEnqueuedMessageIDForUpdate", reflect.TypeOf((*MocktableCRUD)(nil).GetLastEnqueuedMessageIDForUpdate), ctx, queueType)
 }
 
+// GetLastMessagesFromQueue mocks base method.
+func (m *MocktableCRUD) GetLastMessagesFromQueue(ctx context.Context, queueType persistence.QueueType, maxRows int) ([]QueueRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastMessagesFromQueue", ctx, queueType, maxRows)
+	ret0, _ := ret[0].([]QueueRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastMessagesFromQueue indicates an expected call of GetLastMessagesFromQueue.
+func (mr *MocktableCRUDMockRecorder) GetLastMessagesFromQueue(ctx, queueType, maxRows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastMessagesFromQueue", reflect.TypeOf((*MocktableCRUD)(nil).GetLastMessagesFromQueue), ctx, queueType, maxRows)
+}
+
+// GetMessageIDByDedupKey mocks base method.
+func (m *MocktableCRUD) GetMessageIDByDedupKey(ctx context.Context, queueType persistence.QueueType, dedupKey string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageIDByDedupKey", ctx, queueType, dedupKey)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageIDByDedupKey indicates an expected call of GetMessageIDByDedupKey.
+func (mr *MocktableCRUDMockRecorder) GetMessageIDByDedupKey(ctx, queueType, dedupKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageIDByDedupKey", reflect.TypeOf((*MocktableCRUD)(nil).GetMessageIDByDedupKey), ctx, queueType, dedupKey)
+}
+
 // GetMessagesBetween mocks base method.
 func (m *MocktableCRUD) GetMessagesBetween(ctx context.Context, queueType persistence.QueueType, firstMessageID, lastMessageID int64, maxRows int) ([]QueueRow, error) {
 	m.ctrl.T.Helper()
@@ -519,6 +549,21 @@ func (mr *MocktableCRUDMockRecorder) GetMessagesFromQueue(ctx, queueType, lastMe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesFromQueue", reflect.TypeOf((*MocktableCRUD)(nil).GetMessagesFromQueue), ctx, queueType, lastMessageID, maxRows)
 }
 
+// GetOldestMessageCreatedTime mocks base method.
+func (m *MocktableCRUD) GetOldestMessageCreatedTime(ctx context.Context, queueType persistence.QueueType) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOldestMessageCreatedTime", ctx, queueType)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOldestMessageCreatedTime indicates an expected call of GetOldestMessageCreatedTime.
+func (mr *MocktableCRUDMockRecorder) GetOldestMessageCreatedTime(ctx, queueType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOldestMessageCreatedTime", reflect.TypeOf((*MocktableCRUD)(nil).GetOldestMessageCreatedTime), ctx, queueType)
+}
+
 // GetOrphanTasks mocks base method.
 func (m *MocktableCRUD) GetOrphanTasks(ctx context.Context, filter *OrphanTasksFilter) ([]TaskKeyRow, error) {
 	m.ctrl.T.Helper()
@@ -2280,6 +2325,36 @@ func (mr *MockTxMockRecorder) GetLastEnqueuedMessageIDForUpdate(ctx, queueType i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastEnqueuedMessageIDForUpdate", reflect.TypeOf((*MockTx)(nil).GetLastEnqueuedMessageIDForUpdate), ctx, queueType)
 }
 
+// GetLastMessagesFromQueue mocks base method.
+func (m *MockTx) GetLastMessagesFromQueue(ctx context.Context, queueType persistence.QueueType, maxRows int) ([]QueueRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastMessagesFromQueue", ctx, queueType, maxRows)
+	ret0, _ := ret[0].([]QueueRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastMessagesFromQueue indicates an expected call of GetLastMessagesFromQueue.
+func (mr *MockTxMockRecorder) GetLastMessagesFromQueue(ctx, queueType, maxRows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastMessagesFromQueue", reflect.TypeOf((*MockTx)(nil).GetLastMessagesFromQueue), ctx, queueType, maxRows)
+}
+
+// GetMessageIDByDedupKey mocks base method.
+func (m *MockTx) GetMessageIDByDedupKey(ctx context.Context, queueType persistence.QueueType, dedupKey string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageIDByDedupKey", ctx, queueType, dedupKey)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageIDByDedupKey indicates an expected call of GetMessageIDByDedupKey.
+func (mr *MockTxMockRecorder) GetMessageIDByDedupKey(ctx, queueType, dedupKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageIDByDedupKey", reflect.TypeOf((*MockTx)(nil).GetMessageIDByDedupKey), ctx, queueType, dedupKey)
+}
+
 // GetMessagesBetween mocks base method.
 func (m *MockTx) GetMessagesBetween(ctx context.Context, queueType persistence.QueueType, firstMessageID, lastMessageID int64, maxRows int) ([]QueueRow, error) {
 	m.ctrl.T.Helper()
@@ -2310,6 +2385,21 @@ func (mr *MockTxMockRecorder) GetMessagesFromQueue(ctx, queueType, lastMessageID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesFromQueue", reflect.TypeOf((*MockTx)(nil).GetMessagesFromQueue), ctx, queueType, lastMessageID, maxRows)
 }
 
+// GetOldestMessageCreatedTime mocks base method.
+func (m *MockTx) GetOldestMessageCreatedTime(ctx context.Context, queueType persistence.QueueType) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOldestMessageCreatedTime", ctx, queueType)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOldestMessageCreatedTime indicates an expected call of GetOldestMessageCreatedTime.
+func (mr *MockTxMockRecorder) GetOldestMessageCreatedTime(ctx, queueType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOldestMessageCreatedTime", reflect.TypeOf((*MockTx)(nil).GetOldestMessageCreatedTime), ctx, queueType)
+}
+
 // GetOrphanTasks mocks base method.
 func (m *MockTx) GetOrphanTasks(ctx context.Context, filter *OrphanTasksFilter) ([]TaskKeyRow, error) {
 	m.ctrl.T.Helper()
@@ -3986,6 +4076,36 @@ func (mr *MockDBMockRecorder) GetLastEnqueuedMessageIDForUpdate(ctx, queueType i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastEnqueuedMessageIDForUpdate", reflect.TypeOf((*MockDB)(nil).GetLastEnqueuedMessageIDForUpdate), ctx, queueType)
 }
 
+// GetLastMessagesFromQueue mocks base method.
+func (m *MockDB) GetLastMessagesFromQueue(ctx context.Context, queueType persistence.QueueType, maxRows int) ([]QueueRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastMessagesFromQueue", ctx, queueType, maxRows)
+	ret0, _ := ret[0].([]QueueRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastMessagesFromQueue indicates an expected call of GetLastMessagesFromQueue.
+func (mr *MockDBMockRecorder) GetLastMessagesFromQueue(ctx, queueType, maxRows interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastMessagesFromQueue", reflect.TypeOf((*MockDB)(nil).GetLastMessagesFromQueue), ctx, queueType, maxRows)
+}
+
+// GetMessageIDByDedupKey mocks base method.
+func (m *MockDB) GetMessageIDByDedupKey(ctx context.Context, queueType persistence.QueueType, dedupKey string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageIDByDedupKey", ctx, queueType, dedupKey)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageIDByDedupKey indicates an expected call of GetMessageIDByDedupKey.
+func (mr *MockDBMockRecorder) GetMessageIDByDedupKey(ctx, queueType, dedupKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageIDByDedupKey", reflect.TypeOf((*MockDB)(nil).GetMessageIDByDedupKey), ctx, queueType, dedupKey)
+}
+
 // GetMessagesBetween mocks base method.
 func (m *MockDB) GetMessagesBetween(ctx context.Context, queueType persistence.QueueType, firstMessageID, lastMessageID int64, maxRows int) ([]QueueRow, error) {
 	m.ctrl.T.Helper()
@@ -4016,6 +4136,21 @@ func (mr *MockDBMockRecorder) GetMessagesFromQueue(ctx, queueType, lastMessageID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesFromQueue", reflect.TypeOf((*MockDB)(nil).GetMessagesFromQueue), ctx, queueType, lastMessageID, maxRows)
 }
 
+// GetOldestMessageCreatedTime mocks base method.
+func (m *MockDB) GetOldestMessageCreatedTime(ctx context.Context, queueType persistence.QueueType) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOldestMessageCreatedTime", ctx, queueType)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOldestMessageCreatedTime indicates an expected call of GetOldestMessageCreatedTime.
+func (mr *MockDBMockRecorder) GetOldestMessageCreatedTime(ctx, queueType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOldestMessageCreatedTime", reflect.TypeOf((*MockDB)(nil).GetOldestMessageCreatedTime), ctx, queueType)
+}
+
 // GetOrphanTasks mocks base method.
 func (m *MockDB) GetOrphanTasks(ctx context.Context, filter *OrphanTasksFilter) ([]TaskKeyRow, error) {
 	m.ctrl.T.Helper()
@@ -563,6 +563,8 @@ type (
 		QueueType      persistence.QueueType
 		MessageID      int64
 		MessagePayload []byte
+		DedupKey       *string
+		CreatedTime    time.Time
 	}
 
 	// QueueMetadataRow represents a row in queue_metadata table
@@ -802,7 +804,14 @@ type (
 
 		InsertIntoQueue(ctx context.Context, row *QueueRow) (sql.Result, error)
 		GetLastEnqueuedMessageIDForUpdate(ctx context.Context, queueType persistence.QueueType) (int64, error)
+		// GetMessageIDByDedupKey returns sql.ErrNoRows if no message with the dedup key exists
+		GetMessageIDByDedupKey(ctx context.Context, queueType persistence.QueueType, dedupKey string) (int64, error)
+		// GetOldestMessageCreatedTime returns sql.ErrNoRows if the queue has no message with a created time.
+		// Messages enqueued before created_time was added are backfilled with the time of the schema upgrade,
+		// only messages enqueued by hosts which are not upgraded yet have no created time and are skipped.
+		GetOldestMessageCreatedTime(ctx context.Context, queueType persistence.QueueType) (time.Time, error)
 		GetMessagesFromQueue(ctx context.Context, queueType persistence.QueueType, lastMessageID int64, maxRows int) ([]QueueRow, error)
+		GetLastMessagesFromQueue(ctx context.Context, queueType persistence.QueueType, maxRows int) ([]QueueRow, error)
 		GetMessagesBetween(ctx context.Context, queueType persistence.QueueType, firstMessageID int64, lastMessageID int64, maxRows int) ([]QueueRow, error)
 		DeleteMessagesBefore(ctx context.Context, queueType persistence.QueueType, messageID int64) (sql.Result, error)
 		RangeDeleteMessages(ctx context.Context, queueType persistence.QueueType, exclusiveBeginMessageID int64, inclusiveEndMessageID int64) (sql.Result, error)
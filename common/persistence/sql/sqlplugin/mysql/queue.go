@@ -24,24 +24,28 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 )
 
 const (
-	templateEnqueueMessageQuery            = `INSERT INTO queue (queue_type, message_id, message_payload) VALUES(:queue_type, :message_id, :message_payload)`
-	templateGetLastMessageIDQuery          = `SELECT message_id FROM queue WHERE queue_type=? ORDER BY message_id DESC LIMIT 1 FOR UPDATE`
-	templateGetMessagesQuery               = `SELECT message_id, message_payload FROM queue WHERE queue_type = ? and message_id > ? ORDER BY message_id ASC LIMIT ?`
-	templateGetMessagesBetweenQuery        = `SELECT message_id, message_payload FROM queue WHERE queue_type = ? and message_id > ? and message_id <= ? ORDER BY message_id ASC LIMIT ?`
-	templateDeleteMessagesBeforeQuery      = `DELETE FROM queue WHERE queue_type = ? and message_id < ?`
-	templateRangeDeleteMessagesQuery       = `DELETE FROM queue WHERE queue_type = ? and message_id > ? and message_id <= ?`
-	templateDeleteMessageQuery             = `DELETE FROM queue WHERE queue_type = ? and message_id = ?`
-	templateGetQueueMetadataQuery          = `SELECT data from queue_metadata WHERE queue_type = ?`
-	templateGetQueueMetadataForUpdateQuery = templateGetQueueMetadataQuery + ` FOR UPDATE`
-	templateInsertQueueMetadataQuery       = `INSERT INTO queue_metadata (queue_type, data) VALUES(:queue_type, :data)`
-	templateUpdateQueueMetadataQuery       = `UPDATE queue_metadata SET data = ? WHERE queue_type = ?`
-	templateGetQueueSizeQuery              = `SELECT COUNT(1) AS count FROM queue WHERE queue_type=?`
+	templateEnqueueMessageQuery              = `INSERT INTO queue (queue_type, message_id, message_payload, dedup_key, created_time) VALUES(:queue_type, :message_id, :message_payload, :dedup_key, :created_time)`
+	templateGetLastMessageIDQuery            = `SELECT message_id FROM queue WHERE queue_type=? ORDER BY message_id DESC LIMIT 1 FOR UPDATE`
+	templateGetMessageIDByDedupKeyQuery      = `SELECT message_id FROM queue WHERE queue_type = ? and dedup_key = ? LIMIT 1`
+	templateGetOldestMessageCreatedTimeQuery = `SELECT created_time FROM queue WHERE queue_type = ? and created_time IS NOT NULL ORDER BY message_id ASC LIMIT 1`
+	templateGetMessagesQuery                 = `SELECT message_id, message_payload FROM queue WHERE queue_type = ? and message_id > ? ORDER BY message_id ASC LIMIT ?`
+	templateGetLastMessagesQuery             = `SELECT message_id, message_payload FROM queue WHERE queue_type = ? ORDER BY message_id DESC LIMIT ?`
+	templateGetMessagesBetweenQuery          = `SELECT message_id, message_payload FROM queue WHERE queue_type = ? and message_id > ? and message_id <= ? ORDER BY message_id ASC LIMIT ?`
+	templateDeleteMessagesBeforeQuery        = `DELETE FROM queue WHERE queue_type = ? and message_id < ?`
+	templateRangeDeleteMessagesQuery         = `DELETE FROM queue WHERE queue_type = ? and message_id > ? and message_id <= ?`
+	templateDeleteMessageQuery               = `DELETE FROM queue WHERE queue_type = ? and message_id = ?`
+	templateGetQueueMetadataQuery            = `SELECT data from queue_metadata WHERE queue_type = ?`
+	templateGetQueueMetadataForUpdateQuery   = templateGetQueueMetadataQuery + ` FOR UPDATE`
+	templateInsertQueueMetadataQuery         = `INSERT INTO queue_metadata (queue_type, data) VALUES(:queue_type, :data)`
+	templateUpdateQueueMetadataQuery         = `UPDATE queue_metadata SET data = ? WHERE queue_type = ?`
+	templateGetQueueSizeQuery                = `SELECT COUNT(1) AS count FROM queue WHERE queue_type=?`
 )
 
 // InsertIntoQueue inserts a new row into queue table
@@ -50,6 +54,7 @@ func (mdb *db) InsertIntoQueue(
 	row *sqlplugin.QueueRow,
 ) (sql.Result, error) {
 
+	row.CreatedTime = mdb.converter.ToMySQLDateTime(row.CreatedTime)
 	return mdb.driver.NamedExecContext(ctx, sqlplugin.DbDefaultShard, templateEnqueueMessageQuery, row)
 }
 
@@ -64,6 +69,31 @@ func (mdb *db) GetLastEnqueuedMessageIDForUpdate(
 	return lastMessageID, err
 }
 
+// GetMessageIDByDedupKey returns the ID of the message enqueued with the dedup key
+func (mdb *db) GetMessageIDByDedupKey(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	dedupKey string,
+) (int64, error) {
+
+	var messageID int64
+	err := mdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &messageID, templateGetMessageIDByDedupKeyQuery, queueType, dedupKey)
+	return messageID, err
+}
+
+// GetOldestMessageCreatedTime returns the created time of the first message in the queue
+func (mdb *db) GetOldestMessageCreatedTime(
+	ctx context.Context,
+	queueType persistence.QueueType,
+) (time.Time, error) {
+
+	var createdTime time.Time
+	if err := mdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &createdTime, templateGetOldestMessageCreatedTimeQuery, queueType); err != nil {
+		return time.Time{}, err
+	}
+	return mdb.converter.FromMySQLDateTime(createdTime), nil
+}
+
 // GetMessagesFromQueue retrieves messages from the queue
 func (mdb *db) GetMessagesFromQueue(
 	ctx context.Context,
@@ -77,6 +107,18 @@ func (mdb *db) GetMessagesFromQueue(
 	return rows, err
 }
 
+// GetLastMessagesFromQueue retrieves the last messages from the queue in descending ID order
+func (mdb *db) GetLastMessagesFromQueue(
+	ctx context.Context,
+	queueType persistence.QueueType,
+	maxRows int,
+) ([]sqlplugin.QueueRow, error) {
+
+	var rows []sqlplugin.QueueRow
+	err := mdb.driver.SelectContext(ctx, sqlplugin.DbDefaultShard, &rows, templateGetLastMessagesQuery, queueType, maxRows)
+	return rows, err
+}
+
 // GetMessagesBetween retrieves messages from the queue
 func (mdb *db) GetMessagesBetween(
 	ctx context.Context,
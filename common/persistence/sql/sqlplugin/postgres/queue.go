@@ -24,28 +24,33 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"time"
 
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/sql/sqlplugin"
 )
 
 const (
-	templateEnqueueMessageQuery            = `INSERT INTO queue (queue_type, message_id, message_payload) VALUES(:queue_type, :message_id, :message_payload)`
-	templateGetLastMessageIDQuery          = `SELECT message_id FROM queue WHERE queue_type=$1 ORDER BY message_id DESC LIMIT 1 FOR UPDATE`
-	templateGetMessagesQuery               = `SELECT message_id, message_payload FROM queue WHERE queue_type = $1 and message_id > $2 ORDER BY message_id ASC LIMIT $3`
-	templateGetMessagesBetweenQuery        = `SELECT message_id, message_payload FROM queue WHERE queue_type = $1 and messageid > $2 and message_id <= $3 ORDER BY message_id ASC LIMIT $4`
-	templateDeleteMessageQuery             = `DELETE FROM queue WHERE queue_type = $1 and message_id = $2`
-	templateDeleteMessagesBeforeQuery      = `DELETE FROM queue WHERE queue_type = $1 and message_id < $2`
-	templateRangeDeleteMessagesQuery       = `DELETE FROM queue WHERE queue_type = $1 and message_id > $2 and message_id <= $3`
-	templateGetQueueMetadataQuery          = `SELECT data from queue_metadata WHERE queue_type = $1`
-	templateGetQueueMetadataForUpdateQuery = templateGetQueueMetadataQuery + ` FOR UPDATE`
-	templateInsertQueueMetadataQuery       = `INSERT INTO queue_metadata (queue_type, data) VALUES(:queue_type, :data)`
-	templateUpdateQueueMetadataQuery       = `UPDATE queue_metadata SET data = $1 WHERE queue_type = $2`
-	templateGetQueueSizeQuery              = `SELECT COUNT(1) AS count FROM queue WHERE queue_type=$1`
+	templateEnqueueMessageQuery              = `INSERT INTO queue (queue_type, message_id, message_payload, dedup_key, created_time) VALUES(:queue_type, :message_id, :message_payload, :dedup_key, :created_time)`
+	templateGetLastMessageIDQuery            = `SELECT message_id FROM queue WHERE queue_type=$1 ORDER BY message_id DESC LIMIT 1 FOR UPDATE`
+	templateGetMessageIDByDedupKeyQuery      = `SELECT message_id FROM queue WHERE queue_type = $1 and dedup_key = $2 LIMIT 1`
+	templateGetOldestMessageCreatedTimeQuery = `SELECT created_time FROM queue WHERE queue_type = $1 and created_time IS NOT NULL ORDER BY message_id ASC LIMIT 1`
+	templateGetMessagesQuery                 = `SELECT message_id, message_payload FROM queue WHERE queue_type = $1 and message_id > $2 ORDER BY message_id ASC LIMIT $3`
+	templateGetLastMessagesQuery             = `SELECT message_id, message_payload FROM queue WHERE queue_type = $1 ORDER BY message_id DESC LIMIT $2`
+	templateGetMessagesBetweenQuery          = `SELECT message_id, message_payload FROM queue WHERE queue_type = $1 and messageid > $2 and message_id <= $3 ORDER BY message_id ASC LIMIT $4`
+	templateDeleteMessageQuery               = `DELETE FROM queue WHERE queue_type = $1 and message_id = $2`
+	templateDeleteMessagesBeforeQuery        = `DELETE FROM queue WHERE queue_type = $1 and message_id < $2`
+	templateRangeDeleteMessagesQuery         = `DELETE FROM queue WHERE queue_type = $1 and message_id > $2 and message_id <= $3`
+	templateGetQueueMetadataQuery            = `SELECT data from queue_metadata WHERE queue_type = $1`
+	templateGetQueueMetadataForUpdateQuery   = templateGetQueueMetadataQuery + ` FOR UPDATE`
+	templateInsertQueueMetadataQuery         = `INSERT INTO queue_metadata (queue_type, data) VALUES(:queue_type, :data)`
+	templateUpdateQueueMetadataQuery         = `UPDATE queue_metadata SET data = $1 WHERE queue_type = $2`
+	templateGetQueueSizeQuery                = `SELECT COUNT(1) AS count FROM queue WHERE queue_type=$1`
 )
 
 // InsertIntoQueue inserts a new row into queue table
 func (pdb *db) InsertIntoQueue(ctx context.Context, row *sqlplugin.QueueRow) (sql.Result, error) {
+	row.CreatedTime = pdb.converter.ToPostgresDateTime(row.CreatedTime)
 	return pdb.driver.NamedExecContext(ctx, sqlplugin.DbDefaultShard, templateEnqueueMessageQuery, row)
 }
 
@@ -56,6 +61,22 @@ func (pdb *db) GetLastEnqueuedMessageIDForUpdate(ctx context.Context, queueType
 	return lastMessageID, err
 }
 
+// GetMessageIDByDedupKey returns the ID of the message enqueued with the dedup key
+func (pdb *db) GetMessageIDByDedupKey(ctx context.Context, queueType persistence.QueueType, dedupKey string) (int64, error) {
+	var messageID int64
+	err := pdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &messageID, templateGetMessageIDByDedupKeyQuery, queueType, dedupKey)
+	return messageID, err
+}
+
+// GetOldestMessageCreatedTime returns the created time of the first message in the queue
+func (pdb *db) GetOldestMessageCreatedTime(ctx context.Context, queueType persistence.QueueType) (time.Time, error) {
+	var createdTime time.Time
+	if err := pdb.driver.GetContext(ctx, sqlplugin.DbDefaultShard, &createdTime, templateGetOldestMessageCreatedTimeQuery, queueType); err != nil {
+		return time.Time{}, err
+	}
+	return pdb.converter.FromPostgresDateTime(createdTime), nil
+}
+
 // GetMessagesFromQueue retrieves messages from the queue
 func (pdb *db) GetMessagesFromQueue(ctx context.Context, queueType persistence.QueueType, lastMessageID int64, maxRows int) ([]sqlplugin.QueueRow, error) {
 	var rows []sqlplugin.QueueRow
@@ -63,6 +84,13 @@ func (pdb *db) GetMessagesFromQueue(ctx context.Context, queueType persistence.Q
 	return rows, err
 }
 
+// GetLastMessagesFromQueue retrieves the last messages from the queue in descending ID order
+func (pdb *db) GetLastMessagesFromQueue(ctx context.Context, queueType persistence.QueueType, maxRows int) ([]sqlplugin.QueueRow, error) {
+	var rows []sqlplugin.QueueRow
+	err := pdb.driver.SelectContext(ctx, sqlplugin.DbDefaultShard, &rows, templateGetLastMessagesQuery, queueType, maxRows)
+	return rows, err
+}
+
 // GetMessagesBetween retrieves messages from the queue
 func (pdb *db) GetMessagesBetween(ctx context.Context, queueType persistence.QueueType, firstMessageID int64, lastMessageID int64, maxRows int) ([]sqlplugin.QueueRow, error) {
 	var rows []sqlplugin.QueueRow
@@ -69,6 +69,10 @@ func (t *taskManager) DeleteTaskList(ctx context.Context, request *DeleteTaskLis
 	return t.persistence.DeleteTaskList(ctx, request)
 }
 
+func (t *taskManager) GetTaskList(ctx context.Context, request *GetTaskListRequest) (*GetTaskListResponse, error) {
+	return t.persistence.GetTaskList(ctx, request)
+}
+
 func (t *taskManager) GetTaskListSize(ctx context.Context, request *GetTaskListSizeRequest) (*GetTaskListSizeResponse, error) {
 	return t.persistence.GetTaskListSize(ctx, request)
 }
@@ -138,6 +142,7 @@ func (t *taskManager) toInternalTaskInfo(taskInfo *TaskInfo) *InternalTaskInfo {
 		Expiry:                 taskInfo.Expiry,
 		CreatedTime:            taskInfo.CreatedTime,
 		PartitionConfig:        taskInfo.PartitionConfig,
+		Priority:               taskInfo.Priority,
 	}
 }
 func (t *taskManager) fromInternalTaskInfo(internalTaskInfo *InternalTaskInfo) *TaskInfo {
@@ -154,5 +159,6 @@ func (t *taskManager) fromInternalTaskInfo(internalTaskInfo *InternalTaskInfo) *
 		Expiry:                 internalTaskInfo.Expiry,
 		CreatedTime:            internalTaskInfo.CreatedTime,
 		PartitionConfig:        internalTaskInfo.PartitionConfig,
+		Priority:               internalTaskInfo.Priority,
 	}
 }
@@ -81,6 +81,18 @@ func (rl *RateLimiter) UpdateMaxDispatch(maxDispatchPerSecond *float64) {
 	}
 }
 
+// ResetMaxDispatch sets the max dispatch rate of the rate limiter right away, unlike
+// UpdateMaxDispatch it doesn't wait for the TTL to expire before raising the rate
+func (rl *RateLimiter) ResetMaxDispatch(maxDispatchPerSecond *float64) {
+	if maxDispatchPerSecond == nil {
+		return
+	}
+	rl.Lock()
+	rl.maxDispatchPerSecond = maxDispatchPerSecond
+	rl.storeLimiter(maxDispatchPerSecond)
+	rl.Unlock()
+}
+
 // Wait waits up till deadline for a rate limit token
 func (rl *RateLimiter) Wait(ctx context.Context) error {
 	limiter := rl.goRateLimiter.Load().(*rate.Limiter)
@@ -69,10 +69,12 @@ type (
 		PublicClient             workflowserviceclient.Interface
 		ArchivalMetadata         archiver.ArchivalMetadata
 		ArchiverProvider         provider.ArchiverProvider
-		Authorizer               authorization.Authorizer // NOTE: this can be nil. If nil, AccessControlledHandlerImpl will initiate one with config.Authorization
-		AuthorizationConfig      config.Authorization     // NOTE: empty(default) struct will get a authorization.NoopAuthorizer
-		IsolationGroupStore      configstore.Client       // This can be nil, the default config store will be created if so
-		IsolationGroupState      isolationgroup.State     // This can be nil, the default state store will be chosen if so
+		Authorizer               authorization.Authorizer         // NOTE: this can be nil. If nil, AccessControlledHandlerImpl will initiate one with config.Authorization
+		ShadowAuthorizer         authorization.Authorizer         // NOTE: this can be nil. If set, its decisions are only logged and compared against the Authorizer's
+		PrincipalExtractor       authorization.PrincipalExtractor // NOTE: this can be nil. If nil, AccessControlledHandlerImpl will initiate one with config.Authorization
+		AuthorizationConfig      config.Authorization             // NOTE: empty(default) struct will get a authorization.NoopAuthorizer
+		IsolationGroupStore      configstore.Client               // This can be nil, the default config store will be created if so
+		IsolationGroupState      isolationgroup.State             // This can be nil, the default state store will be chosen if so
 		Partitioner              partition.Partitioner
 		PinotConfig              *config.PinotVisibilityConfig
 		PinotClient              pinot.GenericClient
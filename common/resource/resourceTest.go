@@ -73,6 +73,7 @@ type (
 		ArchivalMetadata        *archiver.MockArchivalMetadata
 		ArchiverProvider        *provider.MockArchiverProvider
 		BlobstoreClient         *blobstore.MockClient
+		MessagingClient         messaging.Client
 
 		// membership infos
 		MembershipResolver *membership.MockResolver
@@ -279,7 +280,7 @@ func (s *Test) GetMetricsClient() metrics.Client {
 
 // GetMessagingClient for testing
 func (s *Test) GetMessagingClient() messaging.Client {
-	panic("user should implement this method for test")
+	return s.MessagingClient
 }
 
 // GetBlobstoreClient for testing
@@ -446,3 +446,94 @@ type UpdateDomainIsolationGroupsRequest struct {
 }
 
 type UpdateDomainIsolationGroupsResponse struct{}
+
+// RefreshTaskListRequest is an internal type (TBD...)
+type RefreshTaskListRequest struct {
+	Domain       string        `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+func (v *RefreshTaskListRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *RefreshTaskListRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *RefreshTaskListRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *RefreshTaskListRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// RefreshTaskListResponse is an internal type (TBD...)
+type RefreshTaskListResponse struct {
+	NumReadPartitions  int32 `json:"numReadPartitions,omitempty"`
+	NumWritePartitions int32 `json:"numWritePartitions,omitempty"`
+}
+
+// ResetTaskListAckLevelRequest is an internal type (TBD...)
+type ResetTaskListAckLevelRequest struct {
+	Domain       string        `json:"domain,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+	AckLevel     int64         `json:"ackLevel,omitempty"`
+}
+
+func (v *ResetTaskListAckLevelRequest) SerializeForLogging() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	return SerializeRequest(v)
+}
+
+// GetDomain is an internal getter (TBD...)
+func (v *ResetTaskListAckLevelRequest) GetDomain() (o string) {
+	if v != nil {
+		return v.Domain
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *ResetTaskListAckLevelRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *ResetTaskListAckLevelRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetAckLevel is an internal getter (TBD...)
+func (v *ResetTaskListAckLevelRequest) GetAckLevel() (o int64) {
+	if v != nil {
+		return v.AckLevel
+	}
+	return
+}
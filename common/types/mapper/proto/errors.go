@@ -22,7 +22,10 @@ package proto
 
 import (
 	"errors"
+	"time"
 
+	"github.com/gogo/protobuf/proto"
+	gogo "github.com/gogo/protobuf/types"
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 	"go.uber.org/yarpc/encoding/protobuf"
 	"go.uber.org/yarpc/yarpcerrors"
@@ -99,7 +102,12 @@ func FromError(err error) error {
 	case *types.LimitExceededError:
 		return protobuf.NewError(yarpcerrors.CodeResourceExhausted, e.Message, protobuf.WithErrorDetails(&apiv1.LimitExceededError{}))
 	case *types.ServiceBusyError:
-		return protobuf.NewError(yarpcerrors.CodeResourceExhausted, e.Message, protobuf.WithErrorDetails(&apiv1.ServiceBusyError{}))
+		// the public IDL has no retry-after field so it travels as an extra detail after the typed one
+		details := []proto.Message{&apiv1.ServiceBusyError{}}
+		if e.RetryAfter > 0 {
+			details = append(details, gogo.DurationProto(e.RetryAfter))
+		}
+		return protobuf.NewError(yarpcerrors.CodeResourceExhausted, e.Message, protobuf.WithErrorDetails(details...))
 	case *types.RemoteSyncMatchedError:
 		return protobuf.NewError(yarpcerrors.CodeUnavailable, e.Message, protobuf.WithErrorDetails(&sharedv1.RemoteSyncMatchedError{}))
 	case *types.StickyWorkerUnavailableError:
@@ -225,7 +233,8 @@ func ToError(err error) error {
 			}
 		case *apiv1.ServiceBusyError:
 			return &types.ServiceBusyError{
-				Message: status.Message(),
+				Message:    status.Message(),
+				RetryAfter: getRetryAfterDetail(err),
 			}
 		}
 	case yarpcerrors.CodeUnavailable:
@@ -255,3 +264,14 @@ func getErrorDetails(err error) interface{} {
 	}
 	return nil
 }
+
+func getRetryAfterDetail(err error) time.Duration {
+	for _, detail := range protobuf.GetErrorDetails(err) {
+		if d, ok := detail.(*gogo.Duration); ok {
+			if retryAfter, err := gogo.DurationFromProto(d); err == nil {
+				return retryAfter
+			}
+		}
+	}
+	return 0
+}
@@ -41,6 +41,7 @@ func FromMatchingAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matchi
 		ForwardedFrom:            t.ForwardedFrom,
 		ActivityTaskDispatchInfo: FromActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:          t.PartitionConfig,
+		Priority:                 t.Priority,
 	}
 }
 
@@ -59,6 +60,7 @@ func ToMatchingAddActivityTaskRequest(t *matchingv1.AddActivityTaskRequest) *typ
 		ForwardedFrom:                 t.ForwardedFrom,
 		ActivityTaskDispatchInfo:      ToActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.Priority,
 	}
 }
 
@@ -75,6 +77,8 @@ func FromMatchingAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matchi
 		Source:                 FromTaskSource(t.Source),
 		ForwardedFrom:          t.ForwardedFrom,
 		PartitionConfig:        t.PartitionConfig,
+		Priority:               t.Priority,
+		ForwardingDepth:        t.ForwardingDepth,
 	}
 }
 
@@ -91,6 +95,26 @@ func ToMatchingAddDecisionTaskRequest(t *matchingv1.AddDecisionTaskRequest) *typ
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.ForwardedFrom,
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.Priority,
+		ForwardingDepth:               t.ForwardingDepth,
+	}
+}
+
+func FromMatchingAddDecisionTaskResponse(t *types.AddDecisionTaskResponse) *matchingv1.AddDecisionTaskResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.AddDecisionTaskResponse{
+		ForwardingDepth: t.ForwardingDepth,
+	}
+}
+
+func ToMatchingAddDecisionTaskResponse(t *matchingv1.AddDecisionTaskResponse) *types.AddDecisionTaskResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.AddDecisionTaskResponse{
+		ForwardingDepth: t.ForwardingDepth,
 	}
 }
 
@@ -137,6 +161,7 @@ func FromMatchingCancelOutstandingPollRequest(t *types.CancelOutstandingPollRequ
 		PollerId:     t.PollerID,
 		TaskListType: FromTaskListType(taskListType),
 		TaskList:     FromTaskList(t.TaskList),
+		CancelAll:    t.CancelAll,
 	}
 }
 
@@ -153,6 +178,71 @@ func ToMatchingCancelOutstandingPollRequest(t *matchingv1.CancelOutstandingPollR
 		PollerID:     t.PollerId,
 		TaskListType: taskListType,
 		TaskList:     ToTaskList(t.TaskList),
+		CancelAll:    t.CancelAll,
+	}
+}
+
+func FromMatchingDescribePollerRequest(t *types.MatchingDescribePollerRequest) *matchingv1.DescribePollerRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.DescribePollerRequest{
+		DomainId:     t.DomainUUID,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+		Identity:     t.Identity,
+	}
+}
+
+func ToMatchingDescribePollerRequest(t *matchingv1.DescribePollerRequest) *types.MatchingDescribePollerRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingDescribePollerRequest{
+		DomainUUID:   t.DomainId,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+		Identity:     t.Identity,
+	}
+}
+
+func FromMatchingDescribePollerResponse(t *types.MatchingDescribePollerResponse) *matchingv1.DescribePollerResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.DescribePollerResponse{
+		Poller:               FromPollerInfo(t.Poller),
+		IsolationGroup:       t.IsolationGroup,
+		OutstandingPollerIds: t.OutstandingPollerIDs,
+	}
+}
+
+func ToMatchingDescribePollerResponse(t *matchingv1.DescribePollerResponse) *types.MatchingDescribePollerResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingDescribePollerResponse{
+		Poller:               ToPollerInfo(t.Poller),
+		IsolationGroup:       t.IsolationGroup,
+		OutstandingPollerIDs: t.OutstandingPollerIds,
+	}
+}
+
+func FromMatchingCancelOutstandingPollResponse(t *types.CancelOutstandingPollResponse) *matchingv1.CancelOutstandingPollResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.CancelOutstandingPollResponse{
+		NumCancelledPolls: t.NumCancelledPolls,
+	}
+}
+
+func ToMatchingCancelOutstandingPollResponse(t *matchingv1.CancelOutstandingPollResponse) *types.CancelOutstandingPollResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.CancelOutstandingPollResponse{
+		NumCancelledPolls: t.NumCancelledPolls,
 	}
 }
 
@@ -161,8 +251,9 @@ func FromMatchingDescribeTaskListRequest(t *types.MatchingDescribeTaskListReques
 		return nil
 	}
 	return &matchingv1.DescribeTaskListRequest{
-		Request:  FromDescribeTaskListRequest(t.DescRequest),
-		DomainId: t.DomainUUID,
+		Request:                FromDescribeTaskListRequest(t.DescRequest),
+		DomainId:               t.DomainUUID,
+		IncludeBacklogEstimate: t.IncludeBacklogEstimate,
 	}
 }
 
@@ -171,8 +262,9 @@ func ToMatchingDescribeTaskListRequest(t *matchingv1.DescribeTaskListRequest) *t
 		return nil
 	}
 	return &types.MatchingDescribeTaskListRequest{
-		DescRequest: ToDescribeTaskListRequest(t.Request),
-		DomainUUID:  t.DomainId,
+		DescRequest:            ToDescribeTaskListRequest(t.Request),
+		DomainUUID:             t.DomainId,
+		IncludeBacklogEstimate: t.IncludeBacklogEstimate,
 	}
 }
 
@@ -181,8 +273,9 @@ func FromMatchingDescribeTaskListResponse(t *types.DescribeTaskListResponse) *ma
 		return nil
 	}
 	return &matchingv1.DescribeTaskListResponse{
-		Pollers:        FromPollerInfoArray(t.Pollers),
-		TaskListStatus: FromTaskListStatus(t.TaskListStatus),
+		Pollers:              FromPollerInfoArray(t.Pollers),
+		TaskListStatus:       FromTaskListStatus(t.TaskListStatus),
+		BacklogCountEstimate: t.BacklogCountEstimate,
 	}
 }
 
@@ -191,8 +284,9 @@ func ToMatchingDescribeTaskListResponse(t *matchingv1.DescribeTaskListResponse)
 		return nil
 	}
 	return &types.DescribeTaskListResponse{
-		Pollers:        ToPollerInfoArray(t.Pollers),
-		TaskListStatus: ToTaskListStatus(t.TaskListStatus),
+		Pollers:              ToPollerInfoArray(t.Pollers),
+		TaskListStatus:       ToTaskListStatus(t.TaskListStatus),
+		BacklogCountEstimate: t.BacklogCountEstimate,
 	}
 }
 
@@ -241,7 +335,8 @@ func FromMatchingGetTaskListsByDomainRequest(t *types.GetTaskListsByDomainReques
 		return nil
 	}
 	return &matchingv1.GetTaskListsByDomainRequest{
-		Domain: t.Domain,
+		Domain:         t.Domain,
+		IsolationGroup: t.IsolationGroup,
 	}
 }
 
@@ -250,7 +345,8 @@ func ToMatchingGetTaskListsByDomainRequest(t *matchingv1.GetTaskListsByDomainReq
 		return nil
 	}
 	return &types.GetTaskListsByDomainRequest{
-		Domain: t.Domain,
+		Domain:         t.Domain,
+		IsolationGroup: t.IsolationGroup,
 	}
 }
 
@@ -276,6 +372,210 @@ func ToMatchingGetTaskListsByDomainResponse(t *matchingv1.GetTaskListsByDomainRe
 	}
 }
 
+func FromMatchingGetTaskListConfigRequest(t *types.MatchingGetTaskListConfigRequest) *matchingv1.GetTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.GetTaskListConfigRequest{
+		DomainId:     t.DomainUUID,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+func ToMatchingGetTaskListConfigRequest(t *matchingv1.GetTaskListConfigRequest) *types.MatchingGetTaskListConfigRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingGetTaskListConfigRequest{
+		DomainUUID:   t.DomainId,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+func FromMatchingGetTaskListConfigResponse(t *types.GetTaskListConfigResponse) *matchingv1.GetTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.GetTaskListConfigResponse{
+		NumReadPartitions:               t.NumReadPartitions,
+		NumWritePartitions:              t.NumWritePartitions,
+		EnableSyncMatch:                 t.EnableSyncMatch,
+		EnableTasklistIsolation:         t.EnableTasklistIsolation,
+		GetTasksBatchSize:               t.GetTasksBatchSize,
+		MaxTaskBatchSize:                t.MaxTaskBatchSize,
+		MaxTaskDeleteBatchSize:          t.MaxTaskDeleteBatchSize,
+		MinTaskThrottlingBurstSize:      t.MinTaskThrottlingBurstSize,
+		OutstandingTaskAppendsThreshold: t.OutstandingTaskAppendsThreshold,
+		ForwarderMaxOutstandingPolls:    t.ForwarderMaxOutstandingPolls,
+		ForwarderMaxOutstandingTasks:    t.ForwarderMaxOutstandingTasks,
+		ForwarderMaxRatePerSecond:       t.ForwarderMaxRatePerSecond,
+		ForwarderMaxChildrenPerNode:     t.ForwarderMaxChildrenPerNode,
+		UpdateAckInterval:               secondsToDuration(t.UpdateAckIntervalSeconds),
+		IdleTaskListCheckInterval:       secondsToDuration(t.IdleTaskListCheckIntervalSeconds),
+		MaxTaskListIdleTime:             secondsToDuration(t.MaxTaskListIdleTimeSeconds),
+		LongPollExpirationInterval:      secondsToDuration(t.LongPollExpirationSeconds),
+		AsyncTaskDispatchTimeout:        secondsToDuration(t.AsyncTaskDispatchTimeoutSeconds),
+	}
+}
+
+func ToMatchingGetTaskListConfigResponse(t *matchingv1.GetTaskListConfigResponse) *types.GetTaskListConfigResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.GetTaskListConfigResponse{
+		NumReadPartitions:                t.NumReadPartitions,
+		NumWritePartitions:               t.NumWritePartitions,
+		EnableSyncMatch:                  t.EnableSyncMatch,
+		EnableTasklistIsolation:          t.EnableTasklistIsolation,
+		GetTasksBatchSize:                t.GetTasksBatchSize,
+		MaxTaskBatchSize:                 t.MaxTaskBatchSize,
+		MaxTaskDeleteBatchSize:           t.MaxTaskDeleteBatchSize,
+		MinTaskThrottlingBurstSize:       t.MinTaskThrottlingBurstSize,
+		OutstandingTaskAppendsThreshold:  t.OutstandingTaskAppendsThreshold,
+		ForwarderMaxOutstandingPolls:     t.ForwarderMaxOutstandingPolls,
+		ForwarderMaxOutstandingTasks:     t.ForwarderMaxOutstandingTasks,
+		ForwarderMaxRatePerSecond:        t.ForwarderMaxRatePerSecond,
+		ForwarderMaxChildrenPerNode:      t.ForwarderMaxChildrenPerNode,
+		UpdateAckIntervalSeconds:         durationToSeconds(t.UpdateAckInterval),
+		IdleTaskListCheckIntervalSeconds: durationToSeconds(t.IdleTaskListCheckInterval),
+		MaxTaskListIdleTimeSeconds:       durationToSeconds(t.MaxTaskListIdleTime),
+		LongPollExpirationSeconds:        durationToSeconds(t.LongPollExpirationInterval),
+		AsyncTaskDispatchTimeoutSeconds:  durationToSeconds(t.AsyncTaskDispatchTimeout),
+	}
+}
+
+func FromMatchingResetTaskListAckLevelRequest(t *types.MatchingResetTaskListAckLevelRequest) *matchingv1.ResetTaskListAckLevelRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.ResetTaskListAckLevelRequest{
+		DomainId:     t.DomainUUID,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+		AckLevel:     t.AckLevel,
+	}
+}
+
+func ToMatchingResetTaskListAckLevelRequest(t *matchingv1.ResetTaskListAckLevelRequest) *types.MatchingResetTaskListAckLevelRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingResetTaskListAckLevelRequest{
+		DomainUUID:   t.DomainId,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+		AckLevel:     t.AckLevel,
+	}
+}
+
+func FromMatchingRefreshTaskListRequest(t *types.MatchingRefreshTaskListRequest) *matchingv1.RefreshTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.RefreshTaskListRequest{
+		DomainId:     t.DomainUUID,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+func ToMatchingRefreshTaskListRequest(t *matchingv1.RefreshTaskListRequest) *types.MatchingRefreshTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingRefreshTaskListRequest{
+		DomainUUID:   t.DomainId,
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+func FromMatchingRefreshTaskListResponse(t *types.MatchingRefreshTaskListResponse) *matchingv1.RefreshTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.RefreshTaskListResponse{
+		NumReadPartitions:  t.NumReadPartitions,
+		NumWritePartitions: t.NumWritePartitions,
+	}
+}
+
+func ToMatchingRefreshTaskListResponse(t *matchingv1.RefreshTaskListResponse) *types.MatchingRefreshTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingRefreshTaskListResponse{
+		NumReadPartitions:  t.NumReadPartitions,
+		NumWritePartitions: t.NumWritePartitions,
+	}
+}
+
+func FromMatchingHealthDetailsResponse(t *types.MatchingHealthDetailsResponse) *matchingv1.HealthDetailsResponse {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.HealthDetailsResponse{
+		Ok:     t.Ok,
+		Checks: FromMatchingHealthCheckArray(t.Checks),
+	}
+}
+
+func ToMatchingHealthDetailsResponse(t *matchingv1.HealthDetailsResponse) *types.MatchingHealthDetailsResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingHealthDetailsResponse{
+		Ok:     t.Ok,
+		Checks: ToMatchingHealthCheckArray(t.Checks),
+	}
+}
+
+func FromMatchingHealthCheck(t *types.MatchingHealthCheck) *matchingv1.HealthCheck {
+	if t == nil {
+		return nil
+	}
+	return &matchingv1.HealthCheck{
+		Name:    t.Name,
+		Ok:      t.Ok,
+		Message: t.Message,
+	}
+}
+
+func ToMatchingHealthCheck(t *matchingv1.HealthCheck) *types.MatchingHealthCheck {
+	if t == nil {
+		return nil
+	}
+	return &types.MatchingHealthCheck{
+		Name:    t.Name,
+		Ok:      t.Ok,
+		Message: t.Message,
+	}
+}
+
+func FromMatchingHealthCheckArray(t []*types.MatchingHealthCheck) []*matchingv1.HealthCheck {
+	if t == nil {
+		return nil
+	}
+	v := make([]*matchingv1.HealthCheck, len(t))
+	for i := range t {
+		v[i] = FromMatchingHealthCheck(t[i])
+	}
+	return v
+}
+
+func ToMatchingHealthCheckArray(t []*matchingv1.HealthCheck) []*types.MatchingHealthCheck {
+	if t == nil {
+		return nil
+	}
+	v := make([]*types.MatchingHealthCheck, len(t))
+	for i := range t {
+		v[i] = ToMatchingHealthCheck(t[i])
+	}
+	return v
+}
+
 func FromMatchingDescribeTaskListResponseMap(t map[string]*types.DescribeTaskListResponse) map[string]*matchingv1.DescribeTaskListResponse {
 	if t == nil {
 		return nil
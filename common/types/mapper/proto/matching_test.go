@@ -41,12 +41,36 @@ func TestMatchingAddDecisionTaskRequest(t *testing.T) {
 	}
 }
 
+func TestMatchingAddDecisionTaskResponse(t *testing.T) {
+	for _, item := range []*types.AddDecisionTaskResponse{nil, {}, &testdata.MatchingAddDecisionTaskResponse} {
+		assert.Equal(t, item, ToMatchingAddDecisionTaskResponse(FromMatchingAddDecisionTaskResponse(item)))
+	}
+}
+
 func TestMatchingCancelOutstandingPollRequest(t *testing.T) {
 	for _, item := range []*types.CancelOutstandingPollRequest{nil, {}, &testdata.MatchingCancelOutstandingPollRequest} {
 		assert.Equal(t, item, ToMatchingCancelOutstandingPollRequest(FromMatchingCancelOutstandingPollRequest(item)))
 	}
 }
 
+func TestMatchingDescribePollerRequest(t *testing.T) {
+	for _, item := range []*types.MatchingDescribePollerRequest{nil, {}, &testdata.MatchingDescribePollerRequest} {
+		assert.Equal(t, item, ToMatchingDescribePollerRequest(FromMatchingDescribePollerRequest(item)))
+	}
+}
+
+func TestMatchingDescribePollerResponse(t *testing.T) {
+	for _, item := range []*types.MatchingDescribePollerResponse{nil, {}, &testdata.MatchingDescribePollerResponse} {
+		assert.Equal(t, item, ToMatchingDescribePollerResponse(FromMatchingDescribePollerResponse(item)))
+	}
+}
+
+func TestMatchingCancelOutstandingPollResponse(t *testing.T) {
+	for _, item := range []*types.CancelOutstandingPollResponse{nil, {}, &testdata.MatchingCancelOutstandingPollResponse} {
+		assert.Equal(t, item, ToMatchingCancelOutstandingPollResponse(FromMatchingCancelOutstandingPollResponse(item)))
+	}
+}
+
 func TestMatchingDescribeTaskListRequest(t *testing.T) {
 	for _, item := range []*types.MatchingDescribeTaskListRequest{nil, {}, &testdata.MatchingDescribeTaskListRequest} {
 		assert.Equal(t, item, ToMatchingDescribeTaskListRequest(FromMatchingDescribeTaskListRequest(item)))
@@ -77,6 +101,42 @@ func TestMatchingListTaskListPartitionsResponse(t *testing.T) {
 	}
 }
 
+func TestMatchingGetTaskListConfigRequest(t *testing.T) {
+	for _, item := range []*types.MatchingGetTaskListConfigRequest{nil, {}, &testdata.MatchingGetTaskListConfigRequest} {
+		assert.Equal(t, item, ToMatchingGetTaskListConfigRequest(FromMatchingGetTaskListConfigRequest(item)))
+	}
+}
+
+func TestMatchingGetTaskListConfigResponse(t *testing.T) {
+	for _, item := range []*types.GetTaskListConfigResponse{nil, {}, &testdata.MatchingGetTaskListConfigResponse} {
+		assert.Equal(t, item, ToMatchingGetTaskListConfigResponse(FromMatchingGetTaskListConfigResponse(item)))
+	}
+}
+
+func TestMatchingRefreshTaskListRequest(t *testing.T) {
+	for _, item := range []*types.MatchingRefreshTaskListRequest{nil, {}, &testdata.MatchingRefreshTaskListRequest} {
+		assert.Equal(t, item, ToMatchingRefreshTaskListRequest(FromMatchingRefreshTaskListRequest(item)))
+	}
+}
+
+func TestMatchingRefreshTaskListResponse(t *testing.T) {
+	for _, item := range []*types.MatchingRefreshTaskListResponse{nil, {}, &testdata.MatchingRefreshTaskListResponse} {
+		assert.Equal(t, item, ToMatchingRefreshTaskListResponse(FromMatchingRefreshTaskListResponse(item)))
+	}
+}
+
+func TestMatchingHealthDetailsResponse(t *testing.T) {
+	for _, item := range []*types.MatchingHealthDetailsResponse{nil, {}, &testdata.MatchingHealthDetailsResponse} {
+		assert.Equal(t, item, ToMatchingHealthDetailsResponse(FromMatchingHealthDetailsResponse(item)))
+	}
+}
+
+func TestMatchingResetTaskListAckLevelRequest(t *testing.T) {
+	for _, item := range []*types.MatchingResetTaskListAckLevelRequest{nil, {}, &testdata.MatchingResetTaskListAckLevelRequest} {
+		assert.Equal(t, item, ToMatchingResetTaskListAckLevelRequest(FromMatchingResetTaskListAckLevelRequest(item)))
+	}
+}
+
 func TestMatchingPollForActivityTaskRequest(t *testing.T) {
 	for _, item := range []*types.MatchingPollForActivityTaskRequest{nil, {}, &testdata.MatchingPollForActivityTaskRequest} {
 		assert.Equal(t, item, ToMatchingPollForActivityTaskRequest(FromMatchingPollForActivityTaskRequest(item)))
@@ -120,7 +180,7 @@ func TestMatchingRespondQueryTaskCompletedRequest(t *testing.T) {
 }
 
 func TestMatchingGetTaskListsByDomainRequest(t *testing.T) {
-	for _, item := range []*types.GetTaskListsByDomainRequest{nil, {}, &testdata.MatchingGetTaskListsByDomainRequest} {
+	for _, item := range []*types.GetTaskListsByDomainRequest{nil, {}, &testdata.MatchingGetTaskListsByDomainRequest, {Domain: testdata.DomainName, IsolationGroup: testdata.IsolationGroup}} {
 		assert.Equal(t, item, ToMatchingGetTaskListsByDomainRequest(FromMatchingGetTaskListsByDomainRequest(item)))
 	}
 }
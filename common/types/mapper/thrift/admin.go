@@ -893,3 +893,75 @@ func ToIsolationGroupConfig(in *shared.IsolationGroupConfiguration) *types.Isola
 
 func strPtr(s string) *string                                             { return &s }
 func igStatePtr(s shared.IsolationGroupState) *shared.IsolationGroupState { return &s }
+
+// FromAdminRefreshTaskListRequest converts internal RefreshTaskListRequest type to thrift
+func FromAdminRefreshTaskListRequest(t *types.RefreshTaskListRequest) *admin.RefreshTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.RefreshTaskListRequest{
+		Domain:       &t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+	}
+}
+
+// ToAdminRefreshTaskListRequest converts thrift RefreshTaskListRequest type to internal
+func ToAdminRefreshTaskListRequest(t *admin.RefreshTaskListRequest) *types.RefreshTaskListRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.RefreshTaskListRequest{
+		Domain:       t.GetDomain(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+	}
+}
+
+// FromAdminRefreshTaskListResponse converts internal RefreshTaskListResponse type to thrift
+func FromAdminRefreshTaskListResponse(t *types.RefreshTaskListResponse) *admin.RefreshTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &admin.RefreshTaskListResponse{
+		NumReadPartitions:  &t.NumReadPartitions,
+		NumWritePartitions: &t.NumWritePartitions,
+	}
+}
+
+// ToAdminRefreshTaskListResponse converts thrift RefreshTaskListResponse type to internal
+func ToAdminRefreshTaskListResponse(t *admin.RefreshTaskListResponse) *types.RefreshTaskListResponse {
+	if t == nil {
+		return nil
+	}
+	return &types.RefreshTaskListResponse{
+		NumReadPartitions:  t.GetNumReadPartitions(),
+		NumWritePartitions: t.GetNumWritePartitions(),
+	}
+}
+
+// FromAdminResetTaskListAckLevelRequest converts internal ResetTaskListAckLevelRequest type to thrift
+func FromAdminResetTaskListAckLevelRequest(t *types.ResetTaskListAckLevelRequest) *admin.ResetTaskListAckLevelRequest {
+	if t == nil {
+		return nil
+	}
+	return &admin.ResetTaskListAckLevelRequest{
+		Domain:       &t.Domain,
+		TaskList:     FromTaskList(t.TaskList),
+		TaskListType: FromTaskListType(t.TaskListType),
+		AckLevel:     &t.AckLevel,
+	}
+}
+
+// ToAdminResetTaskListAckLevelRequest converts thrift ResetTaskListAckLevelRequest type to internal
+func ToAdminResetTaskListAckLevelRequest(t *admin.ResetTaskListAckLevelRequest) *types.ResetTaskListAckLevelRequest {
+	if t == nil {
+		return nil
+	}
+	return &types.ResetTaskListAckLevelRequest{
+		Domain:       t.GetDomain(),
+		TaskList:     ToTaskList(t.TaskList),
+		TaskListType: ToTaskListType(t.TaskListType),
+		AckLevel:     t.GetAckLevel(),
+	}
+}
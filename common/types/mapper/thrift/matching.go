@@ -42,6 +42,7 @@ func FromAddActivityTaskRequest(t *types.AddActivityTaskRequest) *matching.AddAc
 		ForwardedFrom:                 &t.ForwardedFrom,
 		ActivityTaskDispatchInfo:      FromActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      &t.Priority,
 	}
 }
 
@@ -61,6 +62,7 @@ func ToAddActivityTaskRequest(t *matching.AddActivityTaskRequest) *types.AddActi
 		ForwardedFrom:                 t.GetForwardedFrom(),
 		ActivityTaskDispatchInfo:      ToActivityTaskDispatchInfo(t.ActivityTaskDispatchInfo),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.GetPriority(),
 	}
 }
 
@@ -109,6 +111,8 @@ func FromAddDecisionTaskRequest(t *types.AddDecisionTaskRequest) *matching.AddDe
 		Source:                        FromTaskSource(t.Source),
 		ForwardedFrom:                 &t.ForwardedFrom,
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      &t.Priority,
+		ForwardingDepth:               &t.ForwardingDepth,
 	}
 }
 
@@ -126,6 +130,8 @@ func ToAddDecisionTaskRequest(t *matching.AddDecisionTaskRequest) *types.AddDeci
 		Source:                        ToTaskSource(t.Source),
 		ForwardedFrom:                 t.GetForwardedFrom(),
 		PartitionConfig:               t.PartitionConfig,
+		Priority:                      t.GetPriority(),
+		ForwardingDepth:               t.GetForwardingDepth(),
 	}
 }
 
@@ -21,7 +21,10 @@
 package thrift
 
 import (
+	"time"
+
 	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -4844,8 +4847,13 @@ func FromServiceBusyError(t *types.ServiceBusyError) *shared.ServiceBusyError {
 	if t == nil {
 		return nil
 	}
+	var retryAfter *int64
+	if t.RetryAfter > 0 {
+		retryAfter = common.Int64Ptr(t.RetryAfter.Milliseconds())
+	}
 	return &shared.ServiceBusyError{
-		Message: t.Message,
+		Message:                  t.Message,
+		RetryAfterInMilliseconds: retryAfter,
 	}
 }
 
@@ -4855,7 +4863,8 @@ func ToServiceBusyError(t *shared.ServiceBusyError) *types.ServiceBusyError {
 		return nil
 	}
 	return &types.ServiceBusyError{
-		Message: t.Message,
+		Message:    t.Message,
+		RetryAfter: time.Duration(t.GetRetryAfterInMilliseconds()) * time.Millisecond,
 	}
 }
 
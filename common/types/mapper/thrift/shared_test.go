@@ -37,3 +37,9 @@ func TestWorkflowExecutionInfo(t *testing.T) {
 		assert.Equal(t, item, thrift.ToWorkflowExecutionInfo(thrift.FromWorkflowExecutionInfo(item)))
 	}
 }
+
+func TestServiceBusyError(t *testing.T) {
+	for _, item := range []*types.ServiceBusyError{nil, {}, &testdata.ServiceBusyError} {
+		assert.Equal(t, item, thrift.ToServiceBusyError(thrift.FromServiceBusyError(item)))
+	}
+}
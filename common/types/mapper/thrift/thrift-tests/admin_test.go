@@ -0,0 +1,49 @@
+// Copyright (c) 2021 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package thrifttests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/uber/cadence/common/types"
+	"github.com/uber/cadence/common/types/mapper/thrift"
+	"github.com/uber/cadence/common/types/testdata"
+)
+
+func TestAdminRefreshTaskListRequest(t *testing.T) {
+	for _, item := range []*types.RefreshTaskListRequest{nil, {}, &testdata.AdminRefreshTaskListRequest} {
+		assert.Equal(t, item, thrift.ToAdminRefreshTaskListRequest(thrift.FromAdminRefreshTaskListRequest(item)))
+	}
+}
+
+func TestAdminRefreshTaskListResponse(t *testing.T) {
+	for _, item := range []*types.RefreshTaskListResponse{nil, {}, &testdata.AdminRefreshTaskListResponse} {
+		assert.Equal(t, item, thrift.ToAdminRefreshTaskListResponse(thrift.FromAdminRefreshTaskListResponse(item)))
+	}
+}
+
+func TestAdminResetTaskListAckLevelRequest(t *testing.T) {
+	for _, item := range []*types.ResetTaskListAckLevelRequest{nil, {}, &testdata.AdminResetTaskListAckLevelRequest} {
+		assert.Equal(t, item, thrift.ToAdminResetTaskListAckLevelRequest(thrift.FromAdminResetTaskListAckLevelRequest(item)))
+	}
+}
@@ -38,6 +38,7 @@ type AddActivityTaskRequest struct {
 	ForwardedFrom                 string                    `json:"forwardedFrom,omitempty"`
 	ActivityTaskDispatchInfo      *ActivityTaskDispatchInfo `json:"activityTaskDispatchInfo,omitempty"`
 	PartitionConfig               map[string]string
+	Priority                      int32 `json:"priority,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -112,6 +113,14 @@ func (v *AddActivityTaskRequest) GetPartitionConfig() (o map[string]string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *AddActivityTaskRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
 // ActivityTaskDispatchInfo is an internal type (TBD...)
 type ActivityTaskDispatchInfo struct {
 	ScheduledEvent                  *HistoryEvent `json:"scheduledEvent,omitempty"`
@@ -133,6 +142,8 @@ type AddDecisionTaskRequest struct {
 	Source                        *TaskSource        `json:"source,omitempty"`
 	ForwardedFrom                 string             `json:"forwardedFrom,omitempty"`
 	PartitionConfig               map[string]string
+	Priority                      int32 `json:"priority,omitempty"`
+	ForwardingDepth               int32 `json:"forwardingDepth,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -199,12 +210,42 @@ func (v *AddDecisionTaskRequest) GetPartitionConfig() (o map[string]string) {
 	return
 }
 
+// GetPriority is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetPriority() (o int32) {
+	if v != nil {
+		return v.Priority
+	}
+	return
+}
+
+// GetForwardingDepth is an internal getter (TBD...)
+func (v *AddDecisionTaskRequest) GetForwardingDepth() (o int32) {
+	if v != nil {
+		return v.ForwardingDepth
+	}
+	return
+}
+
+// AddDecisionTaskResponse is an internal type (TBD...)
+type AddDecisionTaskResponse struct {
+	ForwardingDepth int32 `json:"forwardingDepth,omitempty"`
+}
+
+// GetForwardingDepth is an internal getter (TBD...)
+func (v *AddDecisionTaskResponse) GetForwardingDepth() (o int32) {
+	if v != nil {
+		return v.ForwardingDepth
+	}
+	return
+}
+
 // CancelOutstandingPollRequest is an internal type (TBD...)
 type CancelOutstandingPollRequest struct {
 	DomainUUID   string    `json:"domainUUID,omitempty"`
 	TaskListType *int32    `json:"taskListType,omitempty"`
 	TaskList     *TaskList `json:"taskList,omitempty"`
 	PollerID     string    `json:"pollerID,omitempty"`
+	CancelAll    bool      `json:"cancelAll,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -239,10 +280,103 @@ func (v *CancelOutstandingPollRequest) GetPollerID() (o string) {
 	return
 }
 
+// GetCancelAll is an internal getter (TBD...)
+func (v *CancelOutstandingPollRequest) GetCancelAll() (o bool) {
+	if v != nil {
+		return v.CancelAll
+	}
+	return
+}
+
+// CancelOutstandingPollResponse is an internal type (TBD...)
+type CancelOutstandingPollResponse struct {
+	NumCancelledPolls int32 `json:"numCancelledPolls,omitempty"`
+}
+
+// GetNumCancelledPolls is an internal getter (TBD...)
+func (v *CancelOutstandingPollResponse) GetNumCancelledPolls() (o int32) {
+	if v != nil {
+		return v.NumCancelledPolls
+	}
+	return
+}
+
+// MatchingDescribePollerRequest is an internal type (TBD...)
+type MatchingDescribePollerRequest struct {
+	DomainUUID   string        `json:"domainUUID,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+	Identity     string        `json:"identity,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingDescribePollerRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *MatchingDescribePollerRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *MatchingDescribePollerRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetIdentity is an internal getter (TBD...)
+func (v *MatchingDescribePollerRequest) GetIdentity() (o string) {
+	if v != nil {
+		return v.Identity
+	}
+	return
+}
+
+// MatchingDescribePollerResponse is an internal type (TBD...)
+type MatchingDescribePollerResponse struct {
+	Poller               *PollerInfo `json:"poller,omitempty"`
+	IsolationGroup       string      `json:"isolationGroup,omitempty"`
+	OutstandingPollerIDs []string    `json:"outstandingPollerIDs,omitempty"`
+}
+
+// GetPoller is an internal getter (TBD...)
+func (v *MatchingDescribePollerResponse) GetPoller() (o *PollerInfo) {
+	if v != nil && v.Poller != nil {
+		return v.Poller
+	}
+	return
+}
+
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *MatchingDescribePollerResponse) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
+// GetOutstandingPollerIDs is an internal getter (TBD...)
+func (v *MatchingDescribePollerResponse) GetOutstandingPollerIDs() (o []string) {
+	if v != nil && v.OutstandingPollerIDs != nil {
+		return v.OutstandingPollerIDs
+	}
+	return
+}
+
 // MatchingDescribeTaskListRequest is an internal type (TBD...)
 type MatchingDescribeTaskListRequest struct {
-	DomainUUID  string                   `json:"domainUUID,omitempty"`
-	DescRequest *DescribeTaskListRequest `json:"descRequest,omitempty"`
+	DomainUUID             string                   `json:"domainUUID,omitempty"`
+	DescRequest            *DescribeTaskListRequest `json:"descRequest,omitempty"`
+	IncludeBacklogEstimate bool                     `json:"includeBacklogEstimate,omitempty"`
 }
 
 // GetDomainUUID is an internal getter (TBD...)
@@ -261,6 +395,14 @@ func (v *MatchingDescribeTaskListRequest) GetDescRequest() (o *DescribeTaskListR
 	return
 }
 
+// GetIncludeBacklogEstimate is an internal getter (TBD...)
+func (v *MatchingDescribeTaskListRequest) GetIncludeBacklogEstimate() (o bool) {
+	if v != nil {
+		return v.IncludeBacklogEstimate
+	}
+	return
+}
+
 // MatchingListTaskListPartitionsRequest is an internal type (TBD...)
 type MatchingListTaskListPartitionsRequest struct {
 	Domain   string    `json:"domain,omitempty"`
@@ -296,6 +438,205 @@ func (v *MatchingGetTaskListsByDomainRequest) GetDomain() (o string) {
 	return
 }
 
+// MatchingGetTaskListConfigRequest is an internal type (TBD...)
+type MatchingGetTaskListConfigRequest struct {
+	DomainUUID   string        `json:"domainUUID,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingGetTaskListConfigRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *MatchingGetTaskListConfigRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *MatchingGetTaskListConfigRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// MatchingResetTaskListAckLevelRequest is an internal type (TBD...)
+type MatchingResetTaskListAckLevelRequest struct {
+	DomainUUID   string        `json:"domainUUID,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+	AckLevel     int64         `json:"ackLevel,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingResetTaskListAckLevelRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *MatchingResetTaskListAckLevelRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *MatchingResetTaskListAckLevelRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// GetAckLevel is an internal getter (TBD...)
+func (v *MatchingResetTaskListAckLevelRequest) GetAckLevel() (o int64) {
+	if v != nil {
+		return v.AckLevel
+	}
+	return
+}
+
+// MatchingRefreshTaskListRequest is an internal type (TBD...)
+type MatchingRefreshTaskListRequest struct {
+	DomainUUID   string        `json:"domainUUID,omitempty"`
+	TaskList     *TaskList     `json:"taskList,omitempty"`
+	TaskListType *TaskListType `json:"taskListType,omitempty"`
+}
+
+// GetDomainUUID is an internal getter (TBD...)
+func (v *MatchingRefreshTaskListRequest) GetDomainUUID() (o string) {
+	if v != nil {
+		return v.DomainUUID
+	}
+	return
+}
+
+// GetTaskList is an internal getter (TBD...)
+func (v *MatchingRefreshTaskListRequest) GetTaskList() (o *TaskList) {
+	if v != nil && v.TaskList != nil {
+		return v.TaskList
+	}
+	return
+}
+
+// GetTaskListType is an internal getter (TBD...)
+func (v *MatchingRefreshTaskListRequest) GetTaskListType() (o TaskListType) {
+	if v != nil && v.TaskListType != nil {
+		return *v.TaskListType
+	}
+	return
+}
+
+// MatchingRefreshTaskListResponse is an internal type (TBD...)
+type MatchingRefreshTaskListResponse struct {
+	NumReadPartitions  int32 `json:"numReadPartitions,omitempty"`
+	NumWritePartitions int32 `json:"numWritePartitions,omitempty"`
+}
+
+// GetNumReadPartitions is an internal getter (TBD...)
+func (v *MatchingRefreshTaskListResponse) GetNumReadPartitions() (o int32) {
+	if v != nil {
+		return v.NumReadPartitions
+	}
+	return
+}
+
+// GetNumWritePartitions is an internal getter (TBD...)
+func (v *MatchingRefreshTaskListResponse) GetNumWritePartitions() (o int32) {
+	if v != nil {
+		return v.NumWritePartitions
+	}
+	return
+}
+
+// MatchingHealthDetailsResponse is an internal type (TBD...)
+type MatchingHealthDetailsResponse struct {
+	Ok     bool                   `json:"ok,omitempty"`
+	Checks []*MatchingHealthCheck `json:"checks,omitempty"`
+}
+
+// GetOk is an internal getter (TBD...)
+func (v *MatchingHealthDetailsResponse) GetOk() (o bool) {
+	if v != nil {
+		return v.Ok
+	}
+	return
+}
+
+// GetChecks is an internal getter (TBD...)
+func (v *MatchingHealthDetailsResponse) GetChecks() (o []*MatchingHealthCheck) {
+	if v != nil && v.Checks != nil {
+		return v.Checks
+	}
+	return
+}
+
+// MatchingHealthCheck is an internal type (TBD...)
+type MatchingHealthCheck struct {
+	Name    string `json:"name,omitempty"`
+	Ok      bool   `json:"ok,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// GetName is an internal getter (TBD...)
+func (v *MatchingHealthCheck) GetName() (o string) {
+	if v != nil {
+		return v.Name
+	}
+	return
+}
+
+// GetOk is an internal getter (TBD...)
+func (v *MatchingHealthCheck) GetOk() (o bool) {
+	if v != nil {
+		return v.Ok
+	}
+	return
+}
+
+// GetMessage is an internal getter (TBD...)
+func (v *MatchingHealthCheck) GetMessage() (o string) {
+	if v != nil {
+		return v.Message
+	}
+	return
+}
+
+// GetTaskListConfigResponse is an internal type (TBD...)
+type GetTaskListConfigResponse struct {
+	NumReadPartitions                int32  `json:"numReadPartitions,omitempty"`
+	NumWritePartitions               int32  `json:"numWritePartitions,omitempty"`
+	EnableSyncMatch                  bool   `json:"enableSyncMatch,omitempty"`
+	EnableTasklistIsolation          bool   `json:"enableTasklistIsolation,omitempty"`
+	GetTasksBatchSize                int32  `json:"getTasksBatchSize,omitempty"`
+	MaxTaskBatchSize                 int32  `json:"maxTaskBatchSize,omitempty"`
+	MaxTaskDeleteBatchSize           int32  `json:"maxTaskDeleteBatchSize,omitempty"`
+	MinTaskThrottlingBurstSize       int32  `json:"minTaskThrottlingBurstSize,omitempty"`
+	OutstandingTaskAppendsThreshold  int32  `json:"outstandingTaskAppendsThreshold,omitempty"`
+	ForwarderMaxOutstandingPolls     int32  `json:"forwarderMaxOutstandingPolls,omitempty"`
+	ForwarderMaxOutstandingTasks     int32  `json:"forwarderMaxOutstandingTasks,omitempty"`
+	ForwarderMaxRatePerSecond        int32  `json:"forwarderMaxRatePerSecond,omitempty"`
+	ForwarderMaxChildrenPerNode      int32  `json:"forwarderMaxChildrenPerNode,omitempty"`
+	UpdateAckIntervalSeconds         *int32 `json:"updateAckIntervalSeconds,omitempty"`
+	IdleTaskListCheckIntervalSeconds *int32 `json:"idleTaskListCheckIntervalSeconds,omitempty"`
+	MaxTaskListIdleTimeSeconds       *int32 `json:"maxTaskListIdleTimeSeconds,omitempty"`
+	LongPollExpirationSeconds        *int32 `json:"longPollExpirationSeconds,omitempty"`
+	AsyncTaskDispatchTimeoutSeconds  *int32 `json:"asyncTaskDispatchTimeoutSeconds,omitempty"`
+}
+
 // MatchingPollForActivityTaskRequest is an internal type (TBD...)
 type MatchingPollForActivityTaskRequest struct {
 	DomainUUID     string                      `json:"domainUUID,omitempty"`
@@ -1708,6 +1708,8 @@ func (v *DescribeTaskListRequest) GetIncludeTaskListStatus() (o bool) {
 type DescribeTaskListResponse struct {
 	Pollers        []*PollerInfo   `json:"pollers,omitempty"`
 	TaskListStatus *TaskListStatus `json:"taskListStatus,omitempty"`
+	// BacklogCountEstimate is only set by matching when the backlog estimate is requested
+	BacklogCountEstimate int64 `json:"backlogCountEstimate,omitempty"`
 }
 
 // GetPollers is an internal getter (TBD...)
@@ -1726,6 +1728,14 @@ func (v *DescribeTaskListResponse) GetTaskListStatus() (o *TaskListStatus) {
 	return
 }
 
+// GetBacklogCountEstimate is an internal getter (TBD...)
+func (v *DescribeTaskListResponse) GetBacklogCountEstimate() (o int64) {
+	if v != nil {
+		return v.BacklogCountEstimate
+	}
+	return
+}
+
 // DescribeWorkflowExecutionRequest is an internal type (TBD...)
 type DescribeWorkflowExecutionRequest struct {
 	Domain    string             `json:"domain,omitempty"`
@@ -3461,6 +3471,8 @@ type ListTaskListPartitionsResponse struct {
 // GetTaskListsByDomainRequest is an internal type (TBD...)
 type GetTaskListsByDomainRequest struct {
 	Domain string `json:"domain,omitempty"`
+	// IsolationGroup filters the task lists to the ones with pollers in the isolation group, if not empty
+	IsolationGroup string `json:"isolationGroup,omitempty"`
 }
 
 func (v *GetTaskListsByDomainRequest) SerializeForLogging() (string, error) {
@@ -3478,6 +3490,14 @@ func (v *GetTaskListsByDomainRequest) GetDomain() (o string) {
 	return
 }
 
+// GetIsolationGroup is an internal getter (TBD...)
+func (v *GetTaskListsByDomainRequest) GetIsolationGroup() (o string) {
+	if v != nil {
+		return v.IsolationGroup
+	}
+	return
+}
+
 // GetTaskListsByDomainResponse is an internal type (TBD...)
 type GetTaskListsByDomainResponse struct {
 	DecisionTaskListMap map[string]*DescribeTaskListResponse `json:"decisionTaskListMap,omitempty"`
@@ -5645,6 +5665,8 @@ func (v *SearchAttributes) GetIndexedFields() (o map[string][]byte) {
 // ServiceBusyError is an internal type (TBD...)
 type ServiceBusyError struct {
 	Message string `json:"message,required"`
+	// RetryAfter is the backoff suggested to the caller, zero if the server has no suggestion
+	RetryAfter time.Duration `json:"retryAfter,omitempty"`
 }
 
 // SignalExternalWorkflowExecutionDecisionAttributes is an internal type (TBD...)
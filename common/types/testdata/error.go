@@ -21,6 +21,8 @@
 package testdata
 
 import (
+	"time"
+
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/types"
 )
@@ -94,7 +96,8 @@ var (
 		EndEventVersion:   common.Int64Ptr(Version2),
 	}
 	ServiceBusyError = types.ServiceBusyError{
-		Message: ErrorMessage,
+		Message:    ErrorMessage,
+		RetryAfter: time.Second,
 	}
 	ShardOwnershipLostError = types.ShardOwnershipLostError{
 		Message: ErrorMessage,
@@ -178,6 +178,21 @@ var (
 		ClusterName: ClusterName1,
 		Type:        common.Int32Ptr(QueueType),
 	}
+	AdminRefreshTaskListRequest = types.RefreshTaskListRequest{
+		Domain:       DomainName,
+		TaskList:     &TaskList,
+		TaskListType: types.TaskListTypeDecision.Ptr(),
+	}
+	AdminRefreshTaskListResponse = types.RefreshTaskListResponse{
+		NumReadPartitions:  2,
+		NumWritePartitions: 3,
+	}
+	AdminResetTaskListAckLevelRequest = types.ResetTaskListAckLevelRequest{
+		Domain:       DomainName,
+		TaskList:     &TaskList,
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+		AckLevel:     TaskID,
+	}
 	AdminGetCrossClusterTasksRequest               = GetCrossClusterTasksRequest
 	AdminGetCrossClusterTasksResponse              = GetCrossClusterTasksResponse
 	AdminRespondCrossClusterTasksCompletedRequest  = RespondCrossClusterTasksCompletedRequest
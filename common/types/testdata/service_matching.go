@@ -41,6 +41,7 @@ var (
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
 		PartitionConfig:               PartitionConfig,
+		Priority:                      3,
 	}
 	MatchingAddDecisionTaskRequest = types.AddDecisionTaskRequest{
 		DomainUUID:                    DomainID,
@@ -51,20 +52,42 @@ var (
 		Source:                        types.TaskSourceDbBacklog.Ptr(),
 		ForwardedFrom:                 ForwardedFrom,
 		PartitionConfig:               PartitionConfig,
+		Priority:                      3,
+		ForwardingDepth:               2,
+	}
+	MatchingAddDecisionTaskResponse = types.AddDecisionTaskResponse{
+		ForwardingDepth: 2,
 	}
 	MatchingCancelOutstandingPollRequest = types.CancelOutstandingPollRequest{
 		DomainUUID:   DomainID,
 		TaskListType: common.Int32Ptr(int32(TaskListType)),
 		TaskList:     &TaskList,
 		PollerID:     PollerID,
+		CancelAll:    true,
+	}
+	MatchingCancelOutstandingPollResponse = types.CancelOutstandingPollResponse{
+		NumCancelledPolls: 3,
+	}
+	MatchingDescribePollerRequest = types.MatchingDescribePollerRequest{
+		DomainUUID:   DomainID,
+		TaskList:     &TaskList,
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+		Identity:     Identity,
+	}
+	MatchingDescribePollerResponse = types.MatchingDescribePollerResponse{
+		Poller:               &PollerInfo,
+		IsolationGroup:       IsolationGroup,
+		OutstandingPollerIDs: []string{PollerID},
 	}
 	MatchingDescribeTaskListRequest = types.MatchingDescribeTaskListRequest{
-		DomainUUID:  DomainID,
-		DescRequest: &DescribeTaskListRequest,
+		DomainUUID:             DomainID,
+		DescRequest:            &DescribeTaskListRequest,
+		IncludeBacklogEstimate: true,
 	}
 	MatchingDescribeTaskListResponse = types.DescribeTaskListResponse{
-		Pollers:        PollerInfoArray,
-		TaskListStatus: &TaskListStatus,
+		Pollers:              PollerInfoArray,
+		TaskListStatus:       &TaskListStatus,
+		BacklogCountEstimate: 100,
 	}
 	MatchingListTaskListPartitionsRequest = types.MatchingListTaskListPartitionsRequest{
 		Domain:   DomainName,
@@ -74,6 +97,53 @@ var (
 		ActivityTaskListPartitions: TaskListPartitionMetadataArray,
 		DecisionTaskListPartitions: TaskListPartitionMetadataArray,
 	}
+	MatchingGetTaskListConfigRequest = types.MatchingGetTaskListConfigRequest{
+		DomainUUID:   DomainID,
+		TaskList:     &TaskList,
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	}
+	MatchingGetTaskListConfigResponse = types.GetTaskListConfigResponse{
+		NumReadPartitions:                2,
+		NumWritePartitions:               3,
+		EnableSyncMatch:                  true,
+		EnableTasklistIsolation:          true,
+		GetTasksBatchSize:                1000,
+		MaxTaskBatchSize:                 100,
+		MaxTaskDeleteBatchSize:           200,
+		MinTaskThrottlingBurstSize:       1,
+		OutstandingTaskAppendsThreshold:  250,
+		ForwarderMaxOutstandingPolls:     1,
+		ForwarderMaxOutstandingTasks:     2,
+		ForwarderMaxRatePerSecond:        10,
+		ForwarderMaxChildrenPerNode:      20,
+		UpdateAckIntervalSeconds:         &Duration1,
+		IdleTaskListCheckIntervalSeconds: &Duration2,
+		MaxTaskListIdleTimeSeconds:       &Duration3,
+		LongPollExpirationSeconds:        &Duration1,
+		AsyncTaskDispatchTimeoutSeconds:  &Duration2,
+	}
+	MatchingResetTaskListAckLevelRequest = types.MatchingResetTaskListAckLevelRequest{
+		DomainUUID:   DomainID,
+		TaskList:     &TaskList,
+		TaskListType: types.TaskListTypeDecision.Ptr(),
+		AckLevel:     TaskID,
+	}
+	MatchingRefreshTaskListRequest = types.MatchingRefreshTaskListRequest{
+		DomainUUID:   DomainID,
+		TaskList:     &TaskList,
+		TaskListType: types.TaskListTypeDecision.Ptr(),
+	}
+	MatchingRefreshTaskListResponse = types.MatchingRefreshTaskListResponse{
+		NumReadPartitions:  2,
+		NumWritePartitions: 3,
+	}
+	MatchingHealthDetailsResponse = types.MatchingHealthDetailsResponse{
+		Ok: false,
+		Checks: []*types.MatchingHealthCheck{
+			{Name: "membership", Ok: true, Message: "host is in the membership ring"},
+			{Name: "persistence", Ok: false, Message: "persistence is unreachable"},
+		},
+	}
 	MatchingPollForActivityTaskRequest = types.MatchingPollForActivityTaskRequest{
 		DomainUUID:     DomainID,
 		PollerID:       PollerID,
@@ -150,5 +220,5 @@ var (
 		ActivityTaskListMap: DescribeTaskListResponseMap,
 	}
 
-	DescribeTaskListResponseMap = map[string]*types.DescribeTaskListResponse{DomainName: &MatchingDescribeTaskListResponse}
+	DescribeTaskListResponseMap = map[string]*types.DescribeTaskListResponse{DomainName: &DescribeTaskListResponse}
 )
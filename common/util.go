@@ -23,6 +23,7 @@ package common
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/rand"
@@ -37,6 +38,7 @@ import (
 	"go.uber.org/yarpc/yarpcerrors"
 
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -113,6 +115,15 @@ var (
 // Returns true if the Wait() call succeeded before the timeout
 // Returns false if the Wait() did not return before the timeout
 func AwaitWaitGroup(wg *sync.WaitGroup, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return AwaitWaitGroupCtx(ctx, wg)
+}
+
+// AwaitWaitGroupCtx calls Wait on the given wait
+// Returns true if the Wait() call succeeded before the context is done
+// Returns false if the context is cancelled or timed out before Wait() returned
+func AwaitWaitGroupCtx(ctx context.Context, wg *sync.WaitGroup) bool {
 
 	doneC := make(chan struct{})
 
@@ -124,7 +135,7 @@ func AwaitWaitGroup(wg *sync.WaitGroup, timeout time.Duration) bool {
 	select {
 	case <-doneC:
 		return true
-	case <-time.After(timeout):
+	case <-ctx.Done():
 		return false
 	}
 }
@@ -183,6 +194,15 @@ func CreateDlqPublishRetryPolicy() backoff.RetryPolicy {
 	return policy
 }
 
+// CreateKafkaPublishRetryPolicy creates a retry policy for publishing messages to kafka
+func CreateKafkaPublishRetryPolicy() backoff.RetryPolicy {
+	policy := backoff.NewExponentialRetryPolicy(retryKafkaOperationInitialInterval)
+	policy.SetMaximumInterval(retryKafkaOperationMaxInterval)
+	policy.SetMaximumAttempts(retryKafkaOperationMaxAttempts)
+
+	return policy
+}
+
 // CreateTaskProcessingRetryPolicy creates a retry policy for task processing
 func CreateTaskProcessingRetryPolicy() backoff.RetryPolicy {
 	policy := backoff.NewExponentialRetryPolicy(retryTaskProcessingInitialInterval)
@@ -201,8 +221,32 @@ func CreateReplicationServiceBusyRetryPolicy() backoff.RetryPolicy {
 	return policy
 }
 
-// IsValidIDLength checks if id is valid according to its length
-func IsValidIDLength(
+// IDLengthStatus is the outcome of checking an id length against its limits
+type IDLengthStatus int
+
+const (
+	// IDLengthOK means the id length is within the warn limit
+	IDLengthOK IDLengthStatus = iota
+	// IDLengthWarn means the id length exceeds the warn limit but not the error limit
+	IDLengthWarn
+	// IDLengthTooLong means the id length exceeds the error limit
+	IDLengthTooLong
+)
+
+// IDLengthResult is the result of CheckIDLength
+type IDLengthResult struct {
+	Status IDLengthStatus
+	Length int
+}
+
+// IsValid returns false if the id length exceeds the error limit
+func (r IDLengthResult) IsValid() bool {
+	return r.Status != IDLengthTooLong
+}
+
+// CheckIDLength checks id length against the warn and error limits.
+// When the warn limit is exceeded, metricsCounter is incremented and a warning is logged.
+func CheckIDLength(
 	id string,
 	scope metrics.Scope,
 	warnLimit int,
@@ -211,15 +255,34 @@ func IsValidIDLength(
 	domainName string,
 	logger log.Logger,
 	idTypeViolationTag tag.Tag,
-) bool {
-	if len(id) > warnLimit {
+) IDLengthResult {
+	result := IDLengthResult{Status: IDLengthOK, Length: len(id)}
+	if result.Length > warnLimit {
 		scope.IncCounter(metricsCounter)
 		logger.Warn("ID length exceeds limit.",
 			tag.WorkflowDomainName(domainName),
 			tag.Name(id),
 			idTypeViolationTag)
+		result.Status = IDLengthWarn
+	}
+	if result.Length > errorLimit {
+		result.Status = IDLengthTooLong
 	}
-	return len(id) <= errorLimit
+	return result
+}
+
+// IsValidIDLength checks if id is valid according to its length
+func IsValidIDLength(
+	id string,
+	scope metrics.Scope,
+	warnLimit int,
+	errorLimit int,
+	metricsCounter int,
+	domainName string,
+	logger log.Logger,
+	idTypeViolationTag tag.Tag,
+) bool {
+	return CheckIDLength(id, scope, warnLimit, errorLimit, metricsCounter, domainName, logger, idTypeViolationTag).IsValid()
 }
 
 // CheckDecisionResultLimit checks if decision result count exceeds limits.
@@ -240,7 +303,8 @@ func ToServiceTransientError(err error) error {
 	if err == nil || IsServiceTransientError(err) {
 		return err
 	}
-	return yarpcerrors.Newf(yarpcerrors.CodeUnavailable, err.Error())
+	// wrap the error so that it's still available to errors.As
+	return yarpcerrors.Newf(yarpcerrors.CodeUnavailable, "%w", err)
 }
 
 // IsServiceTransientError checks if the error is a transient error.
@@ -265,19 +329,78 @@ func IsServiceTransientError(err error) bool {
 	return false
 }
 
+// ServiceTransientBackoffHint returns the server suggested backoff of a transient error, if there's one.
+// Only ServiceBusyError carries such a hint.
+func ServiceTransientBackoffHint(err error) (time.Duration, bool) {
+	if busyErr, ok := AsCadenceError[*types.ServiceBusyError](err); ok && busyErr.RetryAfter > 0 {
+		return busyErr.RetryAfter, true
+	}
+	return 0, false
+}
+
+// RetryWithContext runs op until it succeeds, returns an error which is not retryable, the retry policy is
+// exhausted or ctx is done, and returns the last error of op. isRetryable defaults to IsServiceTransientError.
+// Errors converted by ToServiceTransientError are retried by default as they're YARPC unavailable errors,
+// they keep wrapping the original error so a custom isRetryable can still classify it with AsCadenceError.
+// When the error has a ServiceTransientBackoffHint longer than the policy's next delay, the hint is used instead.
+func RetryWithContext(
+	ctx context.Context,
+	policy backoff.RetryPolicy,
+	isRetryable backoff.IsRetryable,
+	op func(context.Context) error,
+) error {
+	if isRetryable == nil {
+		isRetryable = IsServiceTransientError
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	retrier := backoff.NewRetrier(policy, backoff.SystemClock)
+	for {
+		err := op(ctx)
+		if err == nil || !isRetryable(err) {
+			return err
+		}
+		next := retrier.NextBackOff()
+		if next < 0 {
+			return err
+		}
+		if hint, ok := ServiceTransientBackoffHint(err); ok && hint > next {
+			next = hint
+		}
+
+		timer := time.NewTimer(next)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
 // IsEntityNotExistsError checks if the error is an entity not exists error.
 func IsEntityNotExistsError(err error) bool {
-	_, ok := err.(*types.EntityNotExistsError)
+	_, ok := AsCadenceError[*types.EntityNotExistsError](err)
 	return ok
 }
 
 // IsServiceBusyError checks if the error is a service busy error.
 func IsServiceBusyError(err error) bool {
-	switch err.(type) {
-	case *types.ServiceBusyError:
-		return true
+	_, ok := AsCadenceError[*types.ServiceBusyError](err)
+	return ok
+}
+
+// AsCadenceError finds the first error of type T in the chain of err,
+// including errors wrapped by fmt.Errorf and YARPC errors such as the ones returned by ToServiceTransientError.
+func AsCadenceError[T error](err error) (T, bool) {
+	var target T
+	if err == nil {
+		return target, false
 	}
-	return false
+	ok := errors.As(err, &target)
+	return target, ok
 }
 
 // IsContextTimeoutError checks if the error is context timeout error
@@ -289,16 +412,74 @@ func IsContextTimeoutError(err error) bool {
 	return err == context.DeadlineExceeded || yarpcerrors.IsDeadlineExceeded(err)
 }
 
+type (
+	// ShardHasher maps an ID to one of numberOfShards shards
+	ShardHasher interface {
+		Shard(id string, numberOfShards int) int
+	}
+
+	fingerprintShardHasher struct{}
+
+	jumpShardHasher struct{}
+)
+
+// DefaultShardHasher returns the hasher used to map workflowIDs and domainIDs to history shards
+func DefaultShardHasher() ShardHasher {
+	return fingerprintShardHasher{}
+}
+
+// NewJumpShardHasher returns a hasher using jump consistent hash, when the number of shards grows
+// from n to n+1 only 1/(n+1) of the IDs are moved, all of them to the new shard
+func NewJumpShardHasher() ShardHasher {
+	return jumpShardHasher{}
+}
+
+func (fingerprintShardHasher) Shard(id string, numberOfShards int) int {
+	hash := farm.Fingerprint32([]byte(id))
+	return int(hash % uint32(numberOfShards))
+}
+
+// Shard implements "A Fast, Minimal Memory, Consistent Hash Algorithm" by Lamping and Veach
+func (jumpShardHasher) Shard(id string, numberOfShards int) int {
+	key := farm.Fingerprint64([]byte(id))
+	var b, j int64 = -1, 0
+	for j < int64(numberOfShards) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
+
 // WorkflowIDToHistoryShard is used to map a workflowID to a shardID
 func WorkflowIDToHistoryShard(workflowID string, numberOfShards int) int {
-	hash := farm.Fingerprint32([]byte(workflowID))
-	return int(hash % uint32(numberOfShards))
+	return WorkflowIDToHistoryShardWith(DefaultShardHasher(), workflowID, numberOfShards)
+}
+
+// WorkflowIDToHistoryShardWith maps a workflowID to a shardID with the given hasher
+func WorkflowIDToHistoryShardWith(hasher ShardHasher, workflowID string, numberOfShards int) int {
+	return hasher.Shard(workflowID, numberOfShards)
+}
+
+// GroupWorkflowIDsByShard groups workflowIDs by the shardID WorkflowIDToHistoryShard maps them to,
+// the workflowIDs of a shard keep their order in ids
+func GroupWorkflowIDsByShard(ids []string, numberOfShards int) map[int][]string {
+	return GroupWorkflowIDsByShardWith(DefaultShardHasher(), ids, numberOfShards)
+}
+
+// GroupWorkflowIDsByShardWith groups workflowIDs by the shardID they are mapped to with the given hasher
+func GroupWorkflowIDsByShardWith(hasher ShardHasher, ids []string, numberOfShards int) map[int][]string {
+	groups := make(map[int][]string)
+	for _, id := range ids {
+		shardID := WorkflowIDToHistoryShardWith(hasher, id, numberOfShards)
+		groups[shardID] = append(groups[shardID], id)
+	}
+	return groups
 }
 
 // DomainIDToHistoryShard is used to map a domainID to a shardID
 func DomainIDToHistoryShard(domainID string, numberOfShards int) int {
-	hash := farm.Fingerprint32([]byte(domainID))
-	return int(hash % uint32(numberOfShards))
+	return DefaultShardHasher().Shard(domainID, numberOfShards)
 }
 
 // PrettyPrintHistory prints history in human readable format
@@ -458,8 +639,19 @@ func SortInt64Slice(slice []int64) {
 	})
 }
 
+// RetryPolicyLimits are the upper bounds of a retry policy, zero means no limit
+type RetryPolicyLimits struct {
+	MaxExpirationIntervalInSeconds int32
+	MaxMaximumAttempts             int32
+}
+
 // ValidateRetryPolicy validates a retry policy
 func ValidateRetryPolicy(policy *types.RetryPolicy) error {
+	return ValidateRetryPolicyWithLimits(policy, RetryPolicyLimits{})
+}
+
+// ValidateRetryPolicyWithLimits validates a retry policy and that it doesn't exceed the limits
+func ValidateRetryPolicyWithLimits(policy *types.RetryPolicy, limits RetryPolicyLimits) error {
 	if policy == nil {
 		// nil policy is valid which means no retry
 		return nil
@@ -485,6 +677,12 @@ func ValidateRetryPolicy(policy *types.RetryPolicy) error {
 	if policy.GetMaximumAttempts() == 0 && policy.GetExpirationIntervalInSeconds() == 0 {
 		return &types.BadRequestError{Message: "MaximumAttempts and ExpirationIntervalInSeconds are both 0. At least one of them must be specified."}
 	}
+	if limits.MaxExpirationIntervalInSeconds > 0 && policy.GetExpirationIntervalInSeconds() > limits.MaxExpirationIntervalInSeconds {
+		return &types.BadRequestError{Message: fmt.Sprintf("ExpirationIntervalInSeconds cannot be greater than %v on retry policy.", limits.MaxExpirationIntervalInSeconds)}
+	}
+	if limits.MaxMaximumAttempts > 0 && policy.GetMaximumAttempts() > limits.MaxMaximumAttempts {
+		return &types.BadRequestError{Message: fmt.Sprintf("MaximumAttempts cannot be greater than %v on retry policy.", limits.MaxMaximumAttempts)}
+	}
 	return nil
 }
 
@@ -494,6 +692,19 @@ func CreateHistoryStartWorkflowRequest(
 	startRequest *types.StartWorkflowExecutionRequest,
 	now time.Time,
 	partitionConfig map[string]string,
+) (*types.HistoryStartWorkflowExecutionRequest, error) {
+	return CreateHistoryStartWorkflowRequestWithFirstRunAt(domainID, startRequest, now, partitionConfig, time.Time{})
+}
+
+// CreateHistoryStartWorkflowRequestWithFirstRunAt create a start workflow request for history, for a workflow
+// which first runs at firstRunAt instead of after the DelayStartSeconds of startRequest. firstRunAt is ignored
+// if it's zero, otherwise it can't be in the past or be set along with DelayStartSeconds.
+func CreateHistoryStartWorkflowRequestWithFirstRunAt(
+	domainID string,
+	startRequest *types.StartWorkflowExecutionRequest,
+	now time.Time,
+	partitionConfig map[string]string,
+	firstRunAt time.Time,
 ) (*types.HistoryStartWorkflowExecutionRequest, error) {
 	histRequest := &types.HistoryStartWorkflowExecutionRequest{
 		DomainUUID:      domainID,
@@ -501,7 +712,41 @@ func CreateHistoryStartWorkflowRequest(
 		PartitionConfig: partitionConfig,
 	}
 
+	firstDecisionTaskBackoffSeconds, err := getFirstDecisionTaskBackoffSeconds(startRequest, now, firstRunAt)
+	if err != nil {
+		return nil, err
+	}
+	histRequest.FirstDecisionTaskBackoffSeconds = Int32Ptr(firstDecisionTaskBackoffSeconds)
+	histRequest.ExpirationTimestamp = getWorkflowExpiration(startRequest, now, firstDecisionTaskBackoffSeconds)
+
+	return histRequest, nil
+}
+
+// ComputeWorkflowExpiration returns the expiration timestamp in nanoseconds for a workflow started
+// at the given time, or nil if the workflow retry policy has no expiration.
+// The expiration is measured from the first decision task schedule time, so it accounts for
+// cron schedule, delayed start and start jitter.
+func ComputeWorkflowExpiration(startRequest *types.StartWorkflowExecutionRequest, now time.Time) (*int64, error) {
+	firstDecisionTaskBackoffSeconds, err := getFirstDecisionTaskBackoffSeconds(startRequest, now, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	return getWorkflowExpiration(startRequest, now, firstDecisionTaskBackoffSeconds), nil
+}
+
+func getFirstDecisionTaskBackoffSeconds(startRequest *types.StartWorkflowExecutionRequest, now time.Time, firstRunAt time.Time) (int32, error) {
 	delayStartSeconds := startRequest.GetDelayStartSeconds()
+	if !firstRunAt.IsZero() {
+		if delayStartSeconds > 0 {
+			return 0, &types.BadRequestError{Message: "FirstRunAt and DelayStartSeconds cannot both be set."}
+		}
+		delay := firstRunAt.Sub(now)
+		if delay < 0 {
+			return 0, &types.BadRequestError{Message: "FirstRunAt cannot be in the past."}
+		}
+		// round up so that the workflow doesn't run before the requested time
+		delayStartSeconds = int32((delay + time.Second - 1) / time.Second)
+	}
 	jitterStartSeconds := startRequest.GetJitterStartSeconds()
 	firstDecisionTaskBackoffSeconds := delayStartSeconds
 	if len(startRequest.GetCronSchedule()) > 0 {
@@ -510,7 +755,7 @@ func CreateHistoryStartWorkflowRequest(
 		firstDecisionTaskBackoffSeconds, err = backoff.GetBackoffForNextScheduleInSeconds(
 			startRequest.GetCronSchedule(), delayedStartTime, delayedStartTime, jitterStartSeconds)
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
 
 		// backoff seconds was calculated based on delayed start time, so we need to
@@ -520,17 +765,21 @@ func CreateHistoryStartWorkflowRequest(
 		// Add a random jitter to start time, if requested.
 		firstDecisionTaskBackoffSeconds += rand.Int31n(jitterStartSeconds + 1)
 	}
+	return firstDecisionTaskBackoffSeconds, nil
+}
 
-	histRequest.FirstDecisionTaskBackoffSeconds = Int32Ptr(firstDecisionTaskBackoffSeconds)
-
-	if startRequest.RetryPolicy != nil && startRequest.RetryPolicy.GetExpirationIntervalInSeconds() > 0 {
-		expirationInSeconds := startRequest.RetryPolicy.GetExpirationIntervalInSeconds() + firstDecisionTaskBackoffSeconds
-		// expirationTime calculates from first decision task schedule to the end of the workflow
-		deadline := now.Add(time.Duration(expirationInSeconds) * time.Second)
-		histRequest.ExpirationTimestamp = Int64Ptr(deadline.Round(time.Millisecond).UnixNano())
+func getWorkflowExpiration(
+	startRequest *types.StartWorkflowExecutionRequest,
+	now time.Time,
+	firstDecisionTaskBackoffSeconds int32,
+) *int64 {
+	if startRequest.RetryPolicy == nil || startRequest.RetryPolicy.GetExpirationIntervalInSeconds() <= 0 {
+		return nil
 	}
-
-	return histRequest, nil
+	expirationInSeconds := startRequest.RetryPolicy.GetExpirationIntervalInSeconds() + firstDecisionTaskBackoffSeconds
+	// expirationTime calculates from first decision task schedule to the end of the workflow
+	deadline := now.Add(time.Duration(expirationInSeconds) * time.Second)
+	return Int64Ptr(deadline.Round(time.Millisecond).UnixNano())
 }
 
 // CheckEventBlobSizeLimit checks if a blob data exceeds limits. It logs a warning if it exceeds warnLimit,
@@ -767,35 +1016,64 @@ func IsJustOrderByClause(clause string) bool {
 	return strings.HasPrefix(whereClause, "order by")
 }
 
-// ConvertIndexedValueTypeToInternalType takes fieldType as interface{} and convert to IndexedValueType.
-// Because different implementation of dynamic config client may lead to different types
-func ConvertIndexedValueTypeToInternalType(fieldType interface{}, logger log.Logger) types.IndexedValueType {
+// UnknownIndexedValueTypeError is returned when a search attribute type from dynamic config
+// can not be converted to IndexedValueType
+type UnknownIndexedValueTypeError struct {
+	Value interface{}
+	Cause error
+}
+
+func (e *UnknownIndexedValueTypeError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("unknown index value type %v of Go type %T: %v", e.Value, e.Value, e.Cause)
+	}
+	return fmt.Sprintf("unknown index value type %v of Go type %T", e.Value, e.Value)
+}
+
+func (e *UnknownIndexedValueTypeError) Unwrap() error {
+	return e.Cause
+}
+
+// ConvertIndexedValueTypeToInternalTypeWithError takes fieldType as interface{} and convert to IndexedValueType.
+// Because different implementation of dynamic config client may lead to different types.
+// An UnknownIndexedValueTypeError is returned if fieldType can not be converted.
+func ConvertIndexedValueTypeToInternalTypeWithError(fieldType interface{}) (types.IndexedValueType, error) {
 	switch t := fieldType.(type) {
 	case float64:
-		return types.IndexedValueType(t)
+		return types.IndexedValueType(t), nil
 	case int:
-		return types.IndexedValueType(t)
+		return types.IndexedValueType(t), nil
 	case types.IndexedValueType:
-		return t
+		return t, nil
 	case []byte:
 		var result types.IndexedValueType
 		if err := result.UnmarshalText(t); err != nil {
-			logger.Error("unknown index value type", tag.Value(fieldType), tag.ValueType(t), tag.Error(err))
-			return fieldType.(types.IndexedValueType) // it will panic and been captured by logger
+			return 0, &UnknownIndexedValueTypeError{Value: fieldType, Cause: err}
 		}
-		return result
+		return result, nil
 	case string:
 		var result types.IndexedValueType
 		if err := result.UnmarshalText([]byte(t)); err != nil {
-			logger.Error("unknown index value type", tag.Value(fieldType), tag.ValueType(t), tag.Error(err))
-			return fieldType.(types.IndexedValueType) // it will panic and been captured by logger
+			return 0, &UnknownIndexedValueTypeError{Value: fieldType, Cause: err}
 		}
-		return result
+		return result, nil
 	default:
 		// Unknown fieldType, please make sure dynamic config return correct value type
-		logger.Error("unknown index value type", tag.Value(fieldType), tag.ValueType(t))
-		return fieldType.(types.IndexedValueType) // it will panic and been captured by logger
+		return 0, &UnknownIndexedValueTypeError{Value: fieldType}
+	}
+}
+
+// ConvertIndexedValueTypeToInternalType takes fieldType as interface{} and convert to IndexedValueType.
+// Because different implementation of dynamic config client may lead to different types.
+// It logs and panics if fieldType can not be converted, use ConvertIndexedValueTypeToInternalTypeWithError
+// to handle the error instead.
+func ConvertIndexedValueTypeToInternalType(fieldType interface{}, logger log.Logger) types.IndexedValueType {
+	result, err := ConvertIndexedValueTypeToInternalTypeWithError(fieldType)
+	if err != nil {
+		logger.Error("unknown index value type", tag.Value(fieldType), tag.ValueType(fieldType), tag.Error(err))
+		panic(err)
 	}
+	return result
 }
 
 // DeserializeSearchAttributeValue takes json encoded search attribute value and it's type as input, then
@@ -899,6 +1177,60 @@ func ConvertDynamicConfigMapPropertyToIntMap(
 	return intMap, nil
 }
 
+// ConvertDynamicConfigMapPropertyToFloatMap convert a map property from dynamic config to a map
+// whose type is map[string]float64
+func ConvertDynamicConfigMapPropertyToFloatMap(
+	dcValue map[string]interface{},
+) (map[string]float64, error) {
+	floatMap := make(map[string]float64)
+	for key, value := range dcValue {
+		var floatValue float64
+		switch value := value.(type) {
+		case float64:
+			floatValue = value
+		case int:
+			floatValue = float64(value)
+		case int32:
+			floatValue = float64(value)
+		case int64:
+			floatValue = float64(value)
+		default:
+			return nil, fmt.Errorf("unknown value %v with type %T", value, value)
+		}
+		floatMap[strings.TrimSpace(key)] = floatValue
+	}
+	return floatMap, nil
+}
+
+// ConvertDynamicConfigMapPropertyToStringMap convert a map property from dynamic config to a map
+// whose type is map[string]string
+func ConvertDynamicConfigMapPropertyToStringMap(
+	dcValue map[string]interface{},
+) (map[string]string, error) {
+	stringMap := make(map[string]string)
+	for key, value := range dcValue {
+		var stringValue string
+		switch value := value.(type) {
+		case string:
+			stringValue = value
+		case bool:
+			stringValue = strconv.FormatBool(value)
+		case float64:
+			stringValue = strconv.FormatFloat(value, 'f', -1, 64)
+		case int:
+			stringValue = strconv.Itoa(value)
+		case int32:
+			stringValue = strconv.FormatInt(int64(value), 10)
+		case int64:
+			stringValue = strconv.FormatInt(value, 10)
+		default:
+			return nil, fmt.Errorf("unknown value %v with type %T", value, value)
+		}
+		stringMap[strings.TrimSpace(key)] = stringValue
+	}
+	return stringMap, nil
+}
+
 // IsStickyTaskConditionError is error from matching engine
 func IsStickyTaskConditionError(err error) bool {
 	if e, ok := err.(*types.InternalServiceError); ok {
@@ -987,15 +1319,40 @@ func SleepWithMinDuration(desired time.Duration, available time.Duration) time.D
 	return available - d
 }
 
+// SleepWithJitter sleeps for base duration jittered by the given coefficient, which is clamped to [0, 1],
+// returning early with ctx.Err() if the context is done before the sleep ends
+func SleepWithJitter(ctx context.Context, base time.Duration, jitter float64) error {
+	return sleepWithJitter(ctx, clock.NewRealTimeSource(), base, jitter)
+}
+
+func sleepWithJitter(ctx context.Context, timeSource clock.Clock, base time.Duration, jitter float64) error {
+	d := base
+	if base > 0 && jitter > 0 {
+		d = backoff.JitDuration(base, math.Min(jitter, 1))
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timeSource.After(d):
+		return nil
+	}
+}
+
 // ConvertErrToGetTaskFailedCause converts error to GetTaskFailedCause
 func ConvertErrToGetTaskFailedCause(err error) types.GetTaskFailedCause {
 	if IsContextTimeoutError(err) {
 		return types.GetTaskFailedCauseTimeout
 	}
+	// there's no failed cause for cancellation, it's reported as timeout so that
+	// cancelled requests, e.g. on shutdown, are not counted as uncategorized failures
+	if errors.Is(err, context.Canceled) || yarpcerrors.IsCancelled(err) {
+		return types.GetTaskFailedCauseTimeout
+	}
 	if IsServiceBusyError(err) {
 		return types.GetTaskFailedCauseServiceBusy
 	}
-	if _, ok := err.(*types.ShardOwnershipLostError); ok {
+	if _, ok := AsCadenceError[*types.ShardOwnershipLostError](err); ok {
 		return types.GetTaskFailedCauseShardOwnershipLost
 	}
 	return types.GetTaskFailedCauseUncategorized
@@ -1037,3 +1394,34 @@ func IntersectionStringSlice(a, b []string) []string {
 	}
 	return result
 }
+
+// SubtractStringSlice get the items of a which are not in b, without duplicates
+func SubtractStringSlice(a, b []string) []string {
+	var result []string
+	m := make(map[string]struct{})
+	for _, item := range b {
+		m[item] = struct{}{}
+	}
+	for _, item := range a {
+		if _, ok := m[item]; !ok {
+			m[item] = struct{}{}
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// UnionStringSlice get the union of 2 string slices, without duplicates
+func UnionStringSlice(a, b []string) []string {
+	var result []string
+	m := make(map[string]struct{})
+	for _, items := range [][]string{a, b} {
+		for _, item := range items {
+			if _, ok := m[item]; !ok {
+				m[item] = struct{}{}
+				result = append(result, item)
+			}
+		}
+	}
+	return result
+}
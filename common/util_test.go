@@ -34,10 +34,12 @@ import (
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/yarpc/yarpcerrors"
 
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -63,6 +65,142 @@ func TestIsServiceTransientError_ContextCancel(t *testing.T) {
 	require.False(t, IsServiceTransientError(ctx.Err()))
 }
 
+func TestServiceTransientBackoffHint(t *testing.T) {
+	busyErr := &types.ServiceBusyError{Message: "busy", RetryAfter: time.Second}
+	hint, ok := ServiceTransientBackoffHint(busyErr)
+	require.True(t, ok)
+	require.Equal(t, time.Second, hint)
+	require.True(t, IsServiceTransientError(busyErr))
+
+	hint, ok = ServiceTransientBackoffHint(fmt.Errorf("wrapped: %w", busyErr))
+	require.True(t, ok)
+	require.Equal(t, time.Second, hint)
+
+	_, ok = ServiceTransientBackoffHint(&types.ServiceBusyError{Message: "busy"})
+	require.False(t, ok)
+	_, ok = ServiceTransientBackoffHint(&types.InternalServiceError{Message: "internal"})
+	require.False(t, ok)
+	_, ok = ServiceTransientBackoffHint(nil)
+	require.False(t, ok)
+}
+
+func TestRetryWithContext(t *testing.T) {
+	policy := backoff.NewExponentialRetryPolicy(time.Millisecond)
+	policy.SetMaximumAttempts(3)
+
+	t.Run("it retries transient errors until success", func(t *testing.T) {
+		attempts := 0
+		err := RetryWithContext(context.Background(), policy, nil, func(ctx context.Context) error {
+			attempts++
+			if attempts < 3 {
+				return ToServiceTransientError(&types.EntityNotExistsError{})
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 3, attempts)
+	})
+
+	t.Run("it doesn't retry non retryable errors", func(t *testing.T) {
+		attempts := 0
+		err := RetryWithContext(context.Background(), policy, nil, func(ctx context.Context) error {
+			attempts++
+			return &types.BadRequestError{}
+		})
+		require.Equal(t, &types.BadRequestError{}, err)
+		require.Equal(t, 1, attempts)
+	})
+
+	t.Run("it uses the retryable predicate", func(t *testing.T) {
+		attempts := 0
+		err := RetryWithContext(context.Background(), policy, IsEntityNotExistsError, func(ctx context.Context) error {
+			attempts++
+			return ToServiceTransientError(&types.EntityNotExistsError{})
+		})
+		require.True(t, IsEntityNotExistsError(err))
+		// the first attempt and 3 retries
+		require.Equal(t, 4, attempts)
+	})
+
+	t.Run("it stops when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		attempts := 0
+		err := RetryWithContext(ctx, policy, nil, func(ctx context.Context) error {
+			attempts++
+			cancel()
+			return &types.ServiceBusyError{RetryAfter: time.Hour}
+		})
+		require.IsType(t, &types.ServiceBusyError{}, err)
+		require.Equal(t, 1, attempts)
+
+		err = RetryWithContext(ctx, policy, nil, func(ctx context.Context) error {
+			return nil
+		})
+		require.Equal(t, context.Canceled, err)
+	})
+
+	t.Run("it honors the retry-after hint", func(t *testing.T) {
+		attempts := 0
+		start := time.Now()
+		err := RetryWithContext(context.Background(), policy, nil, func(ctx context.Context) error {
+			attempts++
+			if attempts < 2 {
+				return &types.ServiceBusyError{RetryAfter: 50 * time.Millisecond}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.True(t, time.Since(start) >= 50*time.Millisecond)
+	})
+}
+
+func TestAsCadenceError(t *testing.T) {
+	notExistsErr := &types.EntityNotExistsError{Message: "not exists"}
+	tests := map[string]struct {
+		err         error
+		expectFound bool
+	}{
+		"nil": {
+			err: nil,
+		},
+		"other type": {
+			err: &types.InternalServiceError{Message: "internal"},
+		},
+		"unwrapped": {
+			err:         notExistsErr,
+			expectFound: true,
+		},
+		"wrapped": {
+			err:         fmt.Errorf("wrapped: %w", notExistsErr),
+			expectFound: true,
+		},
+		"double wrapped": {
+			err:         fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", notExistsErr)),
+			expectFound: true,
+		},
+		"wrapped by ToServiceTransientError": {
+			err:         ToServiceTransientError(notExistsErr),
+			expectFound: true,
+		},
+		"double wrapped by ToServiceTransientError": {
+			err:         fmt.Errorf("wrapped: %w", ToServiceTransientError(notExistsErr)),
+			expectFound: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			found, ok := AsCadenceError[*types.EntityNotExistsError](test.err)
+			assert.Equal(t, test.expectFound, ok)
+			assert.Equal(t, test.expectFound, IsEntityNotExistsError(test.err))
+			if test.expectFound {
+				assert.Same(t, notExistsErr, found)
+			} else {
+				assert.Nil(t, found)
+			}
+		})
+	}
+}
+
 func TestIsContextTimeoutError(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
 	defer cancel()
@@ -94,6 +232,60 @@ func TestConvertDynamicConfigMapPropertyToIntMap(t *testing.T) {
 	}
 }
 
+func TestConvertDynamicConfigMapPropertyToIntMap_Error(t *testing.T) {
+	_, err := ConvertDynamicConfigMapPropertyToIntMap(map[string]interface{}{"key": 1})
+	require.Error(t, err)
+
+	_, err = ConvertDynamicConfigMapPropertyToIntMap(map[string]interface{}{"0": "1"})
+	require.Error(t, err)
+}
+
+func TestConvertDynamicConfigMapPropertyToFloatMap(t *testing.T) {
+	dcValue := make(map[string]interface{})
+	for idx, value := range []interface{}{int(0), int32(1), int64(2), float64(3.0)} {
+		dcValue[strconv.Itoa(idx)] = value
+	}
+	dcValue[" 4.5 "] = float64(4.5)
+
+	floatMap, err := ConvertDynamicConfigMapPropertyToFloatMap(dcValue)
+	require.NoError(t, err)
+	require.Len(t, floatMap, 5)
+	for i := 0; i != 4; i++ {
+		require.Equal(t, float64(i), floatMap[strconv.Itoa(i)])
+	}
+	require.Equal(t, 4.5, floatMap["4.5"])
+
+	_, err = ConvertDynamicConfigMapPropertyToFloatMap(map[string]interface{}{"0": "1"})
+	require.Error(t, err)
+}
+
+func TestConvertDynamicConfigMapPropertyToStringMap(t *testing.T) {
+	dcValue := map[string]interface{}{
+		"string":  "value",
+		"bool":    true,
+		"int":     int(1),
+		"int32":   int32(2),
+		"int64":   int64(3),
+		"float64": float64(4.5),
+		" space ": float64(6.0),
+	}
+
+	stringMap, err := ConvertDynamicConfigMapPropertyToStringMap(dcValue)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"string":  "value",
+		"bool":    "true",
+		"int":     "1",
+		"int32":   "2",
+		"int64":   "3",
+		"float64": "4.5",
+		"space":   "6",
+	}, stringMap)
+
+	_, err = ConvertDynamicConfigMapPropertyToStringMap(map[string]interface{}{"0": []string{"1"}})
+	require.Error(t, err)
+}
+
 func TestCreateHistoryStartWorkflowRequest_ExpirationTimeWithCron(t *testing.T) {
 	domainID := uuid.New()
 	request := &types.StartWorkflowExecutionRequest{
@@ -121,15 +313,24 @@ func TestFirstDecisionTaskBackoffDuringStartWorkflow(t *testing.T) {
 		cron               bool
 		jitterStartSeconds int32
 		delayStartSeconds  int32
+		firstRunAtSeconds  *int32 // seconds after the start time
 	}{
-		{true, 0, 0},
-		{true, 15, 0},
-		{true, 0, 600},
-		{true, 15, 600},
-		{false, 0, 0},
-		{false, 15, 0},
-		{false, 0, 600},
-		{false, 15, 600},
+		{true, 0, 0, nil},
+		{true, 15, 0, nil},
+		{true, 0, 600, nil},
+		{true, 15, 600, nil},
+		{false, 0, 0, nil},
+		{false, 15, 0, nil},
+		{false, 0, 600, nil},
+		{false, 15, 600, nil},
+		{true, 0, 0, Int32Ptr(0)},
+		{true, 15, 0, Int32Ptr(0)},
+		{true, 0, 0, Int32Ptr(600)},
+		{true, 15, 0, Int32Ptr(600)},
+		{false, 0, 0, Int32Ptr(0)},
+		{false, 15, 0, Int32Ptr(0)},
+		{false, 0, 0, Int32Ptr(600)},
+		{false, 15, 0, Int32Ptr(600)},
 	}
 
 	rand.Seed(int64(time.Now().Nanosecond()))
@@ -151,13 +352,23 @@ func TestFirstDecisionTaskBackoffDuringStartWorkflow(t *testing.T) {
 			for i := 0; i < caseCount; i++ {
 				// Start at the minute boundary so we know what the backoff should be
 				startTime, _ := time.Parse(time.RFC3339, "2018-12-17T08:00:00+00:00")
-				startRequest, err := CreateHistoryStartWorkflowRequest(domainID, request, startTime, nil)
+				var startRequest *types.HistoryStartWorkflowExecutionRequest
+				var err error
+				if tt.firstRunAtSeconds != nil {
+					firstRunAt := startTime.Add(time.Duration(*tt.firstRunAtSeconds) * time.Second)
+					startRequest, err = CreateHistoryStartWorkflowRequestWithFirstRunAt(domainID, request, startTime, nil, firstRunAt)
+				} else {
+					startRequest, err = CreateHistoryStartWorkflowRequest(domainID, request, startTime, nil)
+				}
 				require.NoError(t, err)
 				require.NotNil(t, startRequest)
 
 				backoff := startRequest.GetFirstDecisionTaskBackoffSeconds()
 
 				expectedWithoutJitter := tt.delayStartSeconds
+				if tt.firstRunAtSeconds != nil {
+					expectedWithoutJitter = *tt.firstRunAtSeconds
+				}
 				if tt.cron {
 					expectedWithoutJitter += 60
 				}
@@ -193,6 +404,37 @@ func TestFirstDecisionTaskBackoffDuringStartWorkflow(t *testing.T) {
 	}
 }
 
+func TestCreateHistoryStartWorkflowRequest_InvalidFirstRunAt(t *testing.T) {
+	now := time.Now()
+	for name, tt := range map[string]struct {
+		request    *types.StartWorkflowExecutionRequest
+		firstRunAt time.Time
+	}{
+		"in the past": {
+			request:    &types.StartWorkflowExecutionRequest{},
+			firstRunAt: now.Add(-time.Second),
+		},
+		"along with delay start": {
+			request:    &types.StartWorkflowExecutionRequest{DelayStartSeconds: Int32Ptr(10)},
+			firstRunAt: now.Add(time.Minute),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := CreateHistoryStartWorkflowRequestWithFirstRunAt(uuid.New(), tt.request, now, nil, tt.firstRunAt)
+			var badRequestErr *types.BadRequestError
+			require.ErrorAs(t, err, &badRequestErr)
+		})
+	}
+}
+
+func TestCreateHistoryStartWorkflowRequest_FirstRunAtRoundsUp(t *testing.T) {
+	now := time.Now()
+	request := &types.StartWorkflowExecutionRequest{}
+	startRequest, err := CreateHistoryStartWorkflowRequestWithFirstRunAt(uuid.New(), request, now, nil, now.Add(1500*time.Millisecond))
+	require.NoError(t, err)
+	require.Equal(t, int32(2), startRequest.GetFirstDecisionTaskBackoffSeconds())
+}
+
 func TestCreateHistoryStartWorkflowRequest(t *testing.T) {
 	var tests = []struct {
 		delayStartSeconds  int
@@ -280,6 +522,64 @@ func TestCreateHistoryStartWorkflowRequest_ExpirationTimeWithoutCron(t *testing.
 	require.True(t, delta < 62*time.Second)
 }
 
+func TestComputeWorkflowExpiration(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	retryPolicy := &types.RetryPolicy{
+		InitialIntervalInSeconds:    60,
+		ExpirationIntervalInSeconds: 60,
+	}
+
+	t.Run("no cron", func(t *testing.T) {
+		expiration, err := ComputeWorkflowExpiration(&types.StartWorkflowExecutionRequest{
+			RetryPolicy: retryPolicy,
+		}, now)
+		require.NoError(t, err)
+		require.NotNil(t, expiration)
+		require.Equal(t, now.Add(60*time.Second).UnixNano(), *expiration)
+	})
+
+	t.Run("no cron with delay start", func(t *testing.T) {
+		expiration, err := ComputeWorkflowExpiration(&types.StartWorkflowExecutionRequest{
+			RetryPolicy:       retryPolicy,
+			DelayStartSeconds: Int32Ptr(30),
+		}, now)
+		require.NoError(t, err)
+		require.NotNil(t, expiration)
+		require.Equal(t, now.Add(90*time.Second).UnixNano(), *expiration)
+	})
+
+	t.Run("cron", func(t *testing.T) {
+		expiration, err := ComputeWorkflowExpiration(&types.StartWorkflowExecutionRequest{
+			RetryPolicy:  retryPolicy,
+			CronSchedule: "@every 300s",
+		}, now)
+		require.NoError(t, err)
+		require.NotNil(t, expiration)
+		require.Equal(t, now.Add(360*time.Second).UnixNano(), *expiration)
+	})
+
+	t.Run("invalid cron", func(t *testing.T) {
+		_, err := ComputeWorkflowExpiration(&types.StartWorkflowExecutionRequest{
+			RetryPolicy:  retryPolicy,
+			CronSchedule: "invalid-cron",
+		}, now)
+		require.Error(t, err)
+	})
+
+	t.Run("unbounded", func(t *testing.T) {
+		expiration, err := ComputeWorkflowExpiration(&types.StartWorkflowExecutionRequest{}, now)
+		require.NoError(t, err)
+		require.Nil(t, expiration)
+
+		expiration, err = ComputeWorkflowExpiration(&types.StartWorkflowExecutionRequest{
+			RetryPolicy:  &types.RetryPolicy{InitialIntervalInSeconds: 60, MaximumAttempts: 3},
+			CronSchedule: "@every 300s",
+		}, now)
+		require.NoError(t, err)
+		require.Nil(t, expiration)
+	})
+}
+
 func TestConvertIndexedValueTypeToInternalType(t *testing.T) {
 	values := []types.IndexedValueType{types.IndexedValueTypeString, types.IndexedValueTypeKeyword, types.IndexedValueTypeInt, types.IndexedValueTypeDouble, types.IndexedValueTypeBool, types.IndexedValueTypeDatetime}
 	for _, expected := range values {
@@ -293,6 +593,59 @@ func TestConvertIndexedValueTypeToInternalType(t *testing.T) {
 	}
 }
 
+func TestConvertIndexedValueTypeToInternalTypeWithError(t *testing.T) {
+	for _, expected := range []types.IndexedValueType{types.IndexedValueTypeString, types.IndexedValueTypeDatetime} {
+		got, err := ConvertIndexedValueTypeToInternalTypeWithError(expected)
+		require.NoError(t, err)
+		require.Equal(t, expected, got)
+
+		got, err = ConvertIndexedValueTypeToInternalTypeWithError(int(expected))
+		require.NoError(t, err)
+		require.Equal(t, expected, got)
+	}
+
+	tests := map[string]struct {
+		input       interface{}
+		expectedErr string
+	}{
+		"unknown Go type": {
+			input:       int64(1),
+			expectedErr: "unknown index value type 1 of Go type int64",
+		},
+		"nil": {
+			input:       nil,
+			expectedErr: "unknown index value type <nil> of Go type <nil>",
+		},
+		"unknown string": {
+			input:       "Unknown",
+			expectedErr: "unknown index value type Unknown of Go type string",
+		},
+		"unknown bytes": {
+			input:       []byte("Unknown"),
+			expectedErr: "of Go type []uint8",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ConvertIndexedValueTypeToInternalTypeWithError(tc.input)
+			var unknownErr *UnknownIndexedValueTypeError
+			require.ErrorAs(t, err, &unknownErr)
+			require.Equal(t, tc.input, unknownErr.Value)
+			require.Contains(t, err.Error(), tc.expectedErr)
+		})
+	}
+}
+
+func TestConvertIndexedValueTypeToInternalType_Unknown(t *testing.T) {
+	logger := new(log.MockLogger)
+	logger.On("Error", "unknown index value type", mock.Anything).Once()
+
+	require.Panics(t, func() {
+		ConvertIndexedValueTypeToInternalType(int64(1), logger)
+	})
+	logger.AssertExpectations(t)
+}
+
 func TestValidateDomainUUID(t *testing.T) {
 	testCases := []struct {
 		msg        string
@@ -341,6 +694,18 @@ func TestConvertErrToGetTaskFailedCause(t *testing.T) {
 			err:                 context.DeadlineExceeded,
 			expectedFailedCause: types.GetTaskFailedCauseTimeout,
 		},
+		{
+			err:                 context.Canceled,
+			expectedFailedCause: types.GetTaskFailedCauseTimeout,
+		},
+		{
+			err:                 fmt.Errorf("wrapped: %w", context.Canceled),
+			expectedFailedCause: types.GetTaskFailedCauseTimeout,
+		},
+		{
+			err:                 yarpcerrors.CancelledErrorf("cancelled"),
+			expectedFailedCause: types.GetTaskFailedCauseTimeout,
+		},
 		{
 			err:                 &types.ServiceBusyError{},
 			expectedFailedCause: types.GetTaskFailedCauseServiceBusy,
@@ -371,6 +736,14 @@ func TestToServiceTransientError(t *testing.T) {
 		err := fmt.Errorf("error")
 		assert.True(t, IsServiceTransientError(ToServiceTransientError(err)))
 	})
+
+	t.Run("it keeps the message and the wrapped error", func(t *testing.T) {
+		err := &types.EntityNotExistsError{Message: "100% not exists"}
+		transientErr := ToServiceTransientError(err)
+		assert.True(t, yarpcerrors.IsUnavailable(transientErr))
+		assert.Equal(t, "100% not exists", yarpcerrors.FromError(transientErr).Message())
+		assert.ErrorIs(t, transientErr, err)
+	})
 }
 
 func TestIntersectionStringSlice(t *testing.T) {
@@ -396,6 +769,50 @@ func TestIntersectionStringSlice(t *testing.T) {
 	})
 }
 
+func TestSubtractStringSlice(t *testing.T) {
+	t.Run("it returns no item", func(t *testing.T) {
+		a := []string{"a", "b", "c"}
+		b := []string{"a", "b", "c"}
+		c := SubtractStringSlice(a, b)
+		assert.ElementsMatch(t, []string{}, c)
+	})
+
+	t.Run("it returns all items", func(t *testing.T) {
+		a := []string{"a", "b", "c"}
+		b := []string{"d", "e", "f"}
+		c := SubtractStringSlice(a, b)
+		assert.ElementsMatch(t, []string{"a", "b", "c"}, c)
+	})
+
+	t.Run("it returns difference", func(t *testing.T) {
+		a := []string{"a", "b", "c", "a"}
+		b := []string{"c", "b", "f"}
+		c := SubtractStringSlice(a, b)
+		assert.ElementsMatch(t, []string{"a"}, c)
+	})
+}
+
+func TestUnionStringSlice(t *testing.T) {
+	t.Run("it returns all items once", func(t *testing.T) {
+		a := []string{"a", "b", "c"}
+		b := []string{"a", "b", "c"}
+		c := UnionStringSlice(a, b)
+		assert.ElementsMatch(t, []string{"a", "b", "c"}, c)
+	})
+
+	t.Run("it returns no item", func(t *testing.T) {
+		c := UnionStringSlice(nil, nil)
+		assert.ElementsMatch(t, []string{}, c)
+	})
+
+	t.Run("it returns union", func(t *testing.T) {
+		a := []string{"a", "b", "c", "a"}
+		b := []string{"c", "b", "f"}
+		c := UnionStringSlice(a, b)
+		assert.ElementsMatch(t, []string{"a", "b", "c", "f"}, c)
+	})
+}
+
 func TestAwaitWaitGroup(t *testing.T) {
 	t.Run("wait group done before timeout", func(t *testing.T) {
 		var wg sync.WaitGroup
@@ -427,6 +844,38 @@ func TestAwaitWaitGroup(t *testing.T) {
 	})
 }
 
+func TestAwaitWaitGroupCtx(t *testing.T) {
+	t.Run("wait group done before context", func(t *testing.T) {
+		var wg sync.WaitGroup
+
+		wg.Add(1)
+		wg.Done()
+
+		got := AwaitWaitGroupCtx(context.Background(), &wg)
+		require.True(t, got)
+	})
+
+	t.Run("context cancelled before wait group done", func(t *testing.T) {
+		var (
+			wg    sync.WaitGroup
+			doneC = make(chan struct{})
+		)
+
+		wg.Add(1)
+		go func() {
+			<-doneC
+			wg.Done()
+		}()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		got := AwaitWaitGroupCtx(ctx, &wg)
+		require.False(t, got)
+
+		close(doneC)
+	})
+}
+
 func TestIsValidIDLength(t *testing.T) {
 	var (
 		// test setup
@@ -480,6 +929,68 @@ func TestIsValidIDLength(t *testing.T) {
 	})
 }
 
+func TestCheckIDLength(t *testing.T) {
+	var (
+		scope              = metrics.NoopScope(0)
+		metricCounter      = 0
+		idTypeViolationTag = tag.ClusterName("idTypeViolationTag")
+		domainName         = "domain_name"
+		id                 = "12345"
+	)
+
+	tests := map[string]struct {
+		warnLimit  int
+		errorLimit int
+		expectWarn bool
+		expected   IDLengthResult
+	}{
+		"ok": {
+			warnLimit:  7,
+			errorLimit: 10,
+			expected:   IDLengthResult{Status: IDLengthOK, Length: 5},
+		},
+		"equal to limits": {
+			warnLimit:  5,
+			errorLimit: 5,
+			expected:   IDLengthResult{Status: IDLengthOK, Length: 5},
+		},
+		"over warn limit": {
+			warnLimit:  4,
+			errorLimit: 10,
+			expectWarn: true,
+			expected:   IDLengthResult{Status: IDLengthWarn, Length: 5},
+		},
+		"over error limit": {
+			warnLimit:  1,
+			errorLimit: 4,
+			expectWarn: true,
+			expected:   IDLengthResult{Status: IDLengthTooLong, Length: 5},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			logger := new(log.MockLogger)
+			if tc.expectWarn {
+				logger.On(
+					"Warn",
+					"ID length exceeds limit.",
+					[]tag.Tag{
+						tag.WorkflowDomainName(domainName),
+						tag.Name(id),
+						idTypeViolationTag,
+					},
+				).Once()
+			}
+
+			got := CheckIDLength(id, scope, tc.warnLimit, tc.errorLimit, metricCounter, domainName, logger, idTypeViolationTag)
+			assert.Equal(t, tc.expected, got)
+			assert.Equal(t, tc.expected.Status != IDLengthTooLong, got.IsValid())
+			logger.AssertExpectations(t)
+		})
+	}
+}
+
 func TestIsEntityNotExistsError(t *testing.T) {
 	t.Run("is entity not exists error", func(t *testing.T) {
 		err := &types.EntityNotExistsError{}
@@ -619,6 +1130,7 @@ func TestValidateRetryPolicy_Success(t *testing.T) {
 func TestValidateRetryPolicy_Error(t *testing.T) {
 	for name, c := range map[string]struct {
 		policy  *types.RetryPolicy
+		limits  RetryPolicyLimits
 		wantErr *types.BadRequestError
 	}{
 		"InitialIntervalInSeconds equals 0": {
@@ -685,11 +1197,34 @@ func TestValidateRetryPolicy_Error(t *testing.T) {
 			},
 			wantErr: &types.BadRequestError{Message: "MaximumAttempts and ExpirationIntervalInSeconds are both 0. At least one of them must be specified."},
 		},
+		"ExpirationIntervalInSeconds greater than the limit": {
+			policy: &types.RetryPolicy{
+				InitialIntervalInSeconds:    2,
+				BackoffCoefficient:          1,
+				ExpirationIntervalInSeconds: 3601,
+			},
+			limits:  RetryPolicyLimits{MaxExpirationIntervalInSeconds: 3600},
+			wantErr: &types.BadRequestError{Message: "ExpirationIntervalInSeconds cannot be greater than 3600 on retry policy."},
+		},
+		"MaximumAttempts greater than the limit": {
+			policy: &types.RetryPolicy{
+				InitialIntervalInSeconds: 2,
+				BackoffCoefficient:       1,
+				MaximumAttempts:          101,
+			},
+			limits:  RetryPolicyLimits{MaxMaximumAttempts: 100},
+			wantErr: &types.BadRequestError{Message: "MaximumAttempts cannot be greater than 100 on retry policy."},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
-			got := ValidateRetryPolicy(c.policy)
+			got := ValidateRetryPolicyWithLimits(c.policy, c.limits)
 			require.Error(t, got)
 			require.ErrorContains(t, got, c.wantErr.Message)
+			if c.limits == (RetryPolicyLimits{}) {
+				require.Equal(t, got, ValidateRetryPolicy(c.policy))
+			} else {
+				require.NoError(t, ValidateRetryPolicy(c.policy))
+			}
 		})
 	}
 }
@@ -733,6 +1268,81 @@ func TestWorkflowIDToHistoryShard(t *testing.T) {
 	}
 }
 
+func TestGroupWorkflowIDsByShard(t *testing.T) {
+	const numberOfShards = 16
+	ids := []string{"", "workflowId", "workflowId"}
+	for i := 0; i < 100; i++ {
+		ids = append(ids, fmt.Sprintf("workflow-%v", i))
+	}
+
+	for name, hasher := range map[string]ShardHasher{
+		"default": DefaultShardHasher(),
+		"jump":    NewJumpShardHasher(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			expected := make(map[int][]string)
+			for _, id := range ids {
+				shardID := WorkflowIDToHistoryShardWith(hasher, id, numberOfShards)
+				expected[shardID] = append(expected[shardID], id)
+			}
+			assert.Equal(t, expected, GroupWorkflowIDsByShardWith(hasher, ids, numberOfShards))
+		})
+	}
+
+	assert.Equal(t, GroupWorkflowIDsByShardWith(DefaultShardHasher(), ids, numberOfShards), GroupWorkflowIDsByShard(ids, numberOfShards))
+	assert.Empty(t, GroupWorkflowIDsByShard(nil, numberOfShards))
+}
+
+func TestShardHasherDistribution(t *testing.T) {
+	const (
+		numberOfShards = 16
+		numberOfIDs    = 160000
+	)
+	for name, hasher := range map[string]ShardHasher{
+		"default": DefaultShardHasher(),
+		"jump":    NewJumpShardHasher(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			counts := make([]int, numberOfShards)
+			for i := 0; i < numberOfIDs; i++ {
+				shard := WorkflowIDToHistoryShardWith(hasher, fmt.Sprintf("workflow-%v", i), numberOfShards)
+				require.True(t, shard >= 0 && shard < numberOfShards)
+				counts[shard]++
+			}
+			// chi-squared test with 15 degrees of freedom, 37.7 is the critical value for p=0.001
+			expected := float64(numberOfIDs) / numberOfShards
+			chiSquared := 0.0
+			for _, count := range counts {
+				chiSquared += (float64(count) - expected) * (float64(count) - expected) / expected
+			}
+			assert.Less(t, chiSquared, 37.7, "shard counts: %v", counts)
+		})
+	}
+}
+
+func TestDefaultShardHasher(t *testing.T) {
+	for _, id := range []string{"", "workflowId", "domainId", uuid.New()} {
+		assert.Equal(t, WorkflowIDToHistoryShard(id, 1000), WorkflowIDToHistoryShardWith(DefaultShardHasher(), id, 1000))
+		assert.Equal(t, DomainIDToHistoryShard(id, 1000), DefaultShardHasher().Shard(id, 1000))
+	}
+}
+
+func TestJumpShardHasherMovesIDsToNewShardOnly(t *testing.T) {
+	hasher := NewJumpShardHasher()
+	moved := 0
+	for i := 0; i < 10000; i++ {
+		id := fmt.Sprintf("workflow-%v", i)
+		before := hasher.Shard(id, 10)
+		after := hasher.Shard(id, 11)
+		if before != after {
+			assert.Equal(t, 10, after)
+			moved++
+		}
+	}
+	// about 1/11 of the IDs are expected to move
+	assert.InDelta(t, 10000/11, moved, 150)
+}
+
 func TestDomainIDToHistoryShard(t *testing.T) {
 	for _, c := range []struct {
 		domainID       string
@@ -757,3 +1367,77 @@ func TestDomainIDToHistoryShard(t *testing.T) {
 		})
 	}
 }
+
+type recordingClock struct {
+	*clock.EventTimeSource
+	requestedC chan time.Duration
+}
+
+func (c *recordingClock) After(d time.Duration) <-chan time.Time {
+	ch := c.EventTimeSource.After(d)
+	c.requestedC <- d
+	return ch
+}
+
+func TestSleepWithJitter(t *testing.T) {
+	newClock := func() *recordingClock {
+		return &recordingClock{
+			EventTimeSource: clock.NewEventTimeSource().Update(time.Unix(0, 0)),
+			requestedC:      make(chan time.Duration, 1),
+		}
+	}
+	sleep := func(ctx context.Context, timeSource *recordingClock, base time.Duration, jitter float64) chan error {
+		errC := make(chan error, 1)
+		go func() {
+			errC <- sleepWithJitter(ctx, timeSource, base, jitter)
+		}()
+		return errC
+	}
+
+	t.Run("sleep falls within jitter band", func(t *testing.T) {
+		base := 10 * time.Second
+		for i := 0; i < 100; i++ {
+			timeSource := newClock()
+			errC := sleep(context.Background(), timeSource, base, 0.2)
+			requested := <-timeSource.requestedC
+			require.True(t, requested >= 8*time.Second, "slept %v", requested)
+			require.True(t, requested < 12*time.Second, "slept %v", requested)
+
+			timeSource.Update(timeSource.Now().Add(requested))
+			require.NoError(t, <-errC)
+		}
+	})
+
+	t.Run("no jitter sleeps base", func(t *testing.T) {
+		timeSource := newClock()
+		errC := sleep(context.Background(), timeSource, time.Second, 0)
+		require.Equal(t, time.Second, <-timeSource.requestedC)
+
+		timeSource.Update(timeSource.Now().Add(time.Second))
+		require.NoError(t, <-errC)
+	})
+
+	t.Run("jitter above one is clamped", func(t *testing.T) {
+		base := 10 * time.Second
+		for i := 0; i < 100; i++ {
+			timeSource := newClock()
+			errC := sleep(context.Background(), timeSource, base, 5)
+			requested := <-timeSource.requestedC
+			require.True(t, requested >= 0, "slept %v", requested)
+			require.True(t, requested < 2*base, "slept %v", requested)
+
+			timeSource.Update(timeSource.Now().Add(requested))
+			require.NoError(t, <-errC)
+		}
+	})
+
+	t.Run("returns early on cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		timeSource := newClock()
+		errC := sleep(ctx, timeSource, time.Hour, 0.1)
+		<-timeSource.requestedC
+
+		cancel()
+		require.Equal(t, context.Canceled, <-errC)
+	})
+}
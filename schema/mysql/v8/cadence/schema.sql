@@ -257,9 +257,13 @@ CREATE TABLE queue (
   queue_type INT NOT NULL,
   message_id BIGINT NOT NULL,
   message_payload MEDIUMBLOB NOT NULL,
+  dedup_key VARCHAR(255),
+  created_time DATETIME(6),
   PRIMARY KEY(queue_type, message_id)
 );
 
+CREATE UNIQUE INDEX queue_dedup_key_idx ON queue(queue_type, dedup_key);
+
 CREATE TABLE queue_metadata (
   queue_type INT NOT NULL,
   data MEDIUMBLOB NOT NULL,
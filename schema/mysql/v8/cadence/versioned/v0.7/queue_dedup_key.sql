@@ -0,0 +1,2 @@
+ALTER TABLE queue ADD COLUMN dedup_key VARCHAR(255);
+CREATE UNIQUE INDEX queue_dedup_key_idx ON queue(queue_type, dedup_key);
@@ -0,0 +1,3 @@
+ALTER TABLE queue ADD COLUMN created_time DATETIME(6);
+-- messages enqueued before the upgrade are at least as old as the upgrade
+UPDATE queue SET created_time = UTC_TIMESTAMP(6) WHERE created_time IS NULL;
@@ -255,9 +255,13 @@ CREATE TABLE queue (
   queue_type INTEGER NOT NULL,
   message_id BIGINT NOT NULL,
   message_payload BYTEA NOT NULL,
+  dedup_key VARCHAR(255),
+  created_time TIMESTAMP,
   PRIMARY KEY(queue_type, message_id)
 );
 
+CREATE UNIQUE INDEX queue_dedup_key_idx ON queue(queue_type, dedup_key);
+
 CREATE TABLE queue_metadata (
   queue_type INTEGER NOT NULL,
   data BYTEA NOT NULL,
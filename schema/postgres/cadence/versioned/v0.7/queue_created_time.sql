@@ -0,0 +1,3 @@
+ALTER TABLE queue ADD COLUMN created_time TIMESTAMP;
+-- messages enqueued before the upgrade are at least as old as the upgrade
+UPDATE queue SET created_time = timezone('utc', now()) WHERE created_time IS NULL;
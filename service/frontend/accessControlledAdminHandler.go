@@ -160,6 +160,42 @@ func (a *AccessControlledWorkflowAdminHandler) DescribeQueue(ctx context.Context
 	return a.AdminHandler.DescribeQueue(ctx, request)
 }
 
+func (a *AccessControlledWorkflowAdminHandler) RefreshTaskList(ctx context.Context, request *types.RefreshTaskListRequest) (*types.RefreshTaskListResponse, error) {
+	attr := &authorization.Attributes{
+		APIName:     "RefreshTaskList",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: request,
+	}
+
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return nil, err
+	}
+	if !isAuthorized {
+		return nil, errUnauthorized
+	}
+
+	return a.AdminHandler.RefreshTaskList(ctx, request)
+}
+
+func (a *AccessControlledWorkflowAdminHandler) ResetTaskListAckLevel(ctx context.Context, request *types.ResetTaskListAckLevelRequest) error {
+	attr := &authorization.Attributes{
+		APIName:     "ResetTaskListAckLevel",
+		Permission:  authorization.PermissionAdmin,
+		RequestBody: request,
+	}
+
+	isAuthorized, err := a.isAuthorized(ctx, attr)
+	if err != nil {
+		return err
+	}
+	if !isAuthorized {
+		return errUnauthorized
+	}
+
+	return a.AdminHandler.ResetTaskListAckLevel(ctx, request)
+}
+
 func (a *AccessControlledWorkflowAdminHandler) DescribeWorkflowExecution(ctx context.Context, request *types.AdminDescribeWorkflowExecutionRequest) (*types.AdminDescribeWorkflowExecutionResponse, error) {
 	attr := &authorization.Attributes{
 		APIName:     "DescribeWorkflowExecution",
@@ -22,16 +22,39 @@ package frontend
 
 import (
 	"context"
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"time"
 
+	"go.uber.org/yarpc"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/authorization"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/config"
+	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/types"
 )
 
-var errUnauthorized = &types.AccessDeniedError{Message: "Request unauthorized."}
+var (
+	errUnauthorized               = &types.AccessDeniedError{Message: "Request unauthorized."}
+	errPrincipalRateLimitExceeded = &types.ServiceBusyError{Message: "Principal rate limit exceeded."}
+)
+
+const (
+	defaultAuthorizationCacheTTL      = 10 * time.Second
+	defaultAuthorizationCacheMaxCount = 10000
+
+	defaultPrincipalRateLimitMaxCount = 10000
+)
 
 // AccessControlledWorkflowHandler frontend handler wrapper for authentication and authorization
 type AccessControlledWorkflowHandler struct {
@@ -39,12 +62,54 @@ type AccessControlledWorkflowHandler struct {
 
 	frontendHandler Handler
 	authorizer      authorization.Authorizer
+	// shadowAuthorizer is consulted along with the authorizer, but its decisions are only logged and metered
+	shadowAuthorizer authorization.Authorizer
+	// principalExtractor provides the actor of the attributes
+	principalExtractor authorization.PrincipalExtractor
+
+	// failOpen allows requests when the authorizer returns an error
+	failOpen bool
+	// suppressDenyReason hides the reason of denied requests from the clients
+	suppressDenyReason bool
+	// decisionCache is nil unless the authorization decision cache is enabled
+	decisionCache      cache.Cache
+	cacheDenyDecisions bool
+	// principalLimiters holds a rate limiter for each principal, it's nil unless per principal rate limit is enabled
+	principalLimiters   cache.Cache
+	principalLimitRPS   float64
+	principalLimitBurst int
+}
+
+// authorizationCacheKey identifies an authorization decision. The request body is keyed by the hash of
+// its serialization for logging, which is all the authorizer gets to see of it, so the cache assumes
+// that decisions don't depend on the fields filtered out of that serialization.
+type authorizationCacheKey struct {
+	actor           string
+	callerService   string
+	tlsSubject      string
+	token           string
+	apiName         string
+	domainName      string
+	permission      authorization.Permission
+	workflowType    string
+	taskList        string
+	requestBodyHash [sha256.Size]byte
 }
 
 var _ Handler = (*AccessControlledWorkflowHandler)(nil)
 
-// NewAccessControlledHandlerImpl creates frontend handler with authentication support
-func NewAccessControlledHandlerImpl(wfHandler Handler, resource resource.Resource, authorizer authorization.Authorizer, cfg config.Authorization) *AccessControlledWorkflowHandler {
+// NewAccessControlledHandlerImpl creates frontend handler with authentication support.
+// shadowAuthorizer is optional, when set it runs in shadow mode: it authorizes the same requests as the authorizer,
+// disagreements are logged and metered but only the authorizer's decisions are enforced.
+// principalExtractor is optional, when nil one is created from the authorization config.
+func NewAccessControlledHandlerImpl(
+	wfHandler Handler,
+	resource resource.Resource,
+	authorizer authorization.Authorizer,
+	shadowAuthorizer authorization.Authorizer,
+	principalExtractor authorization.PrincipalExtractor,
+	cfg config.Authorization,
+) *AccessControlledWorkflowHandler {
 	if authorizer == nil {
 		var err error
 		authorizer, err = authorization.NewAuthorizer(cfg, resource.GetLogger(), resource.GetDomainCache())
@@ -52,11 +117,53 @@ func NewAccessControlledHandlerImpl(wfHandler Handler, resource resource.Resourc
 			resource.GetLogger().Fatal("Error when initiating the Authorizer", tag.Error(err))
 		}
 	}
-	return &AccessControlledWorkflowHandler{
-		Resource:        resource,
-		frontendHandler: wfHandler,
-		authorizer:      authorizer,
+	if principalExtractor == nil {
+		var err error
+		principalExtractor, err = authorization.NewPrincipalExtractor(cfg)
+		if err != nil {
+			resource.GetLogger().Fatal("Error when initiating the PrincipalExtractor", tag.Error(err))
+		}
+	}
+	handler := &AccessControlledWorkflowHandler{
+		Resource:           resource,
+		frontendHandler:    wfHandler,
+		authorizer:         authorizer,
+		shadowAuthorizer:   shadowAuthorizer,
+		principalExtractor: principalExtractor,
+		failOpen:           cfg.FailOpen,
+		suppressDenyReason: cfg.SuppressDenyReason,
+	}
+	if cacheCfg := cfg.DecisionCache; cacheCfg.Enable {
+		ttl := cacheCfg.TTL
+		if ttl <= 0 {
+			ttl = defaultAuthorizationCacheTTL
+		}
+		maxCount := cacheCfg.MaxCount
+		if maxCount <= 0 {
+			maxCount = defaultAuthorizationCacheMaxCount
+		}
+		handler.decisionCache = cache.New(&cache.Options{
+			TTL:      ttl,
+			MaxCount: maxCount,
+		})
+		handler.cacheDenyDecisions = cacheCfg.CacheDeny
+	}
+	if limitCfg := cfg.PrincipalRateLimit; limitCfg.Enable {
+		maxCount := limitCfg.MaxCount
+		if maxCount <= 0 {
+			maxCount = defaultPrincipalRateLimitMaxCount
+		}
+		burst := limitCfg.Burst
+		if burst <= 0 {
+			burst = int(math.Ceil(limitCfg.RPS))
+		}
+		handler.principalLimiters = cache.New(&cache.Options{
+			MaxCount: maxCount,
+		})
+		handler.principalLimitRPS = limitCfg.RPS
+		handler.principalLimitBurst = burst
 	}
+	return handler
 }
 
 // Health callback for for health check
@@ -887,24 +994,302 @@ func (a *AccessControlledWorkflowHandler) UpdateDomain(
 	return a.frontendHandler.UpdateDomain(ctx, request)
 }
 
+// isAuthorized authorizes the request and applies the per principal rate limit to the authorized ones
 func (a *AccessControlledWorkflowHandler) isAuthorized(
 	ctx context.Context,
 	attr *authorization.Attributes,
 	scope metrics.Scope,
 ) (bool, error) {
+	isAuth, err := a.authorize(ctx, attr, scope)
+	if err != nil || !isAuth {
+		return false, err
+	}
+	if err := a.allowPrincipal(attr, scope); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (a *AccessControlledWorkflowHandler) authorize(
+	ctx context.Context,
+	attr *authorization.Attributes,
+	scope metrics.Scope,
+) (bool, error) {
+	if err := a.populatePrincipal(ctx, attr); err != nil {
+		return false, err
+	}
+	populateCallerIdentity(ctx, attr)
+	scope = scope.Tagged(metrics.APINameTag(attr.APIName))
 	sw := scope.StartTimer(metrics.CadenceAuthorizationLatency)
 	defer sw.Stop()
 
+	cacheKey, cacheable := a.getAuthorizationCacheKey(ctx, attr)
+	if cacheable {
+		if result, ok := a.decisionCache.Get(cacheKey).(authorization.Result); ok {
+			scope.IncCounter(metrics.CadenceAuthorizationCacheHitCounter)
+			return a.isResultAllowed(result, scope)
+		}
+	}
+
+	var primaryDecisionC chan authorization.Decision
+	if a.shadowAuthorizer != nil {
+		primaryDecisionC = make(chan authorization.Decision, 1)
+		go a.shadowAuthorize(ctx, attr, scope, primaryDecisionC)
+	}
 	result, err := a.authorizer.Authorize(ctx, attr)
+	if primaryDecisionC != nil {
+		if err == nil {
+			primaryDecisionC <- result.Decision
+		}
+		close(primaryDecisionC)
+	}
 	if err != nil {
 		scope.IncCounter(metrics.CadenceErrAuthorizeFailedCounter)
+		if a.canFailOpen(attr) {
+			scope.IncCounter(metrics.CadenceAuthorizationFailOpenCounter)
+			a.GetLogger().Error("Authorizer failed, allowing the request as authorization is configured to fail open",
+				tag.OperationName(attr.APIName),
+				tag.WorkflowDomainName(attr.DomainName),
+				tag.Error(err),
+			)
+			return true, nil
+		}
+		return false, err
+	}
+	if cacheable && (result.Decision == authorization.DecisionAllow || a.cacheDenyDecisions) {
+		a.decisionCache.Put(cacheKey, result)
+	}
+	return a.isResultAllowed(result, scope)
+}
+
+// authorizeBatch authorizes all the attributes and returns false on the first deny.
+// It makes a single call if the authorizer is an authorization.BatchAuthorizer and authorizes
+// each of the attributes otherwise, decision cache and shadow authorizer only apply to the latter.
+// The per principal rate limit is applied once for the whole batch.
+func (a *AccessControlledWorkflowHandler) authorizeBatch(
+	ctx context.Context,
+	attrs []*authorization.Attributes,
+	scope metrics.Scope,
+) (bool, error) {
+	isAuth, err := a.authorizeAll(ctx, attrs, scope)
+	if err != nil || !isAuth {
 		return false, err
 	}
-	isAuth := result.Decision == authorization.DecisionAllow
-	if !isAuth {
-		scope.IncCounter(metrics.CadenceErrUnauthorizedCounter)
+	if len(attrs) > 0 {
+		if err := a.allowPrincipal(attrs[0], scope); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (a *AccessControlledWorkflowHandler) authorizeAll(
+	ctx context.Context,
+	attrs []*authorization.Attributes,
+	scope metrics.Scope,
+) (bool, error) {
+	batchAuthorizer, ok := a.authorizer.(authorization.BatchAuthorizer)
+	if !ok {
+		for _, attr := range attrs {
+			isAuth, err := a.authorize(ctx, attr, scope)
+			if err != nil || !isAuth {
+				return false, err
+			}
+		}
+		return true, nil
+	}
+
+	for _, attr := range attrs {
+		if err := a.populatePrincipal(ctx, attr); err != nil {
+			return false, err
+		}
+		populateCallerIdentity(ctx, attr)
+	}
+	sw := scope.StartTimer(metrics.CadenceAuthorizationLatency)
+	defer sw.Stop()
+
+	results, err := batchAuthorizer.AuthorizeBatch(ctx, attrs)
+	if err == nil && len(results) != len(attrs) {
+		err = fmt.Errorf("authorizer returned %v results for %v attributes", len(results), len(attrs))
+	}
+	if err != nil {
+		scope.IncCounter(metrics.CadenceErrAuthorizeFailedCounter)
+		if a.canFailOpen(attrs...) {
+			scope.IncCounter(metrics.CadenceAuthorizationFailOpenCounter)
+			a.GetLogger().Error("Authorizer failed, allowing the requests as authorization is configured to fail open", tag.Error(err))
+			return true, nil
+		}
+		return false, err
+	}
+	for i, result := range results {
+		if isAuth, err := a.isResultAllowed(result, scope.Tagged(metrics.APINameTag(attrs[i].APIName))); !isAuth {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// canFailOpen returns whether requests with the attributes are allowed when the authorizer fails,
+// admin APIs always fail closed
+func (a *AccessControlledWorkflowHandler) canFailOpen(attrs ...*authorization.Attributes) bool {
+	if !a.failOpen {
+		return false
+	}
+	for _, attr := range attrs {
+		if attr.Permission == authorization.PermissionAdmin {
+			return false
+		}
+	}
+	return true
+}
+
+// isResultAllowed returns an access denied error carrying the reason of the result
+// if the request is denied with a reason, and the reason is not suppressed
+func (a *AccessControlledWorkflowHandler) isResultAllowed(
+	result authorization.Result,
+	scope metrics.Scope,
+) (bool, error) {
+	if result.Decision == authorization.DecisionAllow {
+		return true, nil
+	}
+	scope.IncCounter(metrics.CadenceErrUnauthorizedCounter)
+	if result.Reason == "" || a.suppressDenyReason {
+		return false, nil
+	}
+	return false, &types.AccessDeniedError{Message: fmt.Sprintf("%v Reason: %v", errUnauthorized.Message, result.Reason)}
+}
+
+// shadowAuthorize runs the shadow authorizer and compares its decision with the one received from primaryDecisionC,
+// nothing is compared if the primary authorizer failed and the channel is closed without a decision
+func (a *AccessControlledWorkflowHandler) shadowAuthorize(
+	ctx context.Context,
+	attr *authorization.Attributes,
+	scope metrics.Scope,
+	primaryDecisionC <-chan authorization.Decision,
+) {
+	logger := a.GetLogger().WithTags(tag.OperationName(attr.APIName), tag.WorkflowDomainName(attr.DomainName))
+	var err error
+	defer func() { log.CapturePanic(recover(), logger, &err) }()
+
+	result, err := a.shadowAuthorizer.Authorize(ctx, attr)
+	primaryDecision, ok := <-primaryDecisionC
+	if !ok {
+		return
+	}
+	if err != nil {
+		scope.IncCounter(metrics.CadenceAuthorizationShadowFailedCounter)
+		logger.Warn("Shadow authorizer failed", tag.Error(err))
+		return
+	}
+	if result.Decision == primaryDecision {
+		scope.IncCounter(metrics.CadenceAuthorizationShadowAgreementCounter)
+		return
+	}
+	scope.IncCounter(metrics.CadenceAuthorizationShadowDisagreementCounter)
+	logger.Warn("Shadow authorizer decision disagrees with the authorizer",
+		tag.Dynamic("authorization-decision", primaryDecision),
+		tag.Dynamic("shadow-authorization-decision", result.Decision),
+	)
+}
+
+// allowPrincipal returns a service busy error if the principal of the request exceeded its rate limit,
+// requests without a principal are not limited as they cannot be told apart
+func (a *AccessControlledWorkflowHandler) allowPrincipal(
+	attr *authorization.Attributes,
+	scope metrics.Scope,
+) error {
+	if a.principalLimiters == nil || attr.Actor == "" {
+		return nil
+	}
+	limiter, ok := a.principalLimiters.Get(attr.Actor).(quotas.Limiter)
+	if !ok {
+		value, err := a.principalLimiters.PutIfNotExist(attr.Actor, rate.NewLimiter(rate.Limit(a.principalLimitRPS), a.principalLimitBurst))
+		if err != nil {
+			return err
+		}
+		limiter = value.(quotas.Limiter)
+	}
+	if !limiter.Allow() {
+		scope.Tagged(metrics.APINameTag(attr.APIName)).IncCounter(metrics.CadenceAuthorizationPrincipalThrottledCounter)
+		return errPrincipalRateLimitExceeded
+	}
+	return nil
+}
+
+// populatePrincipal sets the actor of the attributes to the principal returned by the principal extractor,
+// an actor set explicitly by the caller of isAuthorized is kept
+func (a *AccessControlledWorkflowHandler) populatePrincipal(ctx context.Context, attr *authorization.Attributes) error {
+	if attr.Actor != "" {
+		return nil
+	}
+	principal, err := a.principalExtractor.ExtractPrincipal(ctx)
+	if err != nil {
+		a.GetLogger().Error("Failed to extract the principal of the request",
+			tag.OperationName(attr.APIName),
+			tag.WorkflowDomainName(attr.DomainName),
+			tag.Error(err),
+		)
+		return errUnauthorized
+	}
+	attr.Actor = principal
+	return nil
+}
+
+// populateCallerIdentity fills the caller identity of the attributes from the YARPC call metadata
+// and the peer's TLS certificate, identity set explicitly by the caller of isAuthorized is kept
+func populateCallerIdentity(ctx context.Context, attr *authorization.Attributes) {
+	if attr.CallerService == "" {
+		attr.CallerService = yarpc.CallFromContext(ctx).Caller()
+	}
+	if attr.TLSSubject == "" {
+		if p, ok := peer.FromContext(ctx); ok {
+			if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.PeerCertificates) > 0 {
+				attr.TLSSubject = tlsInfo.State.PeerCertificates[0].Subject.String()
+			}
+		}
+	}
+	if attr.Actor == "" {
+		// only the TLS subject is authenticated, the caller service name is self-declared
+		attr.Actor = attr.TLSSubject
+	}
+}
+
+// getAuthorizationCacheKey returns false if decisions are not cached or the request has no authenticated principal,
+// as decisions for anonymous requests cannot be told apart, or if the request body can't be serialized
+func (a *AccessControlledWorkflowHandler) getAuthorizationCacheKey(
+	ctx context.Context,
+	attr *authorization.Attributes,
+) (authorizationCacheKey, bool) {
+	if a.decisionCache == nil {
+		return authorizationCacheKey{}, false
+	}
+	token := yarpc.CallFromContext(ctx).Header(common.AuthorizationTokenHeaderName)
+	if attr.Actor == "" && token == "" {
+		return authorizationCacheKey{}, false
+	}
+	key := authorizationCacheKey{
+		actor:         attr.Actor,
+		callerService: attr.CallerService,
+		tlsSubject:    attr.TLSSubject,
+		token:         token,
+		apiName:       attr.APIName,
+		domainName:    attr.DomainName,
+		permission:    attr.Permission,
+	}
+	if attr.WorkflowType != nil {
+		key.workflowType = attr.WorkflowType.GetName()
+	}
+	if attr.TaskList != nil {
+		key.taskList = attr.TaskList.GetName()
+	}
+	if attr.RequestBody != nil {
+		body, err := attr.RequestBody.SerializeForLogging()
+		if err != nil {
+			return authorizationCacheKey{}, false
+		}
+		key.requestBodyHash = sha256.Sum256([]byte(body))
 	}
-	return isAuth, nil
+	return key, true
 }
 
 // getMetricsScopeWithDomain return metrics scope with domain tag
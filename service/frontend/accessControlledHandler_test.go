@@ -22,18 +22,29 @@ package frontend
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/yarpc/yarpctest"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/authorization"
 	"github.com/uber/cadence/common/config"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/metrics/mocks"
 	"github.com/uber/cadence/common/resource"
+	"github.com/uber/cadence/common/types"
 )
 
 type (
@@ -63,7 +74,7 @@ func (s *accessControlledHandlerSuite) SetupTest() {
 	s.mockFrontendHandler = NewMockHandler(s.controller)
 	s.mockAuthorizer = authorization.NewMockAuthorizer(s.controller)
 	s.mockMetricsScope = &mocks.Scope{}
-	s.handler = NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, config.Authorization{})
+	s.handler = NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, nil, nil, config.Authorization{})
 }
 
 func (s *accessControlledHandlerSuite) TearDownTest() {
@@ -75,6 +86,7 @@ func (s *accessControlledHandlerSuite) TestIsAuthorized() {
 	ctx := context.Background()
 	attr := &authorization.Attributes{}
 
+	s.mockMetricsScope.On("Tagged", metrics.APINameTag("")).Return(s.mockMetricsScope)
 	s.mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).
 		Return(metrics.Stopwatch{}).Once()
 	s.mockAuthorizer.EXPECT().Authorize(ctx, attr).
@@ -89,6 +101,7 @@ func (s *accessControlledHandlerSuite) TestIsAuthorized_Failed() {
 	ctx := context.Background()
 	attr := &authorization.Attributes{}
 
+	s.mockMetricsScope.On("Tagged", metrics.APINameTag("")).Return(s.mockMetricsScope)
 	s.mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).
 		Return(metrics.Stopwatch{}).Once()
 	s.mockAuthorizer.EXPECT().Authorize(ctx, attr).
@@ -105,6 +118,7 @@ func (s *accessControlledHandlerSuite) TestIsAuthorized_Unauthorized() {
 	ctx := context.Background()
 	attr := &authorization.Attributes{}
 
+	s.mockMetricsScope.On("Tagged", metrics.APINameTag("")).Return(s.mockMetricsScope)
 	s.mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).
 		Return(metrics.Stopwatch{}).Once()
 	s.mockAuthorizer.EXPECT().Authorize(ctx, attr).
@@ -116,3 +130,589 @@ func (s *accessControlledHandlerSuite) TestIsAuthorized_Unauthorized() {
 	s.False(res)
 	s.NoError(err)
 }
+
+func (s *accessControlledHandlerSuite) TestIsAuthorized_CachedAllow() {
+	handler := NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, nil, nil, config.Authorization{
+		DecisionCache: config.AuthorizationDecisionCache{Enable: true},
+	})
+	ctx := context.Background()
+	attr := &authorization.Attributes{Actor: "actor", APIName: "DescribeDomain", DomainName: "domain"}
+
+	s.mockMetricsScope.On("Tagged", metrics.APINameTag("DescribeDomain")).Return(s.mockMetricsScope)
+	s.mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).
+		Return(metrics.Stopwatch{}).Twice()
+	s.mockAuthorizer.EXPECT().Authorize(ctx, attr).
+		Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(1)
+	s.mockMetricsScope.On("IncCounter", metrics.CadenceAuthorizationCacheHitCounter).Once()
+
+	for i := 0; i < 2; i++ {
+		res, err := handler.isAuthorized(ctx, attr, s.mockMetricsScope)
+		s.True(res)
+		s.NoError(err)
+	}
+}
+
+func (s *accessControlledHandlerSuite) TestIsAuthorized_CachedPerRequestBody() {
+	handler := NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, nil, nil, config.Authorization{
+		DecisionCache: config.AuthorizationDecisionCache{Enable: true, CacheDeny: true},
+	})
+	ctx := context.Background()
+	allowedAttr := &authorization.Attributes{
+		Actor:       "actor",
+		APIName:     "SignalWorkflowExecution",
+		DomainName:  "domain",
+		RequestBody: &types.SignalWorkflowExecutionRequest{Domain: "domain", SignalName: "allowed-signal"},
+	}
+	deniedAttr := &authorization.Attributes{
+		Actor:       "actor",
+		APIName:     "SignalWorkflowExecution",
+		DomainName:  "domain",
+		RequestBody: &types.SignalWorkflowExecutionRequest{Domain: "domain", SignalName: "denied-signal"},
+	}
+
+	s.mockMetricsScope.On("Tagged", metrics.APINameTag("SignalWorkflowExecution")).Return(s.mockMetricsScope)
+	s.mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).
+		Return(metrics.Stopwatch{}).Twice()
+	s.mockAuthorizer.EXPECT().Authorize(ctx, allowedAttr).
+		Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(1)
+	s.mockAuthorizer.EXPECT().Authorize(ctx, deniedAttr).
+		Return(authorization.Result{Decision: authorization.DecisionDeny}, nil).Times(1)
+	s.mockMetricsScope.On("IncCounter", metrics.CadenceErrUnauthorizedCounter).Once()
+
+	res, err := handler.isAuthorized(ctx, allowedAttr, s.mockMetricsScope)
+	s.True(res)
+	s.NoError(err)
+
+	// the request differs only in its body, so the cached decision doesn't apply to it
+	res, err = handler.isAuthorized(ctx, deniedAttr, s.mockMetricsScope)
+	s.False(res)
+	s.NoError(err)
+}
+
+func (s *accessControlledHandlerSuite) TestIsAuthorized_DenyNotCachedByDefault() {
+	handler := NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, nil, nil, config.Authorization{
+		DecisionCache: config.AuthorizationDecisionCache{Enable: true},
+	})
+	ctx := context.Background()
+	attr := &authorization.Attributes{Actor: "actor", APIName: "DescribeDomain", DomainName: "domain"}
+
+	s.mockMetricsScope.On("Tagged", metrics.APINameTag("DescribeDomain")).Return(s.mockMetricsScope)
+	s.mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).
+		Return(metrics.Stopwatch{}).Twice()
+	s.mockAuthorizer.EXPECT().Authorize(ctx, attr).
+		Return(authorization.Result{Decision: authorization.DecisionDeny}, nil).Times(2)
+	s.mockMetricsScope.On("IncCounter", metrics.CadenceErrUnauthorizedCounter).Twice()
+
+	for i := 0; i < 2; i++ {
+		res, err := handler.isAuthorized(ctx, attr, s.mockMetricsScope)
+		s.False(res)
+		s.NoError(err)
+	}
+}
+
+func (s *accessControlledHandlerSuite) TestIsAuthorized_CachedDeny() {
+	handler := NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, nil, nil, config.Authorization{
+		DecisionCache: config.AuthorizationDecisionCache{Enable: true, CacheDeny: true},
+	})
+	ctx := context.Background()
+	attr := &authorization.Attributes{Actor: "actor", APIName: "DescribeDomain", DomainName: "domain"}
+
+	s.mockMetricsScope.On("Tagged", metrics.APINameTag("DescribeDomain")).Return(s.mockMetricsScope)
+	s.mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).
+		Return(metrics.Stopwatch{}).Twice()
+	s.mockAuthorizer.EXPECT().Authorize(ctx, attr).
+		Return(authorization.Result{Decision: authorization.DecisionDeny}, nil).Times(1)
+	s.mockMetricsScope.On("IncCounter", metrics.CadenceAuthorizationCacheHitCounter).Once()
+	s.mockMetricsScope.On("IncCounter", metrics.CadenceErrUnauthorizedCounter).Twice()
+
+	for i := 0; i < 2; i++ {
+		res, err := handler.isAuthorized(ctx, attr, s.mockMetricsScope)
+		s.False(res)
+		s.NoError(err)
+	}
+}
+
+func (s *accessControlledHandlerSuite) TestIsAuthorized_DenyReason() {
+	tests := map[string]struct {
+		cfg         config.Authorization
+		reason      string
+		expectedErr error
+	}{
+		"no reason": {},
+		"reason": {
+			reason:      "denied by policy",
+			expectedErr: &types.AccessDeniedError{Message: "Request unauthorized. Reason: denied by policy"},
+		},
+		"reason suppressed": {
+			cfg:    config.Authorization{SuppressDenyReason: true},
+			reason: "denied by policy",
+		},
+		"cached reason": {
+			cfg: config.Authorization{
+				DecisionCache: config.AuthorizationDecisionCache{Enable: true, CacheDeny: true},
+			},
+			reason:      "denied by policy",
+			expectedErr: &types.AccessDeniedError{Message: "Request unauthorized. Reason: denied by policy"},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			mockScope := &mocks.Scope{}
+			authorizer := authorization.NewMockAuthorizer(s.controller)
+			handler := NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, authorizer, nil, nil, test.cfg)
+			ctx := context.Background()
+			attr := &authorization.Attributes{Actor: "actor", APIName: "DescribeDomain", DomainName: "domain"}
+
+			mockScope.On("Tagged", metrics.APINameTag("DescribeDomain")).Return(mockScope)
+			mockScope.On("StartTimer", metrics.CadenceAuthorizationLatency).Return(metrics.Stopwatch{})
+			mockScope.On("IncCounter", metrics.CadenceAuthorizationCacheHitCounter).Maybe()
+			mockScope.On("IncCounter", metrics.CadenceErrUnauthorizedCounter)
+			authorizer.EXPECT().Authorize(ctx, attr).
+				Return(authorization.Result{Decision: authorization.DecisionDeny, Reason: test.reason}, nil).MaxTimes(2)
+
+			// the second call is served from the cache when it's enabled
+			for i := 0; i < 2; i++ {
+				res, err := handler.isAuthorized(ctx, attr, mockScope)
+				s.False(res)
+				s.Equal(test.expectedErr, err)
+			}
+		})
+	}
+}
+
+func (s *accessControlledHandlerSuite) TestDescribeDomain_DenyReason() {
+	ctx := context.Background()
+	s.mockAuthorizer.EXPECT().Authorize(ctx, gomock.Any()).
+		Return(authorization.Result{Decision: authorization.DecisionDeny, Reason: "denied by policy"}, nil).Times(1)
+
+	resp, err := s.handler.DescribeDomain(ctx, &types.DescribeDomainRequest{Name: common.StringPtr("domain")})
+	s.Nil(resp)
+	s.Equal(&types.AccessDeniedError{Message: "Request unauthorized. Reason: denied by policy"}, err)
+}
+
+func (s *accessControlledHandlerSuite) TestIsAuthorized_PrincipalRateLimit() {
+	handler := NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, nil, nil, config.Authorization{
+		PrincipalRateLimit: config.PrincipalRateLimit{Enable: true, RPS: 0.001, Burst: 1},
+	})
+	ctx := context.Background()
+
+	s.mockMetricsScope.On("Tagged", metrics.APINameTag("DescribeDomain")).Return(s.mockMetricsScope)
+	s.mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).Return(metrics.Stopwatch{})
+	s.mockAuthorizer.EXPECT().Authorize(ctx, gomock.Any()).
+		Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(5)
+	s.mockMetricsScope.On("IncCounter", metrics.CadenceAuthorizationPrincipalThrottledCounter).Once()
+
+	res, err := handler.isAuthorized(ctx, &authorization.Attributes{Actor: "actor1", APIName: "DescribeDomain"}, s.mockMetricsScope)
+	s.True(res)
+	s.NoError(err)
+
+	res, err = handler.isAuthorized(ctx, &authorization.Attributes{Actor: "actor1", APIName: "DescribeDomain"}, s.mockMetricsScope)
+	s.False(res)
+	s.Equal(errPrincipalRateLimitExceeded, err)
+
+	// other principals are not affected
+	res, err = handler.isAuthorized(ctx, &authorization.Attributes{Actor: "actor2", APIName: "DescribeDomain"}, s.mockMetricsScope)
+	s.True(res)
+	s.NoError(err)
+
+	// requests without principal are not limited
+	for i := 0; i < 2; i++ {
+		res, err = handler.isAuthorized(ctx, &authorization.Attributes{APIName: "DescribeDomain"}, s.mockMetricsScope)
+		s.True(res)
+		s.NoError(err)
+	}
+	s.mockMetricsScope.AssertExpectations(s.T())
+}
+
+func (s *accessControlledHandlerSuite) TestIsAuthorized_PrincipalRateLimitAfterDeny() {
+	handler := NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, nil, nil, config.Authorization{
+		PrincipalRateLimit: config.PrincipalRateLimit{Enable: true, RPS: 0.001, Burst: 1},
+	})
+	ctx := context.Background()
+	attr := &authorization.Attributes{Actor: "actor", APIName: "DescribeDomain"}
+
+	s.mockMetricsScope.On("Tagged", metrics.APINameTag("DescribeDomain")).Return(s.mockMetricsScope)
+	s.mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).Return(metrics.Stopwatch{})
+	s.mockAuthorizer.EXPECT().Authorize(ctx, attr).
+		Return(authorization.Result{Decision: authorization.DecisionDeny}, nil).Times(1)
+	s.mockAuthorizer.EXPECT().Authorize(ctx, attr).
+		Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(1)
+	s.mockMetricsScope.On("IncCounter", metrics.CadenceErrUnauthorizedCounter).Once()
+
+	// denied requests don't consume the rate limit of the principal
+	res, err := handler.isAuthorized(ctx, attr, s.mockMetricsScope)
+	s.False(res)
+	s.NoError(err)
+
+	res, err = handler.isAuthorized(ctx, attr, s.mockMetricsScope)
+	s.True(res)
+	s.NoError(err)
+}
+
+func (s *accessControlledHandlerSuite) TestAuthorizeBatch_PrincipalRateLimit() {
+	handler := NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, nil, nil, config.Authorization{
+		PrincipalRateLimit: config.PrincipalRateLimit{Enable: true, RPS: 0.001, Burst: 1},
+	})
+	ctx := context.Background()
+	attrs := []*authorization.Attributes{
+		{Actor: "actor", APIName: "DescribeDomain", DomainName: "domain1"},
+		{Actor: "actor", APIName: "DescribeDomain", DomainName: "domain2"},
+	}
+
+	s.mockMetricsScope.On("Tagged", metrics.APINameTag("DescribeDomain")).Return(s.mockMetricsScope)
+	s.mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).Return(metrics.Stopwatch{})
+	s.mockAuthorizer.EXPECT().Authorize(ctx, gomock.Any()).
+		Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(4)
+	s.mockMetricsScope.On("IncCounter", metrics.CadenceAuthorizationPrincipalThrottledCounter).Once()
+
+	// the batch consumes a single token
+	res, err := handler.authorizeBatch(ctx, attrs, s.mockMetricsScope)
+	s.True(res)
+	s.NoError(err)
+
+	res, err = handler.authorizeBatch(ctx, attrs, s.mockMetricsScope)
+	s.False(res)
+	s.Equal(errPrincipalRateLimitExceeded, err)
+}
+
+func (s *accessControlledHandlerSuite) TestIsAuthorized_AnonymousNotCached() {
+	handler := NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, nil, nil, config.Authorization{
+		DecisionCache: config.AuthorizationDecisionCache{Enable: true},
+	})
+	ctx := context.Background()
+	attr := &authorization.Attributes{APIName: "DescribeDomain", DomainName: "domain"}
+
+	s.mockMetricsScope.On("Tagged", metrics.APINameTag("DescribeDomain")).Return(s.mockMetricsScope)
+	s.mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).
+		Return(metrics.Stopwatch{}).Twice()
+	s.mockAuthorizer.EXPECT().Authorize(ctx, attr).
+		Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(2)
+
+	for i := 0; i < 2; i++ {
+		res, err := handler.isAuthorized(ctx, attr, s.mockMetricsScope)
+		s.True(res)
+		s.NoError(err)
+	}
+}
+
+func (s *accessControlledHandlerSuite) TestIsAuthorized_ShadowMode() {
+	tests := map[string]struct {
+		shadowResult  authorization.Result
+		shadowErr     error
+		expectCounter int
+	}{
+		"agreement": {
+			shadowResult:  authorization.Result{Decision: authorization.DecisionAllow},
+			expectCounter: metrics.CadenceAuthorizationShadowAgreementCounter,
+		},
+		"disagreement": {
+			shadowResult:  authorization.Result{Decision: authorization.DecisionDeny},
+			expectCounter: metrics.CadenceAuthorizationShadowDisagreementCounter,
+		},
+		"shadow failure": {
+			shadowResult:  authorization.Result{Decision: authorization.DecisionDeny},
+			shadowErr:     errors.New("test"),
+			expectCounter: metrics.CadenceAuthorizationShadowFailedCounter,
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			mockScope := &mocks.Scope{}
+			shadowAuthorizer := authorization.NewMockAuthorizer(s.controller)
+			handler := NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, shadowAuthorizer, nil, config.Authorization{})
+			ctx := context.Background()
+			attr := &authorization.Attributes{APIName: "DescribeDomain", DomainName: "domain"}
+
+			compared := make(chan struct{})
+			mockScope.On("Tagged", metrics.APINameTag("DescribeDomain")).Return(mockScope)
+			mockScope.On("StartTimer", metrics.CadenceAuthorizationLatency).
+				Return(metrics.Stopwatch{}).Once()
+			mockScope.On("IncCounter", test.expectCounter).Run(func(mock.Arguments) { close(compared) }).Once()
+			s.mockAuthorizer.EXPECT().Authorize(ctx, attr).
+				Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(1)
+			shadowAuthorizer.EXPECT().Authorize(ctx, attr).Return(test.shadowResult, test.shadowErr).Times(1)
+
+			res, err := handler.isAuthorized(ctx, attr, mockScope)
+			s.True(res)
+			s.NoError(err)
+			select {
+			case <-compared:
+			case <-time.After(time.Second):
+				s.Fail("shadow decision was not compared")
+			}
+			mockScope.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *accessControlledHandlerSuite) TestIsAuthorized_FailedFailOpen() {
+	handler := NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, nil, nil, config.Authorization{
+		FailOpen: true,
+	})
+	ctx := context.Background()
+	attr := &authorization.Attributes{APIName: "DescribeDomain", DomainName: "domain"}
+
+	s.mockMetricsScope.On("Tagged", metrics.APINameTag("DescribeDomain")).Return(s.mockMetricsScope)
+	s.mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).
+		Return(metrics.Stopwatch{}).Once()
+	s.mockAuthorizer.EXPECT().Authorize(ctx, attr).
+		Return(authorization.Result{Decision: authorization.DecisionDeny}, errors.New("test")).
+		Times(1)
+	s.mockMetricsScope.On("IncCounter", metrics.CadenceErrAuthorizeFailedCounter).Once()
+	s.mockMetricsScope.On("IncCounter", metrics.CadenceAuthorizationFailOpenCounter).Once()
+
+	res, err := handler.isAuthorized(ctx, attr, s.mockMetricsScope)
+	s.True(res)
+	s.NoError(err)
+}
+
+func (s *accessControlledHandlerSuite) TestIsAuthorized_FailedFailOpenAdmin() {
+	handler := NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, nil, nil, config.Authorization{
+		FailOpen: true,
+	})
+	ctx := context.Background()
+	attr := &authorization.Attributes{APIName: "DeprecateDomain", DomainName: "domain", Permission: authorization.PermissionAdmin}
+	authErr := errors.New("test")
+
+	s.mockMetricsScope.On("Tagged", metrics.APINameTag("DeprecateDomain")).Return(s.mockMetricsScope)
+	s.mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).
+		Return(metrics.Stopwatch{}).Once()
+	s.mockAuthorizer.EXPECT().Authorize(ctx, attr).
+		Return(authorization.Result{Decision: authorization.DecisionDeny}, authErr).
+		Times(1)
+	s.mockMetricsScope.On("IncCounter", metrics.CadenceErrAuthorizeFailedCounter).Once()
+
+	res, err := handler.isAuthorized(ctx, attr, s.mockMetricsScope)
+	s.False(res)
+	s.Equal(authErr, err)
+	s.mockMetricsScope.AssertNotCalled(s.T(), "IncCounter", metrics.CadenceAuthorizationFailOpenCounter)
+}
+
+type testPrincipalExtractor struct {
+	principal string
+	err       error
+}
+
+func (e *testPrincipalExtractor) ExtractPrincipal(ctx context.Context) (string, error) {
+	return e.principal, e.err
+}
+
+func (s *accessControlledHandlerSuite) TestIsAuthorized_PrincipalExtractor() {
+	handler := NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, nil, nil, config.Authorization{
+		PrincipalExtractor: config.PrincipalExtractor{Type: config.PrincipalExtractorTypeHeader, Header: "x-principal"},
+	})
+	ctx := yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{
+		Headers: map[string]string{"x-principal": "user"},
+	})
+	attr := &authorization.Attributes{}
+
+	s.mockMetricsScope.On("Tagged", metrics.APINameTag("")).Return(s.mockMetricsScope)
+	s.mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).
+		Return(metrics.Stopwatch{}).Once()
+	s.mockAuthorizer.EXPECT().Authorize(ctx, gomock.Any()).
+		Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(1)
+
+	res, err := handler.isAuthorized(ctx, attr, s.mockMetricsScope)
+	s.True(res)
+	s.NoError(err)
+	s.Equal("user", attr.Actor)
+
+	// an explicitly set actor is kept
+	attr = &authorization.Attributes{Actor: "actor"}
+	s.mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).
+		Return(metrics.Stopwatch{}).Once()
+	s.mockAuthorizer.EXPECT().Authorize(ctx, gomock.Any()).
+		Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(1)
+
+	res, err = handler.isAuthorized(ctx, attr, s.mockMetricsScope)
+	s.True(res)
+	s.NoError(err)
+	s.Equal("actor", attr.Actor)
+}
+
+func (s *accessControlledHandlerSuite) TestIsAuthorized_PrincipalExtractorFailed() {
+	extractor := &testPrincipalExtractor{err: errors.New("test")}
+	handler := NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, s.mockAuthorizer, nil, extractor, config.Authorization{})
+
+	res, err := handler.isAuthorized(context.Background(), &authorization.Attributes{}, s.mockMetricsScope)
+	s.False(res)
+	s.Equal(errUnauthorized, err)
+}
+
+func TestPopulateCallerIdentity(t *testing.T) {
+	ctx := yarpctest.ContextWithCall(context.Background(), &yarpctest.Call{Caller: "caller-service"})
+	ctx = peer.NewContext(ctx, &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "worker"}}},
+		}},
+	})
+
+	attr := &authorization.Attributes{}
+	populateCallerIdentity(ctx, attr)
+	assert.Equal(t, "caller-service", attr.CallerService)
+	assert.Equal(t, "CN=worker", attr.TLSSubject)
+	assert.Equal(t, "CN=worker", attr.Actor)
+
+	attr = &authorization.Attributes{Actor: "actor"}
+	populateCallerIdentity(ctx, attr)
+	assert.Equal(t, "actor", attr.Actor)
+
+	attr = &authorization.Attributes{}
+	populateCallerIdentity(context.Background(), attr)
+	assert.Equal(t, authorization.Attributes{}, *attr)
+}
+
+type testBatchAuthorizer struct {
+	*authorization.MockAuthorizer
+	results []authorization.Result
+	err     error
+}
+
+func (a *testBatchAuthorizer) AuthorizeBatch(ctx context.Context, attributes []*authorization.Attributes) ([]authorization.Result, error) {
+	return a.results, a.err
+}
+
+func (s *accessControlledHandlerSuite) TestAuthorizeBatch_Fallback() {
+	ctx := context.Background()
+	attrs := []*authorization.Attributes{
+		{APIName: "DescribeDomain", DomainName: "domain1"},
+		{APIName: "DescribeDomain", DomainName: "domain2"},
+		{APIName: "DescribeDomain", DomainName: "domain3"},
+	}
+
+	s.mockMetricsScope.On("Tagged", metrics.APINameTag("DescribeDomain")).Return(s.mockMetricsScope)
+	s.mockMetricsScope.On("StartTimer", metrics.CadenceAuthorizationLatency).
+		Return(metrics.Stopwatch{}).Twice()
+	s.mockAuthorizer.EXPECT().Authorize(ctx, attrs[0]).
+		Return(authorization.Result{Decision: authorization.DecisionAllow}, nil).Times(1)
+	s.mockAuthorizer.EXPECT().Authorize(ctx, attrs[1]).
+		Return(authorization.Result{Decision: authorization.DecisionDeny}, nil).Times(1)
+	s.mockMetricsScope.On("IncCounter", metrics.CadenceErrUnauthorizedCounter).Once()
+
+	res, err := s.handler.authorizeBatch(ctx, attrs, s.mockMetricsScope)
+	s.False(res)
+	s.NoError(err)
+}
+
+func (s *accessControlledHandlerSuite) TestAuthorizeBatch() {
+	attrs := []*authorization.Attributes{
+		{APIName: "DescribeDomain", DomainName: "domain1"},
+		{APIName: "DescribeDomain", DomainName: "domain2"},
+	}
+	tests := map[string]struct {
+		results     []authorization.Result
+		err         error
+		expectAuth  bool
+		expectErr   bool
+		setupCounts func(scope *mocks.Scope)
+	}{
+		"all allowed": {
+			results: []authorization.Result{
+				{Decision: authorization.DecisionAllow},
+				{Decision: authorization.DecisionAllow},
+			},
+			expectAuth:  true,
+			setupCounts: func(scope *mocks.Scope) {},
+		},
+		"one denied": {
+			results: []authorization.Result{
+				{Decision: authorization.DecisionAllow},
+				{Decision: authorization.DecisionDeny},
+			},
+			setupCounts: func(scope *mocks.Scope) {
+				scope.On("IncCounter", metrics.CadenceErrUnauthorizedCounter).Once()
+			},
+		},
+		"missing results": {
+			results: []authorization.Result{
+				{Decision: authorization.DecisionAllow},
+			},
+			expectErr: true,
+			setupCounts: func(scope *mocks.Scope) {
+				scope.On("IncCounter", metrics.CadenceErrAuthorizeFailedCounter).Once()
+			},
+		},
+		"error": {
+			err:       errors.New("test"),
+			expectErr: true,
+			setupCounts: func(scope *mocks.Scope) {
+				scope.On("IncCounter", metrics.CadenceErrAuthorizeFailedCounter).Once()
+			},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			mockScope := &mocks.Scope{}
+			authorizer := &testBatchAuthorizer{
+				MockAuthorizer: authorization.NewMockAuthorizer(s.controller),
+				results:        test.results,
+				err:            test.err,
+			}
+			handler := NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, authorizer, nil, nil, config.Authorization{})
+
+			mockScope.On("Tagged", metrics.APINameTag("DescribeDomain")).Return(mockScope).Maybe()
+			mockScope.On("StartTimer", metrics.CadenceAuthorizationLatency).
+				Return(metrics.Stopwatch{}).Once()
+			test.setupCounts(mockScope)
+
+			res, err := handler.authorizeBatch(context.Background(), attrs, mockScope)
+			s.Equal(test.expectAuth, res)
+			if test.expectErr {
+				s.Error(err)
+			} else {
+				s.NoError(err)
+			}
+			mockScope.AssertExpectations(s.T())
+		})
+	}
+}
+
+func (s *accessControlledHandlerSuite) TestAuthorizeBatch_FailOpen() {
+	tests := map[string]struct {
+		attrs      []*authorization.Attributes
+		expectAuth bool
+	}{
+		"workflow APIs fail open": {
+			attrs: []*authorization.Attributes{
+				{APIName: "DescribeDomain", DomainName: "domain1", Permission: authorization.PermissionRead},
+				{APIName: "DescribeDomain", DomainName: "domain2", Permission: authorization.PermissionRead},
+			},
+			expectAuth: true,
+		},
+		"admin APIs fail closed": {
+			attrs: []*authorization.Attributes{
+				{APIName: "DescribeDomain", DomainName: "domain1", Permission: authorization.PermissionRead},
+				{APIName: "DescribeDomain", DomainName: "domain2", Permission: authorization.PermissionAdmin},
+			},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			mockScope := &mocks.Scope{}
+			authorizer := &testBatchAuthorizer{
+				MockAuthorizer: authorization.NewMockAuthorizer(s.controller),
+				err:            errors.New("test"),
+			}
+			handler := NewAccessControlledHandlerImpl(s.mockFrontendHandler, s.mockResource, authorizer, nil, nil, config.Authorization{
+				FailOpen: true,
+			})
+
+			mockScope.On("Tagged", metrics.APINameTag("DescribeDomain")).Return(mockScope).Maybe()
+			mockScope.On("StartTimer", metrics.CadenceAuthorizationLatency).
+				Return(metrics.Stopwatch{}).Once()
+			mockScope.On("IncCounter", metrics.CadenceErrAuthorizeFailedCounter).Once()
+			if test.expectAuth {
+				mockScope.On("IncCounter", metrics.CadenceAuthorizationFailOpenCounter).Once()
+			}
+
+			res, err := handler.authorizeAll(context.Background(), test.attrs, mockScope)
+			s.Equal(test.expectAuth, res)
+			if test.expectAuth {
+				s.NoError(err)
+			} else {
+				s.Error(err)
+			}
+			mockScope.AssertExpectations(s.T())
+		})
+	}
+}
@@ -101,6 +101,8 @@ type (
 		UpdateGlobalIsolationGroups(ctx context.Context, request *types.UpdateGlobalIsolationGroupsRequest) (*types.UpdateGlobalIsolationGroupsResponse, error)
 		GetDomainIsolationGroups(ctx context.Context, request *types.GetDomainIsolationGroupsRequest) (*types.GetDomainIsolationGroupsResponse, error)
 		UpdateDomainIsolationGroups(ctx context.Context, request *types.UpdateDomainIsolationGroupsRequest) (*types.UpdateDomainIsolationGroupsResponse, error)
+		RefreshTaskList(context.Context, *types.RefreshTaskListRequest) (*types.RefreshTaskListResponse, error)
+		ResetTaskListAckLevel(context.Context, *types.ResetTaskListAckLevelRequest) error
 	}
 
 	// adminHandlerImpl is an implementation for admin service independent of wire protocol
@@ -859,7 +861,11 @@ func (adh *adminHandlerImpl) GetWorkflowExecutionRawHistoryV2(
 	rawBlobs := rawHistoryResponse.HistoryEventBlobs
 	blobs := []*types.DataBlob{}
 	for _, blob := range rawBlobs {
-		blobs = append(blobs, blob.ToInternal())
+		internalBlob, err := blob.ToInternalE()
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		blobs = append(blobs, internalBlob)
 	}
 
 	result := &types.GetWorkflowExecutionRawHistoryV2Response{
@@ -1787,6 +1793,82 @@ func (adh *adminHandlerImpl) UpdateDomainIsolationGroups(ctx context.Context, re
 	return &types.UpdateDomainIsolationGroupsResponse{}, nil
 }
 
+// RefreshTaskList applies the current partition config to a task list on its owning matching host
+func (adh *adminHandlerImpl) RefreshTaskList(
+	ctx context.Context,
+	request *types.RefreshTaskListRequest,
+) (_ *types.RefreshTaskListResponse, retError error) {
+
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminRefreshTaskListScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return nil, adh.error(errDomainNotSet, scope)
+	}
+	if request.GetTaskList().GetName() == "" {
+		return nil, adh.error(errTaskListNotSet, scope)
+	}
+
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.GetMatchingClient().RefreshTaskList(ctx, &types.MatchingRefreshTaskListRequest{
+		DomainUUID:   domainID,
+		TaskList:     request.TaskList,
+		TaskListType: request.TaskListType,
+	})
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return &types.RefreshTaskListResponse{
+		NumReadPartitions:  resp.GetNumReadPartitions(),
+		NumWritePartitions: resp.GetNumWritePartitions(),
+	}, nil
+}
+
+// ResetTaskListAckLevel resets the ack level of a task list on its owning matching host
+func (adh *adminHandlerImpl) ResetTaskListAckLevel(
+	ctx context.Context,
+	request *types.ResetTaskListAckLevelRequest,
+) (retError error) {
+
+	defer func() { log.CapturePanic(recover(), adh.GetLogger(), &retError) }()
+	scope, sw := adh.startRequestProfile(ctx, metrics.AdminResetTaskListAckLevelScope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if request.GetTaskList().GetName() == "" {
+		return adh.error(errTaskListNotSet, scope)
+	}
+
+	domainID, err := adh.GetDomainCache().GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.GetMatchingClient().ResetTaskListAckLevel(ctx, &types.MatchingResetTaskListAckLevelRequest{
+		DomainUUID:   domainID,
+		TaskList:     request.TaskList,
+		TaskListType: request.TaskListType,
+		AckLevel:     request.GetAckLevel(),
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
 func convertFromDataBlob(blob *types.DataBlob) (interface{}, error) {
 	switch *blob.EncodingType {
 	case types.EncodingTypeJSON:
@@ -399,6 +399,21 @@ func (mr *MockAdminHandlerMockRecorder) ReapplyEvents(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReapplyEvents", reflect.TypeOf((*MockAdminHandler)(nil).ReapplyEvents), arg0, arg1)
 }
 
+// RefreshTaskList mocks base method.
+func (m *MockAdminHandler) RefreshTaskList(arg0 context.Context, arg1 *types.RefreshTaskListRequest) (*types.RefreshTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTaskList", arg0, arg1)
+	ret0, _ := ret[0].(*types.RefreshTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshTaskList indicates an expected call of RefreshTaskList.
+func (mr *MockAdminHandlerMockRecorder) RefreshTaskList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTaskList", reflect.TypeOf((*MockAdminHandler)(nil).RefreshTaskList), arg0, arg1)
+}
+
 // RefreshWorkflowTasks mocks base method.
 func (m *MockAdminHandler) RefreshWorkflowTasks(arg0 context.Context, arg1 *types.RefreshWorkflowTasksRequest) error {
 	m.ctrl.T.Helper()
@@ -455,6 +470,20 @@ func (mr *MockAdminHandlerMockRecorder) ResetQueue(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetQueue", reflect.TypeOf((*MockAdminHandler)(nil).ResetQueue), arg0, arg1)
 }
 
+// ResetTaskListAckLevel mocks base method.
+func (m *MockAdminHandler) ResetTaskListAckLevel(arg0 context.Context, arg1 *types.ResetTaskListAckLevelRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetTaskListAckLevel", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetTaskListAckLevel indicates an expected call of ResetTaskListAckLevel.
+func (mr *MockAdminHandlerMockRecorder) ResetTaskListAckLevel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetTaskListAckLevel", reflect.TypeOf((*MockAdminHandler)(nil).ResetTaskListAckLevel), arg0, arg1)
+}
+
 // RespondCrossClusterTasksCompleted mocks base method.
 func (m *MockAdminHandler) RespondCrossClusterTasksCompleted(arg0 context.Context, arg1 *types.RespondCrossClusterTasksCompletedRequest) (*types.RespondCrossClusterTasksCompletedResponse, error) {
 	m.ctrl.T.Helper()
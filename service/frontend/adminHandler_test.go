@@ -325,6 +325,51 @@ func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2_FailedOnDomain
 	s.Error(err)
 }
 
+func (s *adminHandlerSuite) Test_RefreshTaskList() {
+	ctx := context.Background()
+	taskList := &types.TaskList{Name: "test-task-list"}
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.MatchingClient.EXPECT().RefreshTaskList(ctx, &types.MatchingRefreshTaskListRequest{
+		DomainUUID:   s.domainID,
+		TaskList:     taskList,
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	}).Return(&types.MatchingRefreshTaskListResponse{NumReadPartitions: 2, NumWritePartitions: 3}, nil).Times(1)
+
+	resp, err := s.handler.RefreshTaskList(ctx, &types.RefreshTaskListRequest{
+		Domain:       s.domainName,
+		TaskList:     taskList,
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+	})
+	s.NoError(err)
+	s.Equal(&types.RefreshTaskListResponse{NumReadPartitions: 2, NumWritePartitions: 3}, resp)
+
+	_, err = s.handler.RefreshTaskList(ctx, &types.RefreshTaskListRequest{Domain: s.domainName})
+	s.Equal(errTaskListNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_ResetTaskListAckLevel() {
+	ctx := context.Background()
+	taskList := &types.TaskList{Name: "test-task-list"}
+	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).Times(1)
+	s.mockResource.MatchingClient.EXPECT().ResetTaskListAckLevel(ctx, &types.MatchingResetTaskListAckLevelRequest{
+		DomainUUID:   s.domainID,
+		TaskList:     taskList,
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+		AckLevel:     10,
+	}).Return(nil).Times(1)
+
+	err := s.handler.ResetTaskListAckLevel(ctx, &types.ResetTaskListAckLevelRequest{
+		Domain:       s.domainName,
+		TaskList:     taskList,
+		TaskListType: types.TaskListTypeActivity.Ptr(),
+		AckLevel:     10,
+	})
+	s.NoError(err)
+
+	err = s.handler.ResetTaskListAckLevel(ctx, &types.ResetTaskListAckLevelRequest{Domain: s.domainName})
+	s.Equal(errTaskListNotSet, err)
+}
+
 func (s *adminHandlerSuite) Test_GetWorkflowExecutionRawHistoryV2() {
 	ctx := context.Background()
 	s.mockDomainCache.EXPECT().GetDomainID(s.domainName).Return(s.domainID, nil).AnyTimes()
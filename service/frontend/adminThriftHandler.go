@@ -196,6 +196,18 @@ func (t AdminThriftHandler) DeleteWorkflow(ctx context.Context, request *admin.A
 	return thrift.FromAdminDeleteWorkflowResponse(response), thrift.FromError(err)
 }
 
+// RefreshTaskList applies the current partition config to a task list on its owning matching host
+func (t AdminThriftHandler) RefreshTaskList(ctx context.Context, request *admin.RefreshTaskListRequest) (*admin.RefreshTaskListResponse, error) {
+	response, err := t.h.RefreshTaskList(ctx, thrift.ToAdminRefreshTaskListRequest(request))
+	return thrift.FromAdminRefreshTaskListResponse(response), thrift.FromError(err)
+}
+
+// ResetTaskListAckLevel resets the ack level of a task list on its owning matching host
+func (t AdminThriftHandler) ResetTaskListAckLevel(ctx context.Context, request *admin.ResetTaskListAckLevelRequest) error {
+	err := t.h.ResetTaskListAckLevel(ctx, thrift.ToAdminResetTaskListAckLevelRequest(request))
+	return thrift.FromError(err)
+}
+
 // MaintainCorruptWorkflow deletes dynamic config value from config store based on filter
 func (t AdminThriftHandler) MaintainCorruptWorkflow(ctx context.Context, request *admin.AdminMaintainWorkflowRequest) (*admin.AdminMaintainWorkflowResponse, error) {
 	response, err := t.h.MaintainCorruptWorkflow(ctx, thrift.ToAdminMaintainWorkflowRequest(request))
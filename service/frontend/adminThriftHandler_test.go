@@ -138,6 +138,17 @@ func TestAdminThriftHandler(t *testing.T) {
 		err := th.ResendReplicationTasks(ctx, &admin.ResendReplicationTasksRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
+	t.Run("ResetTaskListAckLevel", func(t *testing.T) {
+		h.EXPECT().ResetTaskListAckLevel(ctx, &types.ResetTaskListAckLevelRequest{}).Return(internalErr).Times(1)
+		err := th.ResetTaskListAckLevel(ctx, &admin.ResetTaskListAckLevelRequest{})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("RefreshTaskList", func(t *testing.T) {
+		h.EXPECT().RefreshTaskList(ctx, &types.RefreshTaskListRequest{}).Return(&types.RefreshTaskListResponse{}, internalErr).Times(1)
+		resp, err := th.RefreshTaskList(ctx, &admin.RefreshTaskListRequest{})
+		assert.Equal(t, admin.RefreshTaskListResponse{NumReadPartitions: common.Int32Ptr(0), NumWritePartitions: common.Int32Ptr(0)}, *resp)
+		assert.Equal(t, expectedErr, err)
+	})
 	t.Run("ResetQueue", func(t *testing.T) {
 		h.EXPECT().ResetQueue(ctx, &types.ResetQueueRequest{}).Return(internalErr).Times(1)
 		err := th.ResetQueue(ctx, &shared.ResetQueueRequest{})
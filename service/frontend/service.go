@@ -30,6 +30,7 @@ import (
 	"github.com/uber/cadence/common/client"
 	"github.com/uber/cadence/common/domain"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 )
@@ -107,6 +108,10 @@ type Config struct {
 	SearchAttributesSizeOfValueLimit  dynamicconfig.IntPropertyFnWithDomainFilter
 	SearchAttributesTotalSizeLimit    dynamicconfig.IntPropertyFnWithDomainFilter
 
+	// upper bounds of the retry policy of started workflows, 0 means no limit
+	RetryPolicyMaxExpirationIntervalInSeconds dynamicconfig.IntPropertyFnWithDomainFilter
+	RetryPolicyMaxAttempts                    dynamicconfig.IntPropertyFnWithDomainFilter
+
 	// VisibilityArchival system protection
 	VisibilityArchivalQueryMaxPageSize dynamicconfig.IntPropertyFn
 
@@ -176,6 +181,8 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, isAdvancedVis
 		SearchAttributesNumberOfKeysLimit:           dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesNumberOfKeysLimit),
 		SearchAttributesSizeOfValueLimit:            dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesSizeOfValueLimit),
 		SearchAttributesTotalSizeLimit:              dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesTotalSizeLimit),
+		RetryPolicyMaxExpirationIntervalInSeconds:   dc.GetIntPropertyFilteredByDomain(dynamicconfig.RetryPolicyMaxExpirationIntervalInSeconds),
+		RetryPolicyMaxAttempts:                      dc.GetIntPropertyFilteredByDomain(dynamicconfig.RetryPolicyMaxAttempts),
 		VisibilityArchivalQueryMaxPageSize:          dc.GetIntProperty(dynamicconfig.VisibilityArchivalQueryMaxPageSize),
 		DisallowQuery:                               dc.GetBoolPropertyFilteredByDomain(dynamicconfig.DisallowQuery),
 		SendRawWorkflowHistory:                      dc.GetBoolPropertyFilteredByDomain(dynamicconfig.SendRawWorkflowHistory),
@@ -302,7 +309,7 @@ func (s *Service) Start() {
 		handler = NewClusterRedirectionHandler(handler, s, s.config, *s.params.ClusterRedirectionPolicy)
 	}
 
-	handler = NewAccessControlledHandlerImpl(handler, s, s.params.Authorizer, s.params.AuthorizationConfig)
+	handler = NewAccessControlledHandlerImpl(handler, s, s.params.Authorizer, s.params.ShadowAuthorizer, s.params.PrincipalExtractor, s.params.AuthorizationConfig)
 
 	// Register the latest (most decorated) handler
 	thriftHandler := NewThriftHandler(handler)
@@ -339,7 +346,8 @@ func (s *Service) Stop() {
 	}
 
 	// initiate graceful shutdown:
-	// 1. Fail rpc health check, this will cause client side load balancer to stop forwarding requests to this node
+	// 1. Fail rpc health check and remove self from the membership ring, this will cause client side load balancer
+	//    and other services to stop forwarding requests to this node
 	// 2. wait for failure detection time
 	// 3. stop taking new requests by returning InternalServiceError
 	// 4. Wait for a second
@@ -349,18 +357,24 @@ func (s *Service) Stop() {
 	failureDetectionTime := common.MaxDuration(0, s.config.ShutdownDrainDuration()-requestDrainTime)
 
 	s.GetLogger().Info("ShutdownHandler: Updating rpc health status to ShuttingDown")
+	metrics.EmitShutdownDrainPhase(s.GetMetricsClient(), metrics.DrainPhaseEvictSelfTagValue)
 	s.handler.UpdateHealthStatus(HealthStatusShuttingDown)
+	s.GetLogger().Info("ShutdownHandler: Evicting self from membership ring")
+	s.GetMembershipResolver().EvictSelf()
 
 	s.GetLogger().Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
+	metrics.EmitShutdownDrainPhase(s.GetMetricsClient(), metrics.DrainPhaseWaitDiscoveryTagValue)
 	time.Sleep(failureDetectionTime)
 
 	s.handler.Stop()
 	s.adminHandler.Stop()
 
 	s.GetLogger().Info("ShutdownHandler: Draining traffic")
+	metrics.EmitShutdownDrainPhase(s.GetMetricsClient(), metrics.DrainPhaseDrainRequestsTagValue)
 	time.Sleep(requestDrainTime)
 
 	close(s.stopC)
+	metrics.EmitShutdownDrainPhase(s.GetMetricsClient(), metrics.DrainPhaseStoppedTagValue)
 	s.Resource.Stop()
 	s.params.Logger.Info("frontend stopped")
 }
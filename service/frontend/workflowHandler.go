@@ -42,6 +42,7 @@ import (
 	"github.com/uber/cadence/common/elasticsearch/validator"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/partition"
 	"github.com/uber/cadence/common/persistence"
@@ -260,6 +261,9 @@ func (wh *WorkflowHandler) Health(ctx context.Context) (*types.HealthStatus, err
 
 	if status != HealthStatusOK {
 		wh.GetLogger().Warn(fmt.Sprintf("Service status is: %v", msg))
+	} else if err := messaging.CheckClientHealth(ctx, wh.GetMessagingClient()); err != nil {
+		wh.GetLogger().Warn("Messaging client health check failed", tag.Error(err))
+		return &types.HealthStatus{Ok: false, Msg: err.Error()}, nil
 	}
 
 	return &types.HealthStatus{
@@ -846,7 +850,7 @@ func (wh *WorkflowHandler) cancelOutstandingPoll(ctx context.Context, err error,
 	if ctx.Err() == context.Canceled {
 		// Our rpc stack does not propagates context cancellation to the other service.  Lets make an explicit
 		// call to matching to notify this poller is gone to prevent any tasks being dispatched to zombie pollers.
-		err = wh.GetMatchingClient().CancelOutstandingPoll(context.Background(), &types.CancelOutstandingPollRequest{
+		_, err = wh.GetMatchingClient().CancelOutstandingPoll(context.Background(), &types.CancelOutstandingPollRequest{
 			DomainUUID:   domainID,
 			TaskListType: common.Int32Ptr(taskListType),
 			TaskList:     taskList,
@@ -2131,7 +2135,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errWorkflowIDTooLong, scope, tags...)
 	}
 
-	if err := common.ValidateRetryPolicy(startRequest.RetryPolicy); err != nil {
+	if err := common.ValidateRetryPolicyWithLimits(startRequest.RetryPolicy, wh.getRetryPolicyLimits(domainName)); err != nil {
 		return nil, wh.error(err, scope, tags...)
 	}
 
@@ -2174,7 +2178,7 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	jitter := startRequest.GetJitterStartSeconds()
 	cron := startRequest.GetCronSchedule()
 	if cron != "" {
-		if _, err := backoff.ValidateSchedule(startRequest.GetCronSchedule()); err != nil {
+		if err := backoff.ValidateCronSchedule(startRequest.GetCronSchedule()); err != nil {
 			return nil, wh.error(err, scope, tags...)
 		}
 	}
@@ -2771,12 +2775,12 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		return nil, wh.error(errInvalidTaskStartToCloseTimeoutSeconds, scope, tags...)
 	}
 
-	if err := common.ValidateRetryPolicy(signalWithStartRequest.RetryPolicy); err != nil {
+	if err := common.ValidateRetryPolicyWithLimits(signalWithStartRequest.RetryPolicy, wh.getRetryPolicyLimits(domainName)); err != nil {
 		return nil, wh.error(err, scope, tags...)
 	}
 
 	if signalWithStartRequest.GetCronSchedule() != "" {
-		if _, err := backoff.ValidateSchedule(signalWithStartRequest.GetCronSchedule()); err != nil {
+		if err := backoff.ValidateCronSchedule(signalWithStartRequest.GetCronSchedule()); err != nil {
 			return nil, wh.error(err, scope, tags...)
 		}
 	}
@@ -4223,6 +4227,13 @@ func (wh *WorkflowHandler) error(err error, scope metrics.Scope, tagsForErrorLog
 	return frontendInternalServiceError("cadence internal uncategorized error, msg: %v", err.Error())
 }
 
+func (wh *WorkflowHandler) getRetryPolicyLimits(domain string) common.RetryPolicyLimits {
+	return common.RetryPolicyLimits{
+		MaxExpirationIntervalInSeconds: int32(wh.config.RetryPolicyMaxExpirationIntervalInSeconds(domain)),
+		MaxMaximumAttempts:             int32(wh.config.RetryPolicyMaxAttempts(domain)),
+	}
+}
+
 func (wh *WorkflowHandler) validateTaskList(t *types.TaskList, scope metrics.Scope, domain string) error {
 	if t == nil || t.GetName() == "" {
 		return errTaskListNotSet
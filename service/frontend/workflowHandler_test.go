@@ -882,6 +882,24 @@ func (s *workflowHandlerSuite) TestHealth_StatusOK() {
 	s.True(result.Ok)
 }
 
+func (s *workflowHandlerSuite) TestHealth_MessagingClientUnhealthy() {
+	wh := s.getWorkflowHandler(s.newConfig(dc.NewInMemoryClient()))
+	wh.UpdateHealthStatus(HealthStatusOK)
+	s.mockResource.MessagingClient = &healthCheckMessagingClient{err: errors.New("kafka brokers of topic test-topic are unreachable")}
+
+	result, err := wh.Health(context.Background())
+
+	s.NoError(err)
+	s.False(result.Ok)
+	s.Equal("kafka brokers of topic test-topic are unreachable", result.Msg)
+
+	s.mockResource.MessagingClient = &healthCheckMessagingClient{}
+	result, err = wh.Health(context.Background())
+
+	s.NoError(err)
+	s.True(result.Ok)
+}
+
 func (s *workflowHandlerSuite) TestDescribeDomain_Success_ArchivalDisabled() {
 	getDomainResp := persistenceGetDomainResponse(
 		&domain.ArchivalState{Status: types.ArchivalStatusDisabled, URI: ""},
@@ -2063,3 +2081,12 @@ var describeDomainResponseServer = &types.DescribeDomainResponse{
 		},
 	},
 }
+
+type healthCheckMessagingClient struct {
+	messaging.Client
+	err error
+}
+
+func (c *healthCheckMessagingClient) Healthy(context.Context) error {
+	return c.err
+}
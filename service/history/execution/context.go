@@ -1365,12 +1365,16 @@ func (c *contextImpl) ReapplyEvents(
 			Message: fmt.Sprintf("cannot find cluster config %v to do reapply", activeCluster),
 		}
 	}
+	events, err := reapplyEventsDataBlob.ToInternalE()
+	if err != nil {
+		return err
+	}
 	return sourceCluster.ReapplyEvents(
 		ctx,
 		&types.ReapplyEventsRequest{
 			DomainName:        domainEntry.GetInfo().Name,
 			WorkflowExecution: execution,
-			Events:            reapplyEventsDataBlob.ToInternal(),
+			Events:            events,
 		},
 	)
 }
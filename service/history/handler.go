@@ -41,6 +41,7 @@ import (
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/membership"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
@@ -289,6 +290,10 @@ func (h *handlerImpl) CreateEngine(
 func (h *handlerImpl) Health(ctx context.Context) (*types.HealthStatus, error) {
 	h.startWG.Wait()
 	h.GetLogger().Debug("History health check endpoint reached.")
+	if err := messaging.CheckClientHealth(ctx, h.GetMessagingClient()); err != nil {
+		h.GetLogger().Warn("Messaging client health check failed", tag.Error(err))
+		return &types.HealthStatus{Ok: false, Msg: err.Error()}, nil
+	}
 	hs := &types.HealthStatus{Ok: true, Msg: "OK"}
 	return hs, nil
 }
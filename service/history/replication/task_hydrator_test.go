@@ -755,6 +755,18 @@ func TestImmediateTaskHydrator(t *testing.T) {
 			},
 			expectErr: "history blob not set",
 		},
+		{
+			name:             "history task - corrupted data blob",
+			versionHistories: versionHistories,
+			blob:             persistence.NewDataBlob([]byte("not snappy"), common.EncodingTypeThriftRWSnappy),
+			task: persistence.ReplicationTaskInfo{
+				TaskType:     persistence.ReplicationTaskTypeHistory,
+				FirstEventID: testFirstEventID,
+				Version:      testVersion,
+				BranchToken:  testBranchToken,
+			},
+			expectErr: `cadence deserialization error: failed to decompress blob encoding: "thriftrw-snappy", error: snappy: corrupt input`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -27,6 +27,7 @@ import (
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
 	commonResource "github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/service/history/config"
@@ -130,16 +131,21 @@ func (s *Service) Stop() {
 	remainingTime := s.config.ShutdownDrainDuration()
 
 	s.GetLogger().Info("ShutdownHandler: Evicting self from membership ring")
+	metrics.EmitShutdownDrainPhase(s.GetMetricsClient(), metrics.DrainPhaseEvictSelfTagValue)
 	s.GetMembershipResolver().EvictSelf()
 
 	s.GetLogger().Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
+	metrics.EmitShutdownDrainPhase(s.GetMetricsClient(), metrics.DrainPhaseWaitDiscoveryTagValue)
 	remainingTime = common.SleepWithMinDuration(gossipPropagationDelay, remainingTime)
 
+	s.GetLogger().Info("ShutdownHandler: Draining traffic")
+	metrics.EmitShutdownDrainPhase(s.GetMetricsClient(), metrics.DrainPhaseDrainRequestsTagValue)
 	remainingTime = s.handler.PrepareToStop(remainingTime)
 	_ = common.SleepWithMinDuration(gracePeriod, remainingTime)
 
 	close(s.stopC)
 
+	metrics.EmitShutdownDrainPhase(s.GetMetricsClient(), metrics.DrainPhaseStoppedTagValue)
 	s.handler.Stop()
 	s.Resource.Stop()
 
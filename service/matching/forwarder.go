@@ -143,6 +143,8 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *InternalTask) erro
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.name,
 			PartitionConfig:               task.event.PartitionConfig,
+			Priority:                      task.event.Priority,
+			ForwardingDepth:               task.forwardingDepth + 1,
 		})
 	case persistence.TaskListTypeActivity:
 		err = fwdr.client.AddActivityTask(ctx, &types.AddActivityTaskRequest{
@@ -158,6 +160,7 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *InternalTask) erro
 			Source:                        &task.source,
 			ForwardedFrom:                 fwdr.taskListID.name,
 			PartitionConfig:               task.event.PartitionConfig,
+			Priority:                      task.event.Priority,
 		})
 	default:
 		return errInvalidTaskListType
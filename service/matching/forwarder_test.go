@@ -92,6 +92,7 @@ func (t *ForwarderTestSuite) TestForwardDecisionTask() {
 
 	taskInfo := t.newTaskInfo()
 	task := newInternalTask(taskInfo, nil, types.TaskSourceHistory, "", false, nil, "")
+	task.forwardingDepth = 2
 	t.NoError(t.fwdr.ForwardTask(context.Background(), task))
 	t.NotNil(request)
 	t.Equal(t.taskList.Parent(20), request.TaskList.GetName())
@@ -102,6 +103,7 @@ func (t *ForwarderTestSuite) TestForwardDecisionTask() {
 	t.Equal(taskInfo.ScheduleID, request.GetScheduleID())
 	t.Equal(taskInfo.ScheduleToStartTimeout, request.GetScheduleToStartTimeoutSeconds())
 	t.Equal(t.taskList.name, request.GetForwardedFrom())
+	t.Equal(int32(3), request.GetForwardingDepth())
 }
 
 func (t *ForwarderTestSuite) TestForwardActivityTask() {
@@ -22,25 +22,31 @@ package matching
 
 import (
 	"context"
+	"time"
 
 	apiv1 "github.com/uber/cadence-idl/go/proto/api/v1"
 	"go.uber.org/yarpc"
 
 	matchingv1 "github.com/uber/cadence/.gen/proto/matching/v1"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/types/mapper/proto"
 )
 
 type grpcHandler struct {
-	h Handler
+	h      Handler
+	logger log.Logger
 }
 
-func newGRPCHandler(h Handler) grpcHandler {
-	return grpcHandler{h}
+func newGRPCHandler(h Handler, logger log.Logger) grpcHandler {
+	return grpcHandler{h, logger}
 }
 
 func (g grpcHandler) register(dispatcher *yarpc.Dispatcher) {
 	dispatcher.Register(matchingv1.BuildMatchingAPIYARPCProcedures(g))
 	dispatcher.Register(apiv1.BuildMetaAPIYARPCProcedures(g))
+	dispatcher.Register(matchingv1.BuildMatchingHealthAPIYARPCProcedures(g))
 }
 
 func (g grpcHandler) Health(ctx context.Context, _ *apiv1.HealthRequest) (*apiv1.HealthResponse, error) {
@@ -48,52 +54,123 @@ func (g grpcHandler) Health(ctx context.Context, _ *apiv1.HealthRequest) (*apiv1
 	return proto.FromHealthResponse(response), proto.FromError(err)
 }
 
+func (g grpcHandler) HealthDetails(ctx context.Context, _ *matchingv1.HealthDetailsRequest) (*matchingv1.HealthDetailsResponse, error) {
+	response, err := g.h.HealthDetails(ctx)
+	return proto.FromMatchingHealthDetailsResponse(response), proto.FromError(err)
+}
+
 func (g grpcHandler) AddActivityTask(ctx context.Context, request *matchingv1.AddActivityTaskRequest) (*matchingv1.AddActivityTaskResponse, error) {
+	logTimeout := g.deadlineLogger(ctx, "AddActivityTask")
 	err := g.h.AddActivityTask(ctx, proto.ToMatchingAddActivityTaskRequest(request))
+	logTimeout(err)
 	return &matchingv1.AddActivityTaskResponse{}, proto.FromError(err)
 }
 
 func (g grpcHandler) AddDecisionTask(ctx context.Context, request *matchingv1.AddDecisionTaskRequest) (*matchingv1.AddDecisionTaskResponse, error) {
-	err := g.h.AddDecisionTask(ctx, proto.ToMatchingAddDecisionTaskRequest(request))
-	return &matchingv1.AddDecisionTaskResponse{}, proto.FromError(err)
+	logTimeout := g.deadlineLogger(ctx, "AddDecisionTask")
+	response, err := g.h.AddDecisionTask(ctx, proto.ToMatchingAddDecisionTaskRequest(request))
+	logTimeout(err)
+	return proto.FromMatchingAddDecisionTaskResponse(response), proto.FromError(err)
 }
 
 func (g grpcHandler) CancelOutstandingPoll(ctx context.Context, request *matchingv1.CancelOutstandingPollRequest) (*matchingv1.CancelOutstandingPollResponse, error) {
-	err := g.h.CancelOutstandingPoll(ctx, proto.ToMatchingCancelOutstandingPollRequest(request))
-	return &matchingv1.CancelOutstandingPollResponse{}, proto.FromError(err)
+	logTimeout := g.deadlineLogger(ctx, "CancelOutstandingPoll")
+	response, err := g.h.CancelOutstandingPoll(ctx, proto.ToMatchingCancelOutstandingPollRequest(request))
+	logTimeout(err)
+	return proto.FromMatchingCancelOutstandingPollResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) DescribePoller(ctx context.Context, request *matchingv1.DescribePollerRequest) (*matchingv1.DescribePollerResponse, error) {
+	logTimeout := g.deadlineLogger(ctx, "DescribePoller")
+	response, err := g.h.DescribePoller(ctx, proto.ToMatchingDescribePollerRequest(request))
+	logTimeout(err)
+	return proto.FromMatchingDescribePollerResponse(response), proto.FromError(err)
 }
 
 func (g grpcHandler) DescribeTaskList(ctx context.Context, request *matchingv1.DescribeTaskListRequest) (*matchingv1.DescribeTaskListResponse, error) {
+	logTimeout := g.deadlineLogger(ctx, "DescribeTaskList")
 	response, err := g.h.DescribeTaskList(ctx, proto.ToMatchingDescribeTaskListRequest(request))
+	logTimeout(err)
 	return proto.FromMatchingDescribeTaskListResponse(response), proto.FromError(err)
 }
 
 func (g grpcHandler) ListTaskListPartitions(ctx context.Context, request *matchingv1.ListTaskListPartitionsRequest) (*matchingv1.ListTaskListPartitionsResponse, error) {
+	logTimeout := g.deadlineLogger(ctx, "ListTaskListPartitions")
 	response, err := g.h.ListTaskListPartitions(ctx, proto.ToMatchingListTaskListPartitionsRequest(request))
+	logTimeout(err)
 	return proto.FromMatchingListTaskListPartitionsResponse(response), proto.FromError(err)
 }
 
 func (g grpcHandler) GetTaskListsByDomain(ctx context.Context, request *matchingv1.GetTaskListsByDomainRequest) (*matchingv1.GetTaskListsByDomainResponse, error) {
+	logTimeout := g.deadlineLogger(ctx, "GetTaskListsByDomain")
 	response, err := g.h.GetTaskListsByDomain(ctx, proto.ToMatchingGetTaskListsByDomainRequest(request))
+	logTimeout(err)
 	return proto.FromMatchingGetTaskListsByDomainResponse(response), proto.FromError(err)
 }
 
 func (g grpcHandler) PollForActivityTask(ctx context.Context, request *matchingv1.PollForActivityTaskRequest) (*matchingv1.PollForActivityTaskResponse, error) {
+	logTimeout := g.deadlineLogger(ctx, "PollForActivityTask")
 	response, err := g.h.PollForActivityTask(ctx, proto.ToMatchingPollForActivityTaskRequest(request))
+	logTimeout(err)
 	return proto.FromMatchingPollForActivityTaskResponse(response), proto.FromError(err)
 }
 
 func (g grpcHandler) PollForDecisionTask(ctx context.Context, request *matchingv1.PollForDecisionTaskRequest) (*matchingv1.PollForDecisionTaskResponse, error) {
+	logTimeout := g.deadlineLogger(ctx, "PollForDecisionTask")
 	response, err := g.h.PollForDecisionTask(ctx, proto.ToMatchingPollForDecisionTaskRequest(request))
+	logTimeout(err)
 	return proto.FromMatchingPollForDecisionTaskResponse(response), proto.FromError(err)
 }
 
 func (g grpcHandler) QueryWorkflow(ctx context.Context, request *matchingv1.QueryWorkflowRequest) (*matchingv1.QueryWorkflowResponse, error) {
+	logTimeout := g.deadlineLogger(ctx, "QueryWorkflow")
 	response, err := g.h.QueryWorkflow(ctx, proto.ToMatchingQueryWorkflowRequest(request))
+	logTimeout(err)
 	return proto.FromMatchingQueryWorkflowResponse(response), proto.FromError(err)
 }
 
 func (g grpcHandler) RespondQueryTaskCompleted(ctx context.Context, request *matchingv1.RespondQueryTaskCompletedRequest) (*matchingv1.RespondQueryTaskCompletedResponse, error) {
+	logTimeout := g.deadlineLogger(ctx, "RespondQueryTaskCompleted")
 	err := g.h.RespondQueryTaskCompleted(ctx, proto.ToMatchingRespondQueryTaskCompletedRequest(request))
+	logTimeout(err)
 	return &matchingv1.RespondQueryTaskCompletedResponse{}, proto.FromError(err)
 }
+
+func (g grpcHandler) ResetTaskListAckLevel(ctx context.Context, request *matchingv1.ResetTaskListAckLevelRequest) (*matchingv1.ResetTaskListAckLevelResponse, error) {
+	logTimeout := g.deadlineLogger(ctx, "ResetTaskListAckLevel")
+	err := g.h.ResetTaskListAckLevel(ctx, proto.ToMatchingResetTaskListAckLevelRequest(request))
+	logTimeout(err)
+	return &matchingv1.ResetTaskListAckLevelResponse{}, proto.FromError(err)
+}
+
+func (g grpcHandler) RefreshTaskList(ctx context.Context, request *matchingv1.RefreshTaskListRequest) (*matchingv1.RefreshTaskListResponse, error) {
+	logTimeout := g.deadlineLogger(ctx, "RefreshTaskList")
+	response, err := g.h.RefreshTaskList(ctx, proto.ToMatchingRefreshTaskListRequest(request))
+	logTimeout(err)
+	return proto.FromMatchingRefreshTaskListResponse(response), proto.FromError(err)
+}
+
+func (g grpcHandler) GetTaskListConfig(ctx context.Context, request *matchingv1.GetTaskListConfigRequest) (*matchingv1.GetTaskListConfigResponse, error) {
+	logTimeout := g.deadlineLogger(ctx, "GetTaskListConfig")
+	response, err := g.h.GetTaskListConfig(ctx, proto.ToMatchingGetTaskListConfigRequest(request))
+	logTimeout(err)
+	return proto.FromMatchingGetTaskListConfigResponse(response), proto.FromError(err)
+}
+
+// deadlineLogger records the remaining deadline budget of ctx at entry and returns a function which
+// logs it along with the operation name when the call fails with a context timeout error.
+// A small budget at entry means the caller timed out, otherwise the time was spent in matching.
+func (g grpcHandler) deadlineLogger(ctx context.Context, operation string) func(error) {
+	deadline, hasDeadline := ctx.Deadline()
+	budget := time.Until(deadline)
+	return func(err error) {
+		if err == nil || !common.IsContextTimeoutError(err) {
+			return
+		}
+		tags := []tag.Tag{tag.OperationName(operation), tag.Error(err)}
+		if hasDeadline {
+			tags = append(tags, tag.ContextDeadlineBudget(budget))
+		}
+		g.logger.Warn("Matching request timed out", tags...)
+	}
+}
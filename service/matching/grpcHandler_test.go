@@ -0,0 +1,62 @@
+// Copyright (c) 2020 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	matchingv1 "github.com/uber/cadence/.gen/proto/matching/v1"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestGRPCHandlerDeadlineLogging(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	h := NewMockHandler(ctrl)
+	logger := &log.MockLogger{}
+	g := newGRPCHandler(h, logger)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	t.Run("context timeout is logged", func(t *testing.T) {
+		h.EXPECT().PollForDecisionTask(ctx, gomock.Any()).Return(nil, context.DeadlineExceeded).Times(1)
+		logger.On("Warn", "Matching request timed out", mock.Anything).Once()
+
+		_, err := g.PollForDecisionTask(ctx, &matchingv1.PollForDecisionTaskRequest{})
+		assert.Error(t, err)
+		logger.AssertExpectations(t)
+	})
+	t.Run("other errors are not logged", func(t *testing.T) {
+		h.EXPECT().PollForDecisionTask(ctx, gomock.Any()).Return(nil, &types.InternalServiceError{Message: "test"}).Times(1)
+
+		_, err := g.PollForDecisionTask(ctx, &matchingv1.PollForDecisionTaskRequest{})
+		assert.Error(t, err)
+		logger.AssertNumberOfCalls(t, "Warn", 1)
+	})
+}
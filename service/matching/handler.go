@@ -24,6 +24,7 @@ package matching
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/types"
 )
@@ -43,9 +45,10 @@ type (
 		common.Daemon
 
 		Health(context.Context) (*types.HealthStatus, error)
+		HealthDetails(context.Context) (*types.MatchingHealthDetailsResponse, error)
 		AddActivityTask(context.Context, *types.AddActivityTaskRequest) error
-		AddDecisionTask(context.Context, *types.AddDecisionTaskRequest) error
-		CancelOutstandingPoll(context.Context, *types.CancelOutstandingPollRequest) error
+		AddDecisionTask(context.Context, *types.AddDecisionTaskRequest) (*types.AddDecisionTaskResponse, error)
+		CancelOutstandingPoll(context.Context, *types.CancelOutstandingPollRequest) (*types.CancelOutstandingPollResponse, error)
 		DescribeTaskList(context.Context, *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error)
 		ListTaskListPartitions(context.Context, *types.MatchingListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error)
 		GetTaskListsByDomain(context.Context, *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
@@ -53,11 +56,16 @@ type (
 		PollForDecisionTask(context.Context, *types.MatchingPollForDecisionTaskRequest) (*types.MatchingPollForDecisionTaskResponse, error)
 		QueryWorkflow(context.Context, *types.MatchingQueryWorkflowRequest) (*types.QueryWorkflowResponse, error)
 		RespondQueryTaskCompleted(context.Context, *types.MatchingRespondQueryTaskCompletedRequest) error
+		GetTaskListConfig(context.Context, *types.MatchingGetTaskListConfigRequest) (*types.GetTaskListConfigResponse, error)
+		ResetTaskListAckLevel(context.Context, *types.MatchingResetTaskListAckLevelRequest) error
+		RefreshTaskList(context.Context, *types.MatchingRefreshTaskListRequest) (*types.MatchingRefreshTaskListResponse, error)
+		DescribePoller(context.Context, *types.MatchingDescribePollerRequest) (*types.MatchingDescribePollerResponse, error)
 	}
 
 	// handlerImpl is an implementation for matching service independent of wire protocol
 	handlerImpl struct {
 		engine            Engine
+		config            *Config
 		metricsClient     metrics.Client
 		startWG           sync.WaitGroup
 		userRateLimiter   quotas.Policy
@@ -98,6 +106,7 @@ func NewHandler(
 			)),
 		),
 		engine:          engine,
+		config:          config,
 		logger:          logger,
 		throttledLogger: throttledLogger,
 		domainCache:     domainCache,
@@ -125,6 +134,13 @@ func (h *handlerImpl) Health(ctx context.Context) (*types.HealthStatus, error) {
 	return hs, nil
 }
 
+// HealthDetails is for readiness check, it reports the status of each component matching depends on
+func (h *handlerImpl) HealthDetails(ctx context.Context) (resp *types.MatchingHealthDetailsResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+	h.startWG.Wait()
+	return h.engine.HealthDetails(ctx), nil
+}
+
 func (h *handlerImpl) newHandlerContext(
 	ctx context.Context,
 	domainName string,
@@ -168,6 +184,10 @@ func (h *handlerImpl) AddActivityTask(
 		return hCtx.handleErr(errMatchingHostThrottle)
 	}
 
+	if err := validateTaskPriority(request.GetPriority()); err != nil {
+		return hCtx.handleErr(err)
+	}
+
 	syncMatch, err := h.engine.AddActivityTask(hCtx, request)
 	if syncMatch {
 		hCtx.scope.RecordTimer(metrics.SyncMatchLatencyPerTaskList, time.Since(startT))
@@ -176,11 +196,12 @@ func (h *handlerImpl) AddActivityTask(
 	return hCtx.handleErr(err)
 }
 
-// AddDecisionTask - adds a decision task.
+// AddDecisionTask - adds a decision task. The response reports the number of partition hops the task took
+// to reach the partition handling this request, which is also recorded by the forwarding depth metric of the task list.
 func (h *handlerImpl) AddDecisionTask(
 	ctx context.Context,
 	request *types.AddDecisionTaskRequest,
-) (retError error) {
+) (resp *types.AddDecisionTaskResponse, retError error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
 
 	startT := time.Now()
@@ -200,14 +221,28 @@ func (h *handlerImpl) AddDecisionTask(
 	}
 
 	if ok := h.workerRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
-		return hCtx.handleErr(errMatchingHostThrottle)
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	if err := validateTaskPriority(request.GetPriority()); err != nil {
+		return nil, hCtx.handleErr(err)
+	}
+
+	maxDepth := h.config.MaxForwardingDepth(domainName, request.GetTaskList().GetName(), persistence.TaskListTypeDecision)
+	if err := validateForwardingDepth(request.GetForwardingDepth(), maxDepth); err != nil {
+		hCtx.scope.IncCounter(metrics.ForwardingDepthExceededPerTaskListCounter)
+		return nil, hCtx.handleErr(err)
 	}
 
 	syncMatch, err := h.engine.AddDecisionTask(hCtx, request)
 	if syncMatch {
 		hCtx.scope.RecordTimer(metrics.SyncMatchLatencyPerTaskList, time.Since(startT))
 	}
-	return hCtx.handleErr(err)
+	if err != nil {
+		return nil, hCtx.handleErr(err)
+	}
+	hCtx.scope.RecordHistogramValue(metrics.ForwardingDepthPerTaskList, float64(request.GetForwardingDepth()))
+	return &types.AddDecisionTaskResponse{ForwardingDepth: request.GetForwardingDepth()}, nil
 }
 
 // PollForActivityTask - long poll for an activity task.
@@ -340,9 +375,10 @@ func (h *handlerImpl) RespondQueryTaskCompleted(
 	return hCtx.handleErr(err)
 }
 
-// CancelOutstandingPoll is used to cancel outstanding pollers
+// CancelOutstandingPoll is used to cancel outstanding pollers, either a single poller or all of
+// the pollers of the task list when CancelAll is set
 func (h *handlerImpl) CancelOutstandingPoll(ctx context.Context,
-	request *types.CancelOutstandingPollRequest) (retError error) {
+	request *types.CancelOutstandingPollRequest) (resp *types.CancelOutstandingPollResponse, retError error) {
 	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
 
 	domainName := h.domainName(request.GetDomainUUID())
@@ -359,8 +395,43 @@ func (h *handlerImpl) CancelOutstandingPoll(ctx context.Context,
 	// Count the request in the RPS, but we still accept it even if RPS is exceeded
 	h.workerRateLimiter.Allow(quotas.Info{Domain: domainName})
 
-	err := h.engine.CancelOutstandingPoll(hCtx, request)
-	return hCtx.handleErr(err)
+	response, err := h.engine.CancelOutstandingPoll(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
+// DescribePoller returns information about a single poller of the target tasklist: when it last polled,
+// its isolation group and the ids of its outstanding polls, so that they can be inspected before being
+// cancelled with CancelOutstandingPoll.
+func (h *handlerImpl) DescribePoller(
+	ctx context.Context,
+	request *types.MatchingDescribePollerRequest,
+) (resp *types.MatchingDescribePollerResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetTaskList(),
+		metrics.MatchingDescribePollerScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	if request.GetTaskList().GetName() == "" {
+		return nil, hCtx.handleErr(&types.BadRequestError{Message: "TaskList is not set on request."})
+	}
+	if request.GetIdentity() == "" {
+		return nil, hCtx.handleErr(&types.BadRequestError{Message: "Identity is not set on request."})
+	}
+
+	response, err := h.engine.DescribePoller(hCtx, request)
+	return response, hCtx.handleErr(err)
 }
 
 // DescribeTaskList returns information about the target tasklist, right now this API returns the
@@ -445,6 +516,142 @@ func (h *handlerImpl) GetTaskListsByDomain(
 	return response, hCtx.handleErr(err)
 }
 
+// GetTaskListConfig returns the dynamic config values as currently resolved for the target tasklist
+func (h *handlerImpl) GetTaskListConfig(
+	ctx context.Context,
+	request *types.MatchingGetTaskListConfigRequest,
+) (resp *types.GetTaskListConfigResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetTaskList(),
+		metrics.MatchingGetTaskListConfigScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	taskListID, err := newTaskListID(request.GetDomainUUID(), request.GetTaskList().GetName(), int(request.GetTaskListType()))
+	if err != nil {
+		return nil, hCtx.handleErr(err)
+	}
+	config, err := newTaskListConfig(taskListID, h.config, h.domainCache)
+	if err != nil {
+		return nil, hCtx.handleErr(err)
+	}
+
+	return &types.GetTaskListConfigResponse{
+		NumReadPartitions:                int32(config.NumReadPartitions()),
+		NumWritePartitions:               int32(config.NumWritePartitions()),
+		EnableSyncMatch:                  config.EnableSyncMatch(),
+		EnableTasklistIsolation:          config.EnableTasklistIsolation(),
+		GetTasksBatchSize:                int32(config.GetTasksBatchSize()),
+		MaxTaskBatchSize:                 int32(config.MaxTaskBatchSize()),
+		MaxTaskDeleteBatchSize:           int32(config.MaxTaskDeleteBatchSize()),
+		MinTaskThrottlingBurstSize:       int32(config.MinTaskThrottlingBurstSize()),
+		OutstandingTaskAppendsThreshold:  int32(config.OutstandingTaskAppendsThreshold()),
+		ForwarderMaxOutstandingPolls:     int32(config.ForwarderMaxOutstandingPolls()),
+		ForwarderMaxOutstandingTasks:     int32(config.ForwarderMaxOutstandingTasks()),
+		ForwarderMaxRatePerSecond:        int32(config.ForwarderMaxRatePerSecond()),
+		ForwarderMaxChildrenPerNode:      int32(config.ForwarderMaxChildrenPerNode()),
+		UpdateAckIntervalSeconds:         durationToSecondsInt32Ptr(config.UpdateAckInterval()),
+		IdleTaskListCheckIntervalSeconds: durationToSecondsInt32Ptr(config.IdleTasklistCheckInterval()),
+		MaxTaskListIdleTimeSeconds:       durationToSecondsInt32Ptr(config.MaxTasklistIdleTime()),
+		LongPollExpirationSeconds:        durationToSecondsInt32Ptr(config.LongPollExpirationInterval()),
+		AsyncTaskDispatchTimeoutSeconds:  durationToSecondsInt32Ptr(config.AsyncTaskDispatchTimeout()),
+	}, nil
+}
+
+// ResetTaskListAckLevel resets the ack level of the target tasklist so that its backlog is read from the new level.
+// It is used by operators through the admin API to recover from a corrupted backlog, and is rejected by hosts
+// which don't own the tasklist.
+func (h *handlerImpl) ResetTaskListAckLevel(
+	ctx context.Context,
+	request *types.MatchingResetTaskListAckLevelRequest,
+) (retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetTaskList(),
+		metrics.MatchingResetTaskListAckLevelScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	if request.GetTaskList().GetName() == "" {
+		return hCtx.handleErr(&types.BadRequestError{Message: "TaskList is not set on request."})
+	}
+
+	err := h.engine.ResetTaskListAckLevel(hCtx, request)
+	return hCtx.handleErr(err)
+}
+
+// RefreshTaskList applies the current partition config to the target tasklist without waiting for it to be
+// unloaded when idle, and returns the partition counts it's running with.
+func (h *handlerImpl) RefreshTaskList(
+	ctx context.Context,
+	request *types.MatchingRefreshTaskListRequest,
+) (resp *types.MatchingRefreshTaskListResponse, retError error) {
+	defer func() { log.CapturePanic(recover(), h.logger, &retError) }()
+
+	domainName := h.domainName(request.GetDomainUUID())
+	hCtx := h.newHandlerContext(
+		ctx,
+		domainName,
+		request.GetTaskList(),
+		metrics.MatchingRefreshTaskListScope,
+	)
+
+	sw := hCtx.startProfiling(&h.startWG)
+	defer sw.Stop()
+
+	if ok := h.userRateLimiter.Allow(quotas.Info{Domain: domainName}); !ok {
+		return nil, hCtx.handleErr(errMatchingHostThrottle)
+	}
+
+	if request.GetTaskList().GetName() == "" {
+		return nil, hCtx.handleErr(&types.BadRequestError{Message: "TaskList is not set on request."})
+	}
+
+	response, err := h.engine.RefreshTaskList(hCtx, request)
+	return response, hCtx.handleErr(err)
+}
+
+func validateTaskPriority(priority int32) error {
+	if priority < 0 || priority > common.MaxTaskPriority {
+		return &types.BadRequestError{
+			Message: fmt.Sprintf("Task priority %v is out of range [0, %v].", priority, common.MaxTaskPriority),
+		}
+	}
+	return nil
+}
+
+// validateForwardingDepth rejects the tasks forwarded through more partitions than maxDepth, which points
+// to a forwarding loop or a misconfigured partition tree. A non-positive maxDepth disables the check.
+func validateForwardingDepth(depth int32, maxDepth int) error {
+	if maxDepth > 0 && int(depth) > maxDepth {
+		return &types.LimitExceededError{
+			Message: fmt.Sprintf("Task forwarding depth %v exceeds the max forwarding depth %v.", depth, maxDepth),
+		}
+	}
+	return nil
+}
+
 func (h *handlerImpl) domainName(id string) string {
 	domainName, err := h.domainCache.GetDomainName(id)
 	if err != nil {
@@ -452,3 +659,7 @@ func (h *handlerImpl) domainName(id string) string {
 	}
 	return domainName
 }
+
+func durationToSecondsInt32Ptr(d time.Duration) *int32 {
+	return common.Int32Ptr(int32(common.DurationToSeconds(d)))
+}
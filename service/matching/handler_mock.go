@@ -73,11 +73,12 @@ func (mr *MockHandlerMockRecorder) AddActivityTask(arg0, arg1 interface{}) *gomo
 }
 
 // AddDecisionTask mocks base method.
-func (m *MockHandler) AddDecisionTask(arg0 context.Context, arg1 *types.AddDecisionTaskRequest) error {
+func (m *MockHandler) AddDecisionTask(arg0 context.Context, arg1 *types.AddDecisionTaskRequest) (*types.AddDecisionTaskResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddDecisionTask", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*types.AddDecisionTaskResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // AddDecisionTask indicates an expected call of AddDecisionTask.
@@ -87,11 +88,12 @@ func (mr *MockHandlerMockRecorder) AddDecisionTask(arg0, arg1 interface{}) *gomo
 }
 
 // CancelOutstandingPoll mocks base method.
-func (m *MockHandler) CancelOutstandingPoll(arg0 context.Context, arg1 *types.CancelOutstandingPollRequest) error {
+func (m *MockHandler) CancelOutstandingPoll(arg0 context.Context, arg1 *types.CancelOutstandingPollRequest) (*types.CancelOutstandingPollResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CancelOutstandingPoll", arg0, arg1)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*types.CancelOutstandingPollResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CancelOutstandingPoll indicates an expected call of CancelOutstandingPoll.
@@ -100,6 +102,21 @@ func (mr *MockHandlerMockRecorder) CancelOutstandingPoll(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOutstandingPoll", reflect.TypeOf((*MockHandler)(nil).CancelOutstandingPoll), arg0, arg1)
 }
 
+// DescribePoller mocks base method.
+func (m *MockHandler) DescribePoller(arg0 context.Context, arg1 *types.MatchingDescribePollerRequest) (*types.MatchingDescribePollerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribePoller", arg0, arg1)
+	ret0, _ := ret[0].(*types.MatchingDescribePollerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribePoller indicates an expected call of DescribePoller.
+func (mr *MockHandlerMockRecorder) DescribePoller(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribePoller", reflect.TypeOf((*MockHandler)(nil).DescribePoller), arg0, arg1)
+}
+
 // DescribeTaskList mocks base method.
 func (m *MockHandler) DescribeTaskList(arg0 context.Context, arg1 *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +132,21 @@ func (mr *MockHandlerMockRecorder) DescribeTaskList(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskList", reflect.TypeOf((*MockHandler)(nil).DescribeTaskList), arg0, arg1)
 }
 
+// GetTaskListConfig mocks base method.
+func (m *MockHandler) GetTaskListConfig(arg0 context.Context, arg1 *types.MatchingGetTaskListConfigRequest) (*types.GetTaskListConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTaskListConfig", arg0, arg1)
+	ret0, _ := ret[0].(*types.GetTaskListConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTaskListConfig indicates an expected call of GetTaskListConfig.
+func (mr *MockHandlerMockRecorder) GetTaskListConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTaskListConfig", reflect.TypeOf((*MockHandler)(nil).GetTaskListConfig), arg0, arg1)
+}
+
 // GetTaskListsByDomain mocks base method.
 func (m *MockHandler) GetTaskListsByDomain(arg0 context.Context, arg1 *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +177,21 @@ func (mr *MockHandlerMockRecorder) Health(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Health", reflect.TypeOf((*MockHandler)(nil).Health), arg0)
 }
 
+// HealthDetails mocks base method.
+func (m *MockHandler) HealthDetails(arg0 context.Context) (*types.MatchingHealthDetailsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HealthDetails", arg0)
+	ret0, _ := ret[0].(*types.MatchingHealthDetailsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HealthDetails indicates an expected call of HealthDetails.
+func (mr *MockHandlerMockRecorder) HealthDetails(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthDetails", reflect.TypeOf((*MockHandler)(nil).HealthDetails), arg0)
+}
+
 // ListTaskListPartitions mocks base method.
 func (m *MockHandler) ListTaskListPartitions(arg0 context.Context, arg1 *types.MatchingListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -205,6 +252,35 @@ func (mr *MockHandlerMockRecorder) QueryWorkflow(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryWorkflow", reflect.TypeOf((*MockHandler)(nil).QueryWorkflow), arg0, arg1)
 }
 
+// RefreshTaskList mocks base method.
+func (m *MockHandler) RefreshTaskList(arg0 context.Context, arg1 *types.MatchingRefreshTaskListRequest) (*types.MatchingRefreshTaskListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTaskList", arg0, arg1)
+	ret0, _ := ret[0].(*types.MatchingRefreshTaskListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshTaskList indicates an expected call of RefreshTaskList.
+func (mr *MockHandlerMockRecorder) RefreshTaskList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTaskList", reflect.TypeOf((*MockHandler)(nil).RefreshTaskList), arg0, arg1)
+}
+
+// ResetTaskListAckLevel mocks base method.
+func (m *MockHandler) ResetTaskListAckLevel(arg0 context.Context, arg1 *types.MatchingResetTaskListAckLevelRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetTaskListAckLevel", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetTaskListAckLevel indicates an expected call of ResetTaskListAckLevel.
+func (mr *MockHandlerMockRecorder) ResetTaskListAckLevel(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetTaskListAckLevel", reflect.TypeOf((*MockHandler)(nil).ResetTaskListAckLevel), arg0, arg1)
+}
+
 // RespondQueryTaskCompleted mocks base method.
 func (m *MockHandler) RespondQueryTaskCompleted(arg0 context.Context, arg1 *types.MatchingRespondQueryTaskCompletedRequest) error {
 	m.ctrl.T.Helper()
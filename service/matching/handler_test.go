@@ -0,0 +1,190 @@
+// Copyright (c) 2023 Uber Technologies Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/types"
+)
+
+func TestHandlerGetTaskListConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testlogger.New(t)
+	mockDomainCache := cache.NewMockDomainCache(ctrl)
+	mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return(matchingTestDomainName, nil).AnyTimes()
+
+	dcClient := dynamicconfig.NewInMemoryClient()
+	require.NoError(t, dcClient.UpdateValue(dynamicconfig.MatchingNumTasklistReadPartitions, 4))
+	require.NoError(t, dcClient.UpdateValue(dynamicconfig.MatchingNumTasklistWritePartitions, 3))
+	require.NoError(t, dcClient.UpdateValue(dynamicconfig.MatchingEnableSyncMatch, false))
+	require.NoError(t, dcClient.UpdateValue(dynamicconfig.MatchingForwarderMaxRatePerSecond, 42))
+	require.NoError(t, dcClient.UpdateValue(dynamicconfig.MatchingUpdateAckInterval, 5*time.Second))
+	require.NoError(t, dcClient.UpdateValue(dynamicconfig.MatchingLongPollExpirationInterval, 30*time.Second))
+	config := NewConfig(dynamicconfig.NewCollection(dcClient, logger), "test-host")
+
+	handler := NewHandler(
+		nil,
+		config,
+		mockDomainCache,
+		metrics.NewClient(tally.NoopScope, metrics.Matching),
+		logger,
+		logger,
+	)
+	handler.Start()
+
+	taskListType := types.TaskListTypeDecision
+	resp, err := handler.GetTaskListConfig(context.Background(), &types.MatchingGetTaskListConfigRequest{
+		DomainUUID:   "domain-id",
+		TaskList:     &types.TaskList{Name: matchingTestTaskList},
+		TaskListType: &taskListType,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(4), resp.NumReadPartitions)
+	assert.Equal(t, int32(3), resp.NumWritePartitions)
+	assert.False(t, resp.EnableSyncMatch)
+	assert.Equal(t, int32(42), resp.ForwarderMaxRatePerSecond)
+	assert.Equal(t, common.Int32Ptr(5), resp.UpdateAckIntervalSeconds)
+	assert.Equal(t, common.Int32Ptr(30), resp.LongPollExpirationSeconds)
+	assert.Equal(t, int32(config.GetTasksBatchSize(matchingTestDomainName, matchingTestTaskList, persistence.TaskListTypeDecision)), resp.GetTasksBatchSize)
+}
+
+func TestHandlerAddTaskPriorityOutOfRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testlogger.New(t)
+	mockDomainCache := cache.NewMockDomainCache(ctrl)
+	mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return(matchingTestDomainName, nil).AnyTimes()
+	config := NewConfig(dynamicconfig.NewNopCollection(), "test-host")
+
+	handler := NewHandler(
+		nil,
+		config,
+		mockDomainCache,
+		metrics.NewClient(tally.NoopScope, metrics.Matching),
+		logger,
+		logger,
+	)
+	handler.Start()
+
+	for _, priority := range []int32{-1, common.MaxTaskPriority + 1} {
+		err := handler.AddActivityTask(context.Background(), &types.AddActivityTaskRequest{
+			DomainUUID: "domain-id",
+			TaskList:   &types.TaskList{Name: matchingTestTaskList},
+			Priority:   priority,
+		})
+		var badRequestErr *types.BadRequestError
+		assert.ErrorAs(t, err, &badRequestErr)
+
+		_, err = handler.AddDecisionTask(context.Background(), &types.AddDecisionTaskRequest{
+			DomainUUID: "domain-id",
+			TaskList:   &types.TaskList{Name: matchingTestTaskList},
+			Priority:   priority,
+		})
+		assert.ErrorAs(t, err, &badRequestErr)
+	}
+}
+
+func TestHandlerDescribePollerValidation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testlogger.New(t)
+	mockDomainCache := cache.NewMockDomainCache(ctrl)
+	mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return(matchingTestDomainName, nil).AnyTimes()
+	config := NewConfig(dynamicconfig.NewNopCollection(), "test-host")
+
+	handler := NewHandler(
+		nil,
+		config,
+		mockDomainCache,
+		metrics.NewClient(tally.NoopScope, metrics.Matching),
+		logger,
+		logger,
+	)
+	handler.Start()
+
+	for _, request := range []*types.MatchingDescribePollerRequest{
+		{DomainUUID: "domain-id", Identity: "identity"},
+		{DomainUUID: "domain-id", TaskList: &types.TaskList{Name: matchingTestTaskList}},
+	} {
+		_, err := handler.DescribePoller(context.Background(), request)
+		var badRequestErr *types.BadRequestError
+		assert.ErrorAs(t, err, &badRequestErr)
+	}
+}
+
+func TestHandlerAddDecisionTaskForwardingDepth(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testlogger.New(t)
+	mockDomainCache := cache.NewMockDomainCache(ctrl)
+	mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return(matchingTestDomainName, nil).AnyTimes()
+	dcClient := dynamicconfig.NewInMemoryClient()
+	require.NoError(t, dcClient.UpdateValue(dynamicconfig.MatchingMaxForwardingDepth, 2))
+	config := NewConfig(dynamicconfig.NewCollection(dcClient, logger), "test-host")
+
+	handler := NewHandler(
+		nil,
+		config,
+		mockDomainCache,
+		metrics.NewClient(tally.NoopScope, metrics.Matching),
+		logger,
+		logger,
+	)
+	handler.Start()
+
+	resp, err := handler.AddDecisionTask(context.Background(), &types.AddDecisionTaskRequest{
+		DomainUUID:      "domain-id",
+		TaskList:        &types.TaskList{Name: matchingTestTaskList},
+		ForwardedFrom:   "child-partition",
+		ForwardingDepth: 3,
+	})
+	assert.Nil(t, resp)
+	var limitExceededErr *types.LimitExceededError
+	assert.ErrorAs(t, err, &limitExceededErr)
+}
+
+func TestValidateForwardingDepth(t *testing.T) {
+	assert.NoError(t, validateForwardingDepth(0, 2))
+	assert.NoError(t, validateForwardingDepth(2, 2))
+	assert.NoError(t, validateForwardingDepth(100, 0))
+	var limitExceededErr *types.LimitExceededError
+	assert.ErrorAs(t, validateForwardingDepth(3, 2), &limitExceededErr)
+}
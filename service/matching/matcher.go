@@ -23,6 +23,7 @@ package matching
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/time/rate"
@@ -54,6 +55,9 @@ type TaskMatcher struct {
 	fwdr          *Forwarder
 	scope         metrics.Scope // domain metric scope
 	numPartitions func() int    // number of task list partitions
+	// requestedRate is the dispatch rate last requested by the pollers for the whole task list,
+	// before it's divided across the partitions
+	requestedRate atomic.Value
 }
 
 const (
@@ -312,13 +316,29 @@ func (tm *TaskMatcher) UpdateRatelimit(rps *float64) {
 	if rps == nil {
 		return
 	}
-	rate := *rps
+	tm.requestedRate.Store(*rps)
+	rate := tm.partitionRate(*rps)
+	tm.limiter.UpdateMaxDispatch(&rate)
+}
+
+// RefreshRatelimit divides the dispatch rate last requested by the pollers across the current
+// number of partitions, the new rate takes effect right away even if it's higher than the current one
+func (tm *TaskMatcher) RefreshRatelimit() {
+	rps, ok := tm.requestedRate.Load().(float64)
+	if !ok {
+		return
+	}
+	rate := tm.partitionRate(rps)
+	tm.limiter.ResetMaxDispatch(&rate)
+}
+
+func (tm *TaskMatcher) partitionRate(rate float64) float64 {
 	nPartitions := tm.numPartitions()
 	if rate > float64(nPartitions) {
 		// divide the rate equally across all partitions
-		rate = rate / float64(tm.numPartitions())
+		rate = rate / float64(nPartitions)
 	}
-	tm.limiter.UpdateMaxDispatch(&rate)
+	return rate
 }
 
 // Rate returns the current rate at which tasks are dispatched
@@ -652,3 +652,23 @@ func ensureAsyncReady(ctxTimeout time.Duration, cb func(ctx context.Context)) (w
 		<-ctx.Done()
 	}
 }
+
+func (t *MatcherTestSuite) TestRefreshRatelimit() {
+	numPartitions := 4
+	t.matcher.numPartitions = func() int { return numPartitions }
+
+	// refreshing before any poller set a rate keeps the default rate
+	t.matcher.RefreshRatelimit()
+	t.Equal(_defaultTaskDispatchRPS, t.matcher.Rate())
+
+	rps := 100.0
+	t.matcher.UpdateRatelimit(&rps)
+	t.Equal(25.0, t.matcher.Rate())
+
+	// the rate goes up right away when partitions are removed, without waiting for the ttl
+	numPartitions = 2
+	t.matcher.UpdateRatelimit(&rps)
+	t.Equal(25.0, t.matcher.Rate())
+	t.matcher.RefreshRatelimit()
+	t.Equal(50.0, t.matcher.Rate())
+}
@@ -39,6 +39,7 @@ import (
 	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/client"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -50,6 +51,19 @@ import (
 	"github.com/uber/cadence/common/types"
 )
 
+const (
+	healthCheckMembership  = "membership"
+	healthCheckPersistence = "persistence"
+	healthCheckTaskLists   = "tasklists"
+
+	healthCheckDomainID     = "00000000-0000-0000-0000-000000000000"
+	healthCheckTaskListName = "cadence-matching-health-check"
+
+	// persistenceHealthCheckTTL is how long the result of the persistence check is reused,
+	// so that frequent readiness probes don't each make a persistence read
+	persistenceHealthCheckTTL = 10 * time.Second
+)
+
 // If sticky poller is not seem in last 10s, we treat it as sticky worker unavailable
 // This seems aggressive, but the default sticky schedule_to_start timeout is 5s, so 10s seems reasonable.
 const _stickyPollerUnavailableWindow = 10 * time.Second
@@ -92,6 +106,12 @@ type (
 		versionChecker       client.VersionChecker
 		membershipResolver   membership.Resolver
 		partitioner          partition.Partitioner
+		timeSource           clock.TimeSource
+
+		healthCheckLock sync.Mutex
+		// lastPersistenceCheck and lastPersistenceCheckTime cache the result of the persistence check
+		lastPersistenceCheck     types.MatchingHealthCheck
+		lastPersistenceCheckTime time.Time
 	}
 
 	// HistoryInfo consists of two integer regarding the history size and history count
@@ -149,6 +169,7 @@ func NewEngine(taskManager persistence.TaskManager,
 		versionChecker:       client.NewVersionChecker(),
 		membershipResolver:   resolver,
 		partitioner:          partitioner,
+		timeSource:           clock.NewRealTimeSource(),
 	}
 }
 
@@ -236,11 +257,16 @@ func (e *matchingEngineImpl) getTaskListManager(taskList *taskListID, taskListKi
 	return mgr, nil
 }
 
-func (e *matchingEngineImpl) getTaskListByDomainLocked(domainID string) *types.GetTaskListsByDomainResponse {
+// getTaskListByDomainLocked returns the normal task lists of the domain, and if isolationGroup is not empty,
+// only the ones with pollers in the isolation group
+func (e *matchingEngineImpl) getTaskListByDomainLocked(domainID string, isolationGroup string) *types.GetTaskListsByDomainResponse {
 	decisionTaskListMap := make(map[string]*types.DescribeTaskListResponse)
 	activityTaskListMap := make(map[string]*types.DescribeTaskListResponse)
 	for tl, tlm := range e.taskLists {
 		if tlm.GetTaskListKind() == types.TaskListKindNormal && tl.domainID == domainID {
+			if isolationGroup != "" && !hasIsolationGroup(tlm.GetPollerIsolationGroups(), isolationGroup) {
+				continue
+			}
 			if types.TaskListType(tl.taskType) == types.TaskListTypeDecision {
 				decisionTaskListMap[tl.baseName] = tlm.DescribeTaskList(false)
 			}
@@ -254,6 +280,15 @@ func (e *matchingEngineImpl) getTaskListByDomainLocked(domainID string) *types.G
 	}
 }
 
+func hasIsolationGroup(isolationGroups []string, isolationGroup string) bool {
+	for _, group := range isolationGroups {
+		if group == isolationGroup {
+			return true
+		}
+	}
+	return false
+}
+
 // For use in tests
 func (e *matchingEngineImpl) updateTaskList(taskList *taskListID, mgr taskListManager) {
 	e.taskListsLock.Lock()
@@ -339,13 +374,15 @@ func (e *matchingEngineImpl) AddDecisionTask(
 		ScheduleToStartTimeout: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
 		PartitionConfig:        request.GetPartitionConfig(),
+		Priority:               request.GetPriority(),
 	}
 
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
-		execution:     request.Execution,
-		taskInfo:      taskInfo,
-		source:        request.GetSource(),
-		forwardedFrom: request.GetForwardedFrom(),
+		execution:       request.Execution,
+		taskInfo:        taskInfo,
+		source:          request.GetSource(),
+		forwardedFrom:   request.GetForwardedFrom(),
+		forwardingDepth: request.GetForwardingDepth(),
 	})
 }
 
@@ -405,6 +442,7 @@ func (e *matchingEngineImpl) AddActivityTask(
 		ScheduleToStartTimeout: request.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
 		PartitionConfig:        request.GetPartitionConfig(),
+		Priority:               request.GetPriority(),
 	}
 
 	return tlMgr.AddTask(hCtx.Context, addTaskParams{
@@ -737,7 +775,7 @@ func (e *matchingEngineImpl) deliverQueryResult(taskID string, queryResult *quer
 func (e *matchingEngineImpl) CancelOutstandingPoll(
 	hCtx *handlerContext,
 	request *types.CancelOutstandingPollRequest,
-) error {
+) (*types.CancelOutstandingPollResponse, error) {
 	domainID := request.GetDomainUUID()
 	taskListType := int(request.GetTaskListType())
 	taskListName := request.GetTaskList().GetName()
@@ -746,16 +784,53 @@ func (e *matchingEngineImpl) CancelOutstandingPoll(
 
 	taskList, err := newTaskListID(domainID, taskListName, taskListType)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	tlMgr.CancelPoller(pollerID)
-	return nil
+	if request.GetCancelAll() {
+		return &types.CancelOutstandingPollResponse{NumCancelledPolls: int32(tlMgr.CancelAllPollers())}, nil
+	}
+	var numCancelledPolls int32
+	if tlMgr.CancelPoller(pollerID) {
+		numCancelledPolls = 1
+	}
+	return &types.CancelOutstandingPollResponse{NumCancelledPolls: numCancelledPolls}, nil
+}
+
+// DescribePoller returns information about a single poller of the task list, including the ids of its
+// outstanding polls which can be passed to CancelOutstandingPoll
+func (e *matchingEngineImpl) DescribePoller(
+	hCtx *handlerContext,
+	request *types.MatchingDescribePollerRequest,
+) (*types.MatchingDescribePollerResponse, error) {
+	domainID := request.GetDomainUUID()
+	taskListType := persistence.TaskListTypeDecision
+	if request.GetTaskListType() == types.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+	taskListName := request.GetTaskList().GetName()
+	taskListKind := request.GetTaskList().Kind
+
+	taskList, err := newTaskListID(domainID, taskListName, taskListType)
+	if err != nil {
+		return nil, err
+	}
+
+	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
+	if err != nil {
+		return nil, err
+	}
+
+	response, ok := tlMgr.DescribePoller(request.GetIdentity())
+	if !ok {
+		return nil, &types.EntityNotExistsError{Message: fmt.Sprintf("Poller %v not found on task list %v.", request.GetIdentity(), taskListName)}
+	}
+	return response, nil
 }
 
 func (e *matchingEngineImpl) DescribeTaskList(
@@ -780,7 +855,136 @@ func (e *matchingEngineImpl) DescribeTaskList(
 		return nil, err
 	}
 
-	return tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus()), nil
+	response := tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus())
+	if request.GetIncludeBacklogEstimate() {
+		backlogEstimate, err := e.estimateBacklog(hCtx.Context, request, taskList, tlMgr, response)
+		if err != nil {
+			return nil, err
+		}
+		response.BacklogCountEstimate = backlogEstimate
+	}
+	return response, nil
+}
+
+// estimateBacklog returns the approximate backlog of a task list summed across all of its read partitions.
+// Each partition reports the backlogCountHint of its own DescribeTaskList, which is the number of persisted
+// tasks above its ack level, falling back to the in-memory backlog if counting from persistence fails.
+// The partitions are not described atomically, so the estimate is a sum of values read at slightly
+// different times: it can be stale by the duration of the fan-out, plus the tasks buffered in memory
+// by each partition which were already read but not yet acked.
+// Only the root partition fans out, other partitions and sticky task lists return their own backlog.
+func (e *matchingEngineImpl) estimateBacklog(
+	ctx context.Context,
+	request *types.MatchingDescribeTaskListRequest,
+	taskList *taskListID,
+	tlMgr taskListManager,
+	response *types.DescribeTaskListResponse,
+) (int64, error) {
+	status := response.GetTaskListStatus()
+	if status == nil {
+		status = tlMgr.DescribeTaskList(true).GetTaskListStatus()
+	}
+	backlogEstimate := status.GetBacklogCountHint()
+
+	taskListKind := request.GetDescRequest().GetTaskList().GetKind()
+	if !taskList.IsRoot() || taskListKind == types.TaskListKindSticky {
+		return backlogEstimate, nil
+	}
+
+	config, err := newTaskListConfig(taskList, e.config, e.domainCache)
+	if err != nil {
+		return 0, err
+	}
+	for i := 1; i < config.NumReadPartitions(); i++ {
+		partitionResp, err := e.matchingClient.DescribeTaskList(ctx, &types.MatchingDescribeTaskListRequest{
+			DomainUUID: request.GetDomainUUID(),
+			DescRequest: &types.DescribeTaskListRequest{
+				Domain:                request.GetDescRequest().GetDomain(),
+				TaskList:              &types.TaskList{Name: taskList.mkName(i), Kind: &taskListKind},
+				TaskListType:          request.GetDescRequest().TaskListType,
+				IncludeTaskListStatus: true,
+			},
+		})
+		if err != nil {
+			return 0, err
+		}
+		backlogEstimate += partitionResp.GetTaskListStatus().GetBacklogCountHint()
+	}
+	return backlogEstimate, nil
+}
+
+func (e *matchingEngineImpl) ResetTaskListAckLevel(
+	hCtx *handlerContext,
+	request *types.MatchingResetTaskListAckLevelRequest,
+) error {
+	domainID := request.GetDomainUUID()
+	taskListType := persistence.TaskListTypeDecision
+	if request.GetTaskListType() == types.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+	taskListName := request.GetTaskList().GetName()
+	taskListKind := request.GetTaskList().Kind
+
+	taskList, err := newTaskListID(domainID, taskListName, taskListType)
+	if err != nil {
+		return err
+	}
+
+	// resetting the ack level on a host which doesn't own the task list would race with its owner
+	if err := e.checkTaskListOwnership(taskList); err != nil {
+		return err
+	}
+
+	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
+	if err != nil {
+		return err
+	}
+
+	return tlMgr.ResetAckLevel(request.GetAckLevel())
+}
+
+// checkTaskListOwnership returns an error if this host isn't the owner of the task list on the hash ring
+func (e *matchingEngineImpl) checkTaskListOwnership(taskList *taskListID) error {
+	self, err := e.membershipResolver.WhoAmI()
+	if err != nil {
+		return err
+	}
+	owner, err := e.membershipResolver.Lookup(service.Matching, taskList.name)
+	if err != nil {
+		return err
+	}
+	if owner.Identity() != self.Identity() {
+		return &types.BadRequestError{
+			Message: fmt.Sprintf("Task list %v is owned by host %v, not by host %v.", taskList.name, owner.GetAddress(), self.GetAddress()),
+		}
+	}
+	return nil
+}
+
+// RefreshTaskList applies the current partition config to the task list in place, loading it if it isn't
+// loaded yet, and returns the partition counts the task list manager is running with
+func (e *matchingEngineImpl) RefreshTaskList(
+	hCtx *handlerContext,
+	request *types.MatchingRefreshTaskListRequest,
+) (*types.MatchingRefreshTaskListResponse, error) {
+	domainID := request.GetDomainUUID()
+	taskListType := persistence.TaskListTypeDecision
+	if request.GetTaskListType() == types.TaskListTypeActivity {
+		taskListType = persistence.TaskListTypeActivity
+	}
+	taskListName := request.GetTaskList().GetName()
+	taskListKind := request.GetTaskList().Kind
+
+	taskList, err := newTaskListID(domainID, taskListName, taskListType)
+	if err != nil {
+		return nil, err
+	}
+
+	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
+	if err != nil {
+		return nil, err
+	}
+	return tlMgr.RefreshConfig(), nil
 }
 
 func (e *matchingEngineImpl) ListTaskListPartitions(
@@ -838,7 +1042,90 @@ func (e *matchingEngineImpl) GetTaskListsByDomain(
 
 	e.taskListsLock.RLock()
 	defer e.taskListsLock.RUnlock()
-	return e.getTaskListByDomainLocked(domainID), nil
+	return e.getTaskListByDomainLocked(domainID, request.GetIsolationGroup()), nil
+}
+
+// HealthDetails checks each of the components the matching host depends on: whether the host is part of
+// the membership ring, whether persistence is reachable, and how many task list managers are loaded
+func (e *matchingEngineImpl) HealthDetails(ctx context.Context) *types.MatchingHealthDetailsResponse {
+	checks := []*types.MatchingHealthCheck{
+		e.checkMembershipHealth(),
+		e.checkPersistenceHealth(ctx),
+		e.checkTaskListsHealth(),
+	}
+	response := &types.MatchingHealthDetailsResponse{Ok: true, Checks: checks}
+	for _, check := range checks {
+		response.Ok = response.Ok && check.Ok
+	}
+	return response
+}
+
+func (e *matchingEngineImpl) checkMembershipHealth() *types.MatchingHealthCheck {
+	check := &types.MatchingHealthCheck{Name: healthCheckMembership}
+	self, err := e.membershipResolver.WhoAmI()
+	if err != nil {
+		check.Message = fmt.Sprintf("failed to get self host info: %v", err)
+		return check
+	}
+	if _, err := e.membershipResolver.LookupByAddress(service.Matching, self.GetAddress()); err != nil {
+		check.Message = fmt.Sprintf("host %v is not in the membership ring: %v", self.GetAddress(), err)
+		return check
+	}
+	check.Ok = true
+	check.Message = fmt.Sprintf("host %v is in the membership ring", self.GetAddress())
+	return check
+}
+
+// checkPersistenceHealth returns the result of the last persistence check if it is at most persistenceHealthCheckTTL old,
+// results of checks which failed because ctx is done are not cached
+func (e *matchingEngineImpl) checkPersistenceHealth(ctx context.Context) *types.MatchingHealthCheck {
+	e.healthCheckLock.Lock()
+	now := e.timeSource.Now()
+	if !e.lastPersistenceCheckTime.IsZero() && now.Sub(e.lastPersistenceCheckTime) < persistenceHealthCheckTTL {
+		check := e.lastPersistenceCheck
+		e.healthCheckLock.Unlock()
+		return &check
+	}
+	e.healthCheckLock.Unlock()
+
+	check := e.probePersistence(ctx)
+	if ctx.Err() != nil {
+		return check
+	}
+
+	e.healthCheckLock.Lock()
+	e.lastPersistenceCheck = *check
+	e.lastPersistenceCheckTime = now
+	e.healthCheckLock.Unlock()
+	return check
+}
+
+func (e *matchingEngineImpl) probePersistence(ctx context.Context) *types.MatchingHealthCheck {
+	check := &types.MatchingHealthCheck{Name: healthCheckPersistence}
+	// count the tasks of a task list of a domain which never exists, it's a single cheap read for every persistence
+	_, err := e.taskManager.GetTaskListSize(ctx, &persistence.GetTaskListSizeRequest{
+		DomainID:     healthCheckDomainID,
+		TaskListName: healthCheckTaskListName,
+		TaskListType: persistence.TaskListTypeDecision,
+	})
+	if err != nil {
+		check.Message = fmt.Sprintf("persistence is unreachable: %v", err)
+		return check
+	}
+	check.Ok = true
+	check.Message = "persistence is reachable"
+	return check
+}
+
+func (e *matchingEngineImpl) checkTaskListsHealth() *types.MatchingHealthCheck {
+	e.taskListsLock.RLock()
+	count := len(e.taskLists)
+	e.taskListsLock.RUnlock()
+	return &types.MatchingHealthCheck{
+		Name:    healthCheckTaskLists,
+		Ok:      true,
+		Message: fmt.Sprintf("%v task list managers are loaded", count),
+	}
 }
 
 func (e *matchingEngineImpl) getHostInfo(partitionKey string) (string, error) {
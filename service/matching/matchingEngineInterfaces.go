@@ -20,7 +20,11 @@
 
 package matching
 
-import "github.com/uber/cadence/common/types"
+import (
+	"context"
+
+	"github.com/uber/cadence/common/types"
+)
 
 type (
 	// Engine exposes interfaces for clients to poll for activity and decision tasks.
@@ -32,9 +36,13 @@ type (
 		PollForActivityTask(hCtx *handlerContext, request *types.MatchingPollForActivityTaskRequest) (*types.PollForActivityTaskResponse, error)
 		QueryWorkflow(hCtx *handlerContext, request *types.MatchingQueryWorkflowRequest) (*types.QueryWorkflowResponse, error)
 		RespondQueryTaskCompleted(hCtx *handlerContext, request *types.MatchingRespondQueryTaskCompletedRequest) error
-		CancelOutstandingPoll(hCtx *handlerContext, request *types.CancelOutstandingPollRequest) error
+		CancelOutstandingPoll(hCtx *handlerContext, request *types.CancelOutstandingPollRequest) (*types.CancelOutstandingPollResponse, error)
+		DescribePoller(hCtx *handlerContext, request *types.MatchingDescribePollerRequest) (*types.MatchingDescribePollerResponse, error)
 		DescribeTaskList(hCtx *handlerContext, request *types.MatchingDescribeTaskListRequest) (*types.DescribeTaskListResponse, error)
 		ListTaskListPartitions(hCtx *handlerContext, request *types.MatchingListTaskListPartitionsRequest) (*types.ListTaskListPartitionsResponse, error)
 		GetTaskListsByDomain(hCtx *handlerContext, request *types.GetTaskListsByDomainRequest) (*types.GetTaskListsByDomainResponse, error)
+		ResetTaskListAckLevel(hCtx *handlerContext, request *types.MatchingResetTaskListAckLevelRequest) error
+		RefreshTaskList(hCtx *handlerContext, request *types.MatchingRefreshTaskListRequest) (*types.MatchingRefreshTaskListResponse, error)
+		HealthDetails(ctx context.Context) *types.MatchingHealthDetailsResponse
 	}
 )
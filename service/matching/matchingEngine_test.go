@@ -42,19 +42,23 @@ import (
 	"go.uber.org/yarpc"
 
 	"github.com/uber/cadence/client/history"
+	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/isolationgroup/defaultisolationgroupstate"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/log/testlogger"
+	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/partition"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
+	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/types"
 )
 
@@ -165,6 +169,7 @@ func newMatchingEngine(
 		config:          config,
 		domainCache:     mockDomainCache,
 		partitioner:     partitioner,
+		timeSource:      clock.NewRealTimeSource(),
 	}
 }
 
@@ -1144,6 +1149,253 @@ func (s *matchingEngineSuite) TestTaskListManagerGetTaskBatch_ReadBatchDone() {
 	s.NoError(err)
 }
 
+func (s *matchingEngineSuite) TestResetTaskListAckLevel() {
+	taskType := persistence.TaskListTypeActivity
+	testParam := newTestParam(taskType)
+
+	const taskCount = 5
+	for i := int64(0); i < taskCount; i++ {
+		addRequest := &addTaskRequest{
+			TaskType:                      taskType,
+			DomainUUID:                    testParam.DomainID,
+			Execution:                     testParam.WorkflowExecution,
+			ScheduleID:                    i,
+			TaskList:                      testParam.TaskList,
+			ScheduleToStartTimeoutSeconds: 100,
+		}
+		_, err := addTask(s.matchingEngine, s.handlerContext, addRequest)
+		s.NoError(err)
+	}
+
+	tlMgr, ok := s.matchingEngine.taskLists[*testParam.TaskListID].(*taskListManagerImpl)
+	s.True(ok)
+	ackLevel := tlMgr.taskWriter.GetMaxReadLevel() - 1
+
+	mockResolver := membership.NewMockResolver(s.controller)
+	s.matchingEngine.membershipResolver = mockResolver
+	self := membership.NewHostInfo("127.0.0.1:7935")
+	mockResolver.EXPECT().WhoAmI().Return(self, nil)
+	mockResolver.EXPECT().Lookup(service.Matching, testParam.TaskList.GetName()).Return(self, nil)
+
+	err := s.matchingEngine.ResetTaskListAckLevel(s.handlerContext, &types.MatchingResetTaskListAckLevelRequest{
+		DomainUUID:   testParam.DomainID,
+		TaskList:     testParam.TaskList,
+		TaskListType: testParam.TaskListType,
+		AckLevel:     ackLevel,
+	})
+	s.NoError(err)
+
+	// resetting again through the stopped manager is rejected rather than racing with the reload
+	s.Equal(errShutdown, tlMgr.ResetAckLevel(ackLevel))
+
+	// the task list is unloaded so that the new ack level is picked up on the next load
+	tlKind := types.TaskListKindNormal
+	got, err := s.matchingEngine.getTaskListManager(testParam.TaskListID, &tlKind)
+	s.NoError(err)
+	s.NotSame(tlMgr, got)
+	tlm := s.taskManager.getTaskListManager(testParam.TaskListID)
+	tlm.Lock()
+	s.Equal(ackLevel, tlm.ackLevel)
+	tlm.Unlock()
+}
+
+func (s *matchingEngineSuite) TestResetTaskListAckLevelOutOfRange() {
+	taskType := persistence.TaskListTypeActivity
+	testParam := newTestParam(taskType)
+
+	mockResolver := membership.NewMockResolver(s.controller)
+	s.matchingEngine.membershipResolver = mockResolver
+	self := membership.NewHostInfo("127.0.0.1:7935")
+	mockResolver.EXPECT().WhoAmI().Return(self, nil).AnyTimes()
+	mockResolver.EXPECT().Lookup(service.Matching, testParam.TaskList.GetName()).Return(self, nil).AnyTimes()
+
+	for _, ackLevel := range []int64{-1, 1000000} {
+		err := s.matchingEngine.ResetTaskListAckLevel(s.handlerContext, &types.MatchingResetTaskListAckLevelRequest{
+			DomainUUID:   testParam.DomainID,
+			TaskList:     testParam.TaskList,
+			TaskListType: testParam.TaskListType,
+			AckLevel:     ackLevel,
+		})
+		var badRequestErr *types.BadRequestError
+		s.ErrorAs(err, &badRequestErr)
+	}
+}
+
+func (s *matchingEngineSuite) TestResetTaskListAckLevelNotOwner() {
+	taskType := persistence.TaskListTypeActivity
+	testParam := newTestParam(taskType)
+
+	mockResolver := membership.NewMockResolver(s.controller)
+	s.matchingEngine.membershipResolver = mockResolver
+	mockResolver.EXPECT().WhoAmI().Return(membership.NewHostInfo("127.0.0.1:7935"), nil)
+	mockResolver.EXPECT().Lookup(service.Matching, testParam.TaskList.GetName()).Return(membership.NewHostInfo("127.0.0.2:7935"), nil)
+
+	err := s.matchingEngine.ResetTaskListAckLevel(s.handlerContext, &types.MatchingResetTaskListAckLevelRequest{
+		DomainUUID:   testParam.DomainID,
+		TaskList:     testParam.TaskList,
+		TaskListType: testParam.TaskListType,
+		AckLevel:     0,
+	})
+	var badRequestErr *types.BadRequestError
+	s.ErrorAs(err, &badRequestErr)
+
+	// the task list isn't loaded on a host which doesn't own it
+	s.Empty(s.matchingEngine.taskLists)
+}
+
+func (s *matchingEngineSuite) TestDescribePollerNotFound() {
+	testParam := newTestParam(persistence.TaskListTypeActivity)
+
+	_, err := s.matchingEngine.DescribePoller(s.handlerContext, &types.MatchingDescribePollerRequest{
+		DomainUUID:   testParam.DomainID,
+		TaskList:     testParam.TaskList,
+		TaskListType: testParam.TaskListType,
+		Identity:     "unknown",
+	})
+	var entityNotExistsErr *types.EntityNotExistsError
+	s.ErrorAs(err, &entityNotExistsErr)
+}
+
+func (s *matchingEngineSuite) TestRefreshTaskList() {
+	taskType := persistence.TaskListTypeActivity
+	testParam := newTestParam(taskType)
+
+	tlKind := types.TaskListKindNormal
+	tlMgr, err := s.matchingEngine.getTaskListManager(testParam.TaskListID, &tlKind)
+	s.NoError(err)
+
+	resp, err := s.matchingEngine.RefreshTaskList(s.handlerContext, &types.MatchingRefreshTaskListRequest{
+		DomainUUID:   testParam.DomainID,
+		TaskList:     testParam.TaskList,
+		TaskListType: testParam.TaskListType,
+	})
+	s.NoError(err)
+	s.Equal(int32(s.matchingEngine.config.NumTasklistReadPartitions(matchingTestDomainName, testParam.TaskList.Name, taskType)), resp.NumReadPartitions)
+	s.Equal(int32(s.matchingEngine.config.NumTasklistWritePartitions(matchingTestDomainName, testParam.TaskList.Name, taskType)), resp.NumWritePartitions)
+
+	// the config is refreshed in place so the task list isn't reloaded
+	s.matchingEngine.taskListsLock.RLock()
+	got, ok := s.matchingEngine.taskLists[*testParam.TaskListID]
+	s.matchingEngine.taskListsLock.RUnlock()
+	s.True(ok)
+	s.Same(tlMgr, got)
+}
+
+func (s *matchingEngineSuite) TestDescribeTaskListBacklogEstimate() {
+	taskType := persistence.TaskListTypeActivity
+	testParam := newTestParam(taskType)
+
+	const taskCount = 5
+	for i := int64(0); i < taskCount; i++ {
+		addRequest := &addTaskRequest{
+			TaskType:                      taskType,
+			DomainUUID:                    testParam.DomainID,
+			Execution:                     testParam.WorkflowExecution,
+			ScheduleID:                    i,
+			TaskList:                      testParam.TaskList,
+			ScheduleToStartTimeoutSeconds: 100,
+		}
+		_, err := addTask(s.matchingEngine, s.handlerContext, addRequest)
+		s.NoError(err)
+	}
+
+	mockMatchingClient := matching.NewMockClient(s.controller)
+	s.matchingEngine.matchingClient = mockMatchingClient
+	s.matchingEngine.config.NumTasklistReadPartitions = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(3)
+	for i, backlog := range []int64{10, 20} {
+		backlog := backlog
+		partition := fmt.Sprintf("%v%v/%v", common.ReservedTaskListPrefix, testParam.TaskList.Name, i+1)
+		mockMatchingClient.EXPECT().DescribeTaskList(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, request *types.MatchingDescribeTaskListRequest, _ ...yarpc.CallOption) (*types.DescribeTaskListResponse, error) {
+				s.Equal(partition, request.GetDescRequest().GetTaskList().GetName())
+				s.True(request.GetDescRequest().GetIncludeTaskListStatus())
+				s.False(request.GetIncludeBacklogEstimate())
+				return &types.DescribeTaskListResponse{TaskListStatus: &types.TaskListStatus{BacklogCountHint: backlog}}, nil
+			})
+	}
+
+	descResp, err := s.matchingEngine.DescribeTaskList(s.handlerContext, &types.MatchingDescribeTaskListRequest{
+		DomainUUID: testParam.DomainID,
+		DescRequest: &types.DescribeTaskListRequest{
+			TaskList:     testParam.TaskList,
+			TaskListType: testParam.TaskListType,
+		},
+		IncludeBacklogEstimate: true,
+	})
+	s.NoError(err)
+	s.Nil(descResp.GetTaskListStatus())
+	s.Equal(int64(taskCount+10+20), descResp.GetBacklogCountEstimate())
+
+	// the estimate is not computed unless requested
+	descResp, err = s.matchingEngine.DescribeTaskList(s.handlerContext, &types.MatchingDescribeTaskListRequest{
+		DomainUUID: testParam.DomainID,
+		DescRequest: &types.DescribeTaskListRequest{
+			TaskList:     testParam.TaskList,
+			TaskListType: testParam.TaskListType,
+		},
+	})
+	s.NoError(err)
+	s.Zero(descResp.GetBacklogCountEstimate())
+}
+
+func (s *matchingEngineSuite) TestHealthDetails() {
+	testParam := newTestParam(persistence.TaskListTypeActivity)
+	tlKind := types.TaskListKindNormal
+	_, err := s.matchingEngine.getTaskListManager(testParam.TaskListID, &tlKind)
+	s.NoError(err)
+
+	mockResolver := membership.NewMockResolver(s.controller)
+	s.matchingEngine.membershipResolver = mockResolver
+	self := membership.NewHostInfo("127.0.0.1:7935")
+	mockResolver.EXPECT().WhoAmI().Return(self, nil).Times(2)
+	mockResolver.EXPECT().LookupByAddress(service.Matching, self.GetAddress()).Return(self, nil)
+
+	resp := s.matchingEngine.HealthDetails(context.Background())
+	s.True(resp.GetOk())
+	s.Len(resp.GetChecks(), 3)
+	for _, check := range resp.GetChecks() {
+		s.True(check.GetOk(), check.GetName())
+	}
+	s.Equal(healthCheckTaskLists, resp.GetChecks()[2].GetName())
+	s.Equal("1 task list managers are loaded", resp.GetChecks()[2].GetMessage())
+
+	// the host is evicted from the ring
+	mockResolver.EXPECT().LookupByAddress(service.Matching, self.GetAddress()).Return(membership.HostInfo{}, errors.New("host not found"))
+	resp = s.matchingEngine.HealthDetails(context.Background())
+	s.False(resp.GetOk())
+	s.Equal(healthCheckMembership, resp.GetChecks()[0].GetName())
+	s.False(resp.GetChecks()[0].GetOk())
+	s.True(resp.GetChecks()[1].GetOk())
+}
+
+func (s *matchingEngineSuite) TestHealthDetailsCachesPersistenceCheck() {
+	mockResolver := membership.NewMockResolver(s.controller)
+	s.matchingEngine.membershipResolver = mockResolver
+	self := membership.NewHostInfo("127.0.0.1:7935")
+	mockResolver.EXPECT().WhoAmI().Return(self, nil).AnyTimes()
+	mockResolver.EXPECT().LookupByAddress(service.Matching, self.GetAddress()).Return(self, nil).AnyTimes()
+	mockTaskManager := persistence.NewMockTaskManager(s.controller)
+	s.matchingEngine.taskManager = mockTaskManager
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	s.matchingEngine.timeSource = timeSource
+
+	// the persistence check is made once per TTL
+	mockTaskManager.EXPECT().GetTaskListSize(gomock.Any(), gomock.Any()).Return(nil, errors.New("persistence is down"))
+	s.False(s.matchingEngine.HealthDetails(context.Background()).GetChecks()[1].GetOk())
+	s.False(s.matchingEngine.HealthDetails(context.Background()).GetChecks()[1].GetOk())
+
+	// failures because ctx is done are not cached
+	timeSource.Update(timeSource.Now().Add(persistenceHealthCheckTTL))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mockTaskManager.EXPECT().GetTaskListSize(gomock.Any(), gomock.Any()).Return(nil, context.Canceled)
+	s.False(s.matchingEngine.HealthDetails(ctx).GetChecks()[1].GetOk())
+
+	mockTaskManager.EXPECT().GetTaskListSize(gomock.Any(), gomock.Any()).Return(&persistence.GetTaskListSizeResponse{}, nil)
+	s.True(s.matchingEngine.HealthDetails(context.Background()).GetOk())
+	s.True(s.matchingEngine.HealthDetails(context.Background()).GetOk())
+}
+
 func (s *matchingEngineSuite) TestActivityExpiryAndCompletion() {
 	s.TaskExpiryAndCompletion(persistence.TaskListTypeActivity)
 }
@@ -1660,6 +1912,25 @@ func (m *testTaskManager) LeaseTaskList(
 	}, nil
 }
 
+// GetTaskList provides a mock function with given fields: ctx, request
+func (m *testTaskManager) GetTaskList(
+	_ context.Context,
+	request *persistence.GetTaskListRequest,
+) (*persistence.GetTaskListResponse, error) {
+	tlm := m.getTaskListManager(newTestTaskListID(request.DomainID, request.TaskList, request.TaskType))
+	tlm.Lock()
+	defer tlm.Unlock()
+	return &persistence.GetTaskListResponse{
+		TaskListInfo: &persistence.TaskListInfo{
+			AckLevel: tlm.ackLevel,
+			DomainID: request.DomainID,
+			Name:     request.TaskList,
+			TaskType: request.TaskType,
+			RangeID:  tlm.rangeID,
+		},
+	}, nil
+}
+
 // UpdateTaskList provides a mock function with given fields: ctx, request
 func (m *testTaskManager) UpdateTaskList(
 	_ context.Context,
@@ -2128,3 +2399,52 @@ func pollTask(engine *matchingEngineImpl, hCtx *handlerContext, request *pollTas
 func isEmptyToken(token *common.TaskToken) bool {
 	return token == nil || *token == common.TaskToken{}
 }
+
+type isolationGroupTaskListManager struct {
+	taskListManager
+	isolationGroups []string
+}
+
+func (m *isolationGroupTaskListManager) Stop() {}
+
+func (m *isolationGroupTaskListManager) GetTaskListKind() types.TaskListKind {
+	return types.TaskListKindNormal
+}
+
+func (m *isolationGroupTaskListManager) GetPollerIsolationGroups() []string {
+	return m.isolationGroups
+}
+
+func (m *isolationGroupTaskListManager) DescribeTaskList(bool) *types.DescribeTaskListResponse {
+	return &types.DescribeTaskListResponse{}
+}
+
+func (s *matchingEngineSuite) TestGetTaskListsByDomainIsolationGroupFilter() {
+	domainID := uuid.New()
+	s.mockDomainCache.EXPECT().GetDomainID(matchingTestDomainName).Return(domainID, nil).AnyTimes()
+	for name, isolationGroups := range map[string][]string{
+		"tl-zone-a":  {"zone-a"},
+		"tl-zone-ab": {"zone-a", "zone-b"},
+		"tl-zone-b":  {"zone-b"},
+		"tl-idle":    nil,
+	} {
+		id, err := newTaskListID(domainID, name, persistence.TaskListTypeActivity)
+		s.NoError(err)
+		s.matchingEngine.updateTaskList(id, &isolationGroupTaskListManager{isolationGroups: isolationGroups})
+	}
+
+	resp, err := s.matchingEngine.GetTaskListsByDomain(s.handlerContext, &types.GetTaskListsByDomainRequest{
+		Domain: matchingTestDomainName,
+	})
+	s.NoError(err)
+	s.Len(resp.ActivityTaskListMap, 4)
+
+	resp, err = s.matchingEngine.GetTaskListsByDomain(s.handlerContext, &types.GetTaskListsByDomainRequest{
+		Domain:         matchingTestDomainName,
+		IsolationGroup: "zone-a",
+	})
+	s.NoError(err)
+	s.Len(resp.ActivityTaskListMap, 2)
+	s.Contains(resp.ActivityTaskListMap, "tl-zone-a")
+	s.Contains(resp.ActivityTaskListMap, "tl-zone-ab")
+}
@@ -88,21 +88,42 @@ func (pollers *pollerHistory) getPollerInfo(earliestAccessTime time.Time) []*typ
 		// TODO add IP, T1396795
 		lastAccessTime := entry.CreateTime()
 		if earliestAccessTime.Before(lastAccessTime) {
-			rps := _defaultTaskDispatchRPS
-			if value.ratePerSecond != nil {
-				rps = *value.ratePerSecond
-			}
-			result = append(result, &types.PollerInfo{
-				Identity:       string(key),
-				LastAccessTime: common.Int64Ptr(lastAccessTime.UnixNano()),
-				RatePerSecond:  rps,
-			})
+			result = append(result, toPollerInfo(key, value, lastAccessTime))
 		}
 	}
 
 	return result
 }
 
+// getPoller returns the info and the isolation group of the given poller, it returns false if the poller
+// didn't poll in last few minutes
+func (pollers *pollerHistory) getPoller(id pollerIdentity) (*types.PollerInfo, string, bool) {
+	ite := pollers.history.Iterator()
+	defer ite.Close()
+	for ite.HasNext() {
+		entry := ite.Next()
+		key := entry.Key().(pollerIdentity)
+		if key != id {
+			continue
+		}
+		value := entry.Value().(*pollerInfo)
+		return toPollerInfo(key, value, entry.CreateTime()), value.isolationGroup, true
+	}
+	return nil, "", false
+}
+
+func toPollerInfo(id pollerIdentity, info *pollerInfo, lastAccessTime time.Time) *types.PollerInfo {
+	rps := _defaultTaskDispatchRPS
+	if info.ratePerSecond != nil {
+		rps = *info.ratePerSecond
+	}
+	return &types.PollerInfo{
+		Identity:       string(id),
+		LastAccessTime: common.Int64Ptr(lastAccessTime.UnixNano()),
+		RatePerSecond:  rps,
+	}
+}
+
 func (pollers *pollerHistory) getPollerIsolationGroups(earliestAccessTime time.Time) map[string]struct{} {
 	groupSet := make(map[string]struct{})
 	ite := pollers.history.Iterator()
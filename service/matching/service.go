@@ -26,6 +26,7 @@ import (
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/dynamicconfig"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/resource"
 	"github.com/uber/cadence/common/service"
 )
@@ -103,7 +104,7 @@ func (s *Service) Start() {
 	thriftHandler := NewThriftHandler(s.handler)
 	thriftHandler.register(s.GetDispatcher())
 
-	grpcHandler := newGRPCHandler(s.handler)
+	grpcHandler := newGRPCHandler(s.handler, s.GetThrottledLogger())
 	grpcHandler.register(s.GetDispatcher())
 
 	// must start base service first
@@ -123,12 +124,15 @@ func (s *Service) Stop() {
 
 	// remove self from membership ring and wait for traffic to drain
 	s.GetLogger().Info("ShutdownHandler: Evicting self from membership ring")
+	metrics.EmitShutdownDrainPhase(s.GetMetricsClient(), metrics.DrainPhaseEvictSelfTagValue)
 	s.GetMembershipResolver().EvictSelf()
 	s.GetLogger().Info("ShutdownHandler: Waiting for others to discover I am unhealthy")
+	metrics.EmitShutdownDrainPhase(s.GetMetricsClient(), metrics.DrainPhaseWaitDiscoveryTagValue)
 	time.Sleep(s.config.ShutdownDrainDuration())
 
 	close(s.stopC)
 
+	metrics.EmitShutdownDrainPhase(s.GetMetricsClient(), metrics.DrainPhaseStoppedTagValue)
 	s.handler.Stop()
 	s.Resource.Stop()
 
@@ -52,6 +52,7 @@ type (
 		domainName               string
 		source                   types.TaskSource
 		forwardedFrom            string     // name of the child partition this task is forwarded from (empty if not forwarded)
+		forwardingDepth          int32      // number of partition hops this task took before reaching this partition
 		isolationGroup           string     // isolation group of this task (empty if it can be polled by workers from any isolation group)
 		responseC                chan error // non-nil only where there is a caller waiting for response (sync-match)
 		backlogCountHint         int64
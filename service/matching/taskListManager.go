@@ -63,6 +63,7 @@ type (
 		taskInfo                 *persistence.TaskInfo
 		source                   types.TaskSource
 		forwardedFrom            string
+		forwardingDepth          int32
 		activityTaskDispatchInfo *types.ActivityTaskDispatchInfo
 	}
 
@@ -83,17 +84,30 @@ type (
 		// DispatchQueryTask will dispatch query to local or remote poller. If forwarded then result or error is returned,
 		// if dispatched to local poller then nil and nil is returned.
 		DispatchQueryTask(ctx context.Context, taskID string, request *types.MatchingQueryWorkflowRequest) (*types.QueryWorkflowResponse, error)
-		CancelPoller(pollerID string)
+		CancelPoller(pollerID string) bool
+		CancelAllPollers() int
 		GetAllPollerInfo() []*types.PollerInfo
+		// DescribePoller returns information about the given poller, it returns false if the poller
+		// didn't poll from this tasklist in last few minutes and has no outstanding poll
+		DescribePoller(identity string) (*types.MatchingDescribePollerResponse, bool)
 		HasPollerAfter(accessTime time.Time) bool
+		// GetPollerIsolationGroups returns the sorted isolation groups of the recent and outstanding pollers
+		GetPollerIsolationGroups() []string
 		// DescribeTaskList returns information about the target tasklist
 		DescribeTaskList(includeTaskListStatus bool) *types.DescribeTaskListResponse
+		// ResetAckLevel resets the ack level of the task list, it must be within the range of task IDs
+		// allocated to the task list so far
+		ResetAckLevel(ackLevel int64) error
+		// RefreshConfig applies the current partition config to the task list without interrupting
+		// its outstanding polls, and returns the partition counts the task list is running with
+		RefreshConfig() *types.MatchingRefreshTaskListResponse
 		String() string
 		GetTaskListKind() types.TaskListKind
 		TaskListID() *taskListID
 	}
 
 	outstandingPollerInfo struct {
+		identity       string
 		isolationGroup string
 		cancel         context.CancelFunc
 	}
@@ -229,10 +243,14 @@ func (c *taskListManagerImpl) Stop() {
 		return
 	}
 	c.closeCallback(c)
+	c.stopPumps()
+	c.logger.Info("Task list manager state changed", tag.LifeCycleStopped)
+}
+
+func (c *taskListManagerImpl) stopPumps() {
 	c.liveness.Stop()
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
-	c.logger.Info("Task list manager state changed", tag.LifeCycleStopped)
 }
 
 func (c *taskListManagerImpl) handleErr(err error) error {
@@ -366,12 +384,13 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 	defer cancel()
 
 	isolationGroup, _ := ctx.Value(_isolationGroupKey).(string)
+	identity, _ := ctx.Value(identityKey).(string)
 	pollerID, ok := ctx.Value(pollerIDKey).(string)
 	if ok && pollerID != "" {
 		// Found pollerID on context, add it to the map to allow it to be canceled in
 		// response to CancelPoller call
 		c.outstandingPollsLock.Lock()
-		c.outstandingPollsMap[pollerID] = outstandingPollerInfo{isolationGroup: isolationGroup, cancel: cancel}
+		c.outstandingPollsMap[pollerID] = outstandingPollerInfo{identity: identity, isolationGroup: isolationGroup, cancel: cancel}
 		c.outstandingPollsLock.Unlock()
 		defer func() {
 			c.outstandingPollsLock.Lock()
@@ -380,8 +399,7 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 		}()
 	}
 
-	identity, ok := ctx.Value(identityKey).(string)
-	if ok && identity != "" {
+	if identity != "" {
 		c.pollerHistory.updatePollerInfo(pollerIdentity(identity), pollerInfo{ratePerSecond: maxDispatchPerSecond, isolationGroup: isolationGroup})
 		defer func() {
 			// to update timestamp of this poller when long poll ends
@@ -416,6 +434,38 @@ func (c *taskListManagerImpl) GetAllPollerInfo() []*types.PollerInfo {
 	return c.pollerHistory.getPollerInfo(time.Time{})
 }
 
+// DescribePoller returns information about the given poller along with the ids of its outstanding polls,
+// which can be used to cancel them
+func (c *taskListManagerImpl) DescribePoller(identity string) (*types.MatchingDescribePollerResponse, bool) {
+	poller, isolationGroup, found := c.pollerHistory.getPoller(pollerIdentity(identity))
+
+	var outstandingPollerIDs []string
+	c.outstandingPollsLock.Lock()
+	for pollerID, info := range c.outstandingPollsMap {
+		if info.identity == identity {
+			outstandingPollerIDs = append(outstandingPollerIDs, pollerID)
+			if !found {
+				isolationGroup = info.isolationGroup
+			}
+		}
+	}
+	c.outstandingPollsLock.Unlock()
+
+	if !found && len(outstandingPollerIDs) == 0 {
+		return nil, false
+	}
+	if poller == nil {
+		// the poller was evicted from the history while its poll is still outstanding
+		poller = &types.PollerInfo{Identity: identity}
+	}
+	sort.Strings(outstandingPollerIDs)
+	return &types.MatchingDescribePollerResponse{
+		Poller:               poller,
+		IsolationGroup:       isolationGroup,
+		OutstandingPollerIDs: outstandingPollerIDs,
+	}, true
+}
+
 // HasPollerAfter checks if there is any poller after a timestamp
 func (c *taskListManagerImpl) HasPollerAfter(accessTime time.Time) bool {
 	inflightPollerCount := 0
@@ -429,7 +479,8 @@ func (c *taskListManagerImpl) HasPollerAfter(accessTime time.Time) bool {
 	return len(recentPollers) > 0
 }
 
-func (c *taskListManagerImpl) CancelPoller(pollerID string) {
+// CancelPoller cancels the outstanding poll of the given poller, it returns false if there is no such poll
+func (c *taskListManagerImpl) CancelPoller(pollerID string) bool {
 	c.outstandingPollsLock.Lock()
 	info, ok := c.outstandingPollsMap[pollerID]
 	c.outstandingPollsLock.Unlock()
@@ -437,7 +488,27 @@ func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 	if ok && info.cancel != nil {
 		info.cancel()
 		c.logger.Info("canceled outstanding poller", tag.WorkflowDomainName(c.domainName))
+		return true
 	}
+	return false
+}
+
+// CancelAllPollers cancels every outstanding poll of the task list and returns the number of cancelled polls
+func (c *taskListManagerImpl) CancelAllPollers() int {
+	c.outstandingPollsLock.Lock()
+	cancels := make([]context.CancelFunc, 0, len(c.outstandingPollsMap))
+	for _, info := range c.outstandingPollsMap {
+		if info.cancel != nil {
+			cancels = append(cancels, info.cancel)
+		}
+	}
+	c.outstandingPollsLock.Unlock()
+
+	for _, cancel := range cancels {
+		cancel()
+	}
+	c.logger.Info("canceled all outstanding pollers", tag.WorkflowDomainName(c.domainName), tag.Counter(len(cancels)))
+	return len(cancels)
 }
 
 // DescribeTaskList returns information about the target tasklist, right now this API returns the
@@ -469,6 +540,45 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *type
 	return response
 }
 
+// ResetAckLevel persists the new ack level and unloads the task list, so that it's reloaded
+// reading the backlog from the new ack level on next access
+func (c *taskListManagerImpl) ResetAckLevel(ackLevel int64) error {
+	maxReadLevel := c.taskWriter.GetMaxReadLevel()
+	if ackLevel < 0 || ackLevel > maxReadLevel {
+		return &types.BadRequestError{
+			Message: fmt.Sprintf("AckLevel %v is out of the valid range [0, %v] of the task list.", ackLevel, maxReadLevel),
+		}
+	}
+
+	if !atomic.CompareAndSwapInt32(&c.stopped, 0, 1) {
+		return errShutdown
+	}
+	// the task reader persists its current ack level when it's stopped, so stop it before persisting the new
+	// ack level, and only unload the task list afterwards so that it can't be reloaded with the old ack level
+	c.stopPumps()
+	err := c.db.UpdateState(ackLevel)
+	c.closeCallback(c)
+	c.logger.Info("Task list manager state changed", tag.LifeCycleStopped)
+	if err != nil {
+		return err
+	}
+	c.logger.Info("Task list ack level reset", tag.AckLevel(ackLevel))
+	return nil
+}
+
+// RefreshConfig applies the current partition config to the task list. The task list config reads the
+// dynamic config on every call, so only the dispatch rate, which is divided across the partitions when
+// pollers set it, has to be recomputed.
+func (c *taskListManagerImpl) RefreshConfig() *types.MatchingRefreshTaskListResponse {
+	c.matcher.RefreshRatelimit()
+	response := &types.MatchingRefreshTaskListResponse{
+		NumReadPartitions:  int32(c.config.NumReadPartitions()),
+		NumWritePartitions: int32(c.config.NumWritePartitions()),
+	}
+	c.logger.Info("Task list config refreshed", tag.Dynamic("numReadPartitions", response.NumReadPartitions), tag.Dynamic("numWritePartitions", response.NumWritePartitions))
+	return response
+}
+
 func (c *taskListManagerImpl) String() string {
 	buf := new(bytes.Buffer)
 	if c.taskListID.taskType == persistence.TaskListTypeActivity {
@@ -514,6 +624,7 @@ func (c *taskListManagerImpl) executeWithRetry(
 
 func (c *taskListManagerImpl) trySyncMatch(ctx context.Context, params addTaskParams, isolationGroup string) (bool, error) {
 	task := newInternalTask(params.taskInfo, nil, params.source, params.forwardedFrom, true, params.activityTaskDispatchInfo, isolationGroup)
+	task.forwardingDepth = params.forwardingDepth
 	childCtx := ctx
 	cancel := func() {}
 	waitTime := maxSyncMatchWaitTime
@@ -588,7 +699,7 @@ func (c *taskListManagerImpl) getIsolationGroupForTask(ctx context.Context, task
 		// pollers are available in all isolation groups to avoid the risk of leaking a task to another isolation group.
 		// Besides, for sticky and scalable tasklists, not all poller information are available, we also use all isolation group.
 		if time.Now().Sub(c.createTime) > time.Minute && c.taskListKind != types.TaskListKindSticky && c.taskListID.IsRoot() {
-			pollerIsolationGroups = c.getPollerIsolationGroups()
+			pollerIsolationGroups = c.GetPollerIsolationGroups()
 			if len(pollerIsolationGroups) == 0 {
 				// we don't have any pollers, use all isolation groups and wait for pollers' arriving
 				pollerIsolationGroups = c.config.AllIsolationGroups
@@ -610,7 +721,7 @@ func (c *taskListManagerImpl) getIsolationGroupForTask(ctx context.Context, task
 		// to let the task to be re-enqueued to the non-sticky tasklist. If there is poller, just return an empty isolation group, because
 		// there is at most one isolation group for sticky tasklist and we could just use empty isolation group for matching.
 		if c.taskListKind == types.TaskListKindSticky {
-			pollerIsolationGroups = c.getPollerIsolationGroups()
+			pollerIsolationGroups = c.GetPollerIsolationGroups()
 			for _, pollerGroup := range pollerIsolationGroups {
 				if group == pollerGroup {
 					return "", nil
@@ -623,7 +734,7 @@ func (c *taskListManagerImpl) getIsolationGroupForTask(ctx context.Context, task
 	return defaultTaskBufferIsolationGroup, nil
 }
 
-func (c *taskListManagerImpl) getPollerIsolationGroups() []string {
+func (c *taskListManagerImpl) GetPollerIsolationGroups() []string {
 	groupSet := c.pollerHistory.getPollerIsolationGroups(time.Now().Add(-10 * time.Second))
 	c.outstandingPollsLock.Lock()
 	for _, poller := range c.outstandingPollsMap {
@@ -22,6 +22,7 @@ package matching
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -135,6 +136,32 @@ func TestReadLevelForAllExpiredTasksInBatch(t *testing.T) {
 	require.Equal(t, int64(14), tlm.taskAckManager.GetReadLevel())
 }
 
+func TestAddTasksToBufferByPriority(t *testing.T) {
+	controller := gomock.NewController(t)
+	logger := testlogger.New(t)
+
+	tlm := createTestTaskListManager(logger, controller)
+	priorities := []int32{0, 5, 0, 9}
+	tasks := make([]*persistence.TaskInfo, 0, len(priorities))
+	for i, priority := range priorities {
+		taskID := int64(i + 1)
+		tasks = append(tasks, &persistence.TaskInfo{
+			TaskID:      taskID,
+			Expiry:      time.Now().Add(time.Hour),
+			CreatedTime: time.Now(),
+			Priority:    priority,
+		})
+	}
+
+	require.True(t, tlm.taskReader.addTasksToBuffer(tasks))
+	require.Equal(t, int64(4), tlm.taskAckManager.GetReadLevel())
+	buffer := tlm.taskReader.taskBuffers[defaultTaskBufferIsolationGroup]
+	for _, expectedTaskID := range []int64{4, 2, 1, 3} {
+		task := <-buffer
+		assert.Equal(t, expectedTaskID, task.TaskID)
+	}
+}
+
 func createTestTaskListManager(logger log.Logger, controller *gomock.Controller) *taskListManagerImpl {
 	return createTestTaskListManagerWithConfig(logger, controller, defaultTestConfig())
 }
@@ -369,13 +396,13 @@ func TestGetPollerIsolationGroup(t *testing.T) {
 	assert.Contains(t, err.Error(), ErrNoTasks.Error())
 
 	// we should get isolation groups that showed up within last 10 seconds
-	groups := tlm.getPollerIsolationGroups()
+	groups := tlm.GetPollerIsolationGroups()
 	assert.Equal(t, 1, len(groups))
 	assert.Equal(t, config.AllIsolationGroups[0], groups[0])
 
 	// after 10s, the poller from that isolation group are cleared from the poller history
 	time.Sleep(10 * time.Second)
-	groups = tlm.getPollerIsolationGroups()
+	groups = tlm.GetPollerIsolationGroups()
 	assert.Equal(t, 0, len(groups))
 
 	// we should get isolation groups of outstanding pollers
@@ -390,12 +417,97 @@ func TestGetPollerIsolationGroup(t *testing.T) {
 		assert.Contains(t, err.Error(), ErrNoTasks.Error())
 	}()
 	time.Sleep(11 * time.Second)
-	groups = tlm.getPollerIsolationGroups()
+	groups = tlm.GetPollerIsolationGroups()
 	wg.Wait()
 	assert.Equal(t, 1, len(groups))
 	assert.Equal(t, config.AllIsolationGroups[0], groups[0])
 }
 
+func TestCancelPollers(t *testing.T) {
+	controller := gomock.NewController(t)
+	logger := testlogger.New(t)
+
+	config := defaultTestConfig()
+	config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(30 * time.Second)
+	tlm := createTestTaskListManagerWithConfig(logger, controller, config)
+
+	outstandingPolls := func() int {
+		tlm.outstandingPollsLock.Lock()
+		defer tlm.outstandingPollsLock.Unlock()
+		return len(tlm.outstandingPollsMap)
+	}
+
+	const pollerCount = 3
+	var wg sync.WaitGroup
+	for i := 0; i < pollerCount; i++ {
+		wg.Add(1)
+		go func(pollerID string) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), pollerIDKey, pollerID), 30*time.Second)
+			defer cancel()
+			_, err := tlm.GetTask(ctx, nil)
+			assert.Error(t, err)
+		}(fmt.Sprintf("poller%v", i))
+	}
+	assert.Eventually(t, func() bool { return outstandingPolls() == pollerCount }, 5*time.Second, 10*time.Millisecond)
+
+	assert.False(t, tlm.CancelPoller("unknown"))
+	assert.True(t, tlm.CancelPoller("poller0"))
+	assert.Eventually(t, func() bool { return outstandingPolls() == pollerCount-1 }, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, pollerCount-1, tlm.CancelAllPollers())
+	wg.Wait()
+	assert.Zero(t, outstandingPolls())
+	assert.Zero(t, tlm.CancelAllPollers())
+}
+
+func TestDescribePoller(t *testing.T) {
+	controller := gomock.NewController(t)
+	logger := testlogger.New(t)
+
+	config := defaultTestConfig()
+	config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByTaskListInfo(30 * time.Second)
+	tlm := createTestTaskListManagerWithConfig(logger, controller, config)
+
+	_, ok := tlm.DescribePoller("id0")
+	assert.False(t, ok)
+
+	bgCtx := context.WithValue(context.Background(), pollerIDKey, "poller0")
+	bgCtx = context.WithValue(bgCtx, identityKey, "id0")
+	bgCtx = context.WithValue(bgCtx, _isolationGroupKey, config.AllIsolationGroups[0])
+	ctx, cancel := context.WithTimeout(bgCtx, 30*time.Second)
+	defer cancel()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := tlm.GetTask(ctx, nil)
+		assert.Error(t, err)
+	}()
+
+	var resp *types.MatchingDescribePollerResponse
+	assert.Eventually(t, func() bool {
+		resp, ok = tlm.DescribePoller("id0")
+		return ok && len(resp.GetOutstandingPollerIDs()) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "id0", resp.GetPoller().GetIdentity())
+	assert.NotZero(t, resp.GetPoller().GetLastAccessTime())
+	assert.Equal(t, config.AllIsolationGroups[0], resp.GetIsolationGroup())
+	assert.Equal(t, []string{"poller0"}, resp.GetOutstandingPollerIDs())
+
+	_, ok = tlm.DescribePoller("id1")
+	assert.False(t, ok)
+
+	// the poller is still described from the poller history once its poll is cancelled
+	assert.True(t, tlm.CancelPoller("poller0"))
+	wg.Wait()
+	resp, ok = tlm.DescribePoller("id0")
+	assert.True(t, ok)
+	assert.Equal(t, "id0", resp.GetPoller().GetIdentity())
+	assert.Equal(t, config.AllIsolationGroups[0], resp.GetIsolationGroup())
+	assert.Empty(t, resp.GetOutstandingPollerIDs())
+}
+
 // return a client side tasklist throttle error from the rate limiter.
 // The expected behaviour is to retry
 func TestRateLimitErrorsFromTasklistDispatch(t *testing.T) {
@@ -26,6 +26,7 @@ import (
 	"context"
 	"errors"
 	"runtime"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -285,6 +286,7 @@ func (tr *taskReader) isTaskExpired(t *persistence.TaskInfo, now time.Time) bool
 
 func (tr *taskReader) addTasksToBuffer(tasks []*persistence.TaskInfo) bool {
 	now := time.Now()
+	readTasks := make([]*persistence.TaskInfo, 0, len(tasks))
 	for _, t := range tasks {
 		if tr.isTaskExpired(t, now) {
 			tr.scope.IncCounter(metrics.ExpiredTasksPerTaskListCounter)
@@ -293,6 +295,20 @@ func (tr *taskReader) addTasksToBuffer(tasks []*persistence.TaskInfo) bool {
 			tr.taskAckManager.SetReadLevel(t.TaskID)
 			continue
 		}
+		// tasks must be read by the ackManager in taskID order
+		err := tr.taskAckManager.ReadItem(t.TaskID)
+		if err != nil {
+			tr.logger.Fatal("critical bug when adding item to ackManager", tag.Error(err))
+		}
+		readTasks = append(readTasks, t)
+	}
+	// the batch is buffered from the highest priority, tasks of the same priority are kept in FIFO order.
+	// Priority only reorders tasks within a read batch, a high priority task never overtakes
+	// tasks of earlier batches which are already buffered
+	sort.SliceStable(readTasks, func(i, j int) bool {
+		return readTasks[i].Priority > readTasks[j].Priority
+	})
+	for _, t := range readTasks {
 		if !tr.addSingleTaskToBuffer(t) {
 			return false // we are shutting down the task list
 		}
@@ -301,10 +317,6 @@ func (tr *taskReader) addTasksToBuffer(tasks []*persistence.TaskInfo) bool {
 }
 
 func (tr *taskReader) addSingleTaskToBuffer(task *persistence.TaskInfo) bool {
-	err := tr.taskAckManager.ReadItem(task.TaskID)
-	if err != nil {
-		tr.logger.Fatal("critical bug when adding item to ackManager", tag.Error(err))
-	}
 	isolationGroup, err := tr.getIsolationGroupForTask(tr.cancelCtx, task)
 	if err != nil {
 		// it only errors when the tasklist is a sticky tasklist and
@@ -62,13 +62,13 @@ func (t ThriftHandler) AddActivityTask(ctx context.Context, request *m.AddActivi
 
 // AddDecisionTask forwards request to the underlying handler
 func (t ThriftHandler) AddDecisionTask(ctx context.Context, request *m.AddDecisionTaskRequest) error {
-	err := t.h.AddDecisionTask(ctx, thrift.ToAddDecisionTaskRequest(request))
+	_, err := t.h.AddDecisionTask(ctx, thrift.ToAddDecisionTaskRequest(request))
 	return thrift.FromError(err)
 }
 
 // CancelOutstandingPoll forwards request to the underlying handler
 func (t ThriftHandler) CancelOutstandingPoll(ctx context.Context, request *m.CancelOutstandingPollRequest) error {
-	err := t.h.CancelOutstandingPoll(ctx, thrift.ToCancelOutstandingPollRequest(request))
+	_, err := t.h.CancelOutstandingPoll(ctx, thrift.ToCancelOutstandingPollRequest(request))
 	return thrift.FromError(err)
 }
 
@@ -55,12 +55,12 @@ func TestThriftHandler(t *testing.T) {
 		assert.Equal(t, expectedErr, err)
 	})
 	t.Run("AddDecisionTask", func(t *testing.T) {
-		h.EXPECT().AddDecisionTask(ctx, &types.AddDecisionTaskRequest{}).Return(internalErr).Times(1)
+		h.EXPECT().AddDecisionTask(ctx, &types.AddDecisionTaskRequest{}).Return(nil, internalErr).Times(1)
 		err := th.AddDecisionTask(ctx, &m.AddDecisionTaskRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
 	t.Run("CancelOutstandingPoll", func(t *testing.T) {
-		h.EXPECT().CancelOutstandingPoll(ctx, &types.CancelOutstandingPollRequest{}).Return(internalErr).Times(1)
+		h.EXPECT().CancelOutstandingPoll(ctx, &types.CancelOutstandingPollRequest{}).Return(nil, internalErr).Times(1)
 		err := th.CancelOutstandingPoll(ctx, &m.CancelOutstandingPollRequest{})
 		assert.Equal(t, expectedErr, err)
 	})
@@ -24,22 +24,53 @@ import (
 	"context"
 	"time"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/log/tag"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/types"
 )
 
 var retryForeverPolicy = newRetryForeverPolicy()
 
-func (s *Scavenger) completeTasks(info *p.TaskListInfo, taskID int64, limit int) (int, error) {
-	var resp *p.CompleteTasksLessThanResponse
-	var err error
+// completeTasks deletes the given tasks, which must be sorted by task ID, splitting the delete into
+// chunks of at most maxTaskDeleteBatchSize tasks. Every chunk is bounded by the ID of its last task,
+// so the chunk size holds on backends that ignore the limit and delete the whole range
+func (s *Scavenger) completeTasks(ctx context.Context, info *p.TaskListInfo, tasks []*p.TaskInfo) (int, error) {
 	domainName, errorDomain := s.cache.GetDomainName(info.DomainID)
 	if errorDomain != nil {
 		return 0, errorDomain
 	}
-	err = s.retryForever(func() error {
-		resp, err = s.db.CompleteTasksLessThan(s.ctx, &p.CompleteTasksLessThanRequest{
+	maxBatchSize := s.maxTaskDeleteBatchSizeFn()
+	if maxBatchSize <= 0 {
+		maxBatchSize = len(tasks)
+	}
+	if len(tasks) > maxBatchSize {
+		s.logger.Warn("scavenger.completeTasks batch exceeds the max task delete batch size, splitting it",
+			tag.WorkflowDomainID(info.DomainID), tag.WorkflowTaskListName(info.Name), tag.TaskType(info.TaskType),
+			tag.Counter(len(tasks)), tag.Dynamic("max-task-delete-batch-size", maxBatchSize))
+	}
+
+	nCompleted := 0
+	for start := 0; start < len(tasks); start += maxBatchSize {
+		batch := tasks[start:common.MinInt(start+maxBatchSize, len(tasks))]
+		n, err := s.completeTasksBatch(ctx, info, domainName, batch[len(batch)-1].TaskID, len(batch))
+		if err != nil {
+			return nCompleted, err
+		}
+		if n == p.UnknownNumRowsAffected {
+			n = len(batch)
+		}
+		nCompleted += n
+	}
+	return nCompleted, nil
+}
+
+func (s *Scavenger) completeTasksBatch(ctx context.Context, info *p.TaskListInfo, domainName string, taskID int64, limit int) (int, error) {
+	var resp *p.CompleteTasksLessThanResponse
+	var err error
+	err = s.retryForever(ctx, func() error {
+		resp, err = s.db.CompleteTasksLessThan(ctx, &p.CompleteTasksLessThanRequest{
 			DomainID:     info.DomainID,
 			TaskListName: info.Name,
 			TaskType:     info.TaskType,
@@ -58,7 +89,7 @@ func (s *Scavenger) completeTasks(info *p.TaskListInfo, taskID int64, limit int)
 func (s *Scavenger) getOrphanTasks(limit int) (*p.GetOrphanTasksResponse, error) {
 	var tasks *p.GetOrphanTasksResponse
 	var err error
-	err = s.retryForever(func() error {
+	err = s.retryForever(s.ctx, func() error {
 		tasks, err = s.db.GetOrphanTasks(s.ctx, &p.GetOrphanTasksRequest{
 			Limit: limit,
 		})
@@ -73,7 +104,7 @@ func (s *Scavenger) completeTask(info *p.TaskListInfo, taskid int64) error {
 	if errorDomain != nil {
 		return errorDomain
 	}
-	err = s.retryForever(func() error {
+	err = s.retryForever(s.ctx, func() error {
 		err = s.db.CompleteTask(s.ctx, &p.CompleteTaskRequest{
 			TaskList:   info,
 			TaskID:     taskid,
@@ -84,15 +115,15 @@ func (s *Scavenger) completeTask(info *p.TaskListInfo, taskid int64) error {
 	return err
 }
 
-func (s *Scavenger) getTasks(info *p.TaskListInfo, batchSize int) (*p.GetTasksResponse, error) {
+func (s *Scavenger) getTasks(ctx context.Context, info *p.TaskListInfo, batchSize int) (*p.GetTasksResponse, error) {
 	var err error
 	var resp *p.GetTasksResponse
 	domainName, errorDomain := s.cache.GetDomainName(info.DomainID)
 	if errorDomain != nil {
 		return nil, errorDomain
 	}
-	err = s.retryForever(func() error {
-		resp, err = s.db.GetTasks(s.ctx, &p.GetTasksRequest{
+	err = s.retryForever(ctx, func() error {
+		resp, err = s.db.GetTasks(ctx, &p.GetTasksRequest{
 			DomainID:   info.DomainID,
 			TaskList:   info.Name,
 			TaskType:   info.TaskType,
@@ -108,7 +139,7 @@ func (s *Scavenger) getTasks(info *p.TaskListInfo, batchSize int) (*p.GetTasksRe
 func (s *Scavenger) listTaskList(pageSize int, pageToken []byte) (*p.ListTaskListResponse, error) {
 	var err error
 	var resp *p.ListTaskListResponse
-	err = s.retryForever(func() error {
+	err = s.retryForever(s.ctx, func() error {
 		resp, err = s.db.ListTaskList(s.ctx, &p.ListTaskListRequest{
 			PageSize:  pageSize,
 			PageToken: pageToken,
@@ -118,6 +149,37 @@ func (s *Scavenger) listTaskList(pageSize int, pageToken []byte) (*p.ListTaskLis
 	return resp, err
 }
 
+func (s *Scavenger) getTaskList(info *p.TaskListInfo) (*p.TaskListInfo, error) {
+	var err error
+	var resp *p.GetTaskListResponse
+	domainName, errorDomain := s.cache.GetDomainName(info.DomainID)
+	if errorDomain != nil {
+		return nil, errorDomain
+	}
+	op := func() error {
+		resp, err = s.db.GetTaskList(s.ctx, &p.GetTaskListRequest{
+			DomainID:   info.DomainID,
+			DomainName: domainName,
+			TaskList:   info.Name,
+			TaskType:   info.TaskType,
+		})
+		return err
+	}
+	// the task list may have been deleted since it was listed, which is not retried
+	throttleRetry := backoff.NewThrottleRetry(
+		backoff.WithRetryPolicy(retryForeverPolicy),
+		backoff.WithRetryableError(func(err error) bool {
+			_, ok := err.(*types.EntityNotExistsError)
+			return !ok && s.isRetryable(err)
+		}),
+	)
+	err = throttleRetry.Do(context.Background(), op)
+	if err != nil {
+		return nil, err
+	}
+	return resp.TaskListInfo, nil
+}
+
 func (s *Scavenger) deleteTaskList(info *p.TaskListInfo) error {
 	domainName, errorDomain := s.cache.GetDomainName(info.DomainID)
 	if errorDomain != nil {
@@ -143,12 +205,13 @@ func (s *Scavenger) deleteTaskList(info *p.TaskListInfo) error {
 	return throttleRetry.Do(context.Background(), op)
 }
 
-func (s *Scavenger) retryForever(op func() error) error {
+// retryForever retries op until it succeeds, the scavenger is stopped or ctx is done
+func (s *Scavenger) retryForever(ctx context.Context, op func() error) error {
 	throttleRetry := backoff.NewThrottleRetry(
 		backoff.WithRetryPolicy(retryForeverPolicy),
 		backoff.WithRetryableError(s.isRetryable),
 	)
-	return throttleRetry.Do(context.Background(), op)
+	return throttleRetry.Do(ctx, op)
 }
 
 func newRetryForeverPolicy() backoff.RetryPolicy {
@@ -21,13 +21,21 @@
 package tasklist
 
 import (
+	"context"
+	"errors"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 
+	"go.uber.org/multierr"
+
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/ratelimited"
+	"github.com/uber/cadence/common/types"
 	"github.com/uber/cadence/service/worker/scanner/executor"
 )
 
@@ -56,66 +64,153 @@ const scannerTaskListPrefix = "cadence-sys-tl-scanner"
 //   - Delete the entire batch of tasks
 //   - If the number of tasks retrieved is less than batchSize, there are no more tasks in the task-list
 //     Try deleting the task-list if its idle
-func (s *Scavenger) deleteHandler(taskListInfo *p.TaskListInfo) handlerStatus {
-	var err error
-	var nProcessed, nDeleted int
+//
+// In dry run, the tasks of the first batch are counted as would-delete instead of being deleted, and
+// since they would be read again, the handler stops after the first batch
+//
+// If ctx is done, the handler returns StatusDefer before retrieving the next batch
+func (s *Scavenger) deleteHandler(ctx context.Context, taskListInfo *p.TaskListInfo) handlerStatus {
+	if !s.taskListFilter.matches(taskListInfo.Name) {
+		atomic.AddInt64(&s.stats.tasklist.nSkipped, 1)
+		return handlerStatusDone
+	}
+	status, _, _, _ := s.deleteTasks(ctx, taskListInfo, true)
+	return status
+}
+
+// deleteTasks runs the loop of deleteHandler and returns the number of processed and deleted tasks along with
+// the error which made it return StatusErr, the task list is only deleted if it's idle and deleteIdleTaskList is true
+func (s *Scavenger) deleteTasks(
+	ctx context.Context,
+	taskListInfo *p.TaskListInfo,
+	deleteIdleTaskList bool,
+) (status handlerStatus, nProcessed int, nDeleted int, err error) {
+	tryDeleteTaskList := func() {
+		if deleteIdleTaskList {
+			s.tryDeleteTaskList(taskListInfo)
+		}
+	}
 
 	defer func() { s.deleteHandlerLog(taskListInfo, nProcessed, nDeleted, err) }()
 	taskBatchSize := s.taskBatchSizeFn()
 	maxTasksPerJob := s.maxTasksPerJobFn()
 
 	for nProcessed < maxTasksPerJob {
-		resp, err1 := s.getTasks(taskListInfo, taskBatchSize)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			msg := "scavenger.deleteHandler context canceled, deferring"
+			if common.IsContextTimeoutError(ctxErr) {
+				msg = "scavenger.deleteHandler context deadline exceeded, deferring"
+			}
+			s.logger.Info(msg, tag.Error(ctxErr),
+				tag.WorkflowDomainID(taskListInfo.DomainID), tag.WorkflowTaskListName(taskListInfo.Name), tag.TaskType(taskListInfo.TaskType))
+			return handlerStatusDefer, nProcessed, nDeleted, nil
+		}
+
+		resp, err1 := s.getTasks(ctx, taskListInfo, taskBatchSize)
 		if err1 != nil {
-			err = err1
-			return handlerStatusErr
+			return handlerStatusErr, nProcessed, nDeleted, err1
 		}
 
 		nTasks := len(resp.Tasks)
 		if nTasks == 0 {
-			s.tryDeleteTaskList(taskListInfo)
-			return handlerStatusDone
+			tryDeleteTaskList()
+			return handlerStatusDone, nProcessed, nDeleted, nil
 		}
 
-		for _, task := range resp.Tasks {
+		if nProcessed == 0 {
+			s.emitOldestExpiryGap(resp.Tasks[0])
+		}
+		for i, task := range resp.Tasks {
 			nProcessed++
 			if !s.isTaskExpired(task) {
-				return handlerStatusDone
+				s.emitNotExpiredCount(resp.Tasks[i:])
+				return handlerStatusDone, nProcessed, nDeleted, nil
+			}
+		}
+
+		if s.dryRun() {
+			atomic.AddInt64(&s.stats.task.nWouldDelete, int64(nTasks))
+			s.logger.Info("scavenger.deleteHandler dry run, tasks would be deleted",
+				tag.WorkflowDomainID(taskListInfo.DomainID), tag.WorkflowTaskListName(taskListInfo.Name), tag.TaskType(taskListInfo.TaskType), tag.Counter(nTasks))
+			if nTasks < taskBatchSize {
+				tryDeleteTaskList()
 			}
+			return handlerStatusDone, nProcessed, nDeleted, nil
 		}
 
-		taskID := resp.Tasks[nTasks-1].TaskID
-		if _, err = s.completeTasks(taskListInfo, taskID, nTasks); err != nil {
-			return handlerStatusErr
+		nCompleted, err1 := s.completeTasks(ctx, taskListInfo, resp.Tasks)
+		nDeleted += nCompleted
+		if err1 != nil {
+			return handlerStatusErr, nProcessed, nDeleted, err1
 		}
 
-		nDeleted += nTasks
 		if nTasks < taskBatchSize {
-			s.tryDeleteTaskList(taskListInfo)
-			return handlerStatusDone
+			tryDeleteTaskList()
+			return handlerStatusDone, nProcessed, nDeleted, nil
 		}
 	}
 
-	return handlerStatusDefer
+	return handlerStatusDefer, nProcessed, nDeleted, nil
 }
 
 func (s *Scavenger) tryDeleteTaskList(info *p.TaskListInfo) {
 	if strings.HasPrefix(info.Name, scannerTaskListPrefix) {
 		return // avoid deleting our own task list
 	}
-	delta := time.Since(info.LastUpdated)
-	if delta < taskListGracePeriod {
+	if !s.taskListFilter.matches(info.Name) {
+		return
+	}
+	domainName, err := s.cache.GetDomainName(info.DomainID)
+	if err != nil {
+		s.logger.Error("GetDomainName error", tag.Error(err), tag.WorkflowDomainID(info.DomainID))
+		return
+	}
+	delta := s.timeSource.Now().Sub(info.LastUpdated)
+	if delta < s.taskListGracePeriod(domainName, info) {
+		return
+	}
+	if s.dryRun() {
+		atomic.AddInt64(&s.stats.tasklist.nWouldDelete, 1)
+		s.logger.Info("tasklist would be deleted in dry run", tag.WorkflowDomainID(info.DomainID), tag.WorkflowTaskListName(info.Name), tag.TaskType(info.TaskType))
 		return
 	}
 	// usually, matching engine is the authoritative owner of a tasklist
 	// and its incorrect for any other entity to mutate executorTask lists (including deleting it)
 	// the delete here is safe because of two reasons:
-	//   - we delete the executorTask list only if the lastUpdated is > 48H. If a executorTask list is idle for
+	//   - we delete the executorTask list only if the lastUpdated is > grace period (48H by default). If a executorTask list is idle for
 	//     this amount of time, it will no longer be owned by any host in matching engine (because
 	//     of idle timeout). If any new host has to take ownership of this at this time, it can only
 	//     do so by updating the rangeID
 	//   - deleteTaskList is a conditional delete where condition is the rangeID
-	if err := s.deleteTaskList(info); err != nil {
+	err = s.deleteTaskList(info)
+	var conditionErr *p.ConditionFailedError
+	if errors.As(err, &conditionErr) {
+		// the range ID changed since the task list was listed, so retry the idle checks and the delete
+		// once after a delay, with the current state of the task list
+		s.logger.Warn("deleteTaskList condition failed, retrying", tag.Error(err), tag.WorkflowDomainID(info.DomainID), tag.WorkflowTaskListName(info.Name))
+		select {
+		case <-s.timeSource.After(s.deleteRetryDelay):
+		case <-s.stopC:
+			return
+		}
+		info, err = s.getTaskList(info)
+		if err != nil {
+			var notExistsErr *types.EntityNotExistsError
+			if !errors.As(err, &notExistsErr) {
+				s.logger.Error("getTaskList error", tag.Error(err))
+			}
+			return
+		}
+		if s.timeSource.Now().Sub(info.LastUpdated) < s.taskListGracePeriod(domainName, info) {
+			s.logger.Info("tasklist was updated, skipping delete", tag.WorkflowDomainID(info.DomainID), tag.WorkflowTaskListName(info.Name), tag.TaskType(info.TaskType))
+			return
+		}
+		if s.isTaskListActive(info) {
+			return
+		}
+		err = s.deleteTaskList(info)
+	}
+	if err != nil {
 		s.logger.Error("deleteTaskList error", tag.Error(err))
 		return
 	}
@@ -123,6 +218,30 @@ func (s *Scavenger) tryDeleteTaskList(info *p.TaskListInfo) {
 	s.logger.Info("tasklist deleted", tag.WorkflowDomainID(info.DomainID), tag.WorkflowTaskListName(info.Name), tag.TaskType(info.TaskType))
 }
 
+// taskListGracePeriod returns the grace period of the task list's domain, clamped to the idle time after which
+// matching unloads the task list, so that a task list which may still be owned by a matching host is never deleted
+func (s *Scavenger) taskListGracePeriod(domainName string, info *p.TaskListInfo) time.Duration {
+	gracePeriod := s.taskListGracePeriodFn(domainName)
+	if idleTimeout := s.taskListIdleTimeoutFn(domainName, info.Name, info.TaskType); gracePeriod < idleTimeout {
+		return idleTimeout
+	}
+	return gracePeriod
+}
+
+// isTaskListActive returns true if tasks were added to the task list since it was found to be idle
+func (s *Scavenger) isTaskListActive(info *p.TaskListInfo) bool {
+	resp, err := s.getTasks(s.ctx, info, 1)
+	if err != nil {
+		s.logger.Error("getTasks error", tag.Error(err))
+		return true
+	}
+	if len(resp.Tasks) > 0 {
+		s.logger.Info("tasklist became active, skipping delete", tag.WorkflowDomainID(info.DomainID), tag.WorkflowTaskListName(info.Name), tag.TaskType(info.TaskType))
+		return true
+	}
+	return false
+}
+
 func (s *Scavenger) deleteHandlerLog(info *p.TaskListInfo, nProcessed int, nDeleted int, err error) {
 	atomic.AddInt64(&s.stats.task.nDeleted, int64(nDeleted))
 	atomic.AddInt64(&s.stats.task.nProcessed, int64(nProcessed))
@@ -138,11 +257,36 @@ func (s *Scavenger) deleteHandlerLog(info *p.TaskListInfo, nProcessed int, nDele
 }
 
 func (s *Scavenger) isTaskExpired(t *p.TaskInfo) bool {
-	return t.Expiry.After(time.Unix(0, 0)) && time.Now().After(t.Expiry)
+	return t.Expiry.After(time.Unix(0, 0)) && s.timeSource.Now().After(t.Expiry)
+}
+
+// emitOldestExpiryGap emits how long ago the oldest task of a task list expired, or zero if it didn't expire yet
+func (s *Scavenger) emitOldestExpiryGap(oldest *p.TaskInfo) {
+	if !oldest.Expiry.After(time.Unix(0, 0)) {
+		return // the task never expires
+	}
+	gap := s.timeSource.Now().Sub(oldest.Expiry)
+	if gap < 0 {
+		gap = 0
+	}
+	s.scope.RecordTimer(metrics.TaskOldestExpiryGap, gap)
 }
 
+// emitNotExpiredCount emits the number of not expired tasks in the rest of the batch once the first one was found.
+// Tasks are expected to expire in the order of their IDs, the expired tasks left in the batch show they did not.
+func (s *Scavenger) emitNotExpiredCount(tasks []*p.TaskInfo) {
+	nNotExpired := 0
+	for _, task := range tasks {
+		if !s.isTaskExpired(task) {
+			nNotExpired++
+		}
+	}
+	s.scope.AddCounter(metrics.TaskNotExpiredCount, int64(nNotExpired))
+}
+
+// completeOrphanTasksHandler deletes a page of orphan tasks, up to orphanConcurrencyFn of them concurrently.
+// Once a delete failed no more deletes are started, and the handler is deferred if any of them was ratelimited.
 func (s *Scavenger) completeOrphanTasksHandler() handlerStatus {
-	var nDeleted int
 	batchSize := s.getOrphanTasksPageSizeFn()
 	resp, err := s.getOrphanTasks(batchSize)
 	if err == ratelimited.ErrPersistenceLimitExceeded {
@@ -153,25 +297,66 @@ func (s *Scavenger) completeOrphanTasksHandler() handlerStatus {
 		s.logger.Error("scavenger.completeOrphanTasksHandler error getting orphan tasks")
 		return handlerStatusErr
 	}
+
+	concurrency := s.orphanConcurrencyFn()
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	var (
+		nDeleted      int64
+		isRatelimited int32
+		errLock       sync.Mutex
+		errs          error
+		wg            sync.WaitGroup
+	)
+	tokens := make(chan struct{}, concurrency)
 	for _, taskKey := range resp.Tasks {
-		err = s.completeTask(&p.TaskListInfo{
-			DomainID: taskKey.DomainID,
-			Name:     taskKey.TaskListName,
-			TaskType: taskKey.TaskType,
-		}, taskKey.TaskID)
-		if err == ratelimited.ErrPersistenceLimitExceeded {
-			s.logger.Info("scavenger.completeOrphanTasksHandler query was ratelimited; will retry")
-			return handlerStatusDefer
-		}
-		if err != nil {
-			s.logger.Error("scavenger.completeOrphanTasksHandler error getting orphan tasks")
-			return handlerStatusErr
+		tokens <- struct{}{}
+		errLock.Lock()
+		failed := errs != nil || atomic.LoadInt32(&isRatelimited) == 1
+		errLock.Unlock()
+		if failed {
+			<-tokens
+			break
 		}
-		nDeleted++
-		atomic.AddInt64(&s.stats.task.nDeleted, 1)
-		atomic.AddInt64(&s.stats.task.nProcessed, 1)
+
+		wg.Add(1)
+		go func(taskKey *p.TaskKey) {
+			defer func() {
+				<-tokens
+				wg.Done()
+			}()
+			err := s.completeTask(&p.TaskListInfo{
+				DomainID: taskKey.DomainID,
+				Name:     taskKey.TaskListName,
+				TaskType: taskKey.TaskType,
+			}, taskKey.TaskID)
+			if err == ratelimited.ErrPersistenceLimitExceeded {
+				atomic.StoreInt32(&isRatelimited, 1)
+				return
+			}
+			if err != nil {
+				errLock.Lock()
+				errs = multierr.Append(errs, err)
+				errLock.Unlock()
+				return
+			}
+			atomic.AddInt64(&nDeleted, 1)
+			atomic.AddInt64(&s.stats.task.nDeleted, 1)
+			atomic.AddInt64(&s.stats.task.nProcessed, 1)
+		}(taskKey)
+	}
+	wg.Wait()
+
+	if atomic.LoadInt32(&isRatelimited) == 1 {
+		s.logger.Info("scavenger.completeOrphanTasksHandler query was ratelimited; will retry", tag.NumberDeleted(int(nDeleted)))
+		return handlerStatusDefer
+	}
+	if errs != nil {
+		s.logger.Error("scavenger.completeOrphanTasksHandler error deleting orphan tasks", tag.Error(errs), tag.NumberDeleted(int(nDeleted)))
+		return handlerStatusErr
 	}
-	s.logger.Info("scavenger.completeOrphanTasksHandler deleted.", tag.NumberDeleted(nDeleted))
+	s.logger.Info("scavenger.completeOrphanTasksHandler deleted.", tag.NumberDeleted(int(nDeleted)))
 	if len(resp.Tasks) < batchSize {
 		return handlerStatusDone
 	}
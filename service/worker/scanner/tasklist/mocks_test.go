@@ -96,6 +96,16 @@ func (tbl *mockTaskListTable) delete(name string) {
 	tbl.info = newInfo
 }
 
+func (tbl *mockTaskListTable) update(name string, updateFn func(*p.TaskListInfo)) {
+	tbl.Lock()
+	defer tbl.Unlock()
+	for i := range tbl.info {
+		if tbl.info[i].Name == name {
+			updateFn(&tbl.info[i])
+		}
+	}
+}
+
 func (tbl *mockTaskListTable) get(name string) *p.TaskListInfo {
 	tbl.Lock()
 	defer tbl.Unlock()
@@ -22,12 +22,15 @@ package tasklist
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -40,7 +43,7 @@ const (
 	executorMaxDeferredTasks = 10000
 	taskListBatchSize        = 32 // maximum number of task list we process concurrently
 	taskBatchSize            = 16
-	taskListGracePeriod      = 48 * time.Hour // amount of time a executorTask list has to be idle before it becomes a candidate for deletion
+	taskListDeleteRetryDelay = 5 * time.Second // amount of time to wait before retrying a task list delete which failed on a condition conflict
 )
 
 type (
@@ -55,10 +58,18 @@ type (
 		stats                    stats
 		status                   int32
 		getOrphanTasksPageSizeFn dynamicconfig.IntPropertyFn
+		orphanConcurrencyFn      dynamicconfig.IntPropertyFn
 		taskBatchSizeFn          dynamicconfig.IntPropertyFn
 		maxTasksPerJobFn         dynamicconfig.IntPropertyFn
+		maxTaskDeleteBatchSizeFn dynamicconfig.IntPropertyFn
+		taskListGracePeriodFn    dynamicconfig.DurationPropertyFnWithDomainFilter
+		taskListIdleTimeoutFn    dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		taskListFilter           taskListFilter
 		cleanOrphans             dynamicconfig.BoolPropertyFn
+		dryRun                   dynamicconfig.BoolPropertyFn
 		pollInterval             time.Duration
+		deleteRetryDelay         time.Duration
+		timeSource               clock.Clock
 
 		// stopC is used to signal the scavenger to stop
 		stopC chan struct{}
@@ -70,21 +81,58 @@ type (
 
 	stats struct {
 		tasklist struct {
-			nProcessed int64
-			nDeleted   int64
+			nProcessed   int64
+			nDeleted     int64
+			nWouldDelete int64
+			nSkipped     int64
 		}
 		task struct {
-			nProcessed int64
-			nDeleted   int64
+			nProcessed   int64
+			nDeleted     int64
+			nWouldDelete int64
 		}
 	}
+	// ScavengerStats is a snapshot of the progress of the scavenger
+	ScavengerStats struct {
+		TaskListsProcessed   int64
+		TaskListsDeleted     int64
+		TaskListsWouldDelete int64
+		TaskListsSkipped     int64
+		TasksProcessed       int64
+		TasksDeleted         int64
+		TasksWouldDelete     int64
+	}
 	// Options is used to customize scavenger operations
 	Options struct {
 		GetOrphanTasksPageSizeFn dynamicconfig.IntPropertyFn
+		OrphanConcurrencyFn      dynamicconfig.IntPropertyFn
 		TaskBatchSizeFn          dynamicconfig.IntPropertyFn
 		EnableCleaning           dynamicconfig.BoolPropertyFn
 		MaxTasksPerJobFn         dynamicconfig.IntPropertyFn
-		ExecutorPollInterval     time.Duration
+		MaxTaskDeleteBatchSizeFn dynamicconfig.IntPropertyFn
+		// TaskListGracePeriodFn is the amount of time a task list has to be idle before it becomes a candidate for deletion,
+		// it's never lower than TaskListIdleTimeoutFn
+		TaskListGracePeriodFn dynamicconfig.DurationPropertyFnWithDomainFilter
+		// TaskListIdleTimeoutFn is the amount of time a task list has to be idle before matching unloads it
+		TaskListIdleTimeoutFn dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
+		// TaskListAllowlistFn and TaskListDenylistFn are the regexes of the task list names which are processed
+		// and never processed, they are read once when the scavenger is created
+		TaskListAllowlistFn  dynamicconfig.ListPropertyFn
+		TaskListDenylistFn   dynamicconfig.ListPropertyFn
+		ExecutorPollInterval time.Duration
+		// DeleteTaskListRetryDelay is the delay before retrying a task list delete which failed on a condition conflict
+		DeleteTaskListRetryDelay time.Duration
+		TimeSource               clock.Clock
+		// DryRun makes the scavenger only log and count the tasks and task lists it would delete
+		DryRun dynamicconfig.BoolPropertyFn
+	}
+
+	// TaskListScavengeResult is the result of scavenging a single task list on demand
+	TaskListScavengeResult struct {
+		TasksProcessed int
+		TasksDeleted   int
+		// HasMore is true if the task list may have more expired tasks than a single run processes
+		HasMore bool
 	}
 
 	// executorTask is a runnable task that adheres to the executor.Task interface
@@ -99,6 +147,14 @@ type (
 	orphanExecutorTask struct {
 		scvg *Scavenger
 	}
+
+	// taskListFilter decides which task lists are processed by their names
+	taskListFilter struct {
+		// allowlistEnabled is true if an allowlist was configured, even if none of its regexes is valid
+		allowlistEnabled bool
+		allowlist        []*regexp.Regexp
+		denylist         []*regexp.Regexp
+	}
 )
 
 // NewScavenger returns an instance of executorTask list scavenger daemon
@@ -139,6 +195,13 @@ func NewScavenger(
 		}
 	}
 
+	dryRun := opts.DryRun
+	if dryRun == nil {
+		dryRun = func(opts ...dynamicconfig.FilterOption) bool {
+			return false
+		}
+	}
+
 	getOrphanTasksPageSize := opts.GetOrphanTasksPageSizeFn
 	if getOrphanTasksPageSize == nil {
 		getOrphanTasksPageSize = func(opts ...dynamicconfig.FilterOption) int {
@@ -146,6 +209,13 @@ func NewScavenger(
 		}
 	}
 
+	orphanConcurrencyFn := opts.OrphanConcurrencyFn
+	if orphanConcurrencyFn == nil {
+		orphanConcurrencyFn = func(opts ...dynamicconfig.FilterOption) int {
+			return dynamicconfig.ScannerOrphanTasksDeleteConcurrency.DefaultInt()
+		}
+	}
+
 	taskBatchSizeFn := opts.TaskBatchSizeFn
 	if taskBatchSizeFn == nil {
 		taskBatchSizeFn = func(opts ...dynamicconfig.FilterOption) int {
@@ -160,10 +230,47 @@ func NewScavenger(
 		}
 	}
 
+	maxTaskDeleteBatchSizeFn := opts.MaxTaskDeleteBatchSizeFn
+	if maxTaskDeleteBatchSizeFn == nil {
+		maxTaskDeleteBatchSizeFn = func(opts ...dynamicconfig.FilterOption) int {
+			return dynamicconfig.ScannerMaxTaskDeleteBatchSize.DefaultInt()
+		}
+	}
+
+	taskListGracePeriodFn := opts.TaskListGracePeriodFn
+	if taskListGracePeriodFn == nil {
+		taskListGracePeriodFn = func(domain string) time.Duration {
+			return dynamicconfig.ScannerTaskListGracePeriod.DefaultDuration()
+		}
+	}
+
+	taskListIdleTimeoutFn := opts.TaskListIdleTimeoutFn
+	if taskListIdleTimeoutFn == nil {
+		taskListIdleTimeoutFn = func(domain string, taskList string, taskType int) time.Duration {
+			return dynamicconfig.MatchingIdleTasklistCheckInterval.DefaultDuration()
+		}
+	}
+
+	filter := taskListFilter{
+		allowlist: compileTaskListPatterns(opts.TaskListAllowlistFn, logger),
+		denylist:  compileTaskListPatterns(opts.TaskListDenylistFn, logger),
+	}
+	filter.allowlistEnabled = opts.TaskListAllowlistFn != nil && len(opts.TaskListAllowlistFn()) > 0
+
 	pollInterval := opts.ExecutorPollInterval
 	if pollInterval == 0 {
 		pollInterval = time.Minute
 	}
+
+	deleteRetryDelay := opts.DeleteTaskListRetryDelay
+	if deleteRetryDelay == 0 {
+		deleteRetryDelay = taskListDeleteRetryDelay
+	}
+
+	timeSource := opts.TimeSource
+	if timeSource == nil {
+		timeSource = clock.NewRealTimeSource()
+	}
 	return &Scavenger{
 		ctx:                      ctx,
 		db:                       db,
@@ -174,10 +281,18 @@ func NewScavenger(
 		stopped:                  make(chan struct{}),
 		executor:                 taskExecutor,
 		cleanOrphans:             cleanOrphans,
+		dryRun:                   dryRun,
 		taskBatchSizeFn:          taskBatchSizeFn,
 		pollInterval:             pollInterval,
+		deleteRetryDelay:         deleteRetryDelay,
+		timeSource:               timeSource,
 		maxTasksPerJobFn:         maxTasksPerJobFn,
+		maxTaskDeleteBatchSizeFn: maxTaskDeleteBatchSizeFn,
+		taskListGracePeriodFn:    taskListGracePeriodFn,
+		taskListIdleTimeoutFn:    taskListIdleTimeoutFn,
+		taskListFilter:           filter,
 		getOrphanTasksPageSizeFn: getOrphanTasksPageSize,
+		orphanConcurrencyFn:      orphanConcurrencyFn,
 	}
 }
 
@@ -222,7 +337,8 @@ func (s *Scavenger) run() {
 	}()
 
 	// Start a task to delete orphaned tasks from the tasks table, if enabled
-	if s.cleanOrphans() {
+	// orphans are not cleaned in dry run since the handler relies on the deleted orphans not being read again
+	if s.cleanOrphans() && !s.dryRun() {
 		s.executor.Submit(&orphanExecutorTask{scvg: s})
 	}
 
@@ -250,9 +366,39 @@ func (s *Scavenger) run() {
 	s.awaitExecutor()
 }
 
+// ScavengeTaskList deletes the expired tasks of a single task list once, without waiting for the periodic run.
+// At most MaxTasksPerJob tasks are processed per call, HasMore is set if the task list should be scavenged again.
+// Unlike the periodic run, the task list itself is never deleted as its range ID is not known.
+// Task lists excluded by the allowlist and denylist are not scavenged.
+func (s *Scavenger) ScavengeTaskList(
+	ctx context.Context,
+	domainID string,
+	name string,
+	taskType int,
+) (TaskListScavengeResult, error) {
+	if !s.taskListFilter.matches(name) {
+		return TaskListScavengeResult{}, fmt.Errorf("task list %v is excluded from scavenging", name)
+	}
+	info := &p.TaskListInfo{
+		DomainID: domainID,
+		Name:     name,
+		TaskType: taskType,
+	}
+	status, nProcessed, nDeleted, err := s.deleteTasks(ctx, info, false)
+	result := TaskListScavengeResult{
+		TasksProcessed: nProcessed,
+		TasksDeleted:   nDeleted,
+		HasMore:        status == handlerStatusDefer,
+	}
+	if err == nil {
+		err = ctx.Err()
+	}
+	return result, err
+}
+
 // process is a callback function that gets invoked from within the executor.Run() method
 func (s *Scavenger) process(taskListInfo *p.TaskListInfo) executor.TaskStatus {
-	return s.deleteHandler(taskListInfo)
+	return s.deleteHandler(s.ctx, taskListInfo)
 }
 
 func (s *Scavenger) awaitExecutor() {
@@ -268,11 +414,68 @@ func (s *Scavenger) awaitExecutor() {
 	}
 }
 
+// Stats returns a snapshot of the stats of the scavenger, it is safe to call while the scavenger is running
+func (s *Scavenger) Stats() ScavengerStats {
+	return ScavengerStats{
+		TaskListsProcessed:   atomic.LoadInt64(&s.stats.tasklist.nProcessed),
+		TaskListsDeleted:     atomic.LoadInt64(&s.stats.tasklist.nDeleted),
+		TaskListsWouldDelete: atomic.LoadInt64(&s.stats.tasklist.nWouldDelete),
+		TaskListsSkipped:     atomic.LoadInt64(&s.stats.tasklist.nSkipped),
+		TasksProcessed:       atomic.LoadInt64(&s.stats.task.nProcessed),
+		TasksDeleted:         atomic.LoadInt64(&s.stats.task.nDeleted),
+		TasksWouldDelete:     atomic.LoadInt64(&s.stats.task.nWouldDelete),
+	}
+}
+
 func (s *Scavenger) emitStats() {
-	s.scope.UpdateGauge(metrics.TaskProcessedCount, float64(s.stats.task.nProcessed))
-	s.scope.UpdateGauge(metrics.TaskDeletedCount, float64(s.stats.task.nDeleted))
-	s.scope.UpdateGauge(metrics.TaskListProcessedCount, float64(s.stats.tasklist.nProcessed))
-	s.scope.UpdateGauge(metrics.TaskListDeletedCount, float64(s.stats.tasklist.nDeleted))
+	stats := s.Stats()
+	s.scope.UpdateGauge(metrics.TaskProcessedCount, float64(stats.TasksProcessed))
+	s.scope.UpdateGauge(metrics.TaskDeletedCount, float64(stats.TasksDeleted))
+	s.scope.UpdateGauge(metrics.TaskListProcessedCount, float64(stats.TaskListsProcessed))
+	s.scope.UpdateGauge(metrics.TaskListDeletedCount, float64(stats.TaskListsDeleted))
+	s.scope.UpdateGauge(metrics.TaskWouldDeleteCount, float64(stats.TasksWouldDelete))
+	s.scope.UpdateGauge(metrics.TaskListWouldDeleteCount, float64(stats.TaskListsWouldDelete))
+	s.scope.UpdateGauge(metrics.TaskListSkippedCount, float64(stats.TaskListsSkipped))
+}
+
+// compileTaskListPatterns compiles the regexes of the list property, the invalid ones are logged and ignored
+func compileTaskListPatterns(patternsFn dynamicconfig.ListPropertyFn, logger log.Logger) []*regexp.Regexp {
+	if patternsFn == nil {
+		return nil
+	}
+	var regexps []*regexp.Regexp
+	for _, pattern := range patternsFn() {
+		patternStr, ok := pattern.(string)
+		if !ok {
+			logger.Error("Invalid task list pattern type", tag.Value(pattern))
+			continue
+		}
+		re, err := regexp.Compile(patternStr)
+		if err != nil {
+			logger.Error("Invalid task list pattern", tag.Value(patternStr), tag.Error(err))
+			continue
+		}
+		regexps = append(regexps, re)
+	}
+	return regexps
+}
+
+// matches returns true if the task list should be processed
+func (f *taskListFilter) matches(name string) bool {
+	for _, re := range f.denylist {
+		if re.MatchString(name) {
+			return false
+		}
+	}
+	if !f.allowlistEnabled {
+		return true
+	}
+	for _, re := range f.allowlist {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
 }
 
 // newTask returns a new instance of an executable task which will process a single task list
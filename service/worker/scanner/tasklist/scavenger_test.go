@@ -24,20 +24,27 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
 
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/dynamicconfig"
 	"github.com/uber/cadence/common/log/testlogger"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/mocks"
 	p "github.com/uber/cadence/common/persistence"
+	"github.com/uber/cadence/common/persistence/ratelimited"
+	"github.com/uber/cadence/common/types"
 )
 
 type (
@@ -104,6 +111,184 @@ func (s *ScavengerTestSuite) TestAllExpiredTasks() {
 		s.Equal(0, len(tasks), "failed to delete all expired tasks")
 		s.Nil(s.taskListTable.get(tl), "failed to delete expired executorTask list")
 	}
+	s.Equal(ScavengerStats{
+		TaskListsProcessed: int64(nTaskLists),
+		TaskListsDeleted:   int64(nTaskLists),
+		TasksProcessed:     int64(nTasks * nTaskLists),
+		TasksDeleted:       int64(nTasks * nTaskLists),
+	}, s.scvgr.Stats())
+}
+
+func (s *ScavengerTestSuite) TestAllExpiredTasksDryRun() {
+	s.scvgr.dryRun = dynamicconfig.GetBoolPropertyFn(true)
+	nTaskLists := 3
+	for i := 0; i < nTaskLists; i++ {
+		name := fmt.Sprintf("test-dry-run-tl-%v", i)
+		s.taskListTable.generate(name, true)
+		tt := newMockTaskTable()
+		tt.generate(i, true)
+		s.taskTables[name] = tt
+	}
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain_name", nil).AnyTimes()
+	s.setupTaskMgrMocks()
+	s.runScavenger()
+	for i := 0; i < nTaskLists; i++ {
+		name := fmt.Sprintf("test-dry-run-tl-%v", i)
+		s.Len(s.taskTables[name].get(100), i, "scavenger deleted tasks in dry run")
+		s.NotNil(s.taskListTable.get(name), "scavenger deleted task list in dry run")
+	}
+	s.taskMgr.AssertNotCalled(s.T(), "CompleteTasksLessThan", mock.Anything, mock.Anything)
+	s.taskMgr.AssertNotCalled(s.T(), "DeleteTaskList", mock.Anything, mock.Anything)
+	s.taskMgr.AssertNotCalled(s.T(), "GetOrphanTasks", mock.Anything, mock.Anything)
+	s.Equal(int64(0+1+2), s.scvgr.stats.task.nWouldDelete)
+	s.Equal(int64(nTaskLists), s.scvgr.stats.tasklist.nWouldDelete)
+	s.Equal(int64(0), s.scvgr.stats.task.nDeleted)
+	s.Equal(int64(0), s.scvgr.stats.tasklist.nDeleted)
+}
+
+func (s *ScavengerTestSuite) TestTaskListGracePeriodPerDomain() {
+	s.taskListTable.generate("test-idle-tl", true)
+	s.taskListTable.generate("test-bursty-tl", true)
+	domainNames := map[string]string{
+		s.taskListTable.info[0].DomainID: "test_domain_name",
+		s.taskListTable.info[1].DomainID: "bursty_domain_name",
+	}
+	for _, info := range s.taskListTable.info {
+		s.taskTables[info.Name] = newMockTaskTable()
+	}
+	s.scvgr.taskListGracePeriodFn = func(domain string) time.Duration {
+		if domain == "bursty_domain_name" {
+			return time.Since(time.Unix(0, 0))
+		}
+		return dynamicconfig.ScannerTaskListGracePeriod.DefaultDuration()
+	}
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).DoAndReturn(func(domainID string) (string, error) {
+		return domainNames[domainID], nil
+	}).AnyTimes()
+	s.setupTaskMgrMocks()
+	s.runScavenger()
+	s.Nil(s.taskListTable.get("test-idle-tl"), "failed to delete idle task list")
+	s.NotNil(s.taskListTable.get("test-bursty-tl"), "scavenger deleted a task list within the grace period of its domain")
+}
+
+func (s *ScavengerTestSuite) TestTaskListGracePeriodClampedToIdleTimeout() {
+	s.taskListTable.generate("test-idle-tl", true)
+	s.taskListTable.generate("test-loaded-tl", false)
+	for _, info := range s.taskListTable.info {
+		s.taskTables[info.Name] = newMockTaskTable()
+	}
+	s.scvgr.taskListGracePeriodFn = func(domain string) time.Duration {
+		return 0
+	}
+	s.scvgr.taskListIdleTimeoutFn = func(domain string, taskList string, taskType int) time.Duration {
+		return time.Hour
+	}
+	s.Equal(time.Hour, s.scvgr.taskListGracePeriod("test_domain_name", &s.taskListTable.info[0]))
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain_name", nil).AnyTimes()
+	s.setupTaskMgrMocks()
+	s.runScavenger()
+	s.Nil(s.taskListTable.get("test-idle-tl"), "failed to delete idle task list")
+	s.NotNil(s.taskListTable.get("test-loaded-tl"), "scavenger deleted a task list which matching may not have unloaded yet")
+}
+
+func (s *ScavengerTestSuite) TestTaskListDenylist() {
+	s.scvgr.taskListFilter = taskListFilter{denylist: []*regexp.Regexp{regexp.MustCompile("^sticky-")}}
+	s.taskListTable.generate("sticky-tl", true)
+	s.taskListTable.generate("test-expired-tl", true)
+	for _, info := range s.taskListTable.info {
+		tt := newMockTaskTable()
+		tt.generate(4, true)
+		s.taskTables[info.Name] = tt
+	}
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain_name", nil).AnyTimes()
+	s.setupTaskMgrMocks()
+	s.runScavenger()
+	s.Len(s.taskTables["sticky-tl"].get(100), 4, "scavenger deleted tasks of a denied task list")
+	s.NotNil(s.taskListTable.get("sticky-tl"), "scavenger deleted a denied task list")
+	s.Empty(s.taskTables["test-expired-tl"].get(100))
+	s.Nil(s.taskListTable.get("test-expired-tl"))
+	s.Equal(int64(1), s.scvgr.stats.tasklist.nSkipped)
+}
+
+func (s *ScavengerTestSuite) TestDeleteHandlerDefersWhenContextDone() {
+	info := &p.TaskListInfo{DomainID: "domain-id", Name: "test-tl"}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	s.Equal(handlerStatusDefer, s.scvgr.deleteHandler(ctx, info))
+
+	ctx, cancel = context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	s.Equal(handlerStatusDefer, s.scvgr.deleteHandler(ctx, info))
+	s.taskMgr.AssertNotCalled(s.T(), "GetTasks", mock.Anything, mock.Anything)
+}
+
+func (s *ScavengerTestSuite) TestScavengeTaskList() {
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain_name", nil).AnyTimes()
+	s.taskListTable.generate("test-expired-tl", true)
+	tt := newMockTaskTable()
+	tt.generate(32, true)
+	s.taskTables["test-expired-tl"] = tt
+	s.setupTaskMgrMocks()
+
+	result, err := s.scvgr.ScavengeTaskList(context.Background(), "domain-id", "test-expired-tl", p.TaskListTypeDecision)
+	s.NoError(err)
+	s.Equal(TaskListScavengeResult{TasksProcessed: 32, TasksDeleted: 32}, result)
+	s.Empty(tt.get(100))
+	s.NotNil(s.taskListTable.get("test-expired-tl"), "task list should not be deleted on demand")
+	s.taskMgr.AssertNotCalled(s.T(), "ListTaskList", mock.Anything, mock.Anything)
+	s.taskMgr.AssertNotCalled(s.T(), "DeleteTaskList", mock.Anything, mock.Anything)
+}
+
+func (s *ScavengerTestSuite) TestScavengeTaskListHasMore() {
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain_name", nil).AnyTimes()
+	s.scvgr.maxTasksPerJobFn = dynamicconfig.GetIntPropertyFn(16)
+	tt := newMockTaskTable()
+	tt.generate(32, true)
+	s.taskTables["test-expired-tl"] = tt
+	s.setupTaskMgrMocks()
+
+	result, err := s.scvgr.ScavengeTaskList(context.Background(), "domain-id", "test-expired-tl", p.TaskListTypeDecision)
+	s.NoError(err)
+	s.Equal(TaskListScavengeResult{TasksProcessed: 16, TasksDeleted: 16, HasMore: true}, result)
+	s.Len(tt.get(100), 16)
+}
+
+func (s *ScavengerTestSuite) TestScavengeTaskListUsesCallerContext() {
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain_name", nil).AnyTimes()
+	tt := newMockTaskTable()
+	tt.generate(4, true)
+	s.taskTables["test-expired-tl"] = tt
+	s.setupTaskMgrMocks()
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "caller")
+	_, err := s.scvgr.ScavengeTaskList(ctx, "domain-id", "test-expired-tl", p.TaskListTypeDecision)
+	s.NoError(err)
+
+	s.taskMgr.AssertCalled(s.T(), "GetTasks", mock.Anything, mock.Anything)
+	s.taskMgr.AssertCalled(s.T(), "CompleteTasksLessThan", mock.Anything, mock.Anything)
+	for _, call := range s.taskMgr.Calls {
+		s.Equal("caller", call.Arguments.Get(0).(context.Context).Value(ctxKey{}), call.Method)
+	}
+}
+
+func (s *ScavengerTestSuite) TestScavengeTaskListErrors() {
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain_name", nil).AnyTimes()
+	s.scvgr.taskListFilter = taskListFilter{denylist: []*regexp.Regexp{regexp.MustCompile("^sticky-")}}
+	_, err := s.scvgr.ScavengeTaskList(context.Background(), "domain-id", "sticky-tl", p.TaskListTypeDecision)
+	s.Error(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result, err := s.scvgr.ScavengeTaskList(ctx, "domain-id", "test-tl", p.TaskListTypeDecision)
+	s.ErrorIs(err, context.Canceled)
+	s.True(result.HasMore)
+
+	s.taskMgr.On("GetTasks", mock.Anything, mock.Anything).Return(nil, errTest).Once()
+	_, err = s.scvgr.ScavengeTaskList(context.Background(), "domain-id", "test-tl", p.TaskListTypeDecision)
+	s.Error(err)
+	s.taskMgr.AssertNotCalled(s.T(), "CompleteTasksLessThan", mock.Anything, mock.Anything)
 }
 
 func (s *ScavengerTestSuite) TestAllAliveTasks() {
@@ -148,6 +333,74 @@ func (s *ScavengerTestSuite) TestExpiredTasksFollowedByAlive() {
 	}
 }
 
+func (s *ScavengerTestSuite) TestOutOfOrderExpiryMetrics() {
+	testScope := tally.NewTestScope("", nil)
+	s.scvgr.scope = metrics.NewClient(testScope, metrics.Worker).Scope(metrics.TaskListScavengerScope)
+	name := "test-out-of-order-tl"
+	s.taskListTable.generate(name, true)
+	tt := newMockTaskTable()
+	tt.generate(2, false)
+	tt.generate(3, true)
+	s.taskTables[name] = tt
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain_name", nil).AnyTimes()
+	s.setupTaskMgrMocks()
+	s.runScavenger()
+
+	s.Len(s.taskTables[name].get(100), 5, "scavenger deleted tasks after a non-expired one")
+	snapshot := testScope.Snapshot()
+	var nNotExpired int64
+	for _, counter := range snapshot.Counters() {
+		if counter.Name() == "task_not_expired" {
+			nNotExpired += counter.Value()
+		}
+	}
+	s.Equal(int64(2), nNotExpired)
+	var nGaps int
+	for _, timer := range snapshot.Timers() {
+		if timer.Name() == "task_oldest_expiry_gap" {
+			s.Equal([]time.Duration{0}, timer.Values(), "the oldest task is not expired")
+			nGaps++
+		}
+	}
+	s.Equal(1, nGaps)
+}
+
+func (s *ScavengerTestSuite) TestTaskExpiryUsesTimeSource() {
+	testScope := tally.NewTestScope("", nil)
+	s.scvgr.scope = metrics.NewClient(testScope, metrics.Worker).Scope(metrics.TaskListScavengerScope)
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	s.scvgr.timeSource = timeSource
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain_name", nil).AnyTimes()
+	tt := newMockTaskTable()
+	tt.generate(4, false)
+	s.taskTables["test-tl"] = tt
+	s.setupTaskMgrMocks()
+
+	// the tasks expire in an hour
+	result, err := s.scvgr.ScavengeTaskList(context.Background(), "domain-id", "test-tl", p.TaskListTypeDecision)
+	s.NoError(err)
+	s.Equal(TaskListScavengeResult{TasksProcessed: 1}, result)
+	s.Len(tt.get(100), 4)
+
+	timeSource.Update(timeSource.Now().Add(2 * time.Hour))
+	result, err = s.scvgr.ScavengeTaskList(context.Background(), "domain-id", "test-tl", p.TaskListTypeDecision)
+	s.NoError(err)
+	s.Equal(TaskListScavengeResult{TasksProcessed: 4, TasksDeleted: 4}, result)
+	s.Empty(tt.get(100))
+
+	var gaps []time.Duration
+	for _, timer := range testScope.Snapshot().Timers() {
+		if timer.Name() == "task_oldest_expiry_gap" {
+			gaps = append(gaps, timer.Values()...)
+		}
+	}
+	// the oldest task wasn't expired on the first run, and expired about an hour before the second one
+	s.Len(gaps, 2)
+	sort.Slice(gaps, func(i, j int) bool { return gaps[i] < gaps[j] })
+	s.Equal(time.Duration(0), gaps[0])
+	s.InDelta(time.Hour, gaps[1], float64(time.Minute))
+}
+
 func (s *ScavengerTestSuite) TestAliveTasksFollowedByExpired() {
 	nTasks := 32
 	nTaskLists := 3
@@ -190,6 +443,204 @@ func (s *ScavengerTestSuite) TestAllExpiredTasksWithErrors() {
 	s.Equal(1, len(result), "expected partial deletion due to transient errors")
 }
 
+func (s *ScavengerTestSuite) TestAllExpiredTasksChunkedDelete() {
+	nTasks := 32
+	nTaskLists := 3
+	maxDeleteBatchSize := 5
+	s.scvgr.maxTaskDeleteBatchSizeFn = dynamicconfig.GetIntPropertyFn(maxDeleteBatchSize)
+	for i := 0; i < nTaskLists; i++ {
+		name := fmt.Sprintf("test-expired-chunked-tl-%v", i)
+		s.taskListTable.generate(name, true)
+		tt := newMockTaskTable()
+		tt.generate(nTasks, true)
+		s.taskTables[name] = tt
+	}
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain_name", nil).AnyTimes()
+	s.setupTaskMgrMocks()
+	s.runScavenger()
+	for tl, tbl := range s.taskTables {
+		tasks := tbl.get(100)
+		s.Equal(0, len(tasks), "failed to delete all expired tasks")
+		s.Nil(s.taskListTable.get(tl), "failed to delete expired executorTask list")
+	}
+	for _, call := range s.taskMgr.Calls {
+		if call.Method == "CompleteTasksLessThan" {
+			req := call.Arguments.Get(1).(*p.CompleteTasksLessThanRequest)
+			s.LessOrEqual(req.Limit, maxDeleteBatchSize, "delete exceeded max delete batch size")
+		}
+	}
+	s.Equal(int64(nTasks*nTaskLists), s.scvgr.stats.task.nDeleted)
+}
+
+func (s *ScavengerTestSuite) TestAllExpiredTasksChunkedDeleteIgnoringLimit() {
+	nTasks := 12
+	maxDeleteBatchSize := 5
+	name := "test-expired-unknown-rows-tl"
+	s.scvgr.maxTaskDeleteBatchSizeFn = dynamicconfig.GetIntPropertyFn(maxDeleteBatchSize)
+	s.taskListTable.generate(name, true)
+	tt := newMockTaskTable()
+	tt.generate(nTasks, true)
+	s.taskTables[name] = tt
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain_name", nil).AnyTimes()
+	// backends like cassandra ignore the limit, delete the whole range and can't report the affected rows
+	s.taskMgr.On("CompleteTasksLessThan", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *p.CompleteTasksLessThanRequest) *p.CompleteTasksLessThanResponse {
+			rowsDeleted := s.taskTables[req.TaskListName].deleteLessThan(req.TaskID, nTasks)
+			s.LessOrEqual(rowsDeleted, maxDeleteBatchSize, "delete exceeded max delete batch size")
+			return &p.CompleteTasksLessThanResponse{TasksCompleted: p.UnknownNumRowsAffected}
+		}, nil)
+	s.setupTaskMgrMocks()
+	s.runScavenger()
+	s.Empty(tt.get(100), "failed to delete all expired tasks")
+	s.Nil(s.taskListTable.get(name), "failed to delete expired executorTask list")
+	s.taskMgr.AssertNumberOfCalls(s.T(), "CompleteTasksLessThan", 3)
+	s.Equal(int64(nTasks), s.scvgr.stats.task.nDeleted)
+}
+
+func (s *ScavengerTestSuite) TestDeleteTaskListConditionFailedRetryAbortsWhenActive() {
+	name := "test-reacquired-tl"
+	s.taskListTable.generate(name, true)
+	s.taskTables[name] = newMockTaskTable()
+
+	timeSource := clock.NewEventTimeSource().Update(time.Now())
+	s.scvgr.timeSource = timeSource
+	retryDelay := time.Second
+	s.scvgr.deleteRetryDelay = retryDelay
+
+	nDeleteCalls := 0
+	advanceClockDone := make(chan struct{})
+	defer close(advanceClockDone)
+	s.taskMgr.On("DeleteTaskList", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *p.DeleteTaskListRequest) error {
+			nDeleteCalls++
+			// matching re-acquires the task list and adds a task to it
+			s.taskTables[req.TaskListName].generate(1, false)
+			go func() {
+				for {
+					select {
+					case <-advanceClockDone:
+						return
+					case <-time.After(time.Millisecond):
+						timeSource.Update(timeSource.Now().Add(retryDelay))
+					}
+				}
+			}()
+			return &p.ConditionFailedError{Msg: "rangeID mismatch"}
+		})
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain_name", nil).AnyTimes()
+	s.setupTaskMgrMocks()
+	s.runScavenger()
+
+	s.Equal(1, nDeleteCalls, "expected the retry to abort before deleting the active task list")
+	s.NotNil(s.taskListTable.get(name), "scavenger deleted an active task list")
+	s.Len(s.taskTables[name].get(100), 1)
+	s.Equal(int64(0), s.scvgr.stats.tasklist.nDeleted)
+}
+
+func (s *ScavengerTestSuite) TestDeleteTaskListConditionFailedRetryUsesCurrentRangeID() {
+	tl := s.setupReleasedTaskList(false)
+	s.runScavenger()
+	s.Nil(s.taskListTable.get(tl), "failed to delete the task list with its current range ID")
+	s.Equal(int64(1), s.scvgr.stats.tasklist.nDeleted)
+}
+
+func (s *ScavengerTestSuite) TestDeleteTaskListConditionFailedRetryAbortsWhenUpdated() {
+	tl := s.setupReleasedTaskList(true)
+	s.runScavenger()
+	s.NotNil(s.taskListTable.get(tl), "scavenger deleted an updated task list")
+	s.Equal(int64(0), s.scvgr.stats.tasklist.nDeleted)
+}
+
+// setupReleasedTaskList creates an idle task list which is leased again after it was listed by the scavenger,
+// touch updates its last updated time as well
+func (s *ScavengerTestSuite) setupReleasedTaskList(touch bool) string {
+	tl := "test-released-tl"
+	s.taskListTable.generate(tl, true)
+	s.taskTables[tl] = newMockTaskTable()
+	s.scvgr.deleteRetryDelay = 0
+
+	s.taskMgr.On("DeleteTaskList", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *p.DeleteTaskListRequest) error {
+			s.taskListTable.update(tl, func(info *p.TaskListInfo) {
+				info.RangeID++
+				if touch {
+					info.LastUpdated = time.Now()
+				}
+			})
+			return &p.ConditionFailedError{Msg: "rangeID mismatch"}
+		}).Once()
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain_name", nil).AnyTimes()
+	s.setupTaskMgrMocks()
+	return tl
+}
+
+func (s *ScavengerTestSuite) TestCompleteOrphanTasksConcurrently() {
+	concurrency := 4
+	nOrphans := 10
+	s.scvgr.orphanConcurrencyFn = dynamicconfig.GetIntPropertyFn(concurrency)
+	orphans := make([]*p.TaskKey, nOrphans)
+	for i := range orphans {
+		orphans[i] = &p.TaskKey{DomainID: "domain-id", TaskListName: "orphan-tl", TaskID: int64(i)}
+	}
+	s.taskMgr.On("GetOrphanTasks", mock.Anything, mock.Anything).Return(&p.GetOrphanTasksResponse{Tasks: orphans}, nil)
+	var nRunning, maxRunning int32
+	s.taskMgr.On("CompleteTask", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *p.CompleteTaskRequest) error {
+			running := atomic.AddInt32(&nRunning, 1)
+			defer atomic.AddInt32(&nRunning, -1)
+			for {
+				maxRunningSoFar := atomic.LoadInt32(&maxRunning)
+				if running <= maxRunningSoFar || atomic.CompareAndSwapInt32(&maxRunning, maxRunningSoFar, running) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			return nil
+		})
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain_name", nil).AnyTimes()
+
+	s.Equal(handlerStatusDone, s.scvgr.completeOrphanTasksHandler())
+	s.taskMgr.AssertNumberOfCalls(s.T(), "CompleteTask", nOrphans)
+	s.LessOrEqual(atomic.LoadInt32(&maxRunning), int32(concurrency))
+	s.Greater(atomic.LoadInt32(&maxRunning), int32(1))
+	s.Equal(int64(nOrphans), s.scvgr.stats.task.nDeleted)
+	s.Equal(int64(nOrphans), s.scvgr.stats.task.nProcessed)
+}
+
+func (s *ScavengerTestSuite) TestCompleteOrphanTasksConcurrentlyRatelimited() {
+	s.scvgr.orphanConcurrencyFn = dynamicconfig.GetIntPropertyFn(4)
+	orphans := make([]*p.TaskKey, 10)
+	for i := range orphans {
+		orphans[i] = &p.TaskKey{DomainID: "domain-id", TaskListName: "orphan-tl", TaskID: int64(i)}
+	}
+	s.taskMgr.On("GetOrphanTasks", mock.Anything, mock.Anything).Return(&p.GetOrphanTasksResponse{Tasks: orphans}, nil)
+	s.taskMgr.On("CompleteTask", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *p.CompleteTaskRequest) error {
+			if req.TaskID == 0 {
+				return ratelimited.ErrPersistenceLimitExceeded
+			}
+			return nil
+		})
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain_name", nil).AnyTimes()
+
+	s.Equal(handlerStatusDefer, s.scvgr.completeOrphanTasksHandler())
+	s.Less(s.scvgr.stats.task.nDeleted, int64(len(orphans)))
+}
+
+func (s *ScavengerTestSuite) TestCompleteOrphanTasksConcurrentlyError() {
+	s.scvgr.orphanConcurrencyFn = dynamicconfig.GetIntPropertyFn(4)
+	orphans := []*p.TaskKey{
+		{DomainID: "domain-id", TaskListName: "orphan-tl", TaskID: 1},
+		{DomainID: "domain-id", TaskListName: "orphan-tl", TaskID: 2},
+	}
+	s.taskMgr.On("GetOrphanTasks", mock.Anything, mock.Anything).Return(&p.GetOrphanTasksResponse{Tasks: orphans}, nil)
+	s.taskMgr.On("CompleteTask", mock.Anything, mock.Anything).Return(errTest)
+	s.mockDomainCache.EXPECT().GetDomainName(gomock.Any()).Return("test_domain_name", nil).AnyTimes()
+
+	s.Equal(handlerStatusErr, s.scvgr.completeOrphanTasksHandler())
+	s.Equal(int64(0), s.scvgr.stats.task.nDeleted)
+}
+
 func (s *ScavengerTestSuite) runScavenger() {
 	s.scvgr.Start()
 	defer s.scvgr.Stop()
@@ -209,8 +660,19 @@ func (s *ScavengerTestSuite) setupTaskMgrMocks() {
 			items, next := s.taskListTable.list(req.PageToken, req.PageSize)
 			return &p.ListTaskListResponse{Items: items, NextPageToken: next}
 		}, nil)
+	s.taskMgr.On("GetTaskList", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *p.GetTaskListRequest) (*p.GetTaskListResponse, error) {
+			info := s.taskListTable.get(req.TaskList)
+			if info == nil {
+				return nil, &types.EntityNotExistsError{Message: "task list not found"}
+			}
+			return &p.GetTaskListResponse{TaskListInfo: info}, nil
+		})
 	s.taskMgr.On("DeleteTaskList", mock.Anything, mock.Anything).Return(
 		func(_ context.Context, req *p.DeleteTaskListRequest) error {
+			if info := s.taskListTable.get(req.TaskListName); info != nil && info.RangeID != req.RangeID {
+				return &p.ConditionFailedError{Msg: "rangeID mismatch"}
+			}
 			s.taskListTable.delete(req.TaskListName)
 			return nil
 		})
@@ -240,3 +702,27 @@ func (s *ScavengerTestSuite) setupTaskMgrMocksWithErrors() {
 	s.taskMgr.On("GetOrphanTasks", mock.Anything, mock.Anything).Return(nil, errTest).Once()
 	s.setupTaskMgrMocks()
 }
+
+func TestTaskListFilter(t *testing.T) {
+	logger := testlogger.New(t)
+	newFilter := func(allowlist, denylist []interface{}) taskListFilter {
+		scvgr := NewScavenger(context.Background(), nil, metrics.NewNoopMetricsClient(), logger, &Options{
+			TaskListAllowlistFn: dynamicconfig.GetListPropertyFn(allowlist),
+			TaskListDenylistFn:  dynamicconfig.GetListPropertyFn(denylist),
+		}, nil)
+		return scvgr.taskListFilter
+	}
+
+	filter := newFilter(nil, nil)
+	assert.True(t, filter.matches("any-tl"))
+
+	filter = newFilter([]interface{}{"^batch-", "^cron-"}, []interface{}{"^batch-sticky"})
+	assert.True(t, filter.matches("batch-tl"))
+	assert.True(t, filter.matches("cron-tl"))
+	assert.False(t, filter.matches("batch-sticky-tl"))
+	assert.False(t, filter.matches("other-tl"))
+
+	// an allowlist with only invalid regexes must not allow every task list
+	filter = newFilter([]interface{}{"(", 1}, nil)
+	assert.False(t, filter.matches("any-tl"))
+}
@@ -29,6 +29,7 @@ import (
 	cclient "go.uber.org/cadence/client"
 	"go.uber.org/cadence/workflow"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/service/worker/scanner/executions"
 	"github.com/uber/cadence/service/worker/scanner/history"
@@ -179,7 +180,7 @@ func TaskListScavengerActivity(
 			scavenger.Stop()
 			return activityCtx.Err()
 		}
-		time.Sleep(tlScavengerHBInterval)
+		_ = common.SleepWithJitter(activityCtx, tlScavengerHBInterval, 0.1)
 	}
 	return nil
 }
@@ -136,9 +136,16 @@ func NewConfig(params *resource.Params) *Config {
 			ScannerPersistenceMaxQPS: dc.GetIntProperty(dynamicconfig.ScannerPersistenceMaxQPS),
 			TaskListScannerOptions: tasklist.Options{
 				GetOrphanTasksPageSizeFn: dc.GetIntProperty(dynamicconfig.ScannerGetOrphanTasksPageSize),
+				OrphanConcurrencyFn:      dc.GetIntProperty(dynamicconfig.ScannerOrphanTasksDeleteConcurrency),
 				TaskBatchSizeFn:          dc.GetIntProperty(dynamicconfig.ScannerBatchSizeForTasklistHandler),
 				EnableCleaning:           dc.GetBoolProperty(dynamicconfig.EnableCleaningOrphanTaskInTasklistScavenger),
 				MaxTasksPerJobFn:         dc.GetIntProperty(dynamicconfig.ScannerMaxTasksProcessedPerTasklistJob),
+				MaxTaskDeleteBatchSizeFn: dc.GetIntProperty(dynamicconfig.ScannerMaxTaskDeleteBatchSize),
+				TaskListGracePeriodFn:    dc.GetDurationPropertyFilteredByDomain(dynamicconfig.ScannerTaskListGracePeriod),
+				TaskListIdleTimeoutFn:    dc.GetDurationPropertyFilteredByTaskListInfo(dynamicconfig.MatchingIdleTasklistCheckInterval),
+				TaskListAllowlistFn:      dc.GetListProperty(dynamicconfig.ScannerTaskListAllowlist),
+				TaskListDenylistFn:       dc.GetListProperty(dynamicconfig.ScannerTaskListDenylist),
+				DryRun:                   dc.GetBoolProperty(dynamicconfig.TaskListScavengerDryRun),
 			},
 			Persistence:            &params.PersistenceConfig,
 			ClusterMetadata:        params.ClusterMetadata,
@@ -102,7 +102,18 @@ func CheckCompatibleVersion(
 	expectedConsistency gocql.Consistency,
 ) error {
 
-	client, err := NewCQLClient(&CQLClientConfig{
+	client, err := NewCQLClientFromConfig(cfg, expectedConsistency)
+	if err != nil {
+		return fmt.Errorf("creating CQL client: %w", err)
+	}
+	defer client.Close()
+
+	return schema.VerifyCompatibleVersion(client, cfg.Keyspace, expectedVersion)
+}
+
+// NewCQLClientFromConfig creates a CQL client connected to the keyspace of the persistence config
+func NewCQLClientFromConfig(cfg config.Cassandra, expectedConsistency gocql.Consistency) (CqlClient, error) {
+	return NewCQLClient(&CQLClientConfig{
 		Hosts:                 cfg.Hosts,
 		Port:                  cfg.Port,
 		User:                  cfg.User,
@@ -114,12 +125,6 @@ func CheckCompatibleVersion(
 		TLS:                   cfg.TLS,
 		ProtoVersion:          cfg.ProtoVersion,
 	}, expectedConsistency)
-	if err != nil {
-		return fmt.Errorf("creating CQL client: %w", err)
-	}
-	defer client.Close()
-
-	return schema.VerifyCompatibleVersion(client, cfg.Keyspace, expectedVersion)
 }
 
 // setupSchema executes the setupSchemaTask